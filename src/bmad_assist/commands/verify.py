@@ -10,6 +10,7 @@ import logging
 import sys
 from collections.abc import Sequence
 from dataclasses import replace
+from datetime import date
 from pathlib import Path
 
 import typer
@@ -34,10 +35,17 @@ from bmad_assist.deep_verify.core.types import (
     ArtifactDomain,
     DomainConfidence,
     DomainDetectionResult,
+    PatternEffort,
     Severity,
     Verdict,
     VerdictDecision,
 )
+from bmad_assist.deep_verify.patterns.matcher import PatternMatcher
+from bmad_assist.deep_verify.scan import (
+    DEFAULT_LOAD_CONCURRENCY,
+    DEFAULT_MAX_FILE_BYTES,
+    DEFAULT_SAMPLE_SEED,
+)
 
 logger = logging.getLogger(__name__)
 
@@ -588,3 +596,1361 @@ def verify_run(
         raise typer.Exit(code=EXIT_REJECT)
     else:  # UNCERTAIN
         raise typer.Exit(code=EXIT_UNCERTAIN)
+
+
+@verify_app.command("explain")
+def verify_explain(
+    pattern_id: str = typer.Argument(
+        ...,
+        help="Pattern ID to explain (e.g., CC-004 or CC-004-CODE-GO)",
+    ),
+) -> None:
+    """Explain a Deep Verify pattern.
+
+    Prints the pattern's title, description, why it matters, a bad example,
+    a good example, and the help URL. A base ID such as CC-004 also explains
+    every language-specific code pattern sharing it.
+
+    Examples:
+        bmad-assist verify explain CC-004
+        bmad-assist verify explain SEC-001-CODE-GO
+
+    """
+    from bmad_assist.core.exceptions import PatternNotFoundError
+    from bmad_assist.deep_verify.patterns.explain import (
+        explain_pattern,
+        format_explanation,
+    )
+
+    try:
+        explanations = explain_pattern(pattern_id)
+    except PatternNotFoundError as e:
+        _error(f"{e}. Pattern IDs look like CC-004 or CC-004-CODE-GO.")
+        raise typer.Exit(code=EXIT_ERROR) from None
+
+    for idx, explanation in enumerate(explanations):
+        if idx:
+            console.print()
+        # Examples contain brackets, so bypass Rich markup
+        console.print(format_explanation(explanation), markup=False, highlight=False)
+
+
+@verify_app.command("scan")
+def verify_scan(
+    path: str = typer.Argument(
+        ".",
+        help="File or directory to scan",
+    ),
+    output: str = typer.Option(
+        "text",
+        "--output",
+        "-o",
+        help="Output format: text, json, gitlab (Code Quality report), github (Actions "
+        "annotations), sarif, fingerprints (one finding fingerprint per line), badge "
+        "(SVG status badge), or html (interactive report)",
+    ),
+    sarif_baseline: str | None = typer.Option(
+        None,
+        "--sarif-baseline",
+        help="JSON report of an earlier scan; marks SARIF results new, unchanged or absent",
+    ),
+    sarif_delta: bool = typer.Option(
+        False,
+        "--sarif-delta",
+        help="Write only new and absent SARIF results (requires --sarif-baseline)",
+    ),
+    import_sarif_logs: list[str] | None = typer.Option(
+        None,
+        "--import-sarif",
+        help="Merge another tool's SARIF log into the report, as TOOL=PATH (repeatable)",
+    ),
+    sqlite_path: str | None = typer.Option(
+        None,
+        "--sqlite",
+        help="Also write the report to a SQLite database at this path",
+    ),
+    sqlite_append: bool = typer.Option(
+        False,
+        "--append",
+        help="Add a new run to the --sqlite database instead of replacing it",
+    ),
+    commit_sha: str | None = typer.Option(
+        None,
+        "--commit",
+        help="Commit SHA recorded on the --sqlite run (default: HEAD of the scanned tree)",
+    ),
+    split_by_owner_dir: str | None = typer.Option(
+        None,
+        "--split-by-owner",
+        help="Also write one JSON report per CODEOWNERS owner to this directory",
+    ),
+    codeowners_path: str | None = typer.Option(
+        None,
+        "--codeowners",
+        help="CODEOWNERS file for --split-by-owner (default: found from the scan path)",
+    ),
+    threshold: float = typer.Option(
+        PatternMatcher.DEFAULT_THRESHOLD,
+        "--threshold",
+        help="Minimum pattern confidence (0.0-1.0)",
+    ),
+    preset: str | None = typer.Option(
+        None,
+        "--preset",
+        help="Options preset: high-signal (only high-precision patterns, threshold at "
+        "least 0.9)",
+    ),
+    since_version: str | None = typer.Option(
+        None,
+        "--since-version",
+        help="Run only patterns added or changed after this bmad-assist release (X.Y.Z)",
+    ),
+    fail_on: str = typer.Option(
+        "error",
+        "--fail-on",
+        help="Lowest severity that fails the scan: critical, error, warning, info, or none",
+    ),
+    ratchets: list[str] | None = typer.Option(
+        None,
+        "--ratchet",
+        help="Fail on a domain's findings only from a date, as DOMAIN=YYYY-MM-DD (repeatable)",
+    ),
+    label_specs: list[str] | None = typer.Option(
+        None,
+        "--label",
+        help="Attach metadata to the report's JSON and SARIF output, as KEY=VALUE (repeatable)",
+    ),
+    baseline_path: str | None = typer.Option(
+        None,
+        "--baseline",
+        help="Report only findings not accepted in this baseline file (see verify "
+        "baseline-create)",
+    ),
+    no_config: bool = typer.Option(
+        False,
+        "--no-config",
+        help="Ignore .deepverify.yaml files",
+    ),
+    show_suppressed: bool = typer.Option(
+        False,
+        "--show-suppressed",
+        help="Also report findings silenced by deepverify:ignore comments",
+    ),
+    include_generated: bool = typer.Option(
+        False,
+        "--include-generated",
+        help="Analyze generated files with concurrency detectors only (skipped by default)",
+    ),
+    cache_path: str | None = typer.Option(
+        None,
+        "--cache",
+        help="Reuse and update per-file results in this cache file (see verify warm)",
+    ),
+    changed_files_from: str | None = typer.Option(
+        None,
+        "--changed-files-from",
+        help="Manifest of changed files (one per line); with --cache, re-analyze only "
+        "these files and their directories",
+    ),
+    goarch: str | None = typer.Option(
+        None,
+        "--goarch",
+        help="GOARCH the code is built for; 32-bit targets raise atomic alignment findings",
+    ),
+    exported_only: bool = typer.Option(
+        False,
+        "--exported-only",
+        help="Report only findings inside exported Go functions, methods and types",
+    ),
+    summary_only: bool = typer.Option(
+        False,
+        "--summary-only",
+        help="Skip titles and snippets; text output prints only counts (for CI gating)",
+    ),
+    max_file_bytes: int = typer.Option(
+        DEFAULT_MAX_FILE_BYTES,
+        "--max-file-bytes",
+        help="Skip files larger than this many bytes (0 = no limit)",
+    ),
+    max_findings: int = typer.Option(
+        0,
+        "--max-findings",
+        help=(
+            "Report at most this many findings, keeping all critical and error findings "
+            "and sampling warnings and infos (0 = no limit)"
+        ),
+    ),
+    seed: int = typer.Option(
+        DEFAULT_SAMPLE_SEED,
+        "--seed",
+        help="Seed of the --max-findings sample; the same seed keeps the same findings",
+    ),
+    load_concurrency: int = typer.Option(
+        DEFAULT_LOAD_CONCURRENCY,
+        "--load-concurrency",
+        help="Worker threads reading files (IO-bound)",
+    ),
+    analyze_concurrency: int = typer.Option(
+        0,
+        "--analyze-concurrency",
+        help="Worker threads matching patterns (CPU-bound, 0 = CPU count)",
+    ),
+    reproducible: bool = typer.Option(
+        False,
+        "--reproducible",
+        help="Report zero durations for reproducible output",
+    ),
+    json_indent: str = typer.Option(
+        "  ",
+        "--json-indent",
+        help="Indentation of --output json (empty = compact single-line JSON)",
+    ),
+    verbose: bool = typer.Option(
+        False,
+        "--verbose",
+        "-v",
+        help="Enable verbose output with debug logging",
+    ),
+) -> None:
+    """Scan a source tree with the code pattern library.
+
+    Runs only deterministic pattern matching (no LLM calls). Each file uses
+    the nearest .deepverify.yaml config in its directory or an ancestor
+    directory up to the scan root.
+
+    Examples:
+        bmad-assist verify scan .
+        bmad-assist verify scan services/payments --output json
+        bmad-assist verify scan . --fail-on warning
+        bmad-assist verify scan . --fail-on warning --ratchet concurrency=2027-01-01
+        bmad-assist verify scan . --baseline deepverify-baseline.json
+        bmad-assist verify scan . --output gitlab > gl-code-quality-report.json
+        bmad-assist verify scan . --output github
+        bmad-assist verify scan . --output sarif --sarif-baseline main.json --sarif-delta
+        bmad-assist verify scan . --import-sarif gosec=gosec.sarif --output json
+        bmad-assist verify scan . --output fingerprints > fingerprints.txt
+        bmad-assist verify scan . --output badge > deepverify.svg
+        bmad-assist verify scan . --output html > deepverify.html
+        bmad-assist verify scan . --sqlite deepverify.db --append
+        bmad-assist verify trend deepverify.db
+        bmad-assist verify scan . --split-by-owner reports/by-owner
+        bmad-assist verify scan . --show-suppressed
+        bmad-assist verify scan . --include-generated
+        bmad-assist verify scan . --cache .deepverify-cache.json
+        bmad-assist verify scan . --cache .deepverify-cache.json --changed-files-from changed.txt
+        bmad-assist verify scan . --goarch arm
+        bmad-assist verify scan . --exported-only
+        bmad-assist verify scan . --summary-only --fail-on warning
+        bmad-assist verify scan . --preset high-signal
+        bmad-assist verify scan . --since-version 0.4.27
+        bmad-assist verify scan . --output json --reproducible --json-indent ""
+
+    Exit codes:
+        0 = No findings at or above --fail-on
+        1 = Unsuppressed findings at or above --fail-on, outside ratcheted domains
+        2 = Config error
+
+    """
+    from bmad_assist.deep_verify.scan import (
+        SEVERITY_LADDER,
+        CodeOwners,
+        FindingBaseline,
+        ScanCache,
+        ScanOptions,
+        Scanner,
+        apply_finding_baseline,
+        apply_preset,
+        apply_since_version,
+        cap_report,
+        current_commit,
+        deserialize_scan_report,
+        find_codeowners,
+        import_sarif,
+        read_change_manifest,
+        read_finding_baseline,
+        scan_report_json,
+        write_badge,
+        write_github_annotations,
+        write_gitlab_code_quality,
+        write_html,
+        write_owner_reports,
+        write_sarif,
+        write_sqlite,
+    )
+
+    _setup_logging(verbose=verbose, quiet=False)
+
+    formats = ("text", "json", "gitlab", "github", "sarif", "fingerprints", "badge", "html")
+    if output not in formats:
+        _error(
+            f"Invalid output format: '{output}'. Use 'text', 'json', 'gitlab', 'github', "
+            "'sarif', 'fingerprints', 'badge', or 'html'."
+        )
+        raise typer.Exit(code=EXIT_CONFIG_ERROR)
+
+    if (sarif_baseline is not None or sarif_delta) and output != "sarif":
+        _error("--sarif-baseline and --sarif-delta require --output sarif.")
+        raise typer.Exit(code=EXIT_CONFIG_ERROR)
+    if sarif_delta and sarif_baseline is None:
+        _error("--sarif-delta requires --sarif-baseline.")
+        raise typer.Exit(code=EXIT_CONFIG_ERROR)
+    if max_findings < 0:
+        _error(f"--max-findings must be non-negative, got {max_findings}.")
+        raise typer.Exit(code=EXIT_CONFIG_ERROR)
+    baseline_report = None
+    if sarif_baseline is not None:
+        import json as json_module
+
+        try:
+            baseline_report = deserialize_scan_report(
+                json_module.loads(Path(sarif_baseline).read_text(encoding="utf-8"))
+            )
+        except (OSError, ValueError, KeyError, TypeError) as e:
+            _error(f"Failed to read SARIF baseline report: {e}")
+            raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+
+    finding_baseline: FindingBaseline | None = None
+    if baseline_path is not None:
+        try:
+            finding_baseline = read_finding_baseline(Path(baseline_path))
+        except (OSError, ValueError) as e:
+            _error(f"Failed to read baseline: {e}")
+            raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+
+    sarif_imports: list[tuple[str, Path]] = []
+    for spec in import_sarif_logs or []:
+        tool, _, log_path = spec.partition("=")
+        if not tool or not log_path:
+            _error(f"Invalid --import-sarif value: '{spec}'. Use TOOL=PATH.")
+            raise typer.Exit(code=EXIT_CONFIG_ERROR)
+        sarif_imports.append((tool, Path(log_path)))
+
+    if json_indent.strip(" \t"):
+        _error(f"Invalid --json-indent value: {json_indent!r}. Use spaces or tabs.")
+        raise typer.Exit(code=EXIT_CONFIG_ERROR)
+
+    fail_severity: Severity | None = None
+    if fail_on.lower() != "none":
+        try:
+            fail_severity = Severity(fail_on.lower())
+        except ValueError:
+            valid = ", ".join(s.value for s in Severity)
+            _error(f"Invalid --fail-on value: '{fail_on}'. Use one of: {valid}, none.")
+            raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+
+    ratchet_dates: dict[ArtifactDomain, date] = {}
+    for spec in ratchets or []:
+        domain_name, _, since = spec.partition("=")
+        try:
+            ratchet_dates[ArtifactDomain(domain_name.lower())] = date.fromisoformat(since)
+        except ValueError:
+            _error(f"Invalid --ratchet value: '{spec}'. Use DOMAIN=YYYY-MM-DD.")
+            raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+
+    labels: dict[str, str] = {}
+    for spec in label_specs or []:
+        key, separator, value = spec.partition("=")
+        if not key or not separator:
+            _error(f"Invalid --label value: '{spec}'. Use KEY=VALUE.")
+            raise typer.Exit(code=EXIT_CONFIG_ERROR)
+        labels[key] = value
+
+    scan_base = Path(path) if Path(path).is_dir() else Path(path).parent
+    owners: CodeOwners | None = None
+    if split_by_owner_dir is not None:
+        owners_file = Path(codeowners_path) if codeowners_path else find_codeowners(scan_base)
+        if owners_file is None:
+            _error("No CODEOWNERS file found for --split-by-owner. Use --codeowners.")
+            raise typer.Exit(code=EXIT_CONFIG_ERROR)
+        try:
+            owners = CodeOwners.load(owners_file)
+        except OSError as e:
+            _error(f"Failed to read CODEOWNERS file: {e}")
+            raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+
+    changed_files: frozenset[str] | None = None
+    if changed_files_from is not None:
+        try:
+            changed_files = read_change_manifest(Path(changed_files_from))
+        except (OSError, UnicodeDecodeError) as e:
+            _error(f"Failed to read changed files manifest: {e}")
+            raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+
+    cache = ScanCache.load(Path(cache_path)) if cache_path is not None else None
+    try:
+        options = ScanOptions(
+            threshold=threshold,
+            use_config_files=not no_config,
+            reproducible=reproducible,
+            max_file_bytes=max_file_bytes or None,
+            load_concurrency=load_concurrency,
+            analyze_concurrency=analyze_concurrency or None,
+            show_suppressed=show_suppressed,
+            include_generated=include_generated,
+            target_arch=goarch,
+            exported_only=exported_only,
+            changed_files=changed_files,
+            ratchet_dates=ratchet_dates,
+            summary_only=summary_only,
+            labels=labels,
+        )
+        if preset is not None:
+            options = apply_preset(options, preset)
+        if since_version is not None:
+            options = apply_since_version(options, since_version)
+        scanner = Scanner(options, cache=cache)
+        report = scanner.scan(Path(path))
+    except ValueError as e:
+        _error(str(e))
+        raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+    except FileNotFoundError as e:
+        _error(str(e))
+        raise typer.Exit(code=EXIT_ERROR) from None
+    except ConfigError as e:
+        _error(f"Config error: {e}")
+        raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+
+    for tool, log_path in sarif_imports:
+        try:
+            with log_path.open(encoding="utf-8") as log_file:
+                report = import_sarif(report, log_file, tool)
+        except (OSError, ValueError) as e:
+            _error(f"Failed to import SARIF log {log_path}: {e}")
+            raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+
+    if finding_baseline is not None:
+        report = apply_finding_baseline(report, finding_baseline, keep_accepted=show_suppressed)
+
+    # Cap last, so baselined and imported findings are settled before sampling
+    if max_findings:
+        report = cap_report(report, max_findings, seed)
+
+    if cache is not None and cache_path is not None:
+        try:
+            cache.save(Path(cache_path))
+        except OSError as e:
+            _warning(f"Failed to write cache file: {e}")
+
+    if sqlite_path is not None:
+        import sqlite3
+
+        try:
+            write_sqlite(
+                report,
+                Path(sqlite_path),
+                append=sqlite_append,
+                commit_sha=commit_sha or current_commit(scan_base),
+            )
+        except (ValueError, sqlite3.Error) as e:
+            _error(f"Failed to write SQLite database: {e}")
+            raise typer.Exit(code=EXIT_ERROR) from None
+
+    if split_by_owner_dir is not None and owners is not None:
+        try:
+            write_owner_reports(
+                report, owners, Path(split_by_owner_dir), owners.prefix_for(scan_base)
+            )
+        except OSError as e:
+            _error(f"Failed to write owner reports: {e}")
+            raise typer.Exit(code=EXIT_ERROR) from None
+
+    if output == "json":
+        # Use print directly to avoid Rich's wrapping behavior
+        print(scan_report_json(report, indent=json_indent))
+    elif output == "gitlab":
+        write_gitlab_code_quality(report, sys.stdout)
+    elif output == "github":
+        write_github_annotations(report, sys.stdout)
+    elif output == "sarif":
+        write_sarif(report, sys.stdout, baseline=baseline_report, delta_only=sarif_delta)
+    elif output == "fingerprints":
+        for fingerprint in report.fingerprints():
+            print(fingerprint)
+    elif output == "badge":
+        write_badge(report, sys.stdout)
+    elif output == "html":
+        write_html(report, sys.stdout)
+    else:
+        if not summary_only:
+            for finding in report.findings:
+                marker = f" [{finding.tool}]" if finding.tool else ""
+                if finding.suppressed:
+                    reason = finding.suppression_reason
+                    marker += f" [suppressed: {reason}]" if reason else " [suppressed]"
+                console.print(
+                    f"{finding.path}:{finding.line}: {finding.severity.value.upper()} "
+                    f"{finding.pattern_id} {finding.title}{marker}",
+                    markup=False,
+                    highlight=False,
+                    soft_wrap=True,
+                )
+        active = report.unsuppressed_findings()
+        suppressed_count = len(report.findings) - len(active)
+        console.print(
+            f"{len(active)} finding(s) in {len(report.files_scanned)} file(s)"
+            + (f", {suppressed_count} suppressed" if suppressed_count else ""),
+            highlight=False,
+        )
+        effort_counts = report.effort_counts()
+        if effort_counts:
+            console.print(
+                f"Estimated effort: {report.estimated_effort():g}h ("
+                + ", ".join(
+                    f"{effort_counts[e]} {e.value}" for e in PatternEffort if e in effort_counts
+                )
+                + ")",
+                highlight=False,
+            )
+        if report.skipped_large_files:
+            console.print(
+                f"Skipped {len(report.skipped_large_files)} file(s) larger than "
+                f"{max_file_bytes} bytes",
+                highlight=False,
+            )
+        if report.dropped_findings:
+            console.print(
+                f"Capped at {max_findings} finding(s); dropped "
+                + ", ".join(
+                    f"{report.dropped_findings[s]} {s.value}"
+                    for s in reversed(SEVERITY_LADDER)
+                    if s in report.dropped_findings
+                ),
+                highlight=False,
+            )
+        if report.ratcheted_domains:
+            console.print(
+                "Not failing yet on ratcheted domain(s): "
+                + ", ".join(d.value for d in report.ratcheted_domains),
+                highlight=False,
+            )
+        for warning in report.detector_warnings:
+            _warning(warning)
+        if cache is not None:
+            console.print(f"Cache: {cache.hits} hit(s), {cache.misses} miss(es)", highlight=False)
+
+    failed = scanner.failed(report, fail_severity)
+    raise typer.Exit(code=EXIT_REJECT if failed else EXIT_SUCCESS)
+
+
+@verify_app.command("baseline-create")
+def verify_baseline_create(
+    baseline_path: str = typer.Argument(
+        ...,
+        help="Baseline file to write",
+    ),
+    path: str = typer.Option(
+        ".",
+        "--path",
+        "-p",
+        help="File or directory to scan",
+    ),
+    threshold: float = typer.Option(
+        PatternMatcher.DEFAULT_THRESHOLD,
+        "--threshold",
+        help="Minimum pattern confidence (0.0-1.0)",
+    ),
+    no_config: bool = typer.Option(
+        False,
+        "--no-config",
+        help="Ignore .deepverify.yaml files",
+    ),
+    include_generated: bool = typer.Option(
+        False,
+        "--include-generated",
+        help="Analyze generated files with concurrency detectors only",
+    ),
+    preset: str | None = typer.Option(
+        None,
+        "--preset",
+        help="Options preset (as passed to verify scan)",
+    ),
+    goarch: str | None = typer.Option(
+        None,
+        "--goarch",
+        help="GOARCH the code is built for (as passed to verify scan)",
+    ),
+    exported_only: bool = typer.Option(
+        False,
+        "--exported-only",
+        help="Keep only findings in exported Go declarations (as passed to verify scan)",
+    ),
+    max_file_bytes: int = typer.Option(
+        DEFAULT_MAX_FILE_BYTES,
+        "--max-file-bytes",
+        help="Skip files larger than this many bytes (0 = no limit)",
+    ),
+    verbose: bool = typer.Option(
+        False,
+        "--verbose",
+        "-v",
+        help="Enable verbose output with debug logging",
+    ),
+) -> None:
+    """Scan a tree and accept all of its current findings in a baseline file.
+
+    Later scans with --baseline report only findings the baseline does not
+    accept, so an existing code base can adopt scanning without fixing
+    everything first. Findings are matched by fingerprint, which survives
+    line shifts and reformatting. Replaces an existing baseline file. Pass
+    the same analysis options (--threshold, --no-config, --include-generated,
+    --preset, --goarch, --exported-only, --max-file-bytes) as the scans that
+    use the baseline, or it accepts findings they never report.
+
+    Examples:
+        bmad-assist verify baseline-create deepverify-baseline.json
+        bmad-assist verify baseline-create baseline.json --path services/payments
+        bmad-assist verify baseline-create baseline.json --preset high-signal --goarch arm64
+        bmad-assist verify scan . --baseline deepverify-baseline.json
+
+    """
+    from bmad_assist.deep_verify.scan import (
+        ScanOptions,
+        Scanner,
+        apply_preset,
+        create_finding_baseline,
+        write_finding_baseline,
+    )
+
+    _setup_logging(verbose=verbose, quiet=False)
+
+    try:
+        options = ScanOptions(
+            threshold=threshold,
+            use_config_files=not no_config,
+            max_file_bytes=max_file_bytes or None,
+            include_generated=include_generated,
+            target_arch=goarch,
+            exported_only=exported_only,
+        )
+        if preset is not None:
+            options = apply_preset(options, preset)
+        scanner = Scanner(options)
+        report = scanner.scan(Path(path))
+    except ValueError as e:
+        _error(str(e))
+        raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+    except FileNotFoundError as e:
+        _error(str(e))
+        raise typer.Exit(code=EXIT_ERROR) from None
+    except ConfigError as e:
+        _error(f"Config error: {e}")
+        raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+
+    baseline = create_finding_baseline(report)
+    try:
+        write_finding_baseline(baseline, Path(baseline_path))
+    except OSError as e:
+        _error(f"Failed to write baseline: {e}")
+        raise typer.Exit(code=EXIT_ERROR) from None
+
+    console.print(
+        f"Accepted {len(baseline.accepted)} finding(s) in {len(report.files_scanned)} "
+        f"file(s) into {baseline_path}",
+        highlight=False,
+        markup=False,
+    )
+    raise typer.Exit(code=EXIT_SUCCESS)
+
+
+@verify_app.command("validate-config")
+def verify_validate_config(
+    path: str = typer.Argument(
+        ".deepverify.yaml",
+        help="Scan config file to check",
+    ),
+) -> None:
+    """Check a .deepverify.yaml file without scanning.
+
+    Reports unknown keys, selectors that match no pattern, invalid severity
+    names and contradictory enable/disable entries, each with its line.
+    Scans ignore such mistakes silently, so run this before committing a
+    config.
+
+    Examples:
+        bmad-assist verify validate-config
+        bmad-assist verify validate-config services/payments/.deepverify.yaml
+
+    Exit codes:
+        0 = Config is valid
+        2 = Config has issues
+
+    """
+    from bmad_assist.deep_verify.scan import validate_scan_config
+
+    issues = validate_scan_config(Path(path))
+    for issue in issues:
+        console.print(str(issue), highlight=False, markup=False)
+    if issues:
+        console.print(f"{len(issues)} issue(s) in {path}", highlight=False, markup=False)
+        raise typer.Exit(code=EXIT_CONFIG_ERROR)
+    console.print(f"{path} is valid", highlight=False, markup=False)
+    raise typer.Exit(code=EXIT_SUCCESS)
+
+
+@verify_app.command("warm")
+def verify_warm(
+    path: str = typer.Argument(
+        ".",
+        help="File or directory to analyze",
+    ),
+    cache_path: str | None = typer.Option(
+        None,
+        "--cache",
+        help="Cache file to populate (default: .deepverify-cache.json)",
+    ),
+    threshold: float = typer.Option(
+        PatternMatcher.DEFAULT_THRESHOLD,
+        "--threshold",
+        help="Minimum pattern confidence (0.0-1.0)",
+    ),
+    no_config: bool = typer.Option(
+        False,
+        "--no-config",
+        help="Ignore .deepverify.yaml files",
+    ),
+    include_generated: bool = typer.Option(
+        False,
+        "--include-generated",
+        help="Analyze generated files with concurrency detectors only",
+    ),
+    goarch: str | None = typer.Option(
+        None,
+        "--goarch",
+        help="GOARCH the code is built for (as passed to verify scan)",
+    ),
+    exported_only: bool = typer.Option(
+        False,
+        "--exported-only",
+        help="Keep only findings in exported Go declarations (as passed to verify scan)",
+    ),
+    max_file_bytes: int = typer.Option(
+        DEFAULT_MAX_FILE_BYTES,
+        "--max-file-bytes",
+        help="Skip files larger than this many bytes (0 = no limit)",
+    ),
+    verbose: bool = typer.Option(
+        False,
+        "--verbose",
+        "-v",
+        help="Enable verbose output with debug logging",
+    ),
+) -> None:
+    """Populate a scan cache file without reporting findings.
+
+    Run during a CI image build so that later scans with the same --cache
+    file and analysis options (--threshold, --no-config, --include-generated,
+    --goarch, --exported-only, --max-file-bytes) reuse results for unchanged files
+    instead of starting cold. Updates an existing cache file in place.
+
+    Examples:
+        bmad-assist verify warm .
+        bmad-assist verify warm services --cache /cache/deepverify.json
+        bmad-assist verify scan services --cache /cache/deepverify.json
+
+    """
+    from bmad_assist.deep_verify.scan import (
+        DEFAULT_CACHE_FILENAME,
+        ScanCache,
+        ScanOptions,
+        Scanner,
+    )
+
+    _setup_logging(verbose=verbose, quiet=False)
+
+    cache_file = Path(cache_path or DEFAULT_CACHE_FILENAME)
+    cache = ScanCache.load(cache_file)
+    try:
+        scanner = Scanner(
+            ScanOptions(
+                threshold=threshold,
+                use_config_files=not no_config,
+                max_file_bytes=max_file_bytes or None,
+                include_generated=include_generated,
+                target_arch=goarch,
+                exported_only=exported_only,
+            ),
+            cache=cache,
+        )
+        report = scanner.scan(Path(path))
+    except ValueError as e:
+        _error(str(e))
+        raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+    except FileNotFoundError as e:
+        _error(str(e))
+        raise typer.Exit(code=EXIT_ERROR) from None
+    except ConfigError as e:
+        _error(f"Config error: {e}")
+        raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+
+    try:
+        cache.save(cache_file)
+    except OSError as e:
+        _error(f"Failed to write cache file: {e}")
+        raise typer.Exit(code=EXIT_ERROR) from None
+
+    console.print(
+        f"Warmed {cache_file} with {len(report.files_scanned)} file(s) "
+        f"({cache.hits} already cached)",
+        highlight=False,
+    )
+    raise typer.Exit(code=EXIT_SUCCESS)
+
+
+@verify_app.command("fix")
+def verify_fix(
+    path: str = typer.Argument(
+        ".",
+        help="File or directory to fix",
+    ),
+    dry_run: bool = typer.Option(
+        False,
+        "--dry-run",
+        help="Show the fixes as a diff without writing files",
+    ),
+    threshold: float = typer.Option(
+        PatternMatcher.DEFAULT_THRESHOLD,
+        "--threshold",
+        help="Minimum pattern confidence (0.0-1.0)",
+    ),
+    no_config: bool = typer.Option(
+        False,
+        "--no-config",
+        help="Ignore .deepverify.yaml files",
+    ),
+    verbose: bool = typer.Option(
+        False,
+        "--verbose",
+        "-v",
+        help="Enable verbose output with debug logging",
+    ),
+) -> None:
+    """Apply safe automatic fixes, re-scan, and write them if nothing new appears.
+
+    Applies the fix of every unsuppressed finding whose pattern declares one
+    (see ``verify explain``), re-analyzes the changed files, and reports the
+    findings resolved and introduced. If any fix introduces a new finding, no
+    file is written.
+
+    Examples:
+        bmad-assist verify fix .
+        bmad-assist verify fix services/payments --dry-run
+
+    Exit codes:
+        0 = Fixes written (or shown with --dry-run), or nothing to fix
+        1 = Fixes would introduce new findings; no files written
+        2 = Config error
+
+    """
+    from bmad_assist.deep_verify.scan import ScanOptions, Scanner, write_fixes
+
+    _setup_logging(verbose=verbose, quiet=False)
+
+    root = Path(path)
+    try:
+        scanner = Scanner(ScanOptions(threshold=threshold, use_config_files=not no_config))
+        fixes = scanner.fix(root)
+    except ValueError as e:
+        _error(str(e))
+        raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+    except FileNotFoundError as e:
+        _error(str(e))
+        raise typer.Exit(code=EXIT_ERROR) from None
+    except ConfigError as e:
+        _error(f"Config error: {e}")
+        raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+
+    if not fixes:
+        console.print("No fixable findings", highlight=False)
+        raise typer.Exit(code=EXIT_SUCCESS)
+
+    for file_fix in fixes:
+        for label, findings in (("Resolved", file_fix.resolved), ("New", file_fix.introduced)):
+            for finding in findings:
+                console.print(
+                    f"{label}: {finding.path}:{finding.line}: "
+                    f"{finding.severity.value.upper()} {finding.pattern_id} {finding.title}",
+                    markup=False,
+                    highlight=False,
+                    soft_wrap=True,
+                )
+
+    resolved = sum(len(f.resolved) for f in fixes)
+    introduced = sum(len(f.introduced) for f in fixes)
+    if introduced:
+        _error(f"Fixes introduce {introduced} new finding(s); no files were written")
+        raise typer.Exit(code=EXIT_REJECT)
+
+    if dry_run:
+        for file_fix in fixes:
+            console.print(file_fix.diff(), end="", markup=False, highlight=False, soft_wrap=True)
+        console.print(
+            f"Would resolve {resolved} finding(s) in {len(fixes)} file(s)", highlight=False
+        )
+        raise typer.Exit(code=EXIT_SUCCESS)
+
+    try:
+        write_fixes(fixes, root if root.is_dir() else root.parent)
+    except OSError as e:
+        _error(f"Failed to write fixes: {e}")
+        raise typer.Exit(code=EXIT_ERROR) from None
+    console.print(f"Resolved {resolved} finding(s) in {len(fixes)} file(s)", highlight=False)
+    raise typer.Exit(code=EXIT_SUCCESS)
+
+
+@verify_app.command("minimize")
+def verify_minimize(
+    path: str = typer.Argument(
+        ...,
+        help="Source file with the finding to reproduce",
+    ),
+    expect: str = typer.Option(
+        ...,
+        "--expect",
+        "-e",
+        help="Finding to preserve as <pattern>@<line> (e.g., CC-004@42)",
+    ),
+    output_path: str | None = typer.Option(
+        None,
+        "--output",
+        "-o",
+        help="Write the reduced source to this file instead of stdout",
+    ),
+    threshold: float = typer.Option(
+        PatternMatcher.DEFAULT_THRESHOLD,
+        "--threshold",
+        help="Minimum pattern confidence (0.0-1.0)",
+    ),
+    no_config: bool = typer.Option(
+        False,
+        "--no-config",
+        help="Ignore .deepverify.yaml files",
+    ),
+    verbose: bool = typer.Option(
+        False,
+        "--verbose",
+        "-v",
+        help="Enable verbose output with debug logging",
+    ),
+) -> None:
+    """Reduce a file to a minimal snippet that still triggers one finding.
+
+    Removes declarations, statements and other lines while the finding
+    given by --expect is still reported at the same source line, for bug
+    reports and pattern corpus entries. Each step keeps the brackets
+    balanced. The file's config (``.deepverify.yaml``) applies throughout.
+
+    Examples:
+        bmad-assist verify minimize internal/cache/store.go --expect CC-004@42
+        bmad-assist verify minimize main.go -e CC-001@17 -o repro.go
+
+    Exit codes:
+        0 = Reduced source written
+        1 = Failed to read or write a file
+        2 = Invalid target or config, or the finding is not reported
+
+    """
+    from bmad_assist.deep_verify.core.language_detector import LanguageDetector
+    from bmad_assist.deep_verify.scan import (
+        ScanConfigResolver,
+        ScanOptions,
+        Scanner,
+        minimize_source,
+        parse_finding_target,
+    )
+
+    _setup_logging(verbose=verbose, quiet=False)
+
+    source = Path(path)
+    try:
+        target = parse_finding_target(expect)
+        text = source.read_text(encoding="utf-8")
+        config = ScanConfigResolver(source.parent, use_files=not no_config).resolve(source)
+        scanner = Scanner(ScanOptions(threshold=threshold, use_config_files=not no_config))
+        language = LanguageDetector().detect(source).language
+        result = minimize_source(
+            text,
+            target,
+            lambda candidate: scanner.scan_source(
+                candidate, language, source.name, config=config
+            ),
+        )
+    except (OSError, UnicodeDecodeError) as e:
+        _error(f"Failed to read {path}: {e}")
+        raise typer.Exit(code=EXIT_ERROR) from None
+    except ValueError as e:
+        _error(str(e))
+        raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+    except ConfigError as e:
+        _error(f"Config error: {e}")
+        raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+
+    if output_path is None:
+        # Use print directly to avoid Rich's wrapping behavior
+        print(result.text, end="")
+        raise typer.Exit(code=EXIT_SUCCESS)
+    try:
+        Path(output_path).write_text(result.text, encoding="utf-8")
+    except OSError as e:
+        _error(f"Failed to write {output_path}: {e}")
+        raise typer.Exit(code=EXIT_ERROR) from None
+    console.print(
+        f"Reduced {len(text.splitlines())} line(s) to {len(result.lines)}: "
+        f"{result.finding.pattern_id} at line {result.finding.line} "
+        f"({result.checks} candidate(s) scanned)",
+        highlight=False,
+    )
+    raise typer.Exit(code=EXIT_SUCCESS)
+
+
+@verify_app.command("trend")
+def verify_trend(
+    db_path: str = typer.Argument(
+        ...,
+        help="SQLite database written by verify scan --sqlite --append",
+    ),
+    repo: str = typer.Option(
+        ".",
+        "--repo",
+        help="Repository whose git log orders the commits",
+    ),
+    limit: int = typer.Option(
+        20,
+        "--limit",
+        help="Show only the most recent commits (0 = all)",
+    ),
+    output: str = typer.Option(
+        "text",
+        "--output",
+        "-o",
+        help="Output format: text or json",
+    ),
+) -> None:
+    """Chart the code-health score of scanned commits.
+
+    Each commit shows the health score of its latest run (100 = no findings;
+    see scan.trend for the formula), its change from the previous scanned
+    commit in git log order, and the estimated hours to fix its findings.
+
+    Examples:
+        bmad-assist verify scan . --sqlite deepverify.db --append
+        bmad-assist verify trend deepverify.db
+        bmad-assist verify trend deepverify.db --limit 50 --output json
+
+    """
+    import sqlite3
+
+    from bmad_assist.deep_verify.scan import load_trend
+
+    if output not in ("text", "json"):
+        _error(f"Invalid output format: '{output}'. Use 'text' or 'json'.")
+        raise typer.Exit(code=EXIT_CONFIG_ERROR)
+
+    try:
+        points = load_trend(Path(db_path), repo=Path(repo), limit=limit or None)
+    except (FileNotFoundError, sqlite3.Error) as e:
+        _error(f"Failed to read trend database: {e}")
+        raise typer.Exit(code=EXIT_ERROR) from None
+
+    if output == "json":
+        import json as json_module
+
+        data = [
+            {
+                "commit": p.commit_sha,
+                "run_id": p.run_id,
+                "started_at": p.started_at,
+                "health_score": p.health_score,
+                "findings": p.findings,
+                "delta": p.delta,
+                "effort_hours": p.effort_hours,
+            }
+            for p in points
+        ]
+        console.print(json_module.dumps({"commits": data}, indent=2), highlight=False)
+        raise typer.Exit(code=EXIT_SUCCESS)
+
+    if not points:
+        console.print(f"No runs with a commit SHA in {db_path}", highlight=False)
+        raise typer.Exit(code=EXIT_SUCCESS)
+
+    console.print(f"Health score over {len(points)} commit(s):", highlight=False)
+    for p in points:
+        delta = "" if p.delta is None else f"{p.delta:+.2f}"
+        effort = "" if p.effort_hours is None else f"{p.effort_hours:g}h"
+        bar = "#" * round(p.health_score / 100 * 40)
+        console.print(
+            f"  {p.commit_sha[:10]}  {p.health_score:6.2f}  {delta:>7}  "
+            f"{p.findings:>4} finding(s)  {effort:>7}  {bar}",
+            highlight=False,
+            markup=False,
+        )
+    raise typer.Exit(code=EXIT_SUCCESS)
+
+
+@verify_app.command("compare")
+def verify_compare(
+    reports: list[str] = typer.Argument(
+        ...,
+        help="Scan reports written by verify scan --output json, as PATH or LABEL=PATH",
+    ),
+    output: str = typer.Option(
+        "text",
+        "--output",
+        "-o",
+        help="Output format: text or json",
+    ),
+) -> None:
+    """Compare scan reports of several branches side by side.
+
+    Prints a matrix with one column per report: unsuppressed findings per
+    severity, and the findings introduced and resolved relative to the
+    first report, the baseline. Cells worse than the baseline are
+    highlighted. Columns are labeled with the file name unless given as
+    LABEL=PATH.
+
+    Examples:
+        bmad-assist verify compare main.json release.json feature.json
+        bmad-assist verify compare main=a.json rc=b.json --output json
+
+    """
+    import json as json_module
+
+    from rich.table import Table
+
+    from bmad_assist.deep_verify.scan import (
+        COMPARE_SEVERITIES,
+        compare_reports,
+        deserialize_scan_report,
+        serialize_report_comparison,
+    )
+
+    if output not in ("text", "json"):
+        _error(f"Invalid output format: '{output}'. Use 'text' or 'json'.")
+        raise typer.Exit(code=EXIT_CONFIG_ERROR)
+
+    labeled = []
+    for spec in reports:
+        label, sep, path = spec.partition("=")
+        if not sep:
+            label, path = Path(spec).stem, spec
+        try:
+            report = deserialize_scan_report(
+                json_module.loads(Path(path).read_text(encoding="utf-8"))
+            )
+        except (OSError, ValueError, KeyError, TypeError) as e:
+            _error(f"Failed to read scan report {path}: {e}")
+            raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+        labeled.append((label, report))
+    columns = compare_reports(labeled)
+
+    if output == "json":
+        console.print(
+            json_module.dumps(serialize_report_comparison(columns), indent=2), highlight=False
+        )
+        raise typer.Exit(code=EXIT_SUCCESS)
+
+    baseline = columns[0]
+    table = Table(title=f"Findings compared to {baseline.label}")
+    table.add_column("", style="bold")
+    for column in columns:
+        table.add_column(column.label, justify="right")
+
+    def cell(value: int, base: int, worse: bool) -> str:
+        text = str(value) if value == base else f"{value} ({value - base:+d})"
+        return f"[red]{text}[/red]" if worse else text
+
+    for severity in COMPARE_SEVERITIES:
+        base = baseline.counts[severity]
+        table.add_row(
+            severity.value,
+            *(cell(c.counts[severity], base, severity in c.regressions) for c in columns),
+        )
+    table.add_row("total", *(cell(c.total, baseline.total, False) for c in columns))
+    table.add_row(
+        "introduced", *(f"[red]{n}[/red]" if n else "0" for n in (c.introduced for c in columns))
+    )
+    table.add_row("resolved", *(str(c.resolved) for c in columns))
+    console.print(table)
+    regressed = [c.label for c in columns if c.regressed]
+    if regressed:
+        console.print(f"Regressed: {', '.join(regressed)}", highlight=False, markup=False)
+    raise typer.Exit(code=EXIT_SUCCESS)
+
+
+@verify_app.command("bench")
+def verify_bench(
+    files: int = typer.Option(
+        100,
+        "--files",
+        help="Files in the synthetic Go corpus",
+    ),
+    count: int = typer.Option(
+        5,
+        "--count",
+        "-n",
+        help="Number of benchmark runs",
+    ),
+    baseline: str | None = typer.Option(
+        None,
+        "--baseline",
+        help="Baseline file (default: the committed reference baseline)",
+    ),
+    update_baseline: bool = typer.Option(
+        False,
+        "--update-baseline",
+        help="Record this machine's median as the new baseline",
+    ),
+    summary_only: bool = typer.Option(
+        False,
+        "--summary-only",
+        help="Benchmark summary-only scans (see verify scan --summary-only)",
+    ),
+) -> None:
+    """Benchmark analysis speed against the committed budget.
+
+    Prints runs in the Go benchmark format on stdout (compare runs with
+    benchstat) and the budget check on stderr. Exits 1 when the median
+    time per file exceeds the baseline by more than its factor.
+
+    Examples:
+        bmad-assist verify bench
+        bmad-assist verify bench --count 10 > new.txt && benchstat old.txt new.txt
+        bmad-assist verify bench --summary-only
+        bmad-assist verify bench --update-baseline
+
+    """
+    import statistics
+
+    from bmad_assist.deep_verify.scan.bench import (
+        DEFAULT_BASELINE_PATH,
+        BenchBaseline,
+        format_benchstat,
+        load_baseline,
+        run_benchmark,
+        save_baseline,
+    )
+
+    if update_baseline and summary_only:
+        _error("--update-baseline records full scans; drop --summary-only.")
+        raise typer.Exit(code=EXIT_CONFIG_ERROR)
+
+    baseline_path = Path(baseline) if baseline else DEFAULT_BASELINE_PATH
+    try:
+        results = run_benchmark(files=files, count=count, summary_only=summary_only)
+    except ValueError as e:
+        _error(str(e))
+        raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+
+    # Use print directly so the output stays benchstat-readable
+    print(format_benchstat(results), end="")
+
+    if update_baseline:
+        try:
+            previous = load_baseline(baseline_path)
+        except (OSError, ValueError):
+            previous = BenchBaseline(ns_per_file=0.0)
+        updated = replace(
+            previous,
+            ns_per_file=round(statistics.median(r.ns_per_file for r in results)),
+            files=files,
+        )
+        try:
+            save_baseline(updated, baseline_path)
+        except OSError as e:
+            _error(f"Failed to write baseline: {e}")
+            raise typer.Exit(code=EXIT_ERROR) from None
+        print(f"Baseline updated: {baseline_path}", file=sys.stderr)
+        raise typer.Exit(code=EXIT_SUCCESS)
+
+    try:
+        budget = load_baseline(baseline_path)
+    except (OSError, ValueError) as e:
+        _error(f"Failed to read baseline: {e}")
+        raise typer.Exit(code=EXIT_ERROR) from None
+
+    regression = budget.check(results)
+    if regression is not None:
+        print(f"FAIL: {regression}", file=sys.stderr)
+        raise typer.Exit(code=EXIT_ERROR)
+    print(
+        f"ok: within {budget.factor:g}x of the {budget.ns_per_file / 1e6:.2f} ms per file baseline",
+        file=sys.stderr,
+    )
+    raise typer.Exit(code=EXIT_SUCCESS)
+
+
+@verify_app.command("serve")
+def verify_serve(
+    root: str = typer.Option(
+        ".",
+        "--root",
+        help="Directory that path requests must stay within",
+    ),
+    host: str = typer.Option(
+        "127.0.0.1",
+        "--host",
+        help="Interface to bind",
+    ),
+    port: int = typer.Option(
+        8765,
+        "--port",
+        help="Port to bind (0 picks a free port)",
+    ),
+    threshold: float = typer.Option(
+        PatternMatcher.DEFAULT_THRESHOLD,
+        "--threshold",
+        help="Minimum pattern confidence (0.0-1.0)",
+    ),
+    verbose: bool = typer.Option(
+        False,
+        "--verbose",
+        "-v",
+        help="Enable verbose output with debug logging",
+    ),
+) -> None:
+    """Run a scan daemon with an HTTP API.
+
+    Keeps the pattern library loaded and per-file results cached across
+    requests. Stops gracefully on Ctrl+C or SIGTERM.
+
+    Endpoints:
+        GET  /health   Server status and cache statistics
+        POST /analyze  {"path": "..."} or {"source": "...", "language": "go"}
+
+    Examples:
+        bmad-assist verify serve --port 8765
+        curl -s localhost:8765/analyze -d '{"path": "services/payments"}'
+
+    """
+    import signal
+    import threading
+
+    from bmad_assist.deep_verify.scan import ScanOptions, ScanServer
+
+    _setup_logging(verbose=verbose, quiet=False)
+
+    root_path = Path(root)
+    if not root_path.is_dir():
+        _error(f"Root directory not found: {root}")
+        raise typer.Exit(code=EXIT_ERROR)
+
+    try:
+        server = ScanServer(root_path, ScanOptions(threshold=threshold), host=host, port=port)
+    except ValueError as e:
+        _error(str(e))
+        raise typer.Exit(code=EXIT_CONFIG_ERROR) from None
+    except OSError as e:
+        _error(f"Cannot bind {host}:{port}: {e}")
+        raise typer.Exit(code=EXIT_ERROR) from None
+
+    def _stop(signum: int, frame: object) -> None:
+        # shutdown() blocks until serve_forever() returns, so run it elsewhere
+        threading.Thread(target=server.shutdown, daemon=True).start()
+
+    signal.signal(signal.SIGTERM, _stop)
+    console.print(f"Serving Deep Verify scans on {server.url} (root: {root_path.resolve()})")
+    try:
+        server.serve_forever()
+    except KeyboardInterrupt:
+        console.print("Shutting down")
+    raise typer.Exit(code=EXIT_SUCCESS)
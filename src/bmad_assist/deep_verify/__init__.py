@@ -34,6 +34,10 @@ Public API:
     MatchedSignal: Dataclass for matched signals
     get_default_pattern_library: Cached function to load default patterns
 
+NOTE: The domain detector and verification methods are loaded lazily. They
+pull in the LLM providers, which pattern scanning (deep_verify.scan) never
+needs.
+
 Example:
     from bmad_assist.deep_verify import (
         DomainDetector,
@@ -54,11 +58,9 @@ Example:
 
 """
 
+from typing import TYPE_CHECKING
+
 from bmad_assist.deep_verify.config import DeepVerifyConfig
-from bmad_assist.deep_verify.core.domain_detector import (
-    DomainDetector,
-    detect_domains,
-)
 from bmad_assist.deep_verify.core.scoring import (
     EvidenceScorer,
     calculate_score,
@@ -90,23 +92,6 @@ from bmad_assist.deep_verify.knowledge import (
     KnowledgeRule,
     KnowledgeRuleYaml,
 )
-from bmad_assist.deep_verify.methods import (
-    AdversarialCategory,
-    AdversarialReviewMethod,
-    AssumptionCategory,
-    AssumptionSurfacingMethod,
-    BaseVerificationMethod,
-    BoundaryAnalysisMethod,
-    ChecklistItem,
-    ChecklistLoader,
-    DomainExpertMethod,
-    PatternMatchMethod,
-    ScenarioSeverity,
-    TemporalCategory,
-    TemporalConsistencyMethod,
-    WorstCaseCategory,
-    WorstCaseMethod,
-)
 from bmad_assist.deep_verify.patterns import (
     MatchedSignal,
     PatternLibrary,
@@ -115,6 +100,91 @@ from bmad_assist.deep_verify.patterns import (
 )
 from bmad_assist.deep_verify.patterns.library import get_default_pattern_library
 
+if TYPE_CHECKING:
+    from bmad_assist.deep_verify.core.domain_detector import (
+        DomainDetector as DomainDetector,
+    )
+    from bmad_assist.deep_verify.core.domain_detector import (
+        detect_domains as detect_domains,
+    )
+    from bmad_assist.deep_verify.methods import (
+        AdversarialCategory as AdversarialCategory,
+    )
+    from bmad_assist.deep_verify.methods import (
+        AdversarialReviewMethod as AdversarialReviewMethod,
+    )
+    from bmad_assist.deep_verify.methods import (
+        AssumptionCategory as AssumptionCategory,
+    )
+    from bmad_assist.deep_verify.methods import (
+        AssumptionSurfacingMethod as AssumptionSurfacingMethod,
+    )
+    from bmad_assist.deep_verify.methods import (
+        BaseVerificationMethod as BaseVerificationMethod,
+    )
+    from bmad_assist.deep_verify.methods import (
+        BoundaryAnalysisMethod as BoundaryAnalysisMethod,
+    )
+    from bmad_assist.deep_verify.methods import (
+        ChecklistItem as ChecklistItem,
+    )
+    from bmad_assist.deep_verify.methods import (
+        ChecklistLoader as ChecklistLoader,
+    )
+    from bmad_assist.deep_verify.methods import (
+        DomainExpertMethod as DomainExpertMethod,
+    )
+    from bmad_assist.deep_verify.methods import (
+        PatternMatchMethod as PatternMatchMethod,
+    )
+    from bmad_assist.deep_verify.methods import (
+        ScenarioSeverity as ScenarioSeverity,
+    )
+    from bmad_assist.deep_verify.methods import (
+        TemporalCategory as TemporalCategory,
+    )
+    from bmad_assist.deep_verify.methods import (
+        TemporalConsistencyMethod as TemporalConsistencyMethod,
+    )
+    from bmad_assist.deep_verify.methods import (
+        WorstCaseCategory as WorstCaseCategory,
+    )
+    from bmad_assist.deep_verify.methods import (
+        WorstCaseMethod as WorstCaseMethod,
+    )
+
+# Lazy loading mapping
+_lazy_imports = {
+    "DomainDetector": ".core.domain_detector",
+    "detect_domains": ".core.domain_detector",
+    "AdversarialCategory": ".methods",
+    "AdversarialReviewMethod": ".methods",
+    "AssumptionCategory": ".methods",
+    "AssumptionSurfacingMethod": ".methods",
+    "BaseVerificationMethod": ".methods",
+    "BoundaryAnalysisMethod": ".methods",
+    "ChecklistItem": ".methods",
+    "ChecklistLoader": ".methods",
+    "DomainExpertMethod": ".methods",
+    "PatternMatchMethod": ".methods",
+    "ScenarioSeverity": ".methods",
+    "TemporalCategory": ".methods",
+    "TemporalConsistencyMethod": ".methods",
+    "WorstCaseCategory": ".methods",
+    "WorstCaseMethod": ".methods",
+}
+
+
+def __getattr__(name: str) -> object:
+    """Lazy load attributes on first access."""
+    if name in _lazy_imports:
+        import importlib
+
+        module = importlib.import_module(_lazy_imports[name], __package__)
+        return getattr(module, name)
+    raise AttributeError(f"module {__name__!r} has no attribute {name!r}")
+
+
 __all__ = [
     # Domain detection
     "DomainDetector",
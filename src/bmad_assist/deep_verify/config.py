@@ -204,6 +204,10 @@ class LLMConfig(BaseModel):
         le=300.0,
         description="Maximum delay between retries (cap)",
     )
+    seed: int | None = Field(
+        default=0,
+        description="Seed for retry backoff jitter (None = seed from the OS)",
+    )
     tokens_per_minute_limit: int = Field(
         default=100000,
         ge=1000,
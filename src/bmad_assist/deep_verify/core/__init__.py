@@ -1,11 +1,12 @@
-"""Core module for Deep Verify types, scoring, and engine."""
+"""Core module for Deep Verify types, scoring, and engine.
+
+NOTE: DomainDetector and MethodSelector are loaded lazily. They pull in the
+LLM providers (subprocess, asyncio, claude_sdk), which the deterministic scan
+path never needs.
+"""
+
+from typing import TYPE_CHECKING
 
-from bmad_assist.deep_verify.core.domain_detector import (
-    DomainDetector,
-    deserialize_domain_detection_result,
-    detect_domains,
-    serialize_domain_detection_result,
-)
 from bmad_assist.deep_verify.core.exceptions import (
     CategorizedError,
     DeepVerifyError,
@@ -23,7 +24,6 @@ from bmad_assist.deep_verify.core.language_detector import (
     LanguageDetector,
     LanguageInfo,
 )
-from bmad_assist.deep_verify.core.method_selector import MethodSelector
 from bmad_assist.deep_verify.core.scoring import (
     EvidenceScorer,
     calculate_score,
@@ -40,7 +40,10 @@ from bmad_assist.deep_verify.core.types import (
     MethodId,
     MethodResult,
     Pattern,
+    PatternEffort,
+    PatternFix,
     PatternId,
+    PatternPrecision,
     Severity,
     Verdict,
     VerdictDecision,
@@ -51,6 +54,43 @@ from bmad_assist.deep_verify.core.types import (
     serialize_verdict,
 )
 
+if TYPE_CHECKING:
+    from bmad_assist.deep_verify.core.domain_detector import (
+        DomainDetector as DomainDetector,
+    )
+    from bmad_assist.deep_verify.core.domain_detector import (
+        deserialize_domain_detection_result as deserialize_domain_detection_result,
+    )
+    from bmad_assist.deep_verify.core.domain_detector import (
+        detect_domains as detect_domains,
+    )
+    from bmad_assist.deep_verify.core.domain_detector import (
+        serialize_domain_detection_result as serialize_domain_detection_result,
+    )
+    from bmad_assist.deep_verify.core.method_selector import (
+        MethodSelector as MethodSelector,
+    )
+
+# Lazy loading mapping
+_lazy_imports = {
+    "DomainDetector": ".domain_detector",
+    "deserialize_domain_detection_result": ".domain_detector",
+    "detect_domains": ".domain_detector",
+    "serialize_domain_detection_result": ".domain_detector",
+    "MethodSelector": ".method_selector",
+}
+
+
+def __getattr__(name: str) -> object:
+    """Lazy load attributes on first access."""
+    if name in _lazy_imports:
+        import importlib
+
+        module = importlib.import_module(_lazy_imports[name], __package__)
+        return getattr(module, name)
+    raise AttributeError(f"module {__name__!r} has no attribute {name!r}")
+
+
 __all__ = [
     # Exceptions and Error Handling
     "DeepVerifyError",
@@ -80,6 +120,9 @@ __all__ = [
     "Verdict",
     "DeepVerifyValidationResult",
     "Pattern",
+    "PatternEffort",
+    "PatternFix",
+    "PatternPrecision",
     "Severity",
     "VerdictDecision",
     "DomainAmbiguity",
@@ -7,7 +7,6 @@ and domain detection errors.
 
 from __future__ import annotations
 
-import asyncio
 from enum import Enum
 
 from bmad_assist.core.exceptions import BmadAssistError
@@ -272,7 +271,8 @@ class ErrorCategorizer:
         if isinstance(error, self._ProviderTimeoutError):
             return ErrorCategory.RETRYABLE_TIMEOUT
 
-        if isinstance(error, (TimeoutError, asyncio.TimeoutError)):
+        # asyncio.TimeoutError is an alias of TimeoutError (Python 3.11+)
+        if isinstance(error, TimeoutError):
             return ErrorCategory.RETRYABLE_TIMEOUT
 
         # Exit code errors - check specific codes
@@ -12,6 +12,8 @@ from __future__ import annotations
 
 from dataclasses import dataclass, field
 from enum import Enum
+from fnmatch import fnmatchcase
+from pathlib import PurePosixPath
 from typing import TYPE_CHECKING, Any, Literal, NewType
 
 if TYPE_CHECKING:
@@ -68,6 +70,28 @@ class Severity(str, Enum):
     INFO = "info"  # Informational - minimal weight
 
 
+class PatternPrecision(str, Enum):
+    """How often a pattern's findings are real issues, as curated by maintainers.
+
+    Presets such as ``high-signal`` (see scan.presets) select patterns by it.
+    """
+
+    HIGH = "high"  # Findings are almost always real bugs
+    MEDIUM = "medium"  # Usually real, with known false positives
+    LOW = "low"  # Heuristic - expect false positives
+
+
+class PatternEffort(str, Enum):
+    """Typical effort to remediate one of a pattern's findings, as curated by maintainers.
+
+    Scan summaries and trends add it up into an estimate (see scan.types).
+    """
+
+    LOW = "low"  # Local one-line or mechanical change
+    MEDIUM = "medium"  # Change within one function or type
+    HIGH = "high"  # Design change across functions or callers
+
+
 class VerdictDecision(str, Enum):
     """Deterministic verdict decisions for Deep Verify.
 
@@ -178,6 +202,20 @@ class Signal:
         return f"Signal(type={self.type!r}, pattern={self.pattern!r}{weight_str})"
 
 
+@dataclass(frozen=True, slots=True)
+class PatternFix:
+    """Mechanical rewrite that resolves a code pattern's findings.
+
+    Attributes:
+        find: Regex (multiline mode) matched at the start of the finding's line.
+        replace: Replacement template in ``re`` syntax (e.g., ``\\g<0>``, ``\\1``).
+
+    """
+
+    find: str
+    replace: str
+
+
 @dataclass(frozen=True, slots=True)
 class Pattern:
     """Verification pattern for signal matching.
@@ -193,6 +231,21 @@ class Pattern:
         remediation: Optional remediation guidance.
         language: Optional language code for code patterns (e.g., "go", "python").
                  None for spec patterns that apply to all languages.
+        rationale: Optional explanation of why the issue matters.
+        bad_example: Optional snippet showing code that triggers the pattern.
+        good_example: Optional snippet showing the corrected code.
+        help_url: Optional link to further documentation.
+        opt_in: Whether the pattern only runs when explicitly enabled
+            (used for noisy heuristics).
+        files: File name globs the pattern is limited to (e.g., "*_test.go");
+            empty for all files of its language.
+        fix: Optional safe rewrite applied by ``verify fix``.
+        precision: Optional curated rating of how often findings are real
+            issues; None for unrated patterns.
+        effort: Optional curated rating of the work to fix one finding;
+            None for unrated patterns.
+        max_confidence: Optional ceiling on match confidence, for heuristics
+            whose full signal match is still only a hint; None for no ceiling.
 
     """
 
@@ -203,6 +256,16 @@ class Pattern:
     description: str | None = None
     remediation: str | None = None
     language: str | None = None
+    rationale: str | None = None
+    bad_example: str | None = None
+    good_example: str | None = None
+    help_url: str | None = None
+    opt_in: bool = False
+    files: tuple[str, ...] = ()
+    fix: PatternFix | None = None
+    precision: PatternPrecision | None = None
+    effort: PatternEffort | None = None
+    max_confidence: float | None = None
 
     def __repr__(self) -> str:
         """Return a string representation of the pattern."""
@@ -210,6 +273,13 @@ class Pattern:
         lang_str = f", language={self.language!r}" if self.language else ""
         return f"Pattern(id={self.id!r}, domain={self.domain.value!r}, signals={len(self.signals)} signals{desc_str}{lang_str})"
 
+    def applies_to(self, path: str) -> bool:
+        """Return whether the pattern runs on a file (by file name, see files)."""
+        if not self.files:
+            return True
+        name = PurePosixPath(path).name
+        return any(fnmatchcase(name, glob) for glob in self.files)
+
 
 @dataclass(frozen=True, slots=True)
 class Finding:
@@ -432,6 +502,18 @@ def serialize_pattern(pattern: Pattern) -> dict[str, Any]:
         "description": pattern.description,
         "remediation": pattern.remediation,
         "language": pattern.language,
+        "rationale": pattern.rationale,
+        "bad_example": pattern.bad_example,
+        "good_example": pattern.good_example,
+        "help_url": pattern.help_url,
+        "opt_in": pattern.opt_in,
+        "files": list(pattern.files),
+        "fix": (
+            {"find": pattern.fix.find, "replace": pattern.fix.replace} if pattern.fix else None
+        ),
+        "precision": _serialize_enum(pattern.precision) if pattern.precision else None,
+        "effort": _serialize_enum(pattern.effort) if pattern.effort else None,
+        "max_confidence": pattern.max_confidence,
     }
 
 
@@ -445,6 +527,22 @@ def deserialize_pattern(data: dict[str, Any]) -> Pattern:
         description=data.get("description"),
         remediation=data.get("remediation"),
         language=data.get("language"),
+        rationale=data.get("rationale"),
+        bad_example=data.get("bad_example"),
+        good_example=data.get("good_example"),
+        help_url=data.get("help_url"),
+        opt_in=data.get("opt_in", False),
+        files=tuple(data.get("files", ())),
+        fix=PatternFix(**data["fix"]) if data.get("fix") else None,
+        precision=(
+            _deserialize_enum(data["precision"], PatternPrecision)
+            if data.get("precision")
+            else None
+        ),
+        effort=(
+            _deserialize_enum(data["effort"], PatternEffort) if data.get("effort") else None
+        ),
+        max_confidence=data.get("max_confidence"),
     )
 
 
@@ -131,6 +131,7 @@ class LLMClient:
             base_delay_seconds=llm_config.base_delay_seconds,
             max_delay_seconds=llm_config.max_delay_seconds,
             jitter_factor=0.2,
+            seed=llm_config.seed,
         )
         self._retry_handler = RetryHandler(retry_config)
 
@@ -29,6 +29,7 @@ DEFAULT_MAX_RETRIES = 3
 DEFAULT_BASE_DELAY_SECONDS = 1.0
 DEFAULT_MAX_DELAY_SECONDS = 30.0
 DEFAULT_JITTER_FACTOR = 0.2  # 0-20% jitter
+DEFAULT_JITTER_SEED = 0  # Fixed so backoff delays are reproducible across runs
 
 # Exit statuses that warrant a retry
 # Based on AC-2: Retry on RATE_LIMIT, SERVER_ERROR, UNAVAILABLE, TIMEOUT
@@ -69,6 +70,7 @@ class RetryConfig:
         base_delay_seconds: Initial delay between retries.
         max_delay_seconds: Maximum delay between retries (cap).
         jitter_factor: Random jitter factor (0.0-1.0).
+        seed: Seed for the jitter random source (None = seed from the OS).
 
     """
 
@@ -78,12 +80,14 @@ class RetryConfig:
         base_delay_seconds: float = DEFAULT_BASE_DELAY_SECONDS,
         max_delay_seconds: float = DEFAULT_MAX_DELAY_SECONDS,
         jitter_factor: float = DEFAULT_JITTER_FACTOR,
+        seed: int | None = DEFAULT_JITTER_SEED,
     ):
         """Initialize the retry configuration."""
         self.max_retries = max_retries
         self.base_delay_seconds = base_delay_seconds
         self.max_delay_seconds = max_delay_seconds
         self.jitter_factor = jitter_factor
+        self.seed = seed
 
     def __repr__(self) -> str:
         """Return a string representation of the retry configuration."""
@@ -91,7 +95,8 @@ class RetryConfig:
             f"RetryConfig(retries={self.max_retries}, "
             f"base_delay={self.base_delay_seconds}s, "
             f"max_delay={self.max_delay_seconds}s, "
-            f"jitter={self.jitter_factor})"
+            f"jitter={self.jitter_factor}, "
+            f"seed={self.seed})"
         )
 
 
@@ -99,7 +104,9 @@ class RetryHandler:
     """Handler for retry logic with exponential backoff.
 
     This class determines whether errors are retriable and calculates
-    backoff delays with jitter.
+    backoff delays with jitter. Jitter is drawn from a random source seeded
+    with ``config.seed``, so handlers with the same seed produce the same
+    sequence of delays.
 
     Example:
         >>> handler = RetryHandler(RetryConfig(max_retries=3))
@@ -117,6 +124,7 @@ class RetryHandler:
 
         """
         self.config = config or RetryConfig()
+        self._rng = random.Random(self.config.seed)
 
     def should_retry(self, error: Exception) -> bool:
         """Determine if an error warrants a retry.
@@ -198,7 +206,7 @@ class RetryHandler:
         capped_delay = min(exponential_delay, self.config.max_delay_seconds)
 
         # Add jitter: random factor between 0 and jitter_factor
-        jitter: float = random.uniform(0, self.config.jitter_factor)
+        jitter: float = self._rng.uniform(0, self.config.jitter_factor)
         final_delay: float = capped_delay * (1 + jitter)
 
         logger.debug(
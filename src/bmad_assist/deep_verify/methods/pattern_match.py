@@ -147,6 +147,9 @@ class PatternMatchMethod(BaseVerificationMethod):
                 patterns = self._library.get_all_patterns()
                 logger.debug("Using all %d patterns", len(patterns))
 
+            # Opt-in heuristics are only run by explicitly configured scans
+            patterns = [p for p in patterns if not p.opt_in]
+
             if not patterns:
                 logger.warning("No patterns available for matching")
                 return []
@@ -45,6 +45,7 @@ See Also:
 """
 
 from bmad_assist.deep_verify.patterns.library import (
+    PATTERN_API_VERSION,
     PatternLibrary,
     get_default_pattern_library,
 )
@@ -55,6 +56,7 @@ from bmad_assist.deep_verify.patterns.types import (
 )
 
 __all__ = [
+    "PATTERN_API_VERSION",
     "PatternLibrary",
     "PatternMatcher",
     "PatternMatchResult",
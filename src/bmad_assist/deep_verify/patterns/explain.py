@@ -0,0 +1,182 @@
+"""Pattern explanations for Deep Verify.
+
+This module turns pattern metadata into human-readable explanations, backing
+the ``bmad-assist verify explain <ID>`` command.
+
+Example:
+    >>> from bmad_assist.deep_verify.patterns.explain import (
+    ...     explain_pattern,
+    ...     format_explanation,
+    ... )
+    >>> for explanation in explain_pattern("CC-004"):
+    ...     print(format_explanation(explanation))
+
+"""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+
+from bmad_assist.core.exceptions import PatternNotFoundError
+from bmad_assist.deep_verify.core.types import Pattern, PatternId, Severity
+from bmad_assist.deep_verify.patterns.library import PatternLibrary
+from bmad_assist.deep_verify.scan.scanner import known_patterns
+
+# Placeholders shown when a pattern lacks optional metadata
+NO_RATIONALE = "No rationale available"
+NO_EXAMPLE = "No example available"
+NO_HELP_URL = "No help URL available"
+
+
+@dataclass(frozen=True, slots=True)
+class PatternExplanation:
+    """Human-readable explanation of a single pattern.
+
+    Attributes:
+        pattern_id: Pattern identifier (e.g., "CC-004-CODE-GO").
+        title: Short title (description up to the first " - " separator).
+        description: Full pattern description.
+        severity: Severity reported when the pattern matches.
+        domain: Domain name the pattern applies to.
+        language: Language code for code patterns, None for spec patterns.
+        opt_in: Whether the pattern only runs when explicitly enabled.
+        files: File name globs the pattern is limited to (empty = all files).
+        fixable: Whether ``verify fix`` can rewrite the pattern's findings.
+        rationale: Why the issue matters.
+        bad_example: Snippet that triggers the pattern.
+        good_example: Corrected snippet.
+        remediation: How to fix the issue.
+        help_url: Link to further documentation.
+
+    """
+
+    pattern_id: PatternId
+    title: str
+    description: str
+    severity: Severity
+    domain: str
+    language: str | None
+    opt_in: bool
+    files: tuple[str, ...]
+    fixable: bool
+    rationale: str | None
+    bad_example: str | None
+    good_example: str | None
+    remediation: str | None
+    help_url: str | None
+
+
+def explain_pattern(
+    pattern_id: str, library: PatternLibrary | None = None
+) -> list[PatternExplanation]:
+    """Build explanations for a pattern ID.
+
+    A full code pattern ID (e.g., "CC-004-CODE-GO") yields a single
+    explanation. A base ID (e.g., "CC-004") yields the spec pattern, if any,
+    followed by every code pattern sharing that base. Checks implemented in
+    code are explained too (see ``known_patterns``).
+
+    Args:
+        pattern_id: Pattern ID to explain (case-insensitive).
+        library: Pattern library to search. Defaults to the default library.
+
+    Returns:
+        List of explanations, sorted by pattern ID.
+
+    Raises:
+        PatternNotFoundError: If no pattern matches the ID.
+
+    """
+    normalized = pattern_id.strip().upper()
+
+    prefix = f"{normalized}-CODE"
+    patterns = sorted(
+        (p for p in known_patterns(library) if p.id == normalized or p.id.startswith(prefix)),
+        key=lambda p: p.id,
+    )
+
+    if not patterns:
+        raise PatternNotFoundError(
+            f"Unknown pattern ID '{pattern_id}'",
+            pattern_id=pattern_id,
+        )
+
+    return [_build_explanation(p) for p in patterns]
+
+
+def _build_explanation(pattern: Pattern) -> PatternExplanation:
+    """Build an explanation from a Pattern.
+
+    Args:
+        pattern: Pattern to explain.
+
+    Returns:
+        PatternExplanation with title derived from the description.
+
+    """
+    description = pattern.description or f"Pattern {pattern.id}"
+    title = description.split(" - ", 1)[0]
+
+    return PatternExplanation(
+        pattern_id=pattern.id,
+        title=title,
+        description=description,
+        severity=pattern.severity,
+        domain=pattern.domain.value,
+        language=pattern.language,
+        opt_in=pattern.opt_in,
+        files=pattern.files,
+        fixable=pattern.fix is not None,
+        rationale=pattern.rationale,
+        bad_example=pattern.bad_example,
+        good_example=pattern.good_example,
+        remediation=pattern.remediation,
+        help_url=pattern.help_url,
+    )
+
+
+def format_explanation(explanation: PatternExplanation) -> str:
+    """Format an explanation as plain text.
+
+    Args:
+        explanation: Explanation to format.
+
+    Returns:
+        Multi-line plain text explanation.
+
+    """
+    language = explanation.language or "any"
+    lines = [
+        f"{explanation.pattern_id}: {explanation.title}",
+        f"Severity: {explanation.severity.value}",
+        f"Domain: {explanation.domain}",
+        f"Language: {language}",
+        f"Enabled by default: {'no (opt-in)' if explanation.opt_in else 'yes'}",
+    ]
+    if explanation.files:
+        lines.append(f"Files: {', '.join(explanation.files)}")
+    if explanation.fixable:
+        lines.append("Automatic fix: yes (verify fix)")
+    lines += [
+        "",
+        "Description:",
+        f"  {explanation.description}",
+        "",
+        "Why it matters:",
+        f"  {explanation.rationale or NO_RATIONALE}",
+        "",
+        "Bad example:",
+        _indent(explanation.bad_example or NO_EXAMPLE),
+        "",
+        "Good example:",
+        _indent(explanation.good_example or NO_EXAMPLE),
+    ]
+    if explanation.remediation:
+        lines += ["", "Remediation:", f"  {explanation.remediation}"]
+    lines += ["", f"Help: {explanation.help_url or NO_HELP_URL}"]
+    return "\n".join(lines)
+
+
+def _indent(text: str) -> str:
+    """Indent every line of a snippet by two spaces."""
+    return "\n".join(f"  {line}" if line else "" for line in text.rstrip("\n").splitlines())
@@ -18,7 +18,10 @@ from bmad_assist.core.exceptions import PatternLibraryError, PatternNotFoundErro
 from bmad_assist.deep_verify.core.types import (
     ArtifactDomain,
     Pattern,
+    PatternEffort,
+    PatternFix,
     PatternId,
+    PatternPrecision,
     Severity,
     Signal,
 )
@@ -29,6 +32,13 @@ logger = logging.getLogger(__name__)
 # Extended for code patterns: XX-NNN-CODE or XX-NNN-CODE-LANG (e.g., "CC-001-CODE", "CC-001-CODE-GO")
 PATTERN_ID_REGEX = re.compile(r"^[A-Z]{2,3}-\d{3}(-CODE(-[A-Z]{2,})?)?$")
 
+# Version of the pattern file schema. Files declare the version they were
+# written for as a top-level `api_version`; files without one, or with another
+# version, are rejected on load so that plugin files written for an
+# incompatible schema fail loudly instead of loading with fields ignored or
+# misread. Bump on incompatible changes.
+PATTERN_API_VERSION = 1
+
 # Language code mapping - shared across methods for consistency
 LANGUAGE_CODE_MAP: dict[str, str] = {
     "go": "go",
@@ -166,6 +176,20 @@ class PatternLibrary:
                 file_path=path,
             )
 
+        if "api_version" not in data:
+            raise PatternLibraryError(
+                f"Missing api_version: declare 'api_version: {PATTERN_API_VERSION}' for "
+                "the pattern schema the file was written for",
+                file_path=path,
+            )
+        api_version = data["api_version"]
+        if api_version != PATTERN_API_VERSION or isinstance(api_version, bool):
+            raise PatternLibraryError(
+                f"Unsupported api_version {api_version!r}: expected {PATTERN_API_VERSION}; "
+                "the file was written for an incompatible pattern schema",
+                file_path=path,
+            )
+
         patterns_data = data.get("patterns")
         if patterns_data is None:
             logger.debug("No 'patterns' key in YAML file: %s", path)
@@ -294,6 +318,88 @@ class PatternLibrary:
         # Optional fields
         description = data.get("description")
         remediation = data.get("remediation")
+        rationale = data.get("rationale")
+        bad_example = data.get("bad_example")
+        good_example = data.get("good_example")
+        help_url = data.get("help_url")
+        opt_in = bool(data.get("opt_in", False))
+
+        files_data = data.get("files", [])
+        if isinstance(files_data, str):
+            files_data = [files_data]
+        if not isinstance(files_data, list):
+            raise PatternLibraryError(
+                f"Pattern '{pattern_id}' files must be a list of file name globs",
+                file_path=file_path,
+                pattern_id=pattern_id,
+            )
+        files = tuple(str(f) for f in files_data)
+
+        fix_data = data.get("fix")
+        fix = None
+        if fix_data is not None:
+            if not (
+                isinstance(fix_data, dict)
+                and isinstance(fix_data.get("find"), str)
+                and isinstance(fix_data.get("replace"), str)
+            ):
+                raise PatternLibraryError(
+                    f"Pattern '{pattern_id}' fix must be a mapping with 'find' and 'replace'",
+                    file_path=file_path,
+                    pattern_id=pattern_id,
+                )
+            try:
+                re.compile(fix_data["find"], re.MULTILINE)
+            except re.error as e:
+                raise PatternLibraryError(
+                    f"Pattern '{pattern_id}' fix has an invalid 'find' regex: {e}",
+                    file_path=file_path,
+                    pattern_id=pattern_id,
+                ) from e
+            fix = PatternFix(find=fix_data["find"], replace=fix_data["replace"])
+
+        precision = None
+        precision_str = data.get("precision")
+        if precision_str is not None:
+            try:
+                precision = PatternPrecision(str(precision_str).lower())
+            except ValueError as e:
+                valid_precisions = [p.value for p in PatternPrecision]
+                raise PatternLibraryError(
+                    f"Invalid precision '{precision_str}' for pattern '{pattern_id}'. "
+                    f"Valid precisions: {valid_precisions}",
+                    file_path=file_path,
+                    pattern_id=pattern_id,
+                ) from e
+
+        effort = None
+        effort_str = data.get("effort")
+        if effort_str is not None:
+            try:
+                effort = PatternEffort(str(effort_str).lower())
+            except ValueError as e:
+                valid_efforts = [p.value for p in PatternEffort]
+                raise PatternLibraryError(
+                    f"Invalid effort '{effort_str}' for pattern '{pattern_id}'. "
+                    f"Valid efforts: {valid_efforts}",
+                    file_path=file_path,
+                    pattern_id=pattern_id,
+                ) from e
+
+        max_confidence = data.get("max_confidence")
+        if max_confidence is not None:
+            if (
+                isinstance(max_confidence, bool)
+                or not isinstance(max_confidence, (int, float))
+                or not 0.0 < max_confidence <= 1.0
+            ):
+                raise PatternLibraryError(
+                    f"Pattern '{pattern_id}' max_confidence must be a number in (0.0, 1.0], "
+                    f"got {max_confidence!r}",
+                    file_path=file_path,
+                    pattern_id=pattern_id,
+                )
+            max_confidence = float(max_confidence)
 
         # Extract language from file path for code patterns
         # e.g., patterns/data/code/go/concurrency.yaml -> "go"
@@ -307,6 +413,16 @@ class PatternLibrary:
             description=description,
             remediation=remediation,
             language=language,
+            rationale=rationale,
+            bad_example=bad_example,
+            good_example=good_example,
+            help_url=help_url,
+            opt_in=opt_in,
+            files=files,
+            fix=fix,
+            precision=precision,
+            effort=effort,
+            max_confidence=max_confidence,
         )
 
     def _extract_language_from_path(self, file_path: Path) -> str | None:
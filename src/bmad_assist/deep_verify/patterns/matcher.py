@@ -9,6 +9,7 @@ from __future__ import annotations
 import logging
 import re
 import signal
+import threading
 from dataclasses import dataclass
 from typing import TYPE_CHECKING
 
@@ -43,6 +44,7 @@ def match_with_timeout(pattern: re.Pattern[str], text: str, timeout_seconds: flo
     """Match regex pattern with timeout protection.
 
     Uses signal.SIGALRM on Unix systems for timeout. On non-Unix systems,
+    and off the main thread (signal handlers can only be installed there),
     falls back to direct matching without timeout (best effort).
 
     Args:
@@ -57,8 +59,8 @@ def match_with_timeout(pattern: re.Pattern[str], text: str, timeout_seconds: flo
         TimeoutError: If matching exceeds timeout.
 
     """
-    if not _SIGALRM_AVAILABLE:
-        # Fallback: no timeout protection on non-Unix systems
+    if not _SIGALRM_AVAILABLE or threading.current_thread() is not threading.main_thread():
+        # Fallback: no timeout protection on non-Unix systems or worker threads
         return pattern.search(text)
 
     # Set up timeout handler
@@ -77,16 +79,19 @@ def match_with_timeout(pattern: re.Pattern[str], text: str, timeout_seconds: flo
 class MatchContext:
     """Context for pattern matching.
 
+    The context is immutable, so detectors running concurrently over one
+    file can share it.
+
     Attributes:
         text: The full text being matched against.
         lines: The text split into lines (for line number extraction).
-        line_offsets: List of starting positions for each line.
+        line_offsets: Starting positions for each line.
 
     """
 
     text: str
-    lines: list[str]
-    line_offsets: list[int]
+    lines: tuple[str, ...]
+    line_offsets: tuple[int, ...]
 
     @classmethod
     def from_text(cls, text: str) -> MatchContext:
@@ -99,13 +104,13 @@ class MatchContext:
             MatchContext with parsed lines and offsets.
 
         """
-        lines = text.split("\n")
+        lines = tuple(text.split("\n"))
         line_offsets = []
         offset = 0
         for line in lines:
             line_offsets.append(offset)
             offset += len(line) + 1  # +1 for newline character
-        return cls(text=text, lines=lines, line_offsets=line_offsets)
+        return cls(text=text, lines=lines, line_offsets=tuple(line_offsets))
 
     def get_line_number(self, position: int) -> int:
         """Get the 1-based line number for a character position.
@@ -148,6 +153,8 @@ class PatternMatcher:
         _patterns: List of patterns to match against.
         _threshold: Minimum confidence threshold for matches.
         _regex_timeout: Timeout for regex matching in seconds.
+        _skipped: Patterns with signals that could not be evaluated in the
+            last match, with the reason.
 
     """
 
@@ -173,11 +180,22 @@ class PatternMatcher:
         self._threshold = threshold
         self._library = library
         self._regex_timeout = regex_timeout
+        self._skipped: dict[str, str] = {}
 
     def __repr__(self) -> str:
         """Return a string representation of the matcher."""
         return f"PatternMatcher(patterns={len(self._patterns)}, threshold={self._threshold:.2f})"
 
+    @property
+    def skipped(self) -> dict[str, str]:
+        """Patterns whose signals could not all be evaluated in the last match.
+
+        Maps pattern ID to the reason (an invalid or timed-out regex). Such
+        patterns may miss findings, so callers can warn that their results
+        are incomplete.
+        """
+        return dict(self._skipped)
+
     def match(self, text: str) -> list[PatternMatchResult]:
         """Match all patterns against the text.
 
@@ -191,10 +209,27 @@ class PatternMatcher:
         """
         if not text or not self._patterns:
             return []
+        return self.match_context(MatchContext.from_text(text))
 
-        context = MatchContext.from_text(text)
-        results: list[PatternMatchResult] = []
+    def match_context(self, context: MatchContext) -> list[PatternMatchResult]:
+        """Match all patterns against an already split text.
+
+        Lets callers that split a text once share the context between
+        several matchers.
+
+        Args:
+            context: Match context of the text to analyze.
 
+        Returns:
+            List of PatternMatchResult objects with confidence >= threshold,
+            sorted by confidence descending.
+
+        """
+        self._skipped = {}
+        if not context.text or not self._patterns:
+            return []
+
+        results: list[PatternMatchResult] = []
         for pattern in self._patterns:
             result = self._match_single(pattern, context)
             if result and result.confidence >= self._threshold:
@@ -347,6 +382,7 @@ class PatternMatcher:
                     pattern.id,
                     e,
                 )
+                self._skipped[pattern.id] = "invalid regex"
                 return False, 0, ""
 
         try:
@@ -359,6 +395,7 @@ class PatternMatcher:
                 signal.pattern[:50],
                 self._regex_timeout,
             )
+            self._skipped[pattern.id] = f"regex timed out after {self._regex_timeout:.1f}s"
             return False, 0, ""
 
         if not match:
@@ -373,7 +410,8 @@ class PatternMatcher:
     ) -> float:
         """Calculate match confidence based on weighted signal coverage.
 
-        Formula: sum(matched_signal_weights) / sum(all_signal_weights)
+        Formula: sum(matched_signal_weights) / sum(all_signal_weights),
+        capped at the pattern's max_confidence when it sets one.
 
         Args:
             pattern: The pattern being matched.
@@ -390,4 +428,4 @@ class PatternMatcher:
         matched_weight = sum(ms.signal.weight for ms in matched_signals)
         confidence = matched_weight / total_weight
 
-        return min(confidence, 1.0)
+        return min(confidence, 1.0, pattern.max_confidence or 1.0)
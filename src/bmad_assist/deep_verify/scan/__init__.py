@@ -0,0 +1,588 @@
+"""Deterministic source tree scanning for Deep Verify.
+
+This package runs the language-specific code patterns over a file tree
+without LLM calls and reports located findings. Per-directory
+``.deepverify.yaml`` files scope rule sets to subtrees.
+
+Example:
+    >>> from pathlib import Path
+    >>> from bmad_assist.deep_verify.scan import Scanner, ScanOptions
+    >>> report = Scanner(ScanOptions(threshold=0.8)).scan(Path("."))
+    >>> print(f"{len(report.findings)} findings in {len(report.files_scanned)} files")
+
+``ScanServer``, ``write_sqlite``, the trend helpers and the benchmark are
+loaded on first access so that the analysis path imports no networking,
+database or subprocess modules (see ``scan.playground``).
+
+"""
+
+from typing import TYPE_CHECKING
+
+from bmad_assist.deep_verify.scan.badge import (
+    BADGE_COLOR,
+    BADGE_GREEN,
+    BADGE_RED,
+    BADGE_STYLES,
+    BADGE_YELLOW,
+    badge_svg,
+    write_badge,
+)
+from bmad_assist.deep_verify.scan.baselines import (
+    BASELINE_FORMAT_VERSION,
+    BASELINE_REASON,
+    AcceptedFinding,
+    FindingBaseline,
+    apply_finding_baseline,
+    create_finding_baseline,
+    read_finding_baseline,
+    serialize_finding_baseline,
+    write_finding_baseline,
+)
+from bmad_assist.deep_verify.scan.bitwise import (
+    BITWISE_CONDITION_CONFIDENCE,
+    BITWISE_CONDITION_PATTERN,
+    find_bitwise_conditions,
+)
+from bmad_assist.deep_verify.scan.bodies import (
+    UNBOUNDED_BODY_PATTERN,
+    UnboundedBodyOptions,
+    find_unbounded_body_reads,
+)
+from bmad_assist.deep_verify.scan.cache import DEFAULT_CACHE_FILENAME, ScanCache
+from bmad_assist.deep_verify.scan.cancellation import (
+    UNCANCELLABLE_LOOP_CONFIDENCE,
+    UNCANCELLABLE_LOOP_PATTERN,
+    find_uncancellable_loops,
+)
+from bmad_assist.deep_verify.scan.channels import (
+    ONE_WAY_CHANNEL_CONFIDENCE,
+    ONE_WAY_CHANNEL_PATTERN,
+    find_one_way_channels,
+)
+from bmad_assist.deep_verify.scan.clocks import TIME_IDIOM_PATTERN, find_time_idioms
+from bmad_assist.deep_verify.scan.compare import (
+    COMPARE_SEVERITIES,
+    ReportColumn,
+    compare_reports,
+    serialize_report_comparison,
+)
+from bmad_assist.deep_verify.scan.config import (
+    CONFIG_FILENAME,
+    DETECTOR_OPTIONS,
+    DetectorOptions,
+    ScanConfig,
+    ScanConfigResolver,
+    detector_for,
+    load_scan_config,
+    matches_selector,
+    merge_scan_configs,
+    register_detector_options,
+)
+from bmad_assist.deep_verify.scan.contexts import CONTEXT_FIELD_PATTERN, find_context_fields
+from bmad_assist.deep_verify.scan.conversions import (
+    NARROWING_CONVERSION_CONFIDENCE,
+    NARROWING_CONVERSION_PATTERN,
+    find_narrowing_conversions,
+)
+from bmad_assist.deep_verify.scan.copies import WAITGROUP_COPY_PATTERN, find_waitgroup_copies
+from bmad_assist.deep_verify.scan.defers import (
+    DEFERRED_SEND_CONFIDENCE,
+    DEFERRED_SEND_PATTERN,
+    find_deferred_sends,
+)
+from bmad_assist.deep_verify.scan.deprecations import (
+    DEFAULT_DEPRECATED_FUNCS,
+    DEPRECATED_FUNC_PATTERN,
+    find_deprecated_calls,
+    parse_go_imports,
+)
+from bmad_assist.deep_verify.scan.drops import SILENT_DROP_PATTERN, find_silent_drops
+from bmad_assist.deep_verify.scan.embedding import (
+    PROMOTED_MUTEX_CONFIDENCE,
+    PROMOTED_MUTEX_PATTERN,
+    find_promoted_mutex_locks,
+)
+from bmad_assist.deep_verify.scan.enums import (
+    ENUM_SWITCH_PATTERN,
+    find_enum_switches,
+    parse_go_enums,
+)
+from bmad_assist.deep_verify.scan.environment import (
+    UNCHECKED_ENV_CONFIDENCE,
+    UNCHECKED_ENV_PATTERN,
+    UncheckedEnvOptions,
+    find_unchecked_env_reads,
+)
+from bmad_assist.deep_verify.scan.errgroups import GROUP_CONTEXT_PATTERN, find_group_context_leaks
+from bmad_assist.deep_verify.scan.events import AnalysisEvent, AnalysisEventKind
+from bmad_assist.deep_verify.scan.fixes import (
+    FileFix,
+    apply_fixes,
+    compare_findings,
+    write_fixes,
+)
+from bmad_assist.deep_verify.scan.generators import SHARED_RAND_PATTERN, find_shared_rands
+from bmad_assist.deep_verify.scan.github import (
+    GITHUB_COMMAND,
+    github_annotation,
+    write_github_annotations,
+)
+from bmad_assist.deep_verify.scan.gitlab import (
+    GITLAB_SEVERITY,
+    gitlab_code_quality_issue,
+    gitlab_code_quality_report,
+    write_gitlab_code_quality,
+)
+from bmad_assist.deep_verify.scan.helpers import TEST_HELPER_PATTERN, find_unmarked_test_helpers
+from bmad_assist.deep_verify.scan.history import (
+    LIBRARY_RELEASE,
+    PATTERN_HISTORY,
+    apply_since_version,
+    changed_since,
+    parse_version,
+)
+from bmad_assist.deep_verify.scan.htmlreport import HTML_DATA_ID, report_html, write_html
+from bmad_assist.deep_verify.scan.indexes import (
+    CAPTURED_INDEX_CONFIDENCE,
+    CAPTURED_INDEX_PATTERN,
+    find_captured_index_writes,
+)
+from bmad_assist.deep_verify.scan.keys import STRING_CONTEXT_KEY_PATTERN, find_string_context_keys
+from bmad_assist.deep_verify.scan.lazyinit import (
+    LAZY_MAP_CONFIDENCE,
+    LAZY_MAP_PATTERN,
+    find_unguarded_lazy_maps,
+)
+from bmad_assist.deep_verify.scan.literals import (
+    UNKEYED_LITERAL_PATTERN,
+    find_unkeyed_literals,
+)
+from bmad_assist.deep_verify.scan.lockcopies import MUTEX_COPY_PATTERN, find_mutex_struct_copies
+from bmad_assist.deep_verify.scan.maps import (
+    MAP_VALUE_MUTATION_PATTERN,
+    find_map_value_mutations,
+)
+from bmad_assist.deep_verify.scan.minimize import (
+    FindingTarget,
+    MinimizedSource,
+    minimize_source,
+    parse_finding_target,
+)
+from bmad_assist.deep_verify.scan.nesting import (
+    LOOP_LOCK_CONFIDENCE,
+    LOOP_LOCK_PATTERN,
+    find_loop_locks,
+)
+from bmad_assist.deep_verify.scan.nilvalues import (
+    NIL_MAP_VALUE_CONFIDENCE,
+    NIL_MAP_VALUE_PATTERN,
+    find_nil_map_values,
+)
+from bmad_assist.deep_verify.scan.overflow import (
+    SIZE_OVERFLOW_CONFIDENCE,
+    SIZE_OVERFLOW_PATTERN,
+    find_size_overflows,
+)
+from bmad_assist.deep_verify.scan.owners import (
+    CODEOWNERS_LOCATIONS,
+    UNOWNED,
+    CodeOwners,
+    OwnerRule,
+    find_codeowners,
+    owner_report_filename,
+    parse_codeowners,
+    split_by_owner,
+    write_owner_reports,
+)
+from bmad_assist.deep_verify.scan.packages import PackageResolver
+from bmad_assist.deep_verify.scan.panics import (
+    PANIC_ROUTE_CONFIDENCE,
+    PANIC_ROUTE_PATTERN,
+    find_panic_routes,
+)
+from bmad_assist.deep_verify.scan.paths import PATH_CONCAT_PATTERN, find_concatenated_paths
+from bmad_assist.deep_verify.scan.policy import (
+    GOARCH_32BIT,
+    SEVERITY_LADDER,
+    PathRule,
+    apply_path_rules,
+    target_arch_rules,
+)
+from bmad_assist.deep_verify.scan.polls import SLEEP_POLL_PATTERN, find_sleep_polls
+from bmad_assist.deep_verify.scan.presets import (
+    HIGH_SIGNAL_PRESET,
+    HIGH_SIGNAL_THRESHOLD,
+    PRESETS,
+    apply_preset,
+    high_precision_ids,
+)
+from bmad_assist.deep_verify.scan.randomness import (
+    DEFAULT_RANDOM_SECRET_NAMES,
+    WEAK_RANDOM_CONFIDENCE,
+    WEAK_RANDOM_PATTERN,
+    find_weak_random_secrets,
+)
+from bmad_assist.deep_verify.scan.receivers import (
+    VALUE_RECEIVER_PATTERN,
+    find_value_receiver_mutations,
+    parse_go_receivers,
+)
+from bmad_assist.deep_verify.scan.sarif import (
+    SARIF_LEVEL,
+    SARIF_SEVERITY,
+    import_sarif,
+    sarif_log,
+    sarif_result,
+    write_sarif,
+)
+from bmad_assist.deep_verify.scan.scanner import (
+    CAP_SAMPLE_WEIGHTS,
+    DEFAULT_LOAD_CONCURRENCY,
+    DEFAULT_MAX_FILE_BYTES,
+    DEFAULT_SAMPLE_SEED,
+    ScanOptions,
+    Scanner,
+    cap_findings,
+    cap_report,
+    is_generated_source,
+    known_patterns,
+    read_change_manifest,
+)
+from bmad_assist.deep_verify.scan.selects import (
+    CROSS_CASE_RECEIVE_CONFIDENCE,
+    CROSS_CASE_RECEIVE_PATTERN,
+    find_cross_case_receives,
+)
+from bmad_assist.deep_verify.scan.sensitive import (
+    DEFAULT_SENSITIVE_NAMES,
+    SENSITIVE_LOG_PATTERN,
+    find_sensitive_logs,
+)
+from bmad_assist.deep_verify.scan.snippets import wrap_go_snippet
+from bmad_assist.deep_verify.scan.spawns import (
+    LOCKED_SPAWN_CONFIDENCE,
+    LOCKED_SPAWN_PATTERN,
+    find_locked_spawns,
+)
+from bmad_assist.deep_verify.scan.suppressions import (
+    SUPPRESSION_PATTERN,
+    Suppression,
+    apply_suppressions,
+    parse_suppressions,
+)
+from bmad_assist.deep_verify.scan.timers import (
+    TIMER_SELECT_CONFIDENCE,
+    TIMER_SELECT_PATTERN,
+    find_timer_selects,
+)
+from bmad_assist.deep_verify.scan.types import (
+    DEFAULT_JSON_INDENT,
+    EFFORT_HOURS,
+    NOTIFICATION_MAX_CHARS,
+    NOTIFICATION_MAX_FILES,
+    NOTIFICATION_STYLES,
+    RESERVED_LABEL_KEYS,
+    RESERVED_LABEL_PREFIX,
+    IssueGrouping,
+    PackageReport,
+    ScanFinding,
+    ScanIssue,
+    ScanReport,
+    deserialize_scan_finding,
+    deserialize_scan_report,
+    finding_fingerprint,
+    scan_report_json,
+    serialize_scan_finding,
+    serialize_scan_report,
+    validate_labels,
+)
+from bmad_assist.deep_verify.scan.validation import ConfigIssue, validate_scan_config
+from bmad_assist.deep_verify.scan.valuekeys import (
+    DUPLICATE_CONTEXT_KEY_CONFIDENCE,
+    DUPLICATE_CONTEXT_KEY_PATTERN,
+    find_duplicate_context_keys,
+)
+from bmad_assist.deep_verify.scan.visibility import exported_lines, filter_exported
+from bmad_assist.deep_verify.scan.waitgroups import (
+    UNBOUNDED_WAIT_CONFIDENCE,
+    UNBOUNDED_WAIT_PATTERN,
+    find_unbounded_waits,
+)
+
+if TYPE_CHECKING:
+    from bmad_assist.deep_verify.scan.bench import BenchBaseline as BenchBaseline
+    from bmad_assist.deep_verify.scan.bench import BenchResult as BenchResult
+    from bmad_assist.deep_verify.scan.bench import load_baseline as load_baseline
+    from bmad_assist.deep_verify.scan.bench import run_benchmark as run_benchmark
+    from bmad_assist.deep_verify.scan.server import ScanServer as ScanServer
+    from bmad_assist.deep_verify.scan.sqlite import (
+        SQLITE_SCHEMA_VERSION as SQLITE_SCHEMA_VERSION,
+    )
+    from bmad_assist.deep_verify.scan.sqlite import write_sqlite as write_sqlite
+    from bmad_assist.deep_verify.scan.trend import TrendPoint as TrendPoint
+    from bmad_assist.deep_verify.scan.trend import current_commit as current_commit
+    from bmad_assist.deep_verify.scan.trend import health_score as health_score
+    from bmad_assist.deep_verify.scan.trend import load_trend as load_trend
+
+# Lazy loading mapping
+_lazy_imports = {
+    "BenchBaseline": ".bench",
+    "BenchResult": ".bench",
+    "load_baseline": ".bench",
+    "run_benchmark": ".bench",
+    "ScanServer": ".server",
+    "SQLITE_SCHEMA_VERSION": ".sqlite",
+    "write_sqlite": ".sqlite",
+    "TrendPoint": ".trend",
+    "current_commit": ".trend",
+    "health_score": ".trend",
+    "load_trend": ".trend",
+}
+
+
+def __getattr__(name: str) -> object:
+    """Lazy load attributes on first access."""
+    if name in _lazy_imports:
+        import importlib
+
+        module = importlib.import_module(_lazy_imports[name], __package__)
+        return getattr(module, name)
+    raise AttributeError(f"module {__name__!r} has no attribute {name!r}")
+
+
+__all__ = [
+    "BADGE_COLOR",
+    "BADGE_GREEN",
+    "BADGE_RED",
+    "BADGE_STYLES",
+    "BADGE_YELLOW",
+    "BASELINE_FORMAT_VERSION",
+    "BASELINE_REASON",
+    "BITWISE_CONDITION_CONFIDENCE",
+    "BITWISE_CONDITION_PATTERN",
+    "CAPTURED_INDEX_CONFIDENCE",
+    "CAPTURED_INDEX_PATTERN",
+    "CAP_SAMPLE_WEIGHTS",
+    "CODEOWNERS_LOCATIONS",
+    "COMPARE_SEVERITIES",
+    "CONFIG_FILENAME",
+    "CONTEXT_FIELD_PATTERN",
+    "CROSS_CASE_RECEIVE_CONFIDENCE",
+    "CROSS_CASE_RECEIVE_PATTERN",
+    "DEFAULT_CACHE_FILENAME",
+    "DEFAULT_DEPRECATED_FUNCS",
+    "DEFAULT_JSON_INDENT",
+    "DEFAULT_LOAD_CONCURRENCY",
+    "DEFAULT_MAX_FILE_BYTES",
+    "DEFAULT_RANDOM_SECRET_NAMES",
+    "DEFAULT_SAMPLE_SEED",
+    "DEFAULT_SENSITIVE_NAMES",
+    "DEFERRED_SEND_CONFIDENCE",
+    "DEFERRED_SEND_PATTERN",
+    "DEPRECATED_FUNC_PATTERN",
+    "DETECTOR_OPTIONS",
+    "DUPLICATE_CONTEXT_KEY_CONFIDENCE",
+    "DUPLICATE_CONTEXT_KEY_PATTERN",
+    "EFFORT_HOURS",
+    "ENUM_SWITCH_PATTERN",
+    "GITHUB_COMMAND",
+    "GITLAB_SEVERITY",
+    "GOARCH_32BIT",
+    "GROUP_CONTEXT_PATTERN",
+    "HIGH_SIGNAL_PRESET",
+    "HIGH_SIGNAL_THRESHOLD",
+    "HTML_DATA_ID",
+    "LAZY_MAP_CONFIDENCE",
+    "LAZY_MAP_PATTERN",
+    "LIBRARY_RELEASE",
+    "LOCKED_SPAWN_CONFIDENCE",
+    "LOCKED_SPAWN_PATTERN",
+    "LOOP_LOCK_CONFIDENCE",
+    "LOOP_LOCK_PATTERN",
+    "MAP_VALUE_MUTATION_PATTERN",
+    "MUTEX_COPY_PATTERN",
+    "NARROWING_CONVERSION_CONFIDENCE",
+    "NARROWING_CONVERSION_PATTERN",
+    "NIL_MAP_VALUE_CONFIDENCE",
+    "NIL_MAP_VALUE_PATTERN",
+    "NOTIFICATION_MAX_CHARS",
+    "NOTIFICATION_MAX_FILES",
+    "NOTIFICATION_STYLES",
+    "ONE_WAY_CHANNEL_CONFIDENCE",
+    "ONE_WAY_CHANNEL_PATTERN",
+    "PANIC_ROUTE_CONFIDENCE",
+    "PANIC_ROUTE_PATTERN",
+    "PATH_CONCAT_PATTERN",
+    "PROMOTED_MUTEX_CONFIDENCE",
+    "PROMOTED_MUTEX_PATTERN",
+    "PATTERN_HISTORY",
+    "PRESETS",
+    "RESERVED_LABEL_KEYS",
+    "RESERVED_LABEL_PREFIX",
+    "SARIF_LEVEL",
+    "SARIF_SEVERITY",
+    "SENSITIVE_LOG_PATTERN",
+    "SEVERITY_LADDER",
+    "SHARED_RAND_PATTERN",
+    "SILENT_DROP_PATTERN",
+    "SIZE_OVERFLOW_CONFIDENCE",
+    "SIZE_OVERFLOW_PATTERN",
+    "SLEEP_POLL_PATTERN",
+    "SQLITE_SCHEMA_VERSION",
+    "STRING_CONTEXT_KEY_PATTERN",
+    "SUPPRESSION_PATTERN",
+    "TEST_HELPER_PATTERN",
+    "TIMER_SELECT_CONFIDENCE",
+    "TIME_IDIOM_PATTERN",
+    "TIMER_SELECT_PATTERN",
+    "UNBOUNDED_BODY_PATTERN",
+    "UNBOUNDED_WAIT_CONFIDENCE",
+    "UNBOUNDED_WAIT_PATTERN",
+    "UNCANCELLABLE_LOOP_CONFIDENCE",
+    "UNCANCELLABLE_LOOP_PATTERN",
+    "UNCHECKED_ENV_CONFIDENCE",
+    "UNCHECKED_ENV_PATTERN",
+    "UNKEYED_LITERAL_PATTERN",
+    "UNOWNED",
+    "VALUE_RECEIVER_PATTERN",
+    "WAITGROUP_COPY_PATTERN",
+    "WEAK_RANDOM_CONFIDENCE",
+    "WEAK_RANDOM_PATTERN",
+    "AcceptedFinding",
+    "AnalysisEvent",
+    "AnalysisEventKind",
+    "BenchBaseline",
+    "BenchResult",
+    "CodeOwners",
+    "ConfigIssue",
+    "DetectorOptions",
+    "FileFix",
+    "FindingBaseline",
+    "FindingTarget",
+    "IssueGrouping",
+    "MinimizedSource",
+    "OwnerRule",
+    "PackageReport",
+    "PackageResolver",
+    "PathRule",
+    "ReportColumn",
+    "ScanCache",
+    "ScanConfig",
+    "ScanConfigResolver",
+    "ScanFinding",
+    "ScanIssue",
+    "ScanOptions",
+    "ScanReport",
+    "ScanServer",
+    "Scanner",
+    "Suppression",
+    "TrendPoint",
+    "UnboundedBodyOptions",
+    "UncheckedEnvOptions",
+    "apply_finding_baseline",
+    "apply_fixes",
+    "apply_path_rules",
+    "apply_preset",
+    "apply_since_version",
+    "apply_suppressions",
+    "badge_svg",
+    "cap_findings",
+    "cap_report",
+    "changed_since",
+    "compare_findings",
+    "compare_reports",
+    "create_finding_baseline",
+    "current_commit",
+    "deserialize_scan_finding",
+    "deserialize_scan_report",
+    "detector_for",
+    "exported_lines",
+    "filter_exported",
+    "find_bitwise_conditions",
+    "find_captured_index_writes",
+    "find_codeowners",
+    "find_concatenated_paths",
+    "find_context_fields",
+    "find_cross_case_receives",
+    "find_deferred_sends",
+    "find_deprecated_calls",
+    "find_duplicate_context_keys",
+    "find_enum_switches",
+    "find_group_context_leaks",
+    "find_locked_spawns",
+    "find_loop_locks",
+    "find_map_value_mutations",
+    "find_mutex_struct_copies",
+    "find_narrowing_conversions",
+    "find_nil_map_values",
+    "find_one_way_channels",
+    "find_panic_routes",
+    "find_promoted_mutex_locks",
+    "find_sensitive_logs",
+    "find_shared_rands",
+    "find_silent_drops",
+    "find_size_overflows",
+    "find_sleep_polls",
+    "find_string_context_keys",
+    "find_time_idioms",
+    "find_timer_selects",
+    "find_unbounded_body_reads",
+    "find_unbounded_waits",
+    "find_uncancellable_loops",
+    "find_unchecked_env_reads",
+    "find_unguarded_lazy_maps",
+    "find_unkeyed_literals",
+    "find_unmarked_test_helpers",
+    "find_value_receiver_mutations",
+    "find_waitgroup_copies",
+    "find_weak_random_secrets",
+    "finding_fingerprint",
+    "github_annotation",
+    "gitlab_code_quality_issue",
+    "gitlab_code_quality_report",
+    "health_score",
+    "high_precision_ids",
+    "import_sarif",
+    "is_generated_source",
+    "known_patterns",
+    "load_baseline",
+    "load_scan_config",
+    "load_trend",
+    "matches_selector",
+    "merge_scan_configs",
+    "minimize_source",
+    "owner_report_filename",
+    "parse_codeowners",
+    "parse_finding_target",
+    "parse_go_enums",
+    "parse_go_imports",
+    "parse_go_receivers",
+    "parse_suppressions",
+    "parse_version",
+    "read_change_manifest",
+    "read_finding_baseline",
+    "register_detector_options",
+    "report_html",
+    "run_benchmark",
+    "sarif_log",
+    "sarif_result",
+    "scan_report_json",
+    "serialize_finding_baseline",
+    "serialize_report_comparison",
+    "serialize_scan_finding",
+    "serialize_scan_report",
+    "split_by_owner",
+    "target_arch_rules",
+    "validate_labels",
+    "validate_scan_config",
+    "wrap_go_snippet",
+    "write_badge",
+    "write_finding_baseline",
+    "write_fixes",
+    "write_github_annotations",
+    "write_gitlab_code_quality",
+    "write_html",
+    "write_owner_reports",
+    "write_sarif",
+    "write_sqlite",
+]
@@ -0,0 +1,125 @@
+"""SVG status badges for Deep Verify scans.
+
+Repositories advertise their scan status in the README with a
+shields.io-style badge: a grey "deep verify" label and the number of
+unsuppressed findings, colored by the worst severity among them (green
+when there are none, yellow for warnings and info, red for errors and
+critical findings). The SVG is self-contained, with no fonts, images or
+links to fetch.
+
+Example:
+    >>> from pathlib import Path
+    >>> from bmad_assist.deep_verify.scan import Scanner, write_badge
+    >>> report = Scanner().scan(Path("."))
+    >>> with open("deepverify.svg", "w") as f:
+    ...     write_badge(report, f)
+
+"""
+
+from __future__ import annotations
+
+from html import escape
+from typing import TextIO
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan.types import ScanReport
+
+# Badge styles: rounded with a gloss gradient, or square and flat
+BADGE_STYLES = ("flat", "flat-square")
+
+# Message colors: no findings, at worst warnings, errors or worse
+BADGE_GREEN = "#4c1"
+BADGE_YELLOW = "#dfb317"
+BADGE_RED = "#e05d44"
+
+# Worst severity -> message color
+BADGE_COLOR: dict[Severity, str] = {
+    Severity.CRITICAL: BADGE_RED,
+    Severity.ERROR: BADGE_RED,
+    Severity.WARNING: BADGE_YELLOW,
+    Severity.INFO: BADGE_YELLOW,
+}
+
+_LABEL = "deep verify"
+
+# Approximate glyph width of 11px Verdana and the padding around each text
+_CHAR_WIDTH = 7
+_PADDING = 10
+
+_SEVERITY_ORDER = (Severity.INFO, Severity.WARNING, Severity.ERROR, Severity.CRITICAL)
+
+
+def badge_svg(report: ScanReport, style: str = "flat") -> str:
+    """Render a scan report's status badge.
+
+    Args:
+        report: Scan report to summarize.
+        style: One of ``BADGE_STYLES``.
+
+    Returns:
+        SVG document without a trailing newline.
+
+    Raises:
+        ValueError: If style is not a known badge style.
+
+    """
+    if style not in BADGE_STYLES:
+        raise ValueError(f"Unknown badge style: {style!r}. Use one of: {', '.join(BADGE_STYLES)}")
+    findings = report.unsuppressed_findings()
+    message = f"{len(findings)} finding{'' if len(findings) == 1 else 's'}"
+    color = BADGE_GREEN
+    if findings:
+        worst = max((f.severity for f in findings), key=_SEVERITY_ORDER.index)
+        color = BADGE_COLOR[worst]
+
+    label_width = len(_LABEL) * _CHAR_WIDTH + _PADDING
+    message_width = len(message) * _CHAR_WIDTH + _PADDING
+    width = label_width + message_width
+    title = escape(f"{_LABEL}: {message}")
+    radius = 3 if style == "flat" else 0
+    lines = [
+        f'<svg xmlns="http://www.w3.org/2000/svg" width="{width}" height="20" '
+        f'role="img" aria-label="{title}">',
+        f"<title>{title}</title>",
+    ]
+    if style == "flat":
+        lines.append(
+            '<linearGradient id="s" x2="0" y2="100%">'
+            '<stop offset="0" stop-color="#bbb" stop-opacity=".1"/>'
+            '<stop offset="1" stop-opacity=".1"/></linearGradient>'
+        )
+    lines += [
+        f'<clipPath id="r"><rect width="{width}" height="20" rx="{radius}" fill="#fff"/>'
+        "</clipPath>",
+        '<g clip-path="url(#r)">',
+        f'<rect width="{label_width}" height="20" fill="#555"/>',
+        f'<rect x="{label_width}" width="{message_width}" height="20" fill="{color}"/>',
+    ]
+    if style == "flat":
+        lines.append(f'<rect width="{width}" height="20" fill="url(#s)"/>')
+    lines += [
+        "</g>",
+        '<g fill="#fff" text-anchor="middle" '
+        'font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">',
+        f'<text x="{label_width / 2:g}" y="14">{escape(_LABEL)}</text>',
+        f'<text x="{label_width + message_width / 2:g}" y="14">{escape(message)}</text>',
+        "</g>",
+        "</svg>",
+    ]
+    return "\n".join(lines)
+
+
+def write_badge(report: ScanReport, out: TextIO, style: str = "flat") -> None:
+    """Write a scan report's status badge as SVG.
+
+    Args:
+        report: Scan report to summarize.
+        out: Text stream receiving the SVG document.
+        style: One of ``BADGE_STYLES``.
+
+    Raises:
+        ValueError: If style is not a known badge style.
+
+    """
+    out.write(badge_svg(report, style))
+    out.write("\n")
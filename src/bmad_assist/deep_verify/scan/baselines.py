@@ -0,0 +1,181 @@
+"""Accepted-findings baselines for adopting Deep Verify scans on existing code.
+
+A baseline records every current finding of a tree as accepted, so later
+scans report only regressions::
+
+    bmad-assist verify baseline-create deepverify-baseline.json
+    bmad-assist verify scan . --baseline deepverify-baseline.json
+
+Findings match baseline entries by ``finding_fingerprint``, which leaves out
+the line number and normalizes whitespace in the snippet, so accepted
+findings stay accepted when code around them moves or is reformatted. Each
+entry accepts one finding: a second copy of an accepted line in the same
+file is new.
+
+The file is JSON with a format version and one entry per accepted finding.
+Entries keep the pattern, path, line and title next to the fingerprint so
+the baseline can be reviewed::
+
+    {
+      "format": 1,
+      "created_at": "2026-10-15T09:30:00+00:00",
+      "accepted": [
+        {"fingerprint": "9f2c...", "pattern_id": "CC-001-CODE-GO",
+         "path": "worker.go", "line": 12, "title": "..."}
+      ]
+    }
+
+"""
+
+from __future__ import annotations
+
+import json
+from collections import Counter
+from dataclasses import dataclass, field, replace
+from pathlib import Path
+from typing import Any
+
+from bmad_assist.deep_verify.scan.types import ScanFinding, ScanReport, finding_fingerprint
+
+# Version of the baseline file layout; bump on incompatible changes
+BASELINE_FORMAT_VERSION = 1
+
+# Suppression reason of findings accepted by a baseline
+BASELINE_REASON = "accepted in baseline"
+
+
+@dataclass(frozen=True, slots=True)
+class AcceptedFinding:
+    """A finding recorded as accepted in a baseline.
+
+    Attributes:
+        fingerprint: The finding's fingerprint (see finding_fingerprint).
+        pattern_id: Pattern of the finding.
+        path: File path relative to the scan root.
+        line: Line of the finding when the baseline was created.
+        title: Finding title, for review.
+
+    """
+
+    fingerprint: str
+    pattern_id: str
+    path: str
+    line: int
+    title: str = ""
+
+
+@dataclass(frozen=True, slots=True)
+class FindingBaseline:
+    """Findings accepted when a tree adopted scanning.
+
+    Attributes:
+        accepted: Accepted findings, in report order.
+        created_at: ISO 8601 start time of the scan the baseline records.
+
+    """
+
+    accepted: list[AcceptedFinding] = field(default_factory=list)
+    created_at: str | None = None
+
+    def __repr__(self) -> str:
+        """Return a string representation of the baseline."""
+        return f"FindingBaseline(accepted={len(self.accepted)})"
+
+
+def create_finding_baseline(report: ScanReport) -> FindingBaseline:
+    """Record a report's unsuppressed findings as accepted."""
+    return FindingBaseline(
+        accepted=[
+            AcceptedFinding(finding_fingerprint(f), f.pattern_id, f.path, f.line, f.title)
+            for f in report.unsuppressed_findings()
+        ],
+        created_at=report.started_at.isoformat() if report.started_at else None,
+    )
+
+
+def apply_finding_baseline(
+    report: ScanReport, baseline: FindingBaseline, keep_accepted: bool = False
+) -> ScanReport:
+    """Remove the findings a baseline accepts from a report.
+
+    Args:
+        report: Report of the current scan.
+        baseline: Accepted findings.
+        keep_accepted: Keep accepted findings, marked suppressed with
+            ``BASELINE_REASON`` (as ``ScanOptions.show_suppressed`` does for
+            ``deepverify:ignore``), instead of dropping them.
+
+    Returns:
+        The report with only new findings unsuppressed.
+
+    """
+    remaining = Counter(entry.fingerprint for entry in baseline.accepted)
+    findings: list[ScanFinding] = []
+    for finding in report.findings:
+        key = finding_fingerprint(finding)
+        if finding.suppressed or not remaining[key]:
+            findings.append(finding)
+            continue
+        remaining[key] -= 1
+        if keep_accepted:
+            findings.append(replace(finding, suppressed=True, suppression_reason=BASELINE_REASON))
+    return replace(report, findings=findings)
+
+
+def serialize_finding_baseline(baseline: FindingBaseline) -> dict[str, Any]:
+    """Serialize a FindingBaseline to a dictionary for JSON output."""
+    return {
+        "format": BASELINE_FORMAT_VERSION,
+        "created_at": baseline.created_at,
+        "accepted": [
+            {
+                "fingerprint": entry.fingerprint,
+                "pattern_id": entry.pattern_id,
+                "path": entry.path,
+                "line": entry.line,
+                "title": entry.title,
+            }
+            for entry in baseline.accepted
+        ],
+    }
+
+
+def write_finding_baseline(baseline: FindingBaseline, path: Path) -> None:
+    """Write a baseline file.
+
+    Raises:
+        OSError: If the file cannot be written.
+
+    """
+    text = json.dumps(serialize_finding_baseline(baseline), indent=2)
+    path.write_text(text + "\n", encoding="utf-8")
+
+
+def read_finding_baseline(path: Path) -> FindingBaseline:
+    """Read a baseline file written by write_finding_baseline.
+
+    Raises:
+        OSError: If the file cannot be read.
+        ValueError: If the file is not a baseline of a supported format.
+
+    """
+    try:
+        data = json.loads(path.read_text(encoding="utf-8"))
+    except json.JSONDecodeError as e:
+        raise ValueError(f"{path}: invalid baseline JSON: {e}") from None
+    if not isinstance(data, dict) or data.get("format") != BASELINE_FORMAT_VERSION:
+        raise ValueError(f"{path}: not a Deep Verify baseline of format {BASELINE_FORMAT_VERSION}")
+    try:
+        accepted = [
+            AcceptedFinding(
+                fingerprint=entry["fingerprint"],
+                pattern_id=entry["pattern_id"],
+                path=entry["path"],
+                line=entry["line"],
+                title=entry.get("title", ""),
+            )
+            for entry in data.get("accepted", [])
+        ]
+    except (KeyError, TypeError, AttributeError) as e:
+        raise ValueError(f"{path}: malformed baseline entry: {e}") from None
+    return FindingBaseline(accepted=accepted, created_at=data.get("created_at"))
@@ -0,0 +1,387 @@
+"""Analysis speed benchmark and regression guard for Deep Verify scans.
+
+Every detector added to the library runs on every file, so a slow regex
+quietly slows down every scan. The benchmark scans a synthetic Go corpus
+(service handlers, worker pools, caches and clients in the shape of real
+code) with a single worker and reports the time per analyzed file.
+
+Output uses the Go benchmark format, so runs can be compared with
+``benchstat``::
+
+    bmad-assist verify bench --count 10 > old.txt
+    # change detectors
+    bmad-assist verify bench --count 10 > new.txt
+    benchstat old.txt new.txt
+
+Budget:
+    The committed baseline (``scan/data/bench_baseline.json``) records the
+    time per file on the reference hardware it names. A run fails the guard
+    when its median time per file exceeds the baseline by more than the
+    baseline's ``factor``. The factor absorbs slower CI machines; a detector
+    that doubles analysis time on the reference machine still fits, one
+    that triples it does not.
+
+Updating the baseline:
+    After an intentional slowdown (or on new reference hardware), run
+    ``bmad-assist verify bench --update-baseline`` on the reference machine
+    and commit the rewritten baseline file with the change that needed it.
+
+Example:
+    >>> from bmad_assist.deep_verify.scan.bench import load_baseline, run_benchmark
+    >>> results = run_benchmark(count=5)
+    >>> load_baseline().check(results)  # None when within budget
+
+"""
+
+from __future__ import annotations
+
+import json
+import platform
+import statistics
+import tempfile
+import time
+from dataclasses import asdict, dataclass
+from pathlib import Path
+
+from bmad_assist.deep_verify.scan.scanner import ScanOptions, Scanner
+
+# Committed baseline of the reference hardware
+DEFAULT_BASELINE_PATH = Path(__file__).parent / "data" / "bench_baseline.json"
+
+# Files in the synthetic corpus
+DEFAULT_BENCH_FILES = 100
+
+# Allowed slowdown over the baseline before the guard fails
+DEFAULT_BENCH_FACTOR = 3.0
+
+# Code blocks the corpus files are assembled from; {n} varies per file
+_BLOCKS: tuple[str, ...] = (
+    """
+type Handler{n} struct {{
+    mu    sync.Mutex
+    store Store
+    seen  map[string]int
+}}
+
+func (h *Handler{n}) ServeHTTP(w http.ResponseWriter, r *http.Request) {{
+    ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+    defer cancel()
+    id := r.URL.Query().Get("id")
+    h.mu.Lock()
+    h.seen[id]++
+    h.mu.Unlock()
+    item, err := h.store.Get(ctx, id)
+    if err != nil {{
+        http.Error(w, err.Error(), http.StatusInternalServerError)
+        return
+    }}
+    if err := json.NewEncoder(w).Encode(item); err != nil {{
+        log.Printf("encode %s: %v", id, err)
+    }}
+}}
+""",
+    """
+func runPool{n}(ctx context.Context, jobs []Job, workers int) []Result {{
+    var wg sync.WaitGroup
+    in := make(chan Job)
+    out := make(chan Result, len(jobs))
+    for i := 0; i < workers; i++ {{
+        wg.Add(1)
+        go func() {{
+            defer wg.Done()
+            for j := range in {{
+                select {{
+                case <-ctx.Done():
+                    return
+                case out <- j.Run():
+                }}
+            }}
+        }}()
+    }}
+    for _, j := range jobs {{
+        in <- j
+    }}
+    close(in)
+    wg.Wait()
+    close(out)
+    results := make([]Result, 0, len(jobs))
+    for r := range out {{
+        results = append(results, r)
+    }}
+    return results
+}}
+""",
+    """
+type cache{n} struct {{
+    mu      sync.RWMutex
+    entries map[string]entry
+    hits    atomic.Int64
+}}
+
+func (c *cache{n}) get(key string) (string, bool) {{
+    c.mu.RLock()
+    defer c.mu.RUnlock()
+    e, ok := c.entries[key]
+    if !ok || time.Now().After(e.expires) {{
+        return "", false
+    }}
+    c.hits.Add(1)
+    return e.value, true
+}}
+
+func (c *cache{n}) set(key, value string, ttl time.Duration) {{
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    c.entries[key] = entry{{value: value, expires: time.Now().Add(ttl)}}
+}}
+""",
+    """
+// Client{n} calls the upstream API.
+type Client{n} struct {{
+    base string
+    http *http.Client
+}}
+
+func (c *Client{n}) Fetch(ctx context.Context, path string) ([]byte, error) {{
+    req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.base+path, nil)
+    if err != nil {{
+        return nil, fmt.Errorf("build request: %w", err)
+    }}
+    resp, err := c.http.Do(req)
+    if err != nil {{
+        return nil, fmt.Errorf("fetch %s: %w", path, err)
+    }}
+    defer resp.Body.Close()
+    if resp.StatusCode != http.StatusOK {{
+        return nil, fmt.Errorf("fetch %s: status %d", path, resp.StatusCode)
+    }}
+    return io.ReadAll(resp.Body)
+}}
+""",
+    """
+func parseRecords{n}(lines []string) ([]Record, error) {{
+    records := make([]Record, 0, len(lines))
+    for i, line := range lines {{
+        fields := strings.Split(line, ",")
+        if len(fields) < 3 {{
+            return nil, fmt.Errorf("line %d: want 3 fields, got %d", i+1, len(fields))
+        }}
+        amount, err := strconv.ParseFloat(fields[2], 64)
+        if err != nil {{
+            return nil, fmt.Errorf("line %d: %w", i+1, err)
+        }}
+        records = append(records, Record{{ID: fields[0], Name: fields[1], Amount: amount}})
+    }}
+    sort.Slice(records, func(a, b int) bool {{ return records[a].ID < records[b].ID }})
+    return records, nil
+}}
+""",
+)
+
+_HEADER = """package svc{package}
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "io"
+    "log"
+    "net/http"
+    "sort"
+    "strconv"
+    "strings"
+    "sync"
+    "sync/atomic"
+    "time"
+)
+"""
+
+# Blocks per corpus file
+_BLOCKS_PER_FILE = 4
+
+
+@dataclass(frozen=True, slots=True)
+class BenchResult:
+    """One benchmark run.
+
+    Attributes:
+        name: Benchmark name (``BenchmarkScanFile/...``).
+        files: Files analyzed.
+        seconds: Wall time of the scan.
+
+    """
+
+    name: str
+    files: int
+    seconds: float
+
+    @property
+    def ns_per_file(self) -> float:
+        """Average analysis time per file in nanoseconds."""
+        return self.seconds * 1e9 / max(self.files, 1)
+
+    @property
+    def files_per_sec(self) -> float:
+        """Files analyzed per second."""
+        return self.files / self.seconds if self.seconds > 0 else 0.0
+
+
+@dataclass(frozen=True, slots=True)
+class BenchBaseline:
+    """Committed analysis speed of the reference hardware.
+
+    Attributes:
+        ns_per_file: Median time per file on the reference hardware.
+        factor: Allowed slowdown before the guard fails.
+        reference: Description of the reference hardware.
+        files: Corpus size the baseline was measured with.
+
+    """
+
+    ns_per_file: float
+    factor: float = DEFAULT_BENCH_FACTOR
+    reference: str = ""
+    files: int = DEFAULT_BENCH_FILES
+
+    @property
+    def budget_files_per_sec(self) -> float:
+        """Slowest throughput the guard accepts (files per second)."""
+        return 1e9 / (self.ns_per_file * self.factor)
+
+    def check(self, results: list[BenchResult]) -> str | None:
+        """Compare runs against the budget.
+
+        Args:
+            results: Benchmark runs (the median time per file is used).
+
+        Returns:
+            A message describing the regression, or None within budget.
+
+        Raises:
+            ValueError: If results is empty.
+
+        """
+        if not results:
+            raise ValueError("no benchmark results to check")
+        median = statistics.median(r.ns_per_file for r in results)
+        limit = self.ns_per_file * self.factor
+        if median <= limit:
+            return None
+        return (
+            f"analysis takes {median / 1e6:.2f} ms per file, over the budget of "
+            f"{limit / 1e6:.2f} ms ({self.factor:g}x the {self.ns_per_file / 1e6:.2f} ms "
+            f"baseline on {self.reference or 'the reference hardware'})"
+        )
+
+
+def load_baseline(path: Path = DEFAULT_BASELINE_PATH) -> BenchBaseline:
+    """Load a baseline file.
+
+    Raises:
+        OSError: If the file cannot be read.
+        ValueError: If the file is not a valid baseline.
+
+    """
+    data = json.loads(path.read_text(encoding="utf-8"))
+    try:
+        return BenchBaseline(**data)
+    except TypeError as e:
+        raise ValueError(f"Invalid benchmark baseline {path}: {e}") from e
+
+
+def save_baseline(baseline: BenchBaseline, path: Path = DEFAULT_BASELINE_PATH) -> None:
+    """Write a baseline file."""
+    path.parent.mkdir(parents=True, exist_ok=True)
+    path.write_text(json.dumps(asdict(baseline), indent=2) + "\n", encoding="utf-8")
+
+
+def write_corpus(root: Path, files: int = DEFAULT_BENCH_FILES) -> list[Path]:
+    """Write the synthetic Go corpus.
+
+    The corpus is deterministic: the same file count always produces the
+    same files.
+
+    Args:
+        root: Directory to write into.
+        files: Number of files.
+
+    Returns:
+        Written files in order.
+
+    """
+    written: list[Path] = []
+    for i in range(files):
+        blocks = [
+            _BLOCKS[(i + k) % len(_BLOCKS)].format(n=i * _BLOCKS_PER_FILE + k)
+            for k in range(_BLOCKS_PER_FILE)
+        ]
+        path = root / f"svc{i % 10}" / f"file{i:04d}.go"
+        path.parent.mkdir(parents=True, exist_ok=True)
+        path.write_text(_HEADER.format(package=i % 10) + "".join(blocks), encoding="utf-8")
+        written.append(path)
+    return written
+
+
+def run_benchmark(
+    files: int = DEFAULT_BENCH_FILES,
+    count: int = 5,
+    scanner: Scanner | None = None,
+    summary_only: bool = False,
+) -> list[BenchResult]:
+    """Scan the synthetic corpus ``count`` times.
+
+    Args:
+        files: Corpus size.
+        count: Number of runs.
+        scanner: Scanner to measure (default: one worker, no config files).
+        summary_only: Measure the default scanner in summary-only mode
+            (see ScanOptions.summary_only); runs are named
+            ``.../summary`` so benchstat compares them with full runs.
+
+    Returns:
+        One result per run.
+
+    Raises:
+        ValueError: If files or count is below 1.
+
+    """
+    if files < 1 or count < 1:
+        raise ValueError(f"files and count must be at least 1, got {files} and {count}")
+    scanner = scanner or Scanner(
+        ScanOptions(
+            use_config_files=False,
+            load_concurrency=1,
+            analyze_concurrency=1,
+            summary_only=summary_only,
+        )
+    )
+    name = f"BenchmarkScanFile/files={files}" + ("/summary" if summary_only else "")
+    results: list[BenchResult] = []
+    with tempfile.TemporaryDirectory(prefix="deepverify-bench-") as tmp:
+        root = Path(tmp)
+        write_corpus(root, files)
+        for _ in range(count):
+            start = time.perf_counter()
+            report = scanner.scan(root)
+            elapsed = time.perf_counter() - start
+            results.append(BenchResult(name, len(report.files_scanned), elapsed))
+    return results
+
+
+def format_benchstat(results: list[BenchResult]) -> str:
+    """Render runs in the Go benchmark format read by ``benchstat``.
+
+    Configuration lines (``key: value``) describe the machine, followed by
+    one line per run.
+    """
+    lines = [
+        f"os: {platform.system().lower()}",
+        f"arch: {platform.machine()}",
+        f"python: {platform.python_version()}",
+        "pkg: bmad_assist.deep_verify.scan",
+    ]
+    lines.extend(
+        f"{r.name}\t{r.files}\t{r.ns_per_file:.0f} ns/op\t{r.files_per_sec:.1f} files/s"
+        for r in results
+    )
+    return "\n".join(lines) + "\n"
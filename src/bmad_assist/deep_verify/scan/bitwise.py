@@ -0,0 +1,117 @@
+"""Detection of bitwise operators used as logical ones in Go conditions.
+
+``&`` and ``|`` in an ``if`` or ``for`` condition are usually a mistyped
+``&&`` or ``||``. Go rejects most such conditions (an integer condition, or
+``&`` on booleans), but the typo survives in code that is not built yet
+(snippets, templates, generated sources) and reads as intent in review::
+
+    if ready & enabled {        // CC-130: did you mean &&?
+    if flags&FlagDebug != 0 {   // bitmask test, safe
+
+A condition is reported when it has a binary ``&`` or ``|`` and no
+comparison operator: comparing the result (``flags&mask != 0``) is how Go
+tests bits on purpose. Only the condition is read: the init statement of an
+``if`` and the init and post statements of a three-clause ``for`` are
+skipped, and ``range`` loops are ignored. Conditions must fit on the
+statement's line.
+
+Code that compiles rarely keeps such a typo, so findings carry
+``BITWISE_CONDITION_CONFIDENCE``, the default threshold: any stricter
+``--threshold`` drops them.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for & and | in conditions without a comparison
+BITWISE_CONDITION_PATTERN = Pattern(
+    id=PatternId("CC-130-CODE-GO"),
+    domain=ArtifactDomain.TRANSFORM,
+    signals=[],
+    severity=Severity.WARNING,
+    description="Condition uses bitwise & or | - the logical && or || was likely intended",
+    remediation="Use && or || for boolean logic, or compare the bits: flags&mask != 0",
+    language="go",
+    effort=PatternEffort.LOW,
+)
+
+# Confidence of CC-130 findings: the compiler already rejects most real typos,
+# so a condition that reaches a scan is as often deliberate bit logic as a slip
+BITWISE_CONDITION_CONFIDENCE = 0.6
+
+# `if ... {`, `} else if ... {` or `for ... {` with the header on one line
+_HEADER_RE = re.compile(r"^[ \t]*(?:\}[ \t]*else[ \t]+)?(if|for)\b(.*)\{[ \t]*$")
+
+# Binary & or | (not &&, ||, &^, &=, |= or the address-of operator)
+_BITWISE_RE = re.compile(r"(?<=[\w)\]])[ \t]*(?<![&|])([&|])(?![&|=^])")
+
+# Comparison operators (<- is a channel receive, not a comparison)
+_COMPARISON_RE = re.compile(r"==|!=|<=|>=|<(?!-)|(?<!-)>")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _condition(keyword: str, header: str) -> str:
+    """Return the condition of an if or for header ("" if it has none)."""
+    clauses = header.split(";")
+    if keyword == "if":
+        return clauses[-1]
+    if len(clauses) == 3:
+        return clauses[1]
+    if len(clauses) == 1 and not re.search(r"\brange\b", header):
+        return header
+    return ""
+
+
+def find_bitwise_conditions(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report if and for conditions that use & or | without a comparison.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-130 findings in line order, one per condition.
+
+    """
+    if not config.is_enabled(BITWISE_CONDITION_PATTERN.id):
+        return []
+    findings: list[ScanFinding] = []
+    for number, source in enumerate(text.split("\n"), start=1):
+        line = _LITERAL_RE.sub('""', source).split("//", 1)[0]
+        header = _HEADER_RE.match(line)
+        if header is None:
+            continue
+        keyword = header.group(1)
+        condition = _condition(keyword, header.group(2))
+        if _COMPARISON_RE.search(condition):
+            continue
+        operator = _BITWISE_RE.search(condition)
+        if operator is not None:
+            findings.append(
+                builtin_finding(
+                    BITWISE_CONDITION_PATTERN,
+                    f"{keyword} condition uses bitwise {operator.group(1)} - "
+                    f"did you mean {operator.group(1) * 2}?",
+                    rel_path,
+                    number,
+                    source,
+                    config,
+                    BITWISE_CONDITION_CONFIDENCE,
+                )
+            )
+    return findings
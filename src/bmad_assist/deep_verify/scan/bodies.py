@@ -0,0 +1,201 @@
+"""Detection of unbounded request body reads in Go.
+
+An HTTP server reads whatever a client sends, so a handler that reads the
+request body in full lets one request exhaust the server's memory::
+
+    func upload(w http.ResponseWriter, r *http.Request) {
+        data, err := io.ReadAll(r.Body) // CC-142: no size limit
+    }
+
+    func upload(w http.ResponseWriter, r *http.Request) {
+        r.Body = http.MaxBytesReader(w, r.Body, 1<<20)
+        data, err := io.ReadAll(r.Body) // safe
+    }
+
+Requests are the ``*http.Request`` parameters of the enclosing function and
+its function literals (resolved through the file's import aliases). A read
+is ``Body`` passed to a call or its ``Read`` method; passing it to
+``http.MaxBytesReader``, ``io.LimitReader``, ``io.CopyN`` or
+``io.ReadFull`` bounds it, and so does an earlier ``r.Body = ...``
+assignment wrapping it in one of the limit readers. Closing the body and
+comparing it with nil are not reads.
+
+Findings are informational by default; public endpoints can raise them
+with ``severity: {CC-142: warning}`` in ``.deepverify.yaml``. Projects
+with their own limit readers name them in detector options::
+
+    detectors:
+      CC-142:
+        limiting_calls: [LimitBody]
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from pydantic import Field, field_validator
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import (
+    DetectorOptions,
+    ScanConfig,
+    register_detector_options,
+)
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for request bodies read without a size limit
+UNBOUNDED_BODY_PATTERN = Pattern(
+    id=PatternId("CC-142-CODE-GO"),
+    domain=ArtifactDomain.API,
+    signals=[],
+    severity=Severity.INFO,
+    description="Request body read without a size limit - a large request can exhaust memory",
+    remediation="Wrap the body first: r.Body = http.MaxBytesReader(w, r.Body, maxBytes)",
+    language="go",
+    effort=PatternEffort.LOW,
+)
+
+# Calls that bound what they read from the body
+_LIMITING_CALLS = ("MaxBytesReader", "LimitReader", "CopyN", "ReadFull")
+
+
+class UnboundedBodyOptions(DetectorOptions):
+    """CC-142 options.
+
+    Attributes:
+        limiting_calls: Names of extra functions or methods that bound what
+            they read from the body, added to the built-in limit readers.
+
+    """
+
+    limiting_calls: list[str] = Field(
+        default_factory=list,
+        description="Extra functions that bound request body reads (CC-142)",
+    )
+
+    @field_validator("limiting_calls", mode="after")
+    @classmethod
+    def validate_limiting_calls(cls, v: list[str]) -> list[str]:
+        """Validate that each call is an unqualified Go identifier."""
+        invalid = [c for c in v if not c.isidentifier()]
+        if invalid:
+            raise ValueError(
+                f"Invalid limiting call(s): {', '.join(map(repr, invalid))}. "
+                "Use function or method names without a package (LimitBody)"
+            )
+        return v
+
+
+register_detector_options(UNBOUNDED_BODY_PATTERN.id, UnboundedBodyOptions)
+
+_FUNC_RE = re.compile(r"^func\b")
+
+# Name of the call whose "(" ends a line prefix
+_CALLEE_RE = re.compile(r"([A-Za-z_][\w.]*)[ \t]*$")
+
+# What follows `r.Body` in an assignment, a Close call or a nil comparison
+_ASSIGN_RE = re.compile(r"[ \t]*=(?!=)")
+_NOT_READ_RE = re.compile(r"\.Close\(|[ \t]*[!=]=")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _callee(line: str, position: int) -> str | None:
+    """Return the name of the call whose argument list encloses position."""
+    depth = 0
+    for index in range(position - 1, -1, -1):
+        char = line[index]
+        if char in ")]}":
+            depth += 1
+        elif char in "([{":
+            if depth == 0:
+                if char != "(":
+                    return None
+                callee = _CALLEE_RE.search(line[:index])
+                return callee.group(1) if callee else None
+            depth -= 1
+    return None
+
+
+def find_unbounded_body_reads(
+    text: str, rel_path: str, config: ScanConfig
+) -> list[ScanFinding]:
+    """Report reads of an http.Request body that is not wrapped in a limit reader.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-142 findings in line order, one per read.
+
+    """
+    if not config.is_enabled(UNBOUNDED_BODY_PATTERN.id):
+        return []
+    aliases = [a for a, path in parse_go_imports(text).items() if path == "net/http"]
+    if not aliases:
+        return []
+    request_type = "|".join(r"(?<![\w.])" if a == "." else re.escape(a) + r"\." for a in aliases)
+    params_re = re.compile(
+        rf"([A-Za-z_]\w*(?:[ \t]*,[ \t]*[A-Za-z_]\w*)*)[ \t]+\*(?:{request_type})Request\b"
+    )
+    body_re = re.compile(r"(?<![\w.])([A-Za-z_]\w*)\.Body\b")
+    options = config.detector_options(UNBOUNDED_BODY_PATTERN.id, UnboundedBodyOptions)
+    limiting_calls = (*_LIMITING_CALLS, *options.limiting_calls)
+    source_lines = text.split("\n")
+    requests: set[str] = set()
+    limited: set[str] = set()
+    findings: list[ScanFinding] = []
+    for index, line in enumerate(_code_lines(text)):
+        if _FUNC_RE.match(line) or line.startswith("}"):
+            requests, limited = set(), set()
+        if "func" in line:
+            for group in params_re.findall(line):
+                names = {name.strip() for name in group.split(",")}
+                requests |= names
+                limited -= names
+        for body in body_re.finditer(line):
+            name = body.group(1)
+            if name not in requests or name in limited:
+                continue
+            after = line[body.end() :]
+            if _ASSIGN_RE.match(after):
+                if any(f"{call}(" in after for call in limiting_calls):
+                    limited.add(name)
+                continue
+            if _NOT_READ_RE.match(after):
+                continue
+            if after.startswith(".Read("):
+                reader = f"{name}.Body.Read"
+            else:
+                callee = _callee(line, body.start())
+                if callee is None or callee.rsplit(".", 1)[-1] in limiting_calls:
+                    continue
+                reader = callee
+            findings.append(
+                builtin_finding(
+                    UNBOUNDED_BODY_PATTERN,
+                    f"{name}.Body read by {reader} without a size limit - "
+                    "wrap it in http.MaxBytesReader",
+                    rel_path,
+                    index + 1,
+                    source_lines[index],
+                    config,
+                )
+            )
+    return findings
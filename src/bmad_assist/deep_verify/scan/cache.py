@@ -0,0 +1,176 @@
+"""Result cache for repeated Deep Verify scans.
+
+Long-lived scanners (for example the ``verify serve`` daemon) keep per-file
+results between scans. An entry is reused only while the file's size and
+modification time, the effective config, and the scan date are unchanged,
+so edited files are re-analyzed and untouched files are not.
+
+The cache can also be saved to a file and loaded by a later process, so a
+``verify warm`` run during a CI image build leaves real scans a warm cache.
+A saved cache is discarded when the bmad-assist version differs.
+"""
+
+from __future__ import annotations
+
+import json
+import logging
+import os
+import threading
+from collections import OrderedDict
+from collections.abc import Hashable
+from pathlib import Path
+
+from bmad_assist import __version__
+from bmad_assist.deep_verify.scan.types import (
+    ScanFinding,
+    deserialize_scan_finding,
+    serialize_scan_finding,
+)
+
+logger = logging.getLogger(__name__)
+
+# Default number of cached files
+DEFAULT_MAX_ENTRIES = 10_000
+
+# Cache file used by `verify warm` unless --cache names another
+DEFAULT_CACHE_FILENAME = ".deepverify-cache.json"
+
+# Version of the saved cache layout
+CACHE_FORMAT_VERSION = 1
+
+
+class ScanCache:
+    """Thread-safe LRU cache of per-file scan results.
+
+    Attributes:
+        hits: Number of lookups served from the cache.
+        misses: Number of lookups that required analysis.
+        _max_entries: Maximum number of cached files.
+        _entries: Cached results keyed by file key, least recent first.
+        _lock: Guards all state.
+
+    """
+
+    def __init__(self, max_entries: int = DEFAULT_MAX_ENTRIES) -> None:
+        """Initialize the cache.
+
+        Args:
+            max_entries: Maximum number of cached files.
+
+        Raises:
+            ValueError: If max_entries is less than 1.
+
+        """
+        if max_entries < 1:
+            raise ValueError(f"max_entries must be at least 1, got {max_entries}")
+        self.hits = 0
+        self.misses = 0
+        self._max_entries = max_entries
+        self._entries: OrderedDict[Hashable, list[ScanFinding] | None] = OrderedDict()
+        self._lock = threading.Lock()
+
+    def __len__(self) -> int:
+        """Return the number of cached files."""
+        with self._lock:
+            return len(self._entries)
+
+    def __repr__(self) -> str:
+        """Return a string representation of the cache."""
+        return f"ScanCache(entries={len(self)}, hits={self.hits}, misses={self.misses})"
+
+    def get(self, key: Hashable) -> tuple[bool, list[ScanFinding] | None]:
+        """Look up a cached result.
+
+        Args:
+            key: File key built by the scanner.
+
+        Returns:
+            Tuple of (found, findings). Findings are None for files that
+            were examined but not analyzed.
+
+        """
+        with self._lock:
+            if key not in self._entries:
+                self.misses += 1
+                return False, None
+            self._entries.move_to_end(key)
+            self.hits += 1
+            return True, self._entries[key]
+
+    def put(self, key: Hashable, findings: list[ScanFinding] | None) -> None:
+        """Store a result, evicting the least recently used entry if full."""
+        with self._lock:
+            self._entries[key] = findings
+            self._entries.move_to_end(key)
+            while len(self._entries) > self._max_entries:
+                self._entries.popitem(last=False)
+
+    def items(self) -> list[tuple[Hashable, list[ScanFinding] | None]]:
+        """Return a snapshot of the entries, least recently used first.
+
+        Does not count as lookups or change the eviction order.
+        """
+        with self._lock:
+            return list(self._entries.items())
+
+    def clear(self) -> None:
+        """Drop all entries and reset statistics."""
+        with self._lock:
+            self._entries.clear()
+            self.hits = 0
+            self.misses = 0
+
+    def save(self, path: Path) -> None:
+        """Write the entries to a cache file, replacing it atomically.
+
+        Keys must be tuples of JSON values (as built by the scanner).
+
+        Raises:
+            OSError: If the file cannot be written.
+
+        """
+        entries: list[dict[str, object]] = []
+        with self._lock:
+            for key, findings in self._entries.items():
+                serialized = None
+                if findings is not None:
+                    serialized = [serialize_scan_finding(f) for f in findings]
+                entries.append({"key": key, "findings": serialized})
+        data = {"format": CACHE_FORMAT_VERSION, "version": __version__, "entries": entries}
+        tmp_path = path.with_name(f"{path.name}.tmp")
+        tmp_path.write_text(json.dumps(data), encoding="utf-8")
+        os.replace(tmp_path, path)
+
+    @classmethod
+    def load(cls, path: Path, max_entries: int = DEFAULT_MAX_ENTRIES) -> ScanCache:
+        """Load a cache file written by save().
+
+        A missing file yields an empty cache. So does an unreadable file or
+        one written by another format or bmad-assist version, with a warning.
+
+        Args:
+            path: Cache file.
+            max_entries: Maximum number of cached files.
+
+        Returns:
+            Cache with the saved entries and zeroed statistics.
+
+        """
+        cache = cls(max_entries)
+        if not path.exists():
+            return cache
+        try:
+            data = json.loads(path.read_text(encoding="utf-8"))
+            if data.get("format") != CACHE_FORMAT_VERSION or data.get("version") != __version__:
+                logger.warning("Ignoring cache file %s written by another version", path)
+                return cache
+            for entry in data["entries"]:
+                findings = entry["findings"]
+                cache.put(
+                    tuple(entry["key"]),
+                    None if findings is None else [deserialize_scan_finding(f) for f in findings],
+                )
+        except (OSError, ValueError, KeyError, TypeError, AttributeError) as e:
+            logger.warning("Ignoring unreadable cache file %s: %s", path, e)
+            cache.clear()
+        return cache
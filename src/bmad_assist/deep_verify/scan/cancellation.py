@@ -0,0 +1,169 @@
+"""Detection of loops that never check for cancellation in Go scans.
+
+A function taking a ``context.Context`` promises to stop when its caller
+gives up, but a long loop that never looks at the context keeps running
+after the deadline has passed and delays shutdown::
+
+    func index(ctx context.Context, docs []Doc) {
+        for _, d := range docs { // CC-101: never checks ctx
+            tokenize(d)
+        }
+    }
+
+In functions with a ``context.Context`` parameter (resolved through the
+file's imports), each outermost ``for`` loop is reported unless its header
+or body calls ``Err()`` or ``Done()`` on the context or passes the context
+to a call, which then observes it. Loops bounded by an integer literal
+(``for i := 0; i < 3; i++``, ``for range 8``) are short and not reported.
+
+The check is a heuristic and opt-in: enable it with ``opt_in: [CC-101]`` in
+``.deepverify.yaml``.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for loops in context-aware functions that never check the context
+UNCANCELLABLE_LOOP_PATTERN = Pattern(
+    id=PatternId("CC-101-CODE-GO"),
+    domain=ArtifactDomain.CONCURRENCY,
+    signals=[],
+    severity=Severity.INFO,
+    description="Loop in a context-aware function never checks ctx - work cannot be cancelled",
+    remediation="Check ctx.Err() periodically inside the loop, or pass ctx to the per-item call",
+    language="go",
+    opt_in=True,
+    effort=PatternEffort.MEDIUM,
+)
+
+# Confidence of CC-101 findings: most loops over in-memory data finish long
+# before a cancellation would matter, and the scan cannot tell which ones do not
+UNCANCELLABLE_LOOP_CONFIDENCE = 0.6
+
+# Function or method declaration with its parameters on the first line
+_FUNC_DECL_RE = re.compile(
+    r"^func[ \t]*(?:\([^)\n]*\)[ \t]*)?([A-Za-z_]\w*)(?:\[[^\]\n]*\])?[ \t]*\(([^)\n]*)"
+)
+
+# One parameter: `ctx context.Context`, or a bare name sharing the next type
+_PARAM_RE = re.compile(r"([A-Za-z_]\w*)(?:[ \t]+(.+))?$")
+
+# Loop header opening its body on the same line
+_FOR_RE = re.compile(r"^[ \t]*for\b.*\{[ \t]*$")
+
+# Loop headers bounded by an integer literal: `i < 3;`, `range 8`
+_BOUNDED_RE = re.compile(r"^[ \t]*for\b(?:[^;{]*;[^;{]*<=?[ \t]*\d+[ \t]*;|.*\brange[ \t]+\d+\b)")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _context_params(params: str, context_re: re.Pattern[str]) -> list[str]:
+    """Return the names of parameters whose type matches context_re."""
+    names: list[str] = []
+    pending: list[str] = []  # `a, b T` declares a and b as T
+    for part in params.split(","):
+        match = _PARAM_RE.match(part.strip())
+        if match is None:
+            pending = []
+            continue
+        name, param_type = match.groups()
+        if param_type is None:
+            pending.append(name)
+            continue
+        if context_re.fullmatch(param_type.strip()):
+            names.extend([*pending, name])
+        pending = []
+    return [name for name in names if name != "_"]
+
+
+def find_uncancellable_loops(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report loops in context-aware functions that never observe the context.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file (CC-101 is opt-in).
+
+    Returns:
+        CC-101 findings in line order, one per outermost loop, at
+        UNCANCELLABLE_LOOP_CONFIDENCE.
+
+    """
+    if not config.is_enabled(
+        UNCANCELLABLE_LOOP_PATTERN.id, opt_in=UNCANCELLABLE_LOOP_PATTERN.opt_in
+    ):
+        return []
+    aliases = [name for name, path in parse_go_imports(text).items() if path == "context"]
+    if not aliases:
+        return []
+    context_re = re.compile(
+        "|".join("Context" if a == "." else re.escape(a) + r"\.Context" for a in aliases)
+    )
+
+    source_lines = text.split("\n")
+    findings: list[ScanFinding] = []
+    func_name = ""
+    observe_re: re.Pattern[str] | None = None  # uses of the current function's contexts
+    contexts: list[str] = []
+    loop: tuple[int, int] | None = None  # start line, depth before the loop
+    depth = 0
+    lines = _code_lines(text)
+    for index, line in enumerate(lines):
+        decl = _FUNC_DECL_RE.match(line)
+        if decl is not None:
+            func_name = decl.group(1)
+            contexts = _context_params(decl.group(2), context_re)
+            names = "|".join(re.escape(name) for name in contexts)
+            observe_re = (
+                re.compile(
+                    rf"(?<![\w.])(?:{names})\.(?:Err|Done)\(\)"
+                    rf"|[(,][ \t]*(?:{names})[ \t]*[,)]"
+                )
+                if contexts
+                else None
+            )
+            loop, depth = None, 0
+        if (
+            observe_re is not None
+            and loop is None
+            and _FOR_RE.match(line)
+            and not _BOUNDED_RE.match(line)
+        ):
+            loop = (index, depth)
+        depth += line.count("{") - line.count("}")
+        if loop is None or depth > loop[1]:
+            continue
+        start = loop[0]
+        loop = None
+        if observe_re is not None and not observe_re.search("\n".join(lines[start : index + 1])):
+            findings.append(
+                builtin_finding(
+                    UNCANCELLABLE_LOOP_PATTERN,
+                    f"Loop in {func_name} never checks {contexts[0]}",
+                    rel_path,
+                    start + 1,
+                    source_lines[start],
+                    config,
+                    UNCANCELLABLE_LOOP_CONFIDENCE,
+                )
+            )
+    return findings
@@ -0,0 +1,170 @@
+"""Detection of local channels used in only one direction in Go scans.
+
+A channel made inside a function and never handed to anyone else can only
+be served by that function and the closures it starts. When the function
+only ever receives from it, or only ever sends on it, the operation has no
+counterpart and blocks forever::
+
+    func wait() {
+        done := make(chan struct{}) // CC-114: only received from
+        go cleanup()
+        <-done
+    }
+
+Channels are declared with ``make(chan ...)`` in a function body, which ends
+at the first column-0 closing brace, as gofmt writes it. Every later use of
+the name in the body is classified: sends (``ch <- v``) and ``close(ch)``
+on one side, receives (``<-ch``, including in ``select`` cases) and
+``range ch`` on the other, and ``len``/``cap`` on neither. Any other use -
+an argument, a return value, an assignment, a value sent on another
+channel - lets the channel escape, and it is not reported. Sends on a
+buffered channel may never block, so only unbuffered channels are reported
+for missing receivers. Whether an escape was missed is unknown, so
+findings carry ``ONE_WAY_CHANNEL_CONFIDENCE``.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for local channels that are only received from or only sent on
+ONE_WAY_CHANNEL_PATTERN = Pattern(
+    id=PatternId("CC-114-CODE-GO"),
+    domain=ArtifactDomain.CONCURRENCY,
+    signals=[],
+    severity=Severity.WARNING,
+    description=(
+        "Channel created with make is only received from (or only sent to) and never "
+        "escapes - the operation blocks forever"
+    ),
+    remediation=(
+        "Send on (or close) the channel where it is received, or pass it to the other side"
+    ),
+    language="go",
+    effort=PatternEffort.MEDIUM,
+)
+
+# Confidence of CC-114 findings: a one-sided channel in a function body nearly
+# always hangs, but a use outside the classified forms (a method value, a closure
+# stored for later) can hide the other side
+ONE_WAY_CHANNEL_CONFIDENCE = 0.7
+
+# Local channel declaration: `done := make(chan`, `var done = make(chan`
+_MAKE_CHAN_RE = re.compile(
+    r"^[ \t]+(?:var[ \t]+([A-Za-z_]\w*)[^=\n]*=|([A-Za-z_]\w*)[ \t]*:=)"
+    r"[ \t]*make(\()[ \t]*chan\b"
+)
+
+# Keywords and operators after which `<-` is a receive rather than a send
+_RECEIVE_PREFIX_RE = re.compile(r"(?:^|[=(,:;{\[!&|*/%+\-<>^]|\b(?:return|case|if|switch))$")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _is_buffered(line: str, open_paren: int) -> bool:
+    """Return whether the make call opening at open_paren passes a capacity."""
+    depth = 0
+    for char in line[open_paren:]:
+        if char in "([{":
+            depth += 1
+        elif char in ")]}":
+            depth -= 1
+            if depth == 0:
+                return False
+        elif char == "," and depth == 1:
+            return True
+    return False
+
+
+def _classify(line: str, start: int, end: int) -> str:
+    """Classify one use of a channel name as "send", "receive", "neutral" or "escape"."""
+    before = line[:start].rstrip()
+    after = line[end:].lstrip()
+    if after.startswith("<-"):
+        return "send"
+    if before.endswith("<-"):
+        prefix = before[:-2].rstrip()
+        return "receive" if _RECEIVE_PREFIX_RE.search(prefix) else "escape"
+    if re.search(r"\brange$", before):
+        return "receive"
+    if after.startswith(")"):
+        if re.search(r"(?<![\w.])close[ \t]*\($", before):
+            return "send"
+        if re.search(r"(?<![\w.])(?:len|cap)[ \t]*\($", before):
+            return "neutral"
+    return "escape"
+
+
+def find_one_way_channels(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report local channels that are only received from or only sent on.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-114 findings in line order, one per channel declaration, at
+        ONE_WAY_CHANNEL_CONFIDENCE.
+
+    """
+    if not config.is_enabled(ONE_WAY_CHANNEL_PATTERN.id):
+        return []
+    lines = _code_lines(text)
+    source_lines = text.split("\n")
+    findings: list[ScanFinding] = []
+    func_end = -1
+    for index, line in enumerate(lines):
+        if line.startswith("func") and not line.rstrip().endswith("}"):
+            func_end = next(
+                (i for i in range(index + 1, len(lines)) if lines[i].startswith("}")),
+                len(lines),
+            )
+        declared = _MAKE_CHAN_RE.match(line)
+        if declared is None or index >= func_end:
+            continue
+        name = declared.group(1) or declared.group(2)
+        use_re = re.compile(r"(?<![\w.])" + re.escape(name) + r"\b")
+        uses: set[str] = set()
+        for body_line in lines[index + 1 : func_end]:
+            for use in use_re.finditer(body_line):
+                uses.add(_classify(body_line, use.start(), use.end()))
+        if "escape" in uses:
+            continue
+        if "receive" in uses and "send" not in uses:
+            direction = "received from"
+        elif "send" in uses and "receive" not in uses and not _is_buffered(
+            line, declared.start(3)
+        ):
+            direction = "sent on"
+        else:
+            continue
+        findings.append(
+            builtin_finding(
+                ONE_WAY_CHANNEL_PATTERN,
+                f"Channel {name} is only {direction} and never escapes",
+                rel_path,
+                index + 1,
+                source_lines[index],
+                config,
+                ONE_WAY_CHANNEL_CONFIDENCE,
+            )
+        )
+    return findings
@@ -0,0 +1,104 @@
+"""Detection of roundabout time idioms in Go.
+
+Two ``time`` idioms have a clearer standard form::
+
+    elapsed := time.Now().Sub(start) // CC-141: use time.Since(start)
+
+    if t == time.Unix(0, 0) { // CC-141: use t.IsZero()
+        t = time.Now()
+    }
+
+``time.Since`` says what ``time.Now().Sub`` computes. The zero
+``time.Time`` is January 1 of year 1, not the Unix epoch, so an epoch
+sentinel misses unset values, and ``==`` also compares locations and
+monotonic readings. Reported are ``Now().Sub(`` calls and comparisons with
+``time.Unix(0, 0)`` by ``==``, ``!=`` or ``Equal``, resolved through the
+file's import aliases.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for time.Now().Sub and Unix epoch zero checks
+TIME_IDIOM_PATTERN = Pattern(
+    id=PatternId("CC-141-CODE-GO"),
+    domain=ArtifactDomain.TRANSFORM,
+    signals=[],
+    severity=Severity.INFO,
+    description="Roundabout time idiom - time.Now().Sub or a Unix epoch zero check",
+    remediation="Use time.Since(x) for elapsed time and t.IsZero() to check for an unset time",
+    language="go",
+    effort=PatternEffort.LOW,
+)
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def find_time_idioms(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report time.Now().Sub calls and comparisons with the Unix epoch.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-141 findings in line order.
+
+    """
+    if not config.is_enabled(TIME_IDIOM_PATTERN.id):
+        return []
+    aliases = [a for a, path in parse_go_imports(text).items() if path == "time"]
+    if not aliases:
+        return []
+    qualified = "|".join(r"(?<![\w.])" if a == "." else re.escape(a) + r"\." for a in aliases)
+    now_sub_re = re.compile(rf"(?:{qualified})Now\(\)\.Sub\(")
+    epoch = rf"(?:{qualified})Unix\([ \t]*0[ \t]*,[ \t]*0[ \t]*\)"
+    epoch_compare_re = re.compile(
+        rf"[=!]=[ \t]*{epoch}|{epoch}[ \t]*(?:[=!]=|\.Equal\()|\.Equal\([ \t]*{epoch}[ \t]*\)"
+    )
+    source_lines = text.split("\n")
+    findings: list[ScanFinding] = []
+    for index, line in enumerate(_code_lines(text)):
+        for _ in now_sub_re.finditer(line):
+            findings.append(
+                builtin_finding(
+                    TIME_IDIOM_PATTERN,
+                    "time.Now().Sub(x) computes elapsed time - use time.Since(x)",
+                    rel_path,
+                    index + 1,
+                    source_lines[index],
+                    config,
+                )
+            )
+        for _ in epoch_compare_re.finditer(line):
+            findings.append(
+                builtin_finding(
+                    TIME_IDIOM_PATTERN,
+                    "Time compared with the Unix epoch as a zero check - use IsZero()",
+                    rel_path,
+                    index + 1,
+                    source_lines[index],
+                    config,
+                )
+            )
+    return findings
@@ -0,0 +1,120 @@
+"""Comparison of Deep Verify scan reports across branches.
+
+Release readiness reviews put several reports side by side, such as main,
+the release branch and a feature branch. ``compare_reports`` turns them into
+a matrix with one column per report: unsuppressed finding counts per
+severity, plus the findings introduced and resolved relative to the first
+report, the baseline. Findings are matched by fingerprint (see
+``compare_findings``), so line shifts between branches do not count as
+changes. A column regresses when it introduces findings or has more findings
+of some severity than the baseline.
+
+Example:
+    >>> import json
+    >>> from pathlib import Path
+    >>> from bmad_assist.deep_verify.scan import compare_reports, deserialize_scan_report
+    >>> reports = [
+    ...     (name, deserialize_scan_report(json.loads(Path(f"{name}.json").read_text())))
+    ...     for name in ("main", "release", "feature")
+    ... ]
+    >>> for column in compare_reports(reports):
+    ...     print(column.label, column.total, column.regressed)
+
+"""
+
+from __future__ import annotations
+
+from collections.abc import Sequence
+from dataclasses import dataclass, field
+from typing import Any
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan.fixes import compare_findings
+from bmad_assist.deep_verify.scan.types import ScanReport
+
+# Matrix rows, most severe first
+COMPARE_SEVERITIES = (Severity.CRITICAL, Severity.ERROR, Severity.WARNING, Severity.INFO)
+
+
+@dataclass(frozen=True, slots=True)
+class ReportColumn:
+    """One report's column of a comparison matrix.
+
+    Attributes:
+        label: Name of the report, such as its branch.
+        counts: Unsuppressed findings per severity, with zero counts.
+        introduced: Unsuppressed findings not in the baseline report.
+        resolved: Unsuppressed baseline findings no longer reported.
+        regressions: Severities with more findings than the baseline.
+
+    """
+
+    label: str
+    counts: dict[Severity, int] = field(default_factory=dict)
+    introduced: int = 0
+    resolved: int = 0
+    regressions: tuple[Severity, ...] = ()
+
+    @property
+    def total(self) -> int:
+        """Return the number of unsuppressed findings."""
+        return sum(self.counts.values())
+
+    @property
+    def regressed(self) -> bool:
+        """Return whether the report is worse than the baseline."""
+        return bool(self.introduced or self.regressions)
+
+
+def compare_reports(reports: Sequence[tuple[str, ScanReport]]) -> list[ReportColumn]:
+    """Build a comparison matrix of labeled reports.
+
+    Args:
+        reports: (label, report) pairs; the first is the baseline.
+
+    Returns:
+        One column per report, in the given order. The baseline's column
+        has no introduced or resolved findings.
+
+    """
+    if not reports:
+        return []
+    baseline = reports[0][1]
+    base_counts = baseline.severity_counts()
+    columns: list[ReportColumn] = []
+    for label, report in reports:
+        severity_counts = report.severity_counts()
+        counts = {s: severity_counts.get(s, 0) for s in COMPARE_SEVERITIES}
+        resolved, introduced = compare_findings(
+            baseline.unsuppressed_findings(), report.unsuppressed_findings()
+        )
+        regressions = tuple(s for s in COMPARE_SEVERITIES if counts[s] > base_counts.get(s, 0))
+        columns.append(
+            ReportColumn(
+                label=label,
+                counts=counts,
+                introduced=len(introduced),
+                resolved=len(resolved),
+                regressions=regressions,
+            )
+        )
+    return columns
+
+
+def serialize_report_comparison(columns: Sequence[ReportColumn]) -> dict[str, Any]:
+    """Serialize a comparison matrix to a dictionary for JSON output."""
+    return {
+        "baseline": columns[0].label if columns else None,
+        "reports": [
+            {
+                "label": c.label,
+                "counts": {s.value: c.counts.get(s, 0) for s in COMPARE_SEVERITIES},
+                "total": c.total,
+                "introduced": c.introduced,
+                "resolved": c.resolved,
+                "regressions": [s.value for s in c.regressions],
+                "regressed": c.regressed,
+            }
+            for c in columns
+        ],
+    }
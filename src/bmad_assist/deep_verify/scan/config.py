@@ -0,0 +1,394 @@
+"""Per-directory scan configuration for Deep Verify.
+
+This module loads ``.deepverify.yaml`` files and resolves the effective
+configuration for each scanned file. A config file applies to every file in
+its directory and below; the nearest ancestor wins, so a team can scope its
+own rule set to a subtree of a monorepo.
+
+Merging:
+    Configs are merged from the scan root down to the file's directory.
+    A key set in a nested config replaces the inherited value, except
+    ``severity`` and ``detectors``, whose entries are merged (nested entries
+    win). Path severity rules (see scan.policy) apply after these overrides.
+
+Example:
+    .deepverify.yaml (repository root)::
+
+        disable: ["CQ-007-CODE-GO"]
+
+    services/payments/.deepverify.yaml::
+
+        enable: ["CC-", "SEC-"]
+        opt_in: ["CC-101"]
+        severity:
+          CC-001-CODE-GO: error
+        suppression_fields: [reason, owner]
+        detectors:
+          CC-146:
+            secret_words: [signing]
+
+Detector options:
+    Detectors with parameters declare a ``DetectorOptions`` model and
+    register it with ``register_detector_options``. Each entry of the
+    ``detectors`` key is validated against the named detector's model when
+    the config is loaded, so an unknown detector, an unknown option or a
+    value of the wrong type is a config error rather than silently ignored.
+
+"""
+
+from __future__ import annotations
+
+import logging
+from pathlib import Path
+from typing import Any, Literal, TypeVar
+
+import yaml
+from pydantic import BaseModel, ConfigDict, Field, ValidationError, field_validator
+
+from bmad_assist.core.exceptions import ConfigError
+from bmad_assist.deep_verify.core.types import Pattern, Severity
+
+logger = logging.getLogger(__name__)
+
+# Name of the per-directory config file
+CONFIG_FILENAME = ".deepverify.yaml"
+
+# Governance fields a suppression comment can be required to set
+SuppressionField = Literal["reason", "owner", "expires"]
+
+
+def matches_selector(pattern_id: str, selector: str) -> bool:
+    """Check whether a pattern ID is selected by a config entry.
+
+    Selectors are matched case-insensitively and may be:
+    - An exact pattern ID ("CC-004-CODE-GO")
+    - A base ID covering its code variants ("CC-004")
+    - A prefix ending in "-" ("CC-" selects every CC pattern)
+
+    Args:
+        pattern_id: Pattern ID to test.
+        selector: Config entry to match against.
+
+    Returns:
+        True if the selector covers the pattern ID.
+
+    """
+    pid = pattern_id.upper()
+    sel = selector.strip().upper()
+    if not sel:
+        return False
+    if sel.endswith("-"):
+        return pid.startswith(sel)
+    return pid == sel or pid.startswith(f"{sel}-CODE")
+
+
+class DetectorOptions(BaseModel):
+    """Base class of a detector's options under the ``detectors`` config key.
+
+    Subclasses declare typed fields with defaults; keys they do not declare
+    are rejected.
+    """
+
+    model_config = ConfigDict(frozen=True, extra="forbid")
+
+
+_OptionsT = TypeVar("_OptionsT", bound=DetectorOptions)
+
+# Options model of each configurable detector, keyed by pattern ID
+DETECTOR_OPTIONS: dict[str, type[DetectorOptions]] = {}
+
+
+def register_detector_options(pattern_id: str, options: type[_OptionsT]) -> type[_OptionsT]:
+    """Make a detector configurable under the ``detectors`` config key.
+
+    Args:
+        pattern_id: Full pattern ID of the detector.
+        options: Options model the detector reads with
+            ``ScanConfig.detector_options``.
+
+    Returns:
+        The options model.
+
+    """
+    DETECTOR_OPTIONS[pattern_id] = options
+    return options
+
+
+def detector_for(selector: str) -> str | None:
+    """Return the configurable detector a ``detectors`` entry names.
+
+    Entries are exact or base pattern IDs; prefixes ("CC-") are not accepted
+    because options belong to one detector.
+
+    Args:
+        selector: Key of a ``detectors`` entry.
+
+    Returns:
+        Pattern ID of the registered detector, or None.
+
+    """
+    if selector.strip().endswith("-"):
+        return None
+    return next((pid for pid in DETECTOR_OPTIONS if matches_selector(pid, selector)), None)
+
+
+class ScanConfig(BaseModel):
+    """Scan configuration loaded from ``.deepverify.yaml``.
+
+    Attributes:
+        enable: Selectors for patterns to run. None runs every pattern.
+        disable: Selectors for patterns to skip (applied after enable).
+        opt_in: Selectors for opt-in patterns to run. Opt-in patterns ignore
+            ``enable`` and run only when selected here (``disable`` still wins).
+        severity: Severity overrides keyed by selector.
+        suppression_fields: Fields every ``deepverify:ignore`` comment must
+            set. Non-empty enables suppression governance (CC-103).
+        context_holders: Go struct types allowed to store a
+            ``context.Context`` field (exempt from CC-127).
+        detectors: Options of configurable detectors, keyed by full pattern
+            ID after validation (see ``detector_options``).
+
+    """
+
+    model_config = ConfigDict(frozen=True)
+
+    enable: list[str] | None = Field(
+        None,
+        description="Pattern selectors to run (None = all patterns)",
+    )
+    disable: list[str] = Field(
+        default_factory=list,
+        description="Pattern selectors to skip, applied after enable",
+    )
+    opt_in: list[str] = Field(
+        default_factory=list,
+        description="Selectors for opt-in patterns to run",
+    )
+    severity: dict[str, Severity] = Field(
+        default_factory=dict,
+        description="Severity overrides keyed by pattern selector",
+    )
+    suppression_fields: list[SuppressionField] = Field(
+        default_factory=list,
+        description="Fields required on every suppression comment",
+    )
+    context_holders: list[str] = Field(
+        default_factory=list,
+        description="Struct type names allowed to store a context.Context (CC-127)",
+    )
+    detectors: dict[str, Any] = Field(
+        default_factory=dict,
+        description="Options of configurable detectors keyed by pattern ID",
+    )
+
+    @field_validator("detectors", mode="after")
+    @classmethod
+    def validate_detectors(cls, v: dict[str, Any]) -> dict[str, Any]:
+        """Validate each entry against its detector's registered options model."""
+        validated: dict[str, Any] = {}
+        for selector, value in v.items():
+            pattern_id = detector_for(selector)
+            if pattern_id is None:
+                configurable = ", ".join(sorted(DETECTOR_OPTIONS)) or "none"
+                raise ValueError(
+                    f"Unknown detector in detectors: {selector!r}. "
+                    f"Configurable detectors: {configurable}"
+                )
+            options = DETECTOR_OPTIONS[pattern_id]
+            if isinstance(value, options):
+                validated[pattern_id] = value
+                continue
+            try:
+                validated[pattern_id] = options.model_validate(value or {})
+            except ValidationError as e:
+                raise ValueError(f"Invalid options for {selector}: {e}") from e
+        return validated
+
+    def is_enabled(self, pattern_id: str, opt_in: bool = False) -> bool:
+        """Check whether a pattern runs under this config.
+
+        Args:
+            pattern_id: Pattern ID to check.
+            opt_in: Whether the pattern is opt-in (off unless selected by
+                the ``opt_in`` key).
+
+        Returns:
+            True if the pattern is enabled and not disabled.
+
+        """
+        if any(matches_selector(pattern_id, s) for s in self.disable):
+            return False
+        if opt_in:
+            return any(matches_selector(pattern_id, s) for s in self.opt_in)
+        return self.enable is None or any(matches_selector(pattern_id, s) for s in self.enable)
+
+    def severity_for(self, pattern: Pattern) -> Severity:
+        """Return the effective severity for a pattern.
+
+        Exact-ID overrides take precedence over broader selectors.
+
+        Args:
+            pattern: Pattern to look up.
+
+        Returns:
+            Overridden severity, or the pattern's default.
+
+        """
+        for selector, severity in self.severity.items():
+            if selector.strip().upper() == pattern.id:
+                return severity
+        for selector, severity in self.severity.items():
+            if matches_selector(pattern.id, selector):
+                return severity
+        return pattern.severity
+
+    def detector_options(self, pattern_id: str, options: type[_OptionsT]) -> _OptionsT:
+        """Return a detector's configured options.
+
+        Args:
+            pattern_id: Full pattern ID of the detector.
+            options: The detector's registered options model.
+
+        Returns:
+            Options from the ``detectors`` key, or the model's defaults.
+
+        """
+        configured = self.detectors.get(pattern_id)
+        return configured if isinstance(configured, options) else options()
+
+
+def merge_scan_configs(parent: ScanConfig, child: ScanConfig) -> ScanConfig:
+    """Merge a nested config over its parent.
+
+    Keys explicitly set in ``child`` replace the parent's values, except
+    ``severity`` and ``detectors``, which are merged key-wise with child
+    entries winning.
+
+    Args:
+        parent: Inherited configuration.
+        child: Nearer configuration.
+
+    Returns:
+        Merged ScanConfig.
+
+    """
+    updates: dict[str, Any] = {}
+    for name in child.model_fields_set:
+        if name in ("severity", "detectors"):
+            updates[name] = {**getattr(parent, name), **getattr(child, name)}
+        else:
+            updates[name] = getattr(child, name)
+    return parent.model_copy(update=updates)
+
+
+def load_scan_config(path: Path) -> ScanConfig:
+    """Load a scan config file.
+
+    Args:
+        path: Path to a ``.deepverify.yaml`` file.
+
+    Returns:
+        Parsed ScanConfig. An empty file yields the default config.
+
+    Raises:
+        ConfigError: If the file cannot be read, is not a YAML mapping, or
+            fails validation.
+
+    """
+    try:
+        with path.open(encoding="utf-8") as f:
+            data = yaml.safe_load(f)
+    except (OSError, yaml.YAMLError) as e:
+        raise ConfigError(f"Failed to read scan config {path}: {e}") from e
+
+    if data is None:
+        data = {}
+    if not isinstance(data, dict):
+        raise ConfigError(
+            f"Scan config {path} must be a mapping, got {type(data).__name__}"
+        )
+
+    try:
+        return ScanConfig.model_validate(data)
+    except ValidationError as e:
+        raise ConfigError(f"Invalid scan config {path}: {e}") from e
+
+
+class ScanConfigResolver:
+    """Resolves the effective scan config for files under a scan root.
+
+    Config files are looked up from the scan root down to each file's
+    directory and cached per directory, so a scan reads each config once.
+
+    Attributes:
+        _root: Scan root directory (resolved).
+        _base: Config applied before any file is found.
+        _use_files: Whether ``.deepverify.yaml`` files are read.
+        _cache: Effective config per directory.
+
+    Example:
+        >>> resolver = ScanConfigResolver(Path("."))
+        >>> config = resolver.resolve(Path("services/payments/api.go"))
+        >>> config.is_enabled("CC-001-CODE-GO")
+        True
+
+    """
+
+    def __init__(
+        self,
+        root: Path,
+        base: ScanConfig | None = None,
+        use_files: bool = True,
+    ) -> None:
+        """Initialize the resolver.
+
+        Args:
+            root: Scan root directory. Configs above it are ignored.
+            base: Config applied beneath every file config (default: empty).
+            use_files: If False, only ``base`` is used.
+
+        """
+        self._root = root.resolve()
+        self._base = base or ScanConfig()
+        self._use_files = use_files
+        self._cache: dict[Path, ScanConfig] = {}
+
+    def __repr__(self) -> str:
+        """Return a string representation of the resolver."""
+        return f"ScanConfigResolver(root={str(self._root)!r}, use_files={self._use_files})"
+
+    def resolve(self, file_path: Path) -> ScanConfig:
+        """Return the effective config for a file.
+
+        Args:
+            file_path: File inside the scan root.
+
+        Returns:
+            Effective ScanConfig for the file.
+
+        Raises:
+            ConfigError: If a config file on the path is invalid.
+
+        """
+        return self._resolve_dir(file_path.resolve().parent)
+
+    def _resolve_dir(self, directory: Path) -> ScanConfig:
+        """Return the effective config for a directory (cached)."""
+        cached = self._cache.get(directory)
+        if cached is not None:
+            return cached
+
+        # Files outside the scan root only get the base config
+        if directory != self._root and self._root not in directory.parents:
+            return self._base
+
+        parent = self._base if directory == self._root else self._resolve_dir(directory.parent)
+
+        config = parent
+        candidate = directory / CONFIG_FILENAME
+        if self._use_files and candidate.is_file():
+            logger.debug("Applying scan config %s", candidate)
+            config = merge_scan_configs(parent, load_scan_config(candidate))
+
+        self._cache[directory] = config
+        return config
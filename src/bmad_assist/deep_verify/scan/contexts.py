@@ -0,0 +1,141 @@
+"""Detection of context.Context stored in struct fields for Go scans.
+
+A ``context.Context`` carries the deadline and cancellation of one call.
+Stored in a struct it outlives that call, so later method calls run under
+a stale or already cancelled context and callers cannot pass their own::
+
+    type Client struct {
+        ctx context.Context // CC-127: pass ctx to each method instead
+    }
+
+Fields are matched by type, resolved through the file's imports (so an
+aliased ``stdctx "context"`` import is followed and a local ``Context``
+type is not). Named, grouped (``a, b context.Context``) and embedded
+fields are reported, in named and anonymous structs alike. Types that hold
+a context on purpose, such as request-scoped holders, are exempted by name
+with the ``context_holders`` key of ``.deepverify.yaml``::
+
+    context_holders: [requestScope]
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for struct fields of type context.Context
+CONTEXT_FIELD_PATTERN = Pattern(
+    id=PatternId("CC-127-CODE-GO"),
+    domain=ArtifactDomain.API,
+    signals=[],
+    severity=Severity.INFO,
+    description="Struct stores a context.Context - contexts belong to a single call",
+    remediation="Pass the context as the first parameter of each method that needs it",
+    language="go",
+    effort=PatternEffort.HIGH,
+)
+
+# Struct type opening: `type Client struct {`, `Client struct {` (a type block
+# spec or a field of anonymous struct type), or an anonymous `struct {`
+_STRUCT_RE = re.compile(
+    r"(?:^[ \t]*(type[ \t]+)?([A-Za-z_]\w*)(?:\[[^\]\n]*\])?[ \t]+)?(?<!\w)struct[ \t]*\{",
+    re.MULTILINE,
+)
+
+# Top-level `type (` block, up to its column-0 closing parenthesis
+_TYPE_BLOCK_RE = re.compile(r"^type[ \t]*\(\n(.*?)^\)", re.MULTILINE | re.DOTALL)
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _owner(code: str, struct: re.Match[str], blocks: list[tuple[int, int]]) -> str | None:
+    """Return the type name a struct declares, or None for an anonymous struct."""
+    keyword, name = struct.group(1, 2)
+    if name is None:
+        return None
+    if keyword is not None:
+        return name
+    # Without `type`, a name is a type spec only at the top level of a type block
+    for begin, end in blocks:
+        if begin <= struct.start() < end:
+            prefix = code[begin : struct.start()]
+            return name if prefix.count("{") == prefix.count("}") else None
+    return None
+
+
+def find_context_fields(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report struct fields of type context.Context in a Go file.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file; its ``context_holders`` name
+            struct types allowed to hold a context.
+
+    Returns:
+        CC-127 findings in line order, one per field line.
+
+    """
+    if not config.is_enabled(CONTEXT_FIELD_PATTERN.id):
+        return []
+    aliases = [name for name, path in parse_go_imports(text).items() if path == "context"]
+    if not aliases:
+        return []
+    qualified = [r"(?<![\w.])Context" if a == "." else re.escape(a) + r"\.Context" for a in aliases]
+    field_re = re.compile(
+        r"^[ \t]*(?:([A-Za-z_]\w*(?:[ \t]*,[ \t]*[A-Za-z_]\w*)*)[ \t]+)?\*?(?:"
+        + "|".join(qualified)
+        + r")[ \t]*(?:\"\"[ \t]*)?$"
+    )
+
+    source_lines = text.split("\n")
+    lines = _code_lines(text)
+    code = "\n".join(lines)
+    blocks = [block.span(1) for block in _TYPE_BLOCK_RE.finditer(code)]
+    allowed = set(config.context_holders)
+    findings: list[ScanFinding] = []
+    for struct in _STRUCT_RE.finditer(code):
+        owner = _owner(code, struct, blocks)
+        if owner in allowed:
+            continue
+        start = code.count("\n", 0, struct.end())
+        column = struct.end() - (code.rfind("\n", 0, struct.end()) + 1)
+        rest = lines[start][column:]
+        # Braces closed on the opening line: a one-line struct such as `struct{}`
+        depth = 1 + rest.count("{") - rest.count("}")
+        for index in range(start + 1, len(lines)):
+            if depth <= 0:
+                break
+            line = lines[index]
+            if depth == 1 and (field := field_re.match(line)):
+                names = field.group(1) or "Context"
+                findings.append(
+                    builtin_finding(
+                        CONTEXT_FIELD_PATTERN,
+                        f"{owner or 'Anonymous struct'} stores a context.Context in {names}",
+                        rel_path,
+                        index + 1,
+                        source_lines[index],
+                        config,
+                    )
+                )
+            depth += line.count("{") - line.count("}")
+    findings.sort(key=lambda f: f.line)
+    return findings
@@ -0,0 +1,275 @@
+"""Detection of narrowing integer conversions in Go scans.
+
+Converting an integer to a narrower type keeps only the low bits, so a value
+out of the destination's range silently wraps::
+
+    func setQuota(n int64) {
+        quota := int32(n) // CC-148: n above 2^31-1 wraps negative
+        ...
+    }
+
+``int``, ``uint`` and ``uintptr`` are 32 bits wide on 32-bit builds, so
+converting ``int64`` to ``int`` truncates there while passing every test
+on 64-bit machines. For the width comparison they count as 64 bits when
+converted from and 32 bits when converted to; conversions between them are
+not reported.
+
+A conversion ``T(x)`` is reported when ``x`` is an identifier, a selector
+or a call whose type the file declares: a parameter, a ``var`` or ``x :=
+T(...)`` declaration of the function, a package-level ``var``, a struct
+field, or the single result of a function of the file. A few well-known
+sources are typed without a declaration: ``strconv.ParseInt`` and
+``ParseUint`` results (at the bit size they were parsed with), and the
+``Size()``, ``Unix()`` and ``UnixNano()`` methods and ``ContentLength``
+field, which are ``int64``. Literals and constants, whose range the
+compiler checks, are not reported, and neither are conversions after the
+function compares ``x`` with ``<`` or ``>`` or uses a ``math.Max*`` or
+``math.Min*`` bound.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for integer conversions to a narrower type
+NARROWING_CONVERSION_PATTERN = Pattern(
+    id=PatternId("CC-148-CODE-GO"),
+    domain=ArtifactDomain.TRANSFORM,
+    signals=[],
+    severity=Severity.INFO,
+    description="Integer converted to a narrower type - values out of range are truncated",
+    remediation="Check the value against the destination's bounds (math.MaxInt32) first",
+    language="go",
+    effort=PatternEffort.LOW,
+)
+
+# Confidence of CC-148 findings: the declared types are exact, but callers often
+# bound the value in code the check does not follow, such as a validating caller
+NARROWING_CONVERSION_CONFIDENCE = 0.7
+
+# Bit widths of the fixed-size integer types
+_WIDTHS = {
+    "int8": 8,
+    "uint8": 8,
+    "byte": 8,
+    "int16": 16,
+    "uint16": 16,
+    "int32": 32,
+    "uint32": 32,
+    "rune": 32,
+    "int64": 64,
+    "uint64": 64,
+}
+
+# Integer types as wide as a machine word: 32 bits on 32-bit builds, else 64
+_WORD_TYPES = frozenset({"int", "uint", "uintptr"})
+
+_TYPES = "|".join(sorted((*_WIDTHS, *_WORD_TYPES), key=len, reverse=True))
+
+# Conversion of an identifier, selector or call: `int32(n)`, `int(fi.Size())`
+_CONVERSION_RE = re.compile(
+    rf"(?<![\w.])({_TYPES})\([ \t]*"
+    r"([A-Za-z_]\w*(?:\.[A-Za-z_]\w*)*)(\([^()]*\))?[ \t]*\)"
+)
+
+# Results typed without a declaration in the file
+_KNOWN_CALLS = {"Size": "int64", "Unix": "int64", "UnixNano": "int64"}
+_KNOWN_FIELDS = {"ContentLength": "int64"}
+
+# Function declaration with its parameters and a single unnamed result
+_FUNC_DECL_RE = re.compile(
+    r"^func[ \t]*(?:\([^)\n]*\)[ \t]*)?([A-Za-z_]\w*)[ \t]*(?:\[[^\]\n]*\][ \t]*)?"
+    r"\(([^)\n]*)\)[ \t]*(?:\([^)\n]*\)|([\w.*\[\]]*))[ \t]*\{",
+    re.MULTILINE,
+)
+
+# Typed variable declarations: `var a, b int64`, `a := int64(...)`
+_VAR_RE = re.compile(rf"(?<![\w.])var[ \t]+([\w, \t]+?)[ \t]+({_TYPES})\b")
+_SHORT_RE = re.compile(rf"(?<![\w.])([A-Za-z_]\w*)[ \t]*:=[ \t]*({_TYPES})\(")
+_CALL_ASSIGN_RE = re.compile(r"(?<![\w.])([A-Za-z_]\w*)[ \t]*(?:,[ \t]*\w+[ \t]*)?:?=[ \t]*")
+
+# strconv.ParseInt(s, base, bits) and ParseUint results
+_PARSE_RE = re.compile(
+    r"(?<![\w.])([A-Za-z_]\w*)[ \t]*,[ \t]*\w+[ \t]*:?=[ \t]*strconv\.Parse(Int|Uint)\("
+    r"[^,()]*(?:\([^()]*\))?[^,()]*,[^,()]*,[ \t]*(\d+)[ \t]*\)"
+)
+
+# Struct field declarations: `Size int64`, `a, b int32`
+_FIELD_RE = re.compile(rf"^[ \t]+([A-Za-z_]\w*(?:[ \t]*,[ \t]*[A-Za-z_]\w*)*)[ \t]+({_TYPES})\b")
+_STRUCT_RE = re.compile(r"(?<![\w.])struct[ \t]*\{[ \t]*$")
+
+# Constants: `const maxSize = 1 << 20` and names inside `const ( ... )`
+_CONST_RE = re.compile(r"^[ \t]*const[ \t]+([A-Za-z_]\w*)")
+_CONST_BLOCK_RE = re.compile(r"^[ \t]*const[ \t]*\([ \t]*$")
+_BLOCK_NAME_RE = re.compile(r"^[ \t]*([A-Za-z_]\w*)\b")
+
+_BOUND_RE = re.compile(r"(?<![\w.])math\.(?:Max|Min)\w+")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _narrows(source: str, destination: str) -> bool:
+    """Return whether converting source to destination can drop bits."""
+    if source == destination or (source in _WORD_TYPES and destination in _WORD_TYPES):
+        return False
+    source_width = 64 if source in _WORD_TYPES else _WIDTHS.get(source)
+    destination_width = 32 if destination in _WORD_TYPES else _WIDTHS[destination]
+    return source_width is not None and source_width > destination_width
+
+
+def _param_types(params: str) -> dict[str, str]:
+    """Return the names and types of a parameter list (`a, b int64, c string`)."""
+    types: dict[str, str] = {}
+    pending: list[str] = []
+    for part in params.split(","):
+        words = part.split()
+        if len(words) == 1:
+            pending.append(words[0])
+        elif len(words) >= 2:
+            for name in (*pending, words[0]):
+                types[name] = words[-1]
+            pending = []
+    return types
+
+
+def _file_types(lines: list[str]) -> tuple[dict[str, str], dict[str, str], set[str]]:
+    """Return the file's struct field types, package-level var types and constants.
+
+    Fields declared with different types in different structs are left out.
+    """
+    fields: dict[str, str] = {}
+    ambiguous: set[str] = set()
+    package_vars: dict[str, str] = {}
+    constants: set[str] = set()
+    depth = 0
+    in_struct = in_const = False
+    for line in lines:
+        if in_const:
+            if line.strip().startswith(")"):
+                in_const = False
+            elif match := _BLOCK_NAME_RE.match(line):
+                constants.add(match.group(1))
+            continue
+        if _CONST_BLOCK_RE.match(line):
+            in_const = True
+        elif match := _CONST_RE.match(line):
+            constants.add(match.group(1))
+        elif depth == 0 and (match := _VAR_RE.match(line)):
+            for name in match.group(1).split(","):
+                package_vars[name.strip()] = match.group(2)
+        if in_struct and (match := _FIELD_RE.match(line)):
+            for name in (n.strip() for n in match.group(1).split(",")):
+                if fields.setdefault(name, match.group(2)) != match.group(2):
+                    ambiguous.add(name)
+        if _STRUCT_RE.search(line):
+            in_struct = True
+        elif line.strip().startswith("}"):
+            in_struct = False
+        depth += line.count("{") - line.count("}")
+    return {k: v for k, v in fields.items() if k not in ambiguous}, package_vars, constants
+
+
+def _local_types(body: str, params: str, results: dict[str, str]) -> dict[str, str]:
+    """Return the types of a function's parameters and typed local variables."""
+    types = _param_types(params)
+    for match in _VAR_RE.finditer(body):
+        for name in match.group(1).split(","):
+            types[name.strip()] = match.group(2)
+    for match in _SHORT_RE.finditer(body):
+        types[match.group(1)] = match.group(2)
+    for match in _CALL_ASSIGN_RE.finditer(body):
+        call = re.match(r"([A-Za-z_]\w*)\(", body[match.end() :])
+        if call and call.group(1) in results:
+            types[match.group(1)] = results[call.group(1)]
+    for match in _PARSE_RE.finditer(body):
+        bits = int(match.group(3)) or 64
+        types[match.group(1)] = ("int" if match.group(2) == "Int" else "uint") + str(bits)
+    return types
+
+
+def _is_bounded(body: str, operand: str) -> bool:
+    """Return whether a function body compares the operand or uses a math bound."""
+    ref = re.escape(operand)
+    return bool(
+        _BOUND_RE.search(body)
+        or re.search(rf"(?<![\w.]){ref}(?:\(\))?[ \t]*(?:<|>)(?![<>-])", body)
+        or re.search(rf"(?<![<>])(?:<|>)=?[ \t]*{ref}\b", body)
+    )
+
+
+def find_narrowing_conversions(
+    text: str, rel_path: str, config: ScanConfig
+) -> list[ScanFinding]:
+    """Report integer conversions to a narrower type of values the file types.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-148 findings in line order, one per conversion.
+
+    """
+    if not config.is_enabled(NARROWING_CONVERSION_PATTERN.id):
+        return []
+    lines = _code_lines(text)
+    code = "\n".join(lines)
+    source_lines = text.split("\n")
+    fields, package_vars, constants = _file_types(lines)
+    functions = list(_FUNC_DECL_RE.finditer(code))
+    results = {f.group(1): f.group(3) for f in functions if f.group(3)}
+    findings: list[ScanFinding] = []
+    for number, function in enumerate(functions):
+        body_start = function.end()
+        end = code.find("\n}", body_start)
+        end = len(code) if end < 0 else end
+        if number + 1 < len(functions):
+            end = min(end, functions[number + 1].start())
+        local = _local_types(code[body_start:end], function.group(2), results)
+        for conversion in _CONVERSION_RE.finditer(code, body_start, end):
+            destination, operand, call = conversion.groups()
+            name = operand.rsplit(".", 1)[-1]
+            if call is not None:
+                source = results.get(name) or _KNOWN_CALLS.get(name)
+            elif "." in operand:
+                source = fields.get(name) or _KNOWN_FIELDS.get(name)
+            elif operand in constants and operand not in local:
+                continue
+            else:
+                source = local.get(operand) or package_vars.get(operand)
+            if source is None or not _narrows(source, destination):
+                continue
+            if _is_bounded(code[body_start : conversion.start()], operand):
+                continue
+            index = code.count("\n", 0, conversion.start())
+            expression = f"{destination}({operand}{call or ''})"
+            findings.append(
+                builtin_finding(
+                    NARROWING_CONVERSION_PATTERN,
+                    f"{expression} narrows {source} - out-of-range values are truncated",
+                    rel_path,
+                    index + 1,
+                    source_lines[index],
+                    config,
+                    NARROWING_CONVERSION_CONFIDENCE,
+                )
+            )
+    return findings
@@ -0,0 +1,150 @@
+"""Detection of sync.WaitGroup parameters passed by value in Go scans.
+
+A ``sync.WaitGroup`` parameter is a copy of the caller's WaitGroup, so the
+callee's ``Done`` never reaches the counter the caller waits on and its
+``Wait`` hangs or returns at once::
+
+    func worker(id int, wg sync.WaitGroup) { // CC-136: wg is a copy
+        defer wg.Done()
+        process(id)
+    }
+
+Functions, methods and function literals (``go func(wg sync.WaitGroup)
+{...}(wg)``) are reported when they take a ``sync.WaitGroup`` (resolved
+through the file's imports) by value and call ``Add``, ``Done`` or ``Wait``
+on it. ``*sync.WaitGroup`` parameters share the caller's counter and are
+safe. This is the WaitGroup case of go vet's copylocks analysis, reported
+for the declaration rather than for each call.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    PatternPrecision,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for WaitGroup parameters that are copies of the caller's
+WAITGROUP_COPY_PATTERN = Pattern(
+    id=PatternId("CC-136-CODE-GO"),
+    domain=ArtifactDomain.CONCURRENCY,
+    signals=[],
+    severity=Severity.ERROR,
+    description="WaitGroup passed by value - Add, Done and Wait act on a copy",
+    remediation="Take a *sync.WaitGroup parameter and pass &wg",
+    language="go",
+    precision=PatternPrecision.HIGH,
+    effort=PatternEffort.LOW,
+)
+
+# Function, method or function literal with its parameters on one line;
+# a method has both a receiver and a name
+_FUNC_RE = re.compile(
+    r"(?<![\w.])func[ \t]*(?:(?:\([^)\n]*\)[ \t]*)?([A-Za-z_]\w*))?(?:\[[^\]\n]*\])?"
+    r"[ \t]*\(([^)\n]*)\)"
+)
+
+# One parameter: `wg sync.WaitGroup`, or a bare name sharing the next type
+_PARAM_RE = re.compile(r"([A-Za-z_]\w*)(?:[ \t]+(.+))?$")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _params_of_type(params: str, type_re: re.Pattern[str]) -> list[str]:
+    """Return the names of parameters whose type matches type_re."""
+    names: list[str] = []
+    pending: list[str] = []  # `a, b T` declares a and b as T
+    for part in params.split(","):
+        match = _PARAM_RE.match(part.strip())
+        if match is None:
+            pending = []
+            continue
+        name, param_type = match.groups()
+        if param_type is None:
+            pending.append(name)
+            continue
+        if type_re.fullmatch(param_type.strip()):
+            names.extend([*pending, name])
+        pending = []
+    return names
+
+
+def _body(code: str, start: int) -> str | None:
+    """Return the braced body opening on the line at start, if any."""
+    line_end = code.find("\n", start)
+    open_brace = code.find("{", start, len(code) if line_end < 0 else line_end)
+    if open_brace < 0:
+        return None  # declaration or func type without a body
+    depth = 0
+    for position in range(open_brace, len(code)):
+        if code[position] == "{":
+            depth += 1
+        elif code[position] == "}":
+            depth -= 1
+            if depth == 0:
+                return code[open_brace + 1 : position]
+    return code[open_brace + 1 :]
+
+
+def find_waitgroup_copies(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report functions that use a sync.WaitGroup parameter taken by value.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-136 findings in line order, one per parameter.
+
+    """
+    if not config.is_enabled(WAITGROUP_COPY_PATTERN.id):
+        return []
+    aliases = [name for name, path in parse_go_imports(text).items() if path == "sync"]
+    if not aliases:
+        return []
+    waitgroup_re = re.compile(
+        "|".join("WaitGroup" if a == "." else re.escape(a) + r"\.WaitGroup" for a in aliases)
+    )
+
+    code = "\n".join(_code_lines(text))
+    source_lines = text.split("\n")
+    findings: list[ScanFinding] = []
+    for func in _FUNC_RE.finditer(code):
+        names = _params_of_type(func.group(2), waitgroup_re)
+        if not names:
+            continue
+        body = _body(code, func.end())
+        if body is None:
+            continue
+        index = code.count("\n", 0, func.start())
+        for name in names:
+            if re.search(r"(?<![\w.])" + re.escape(name) + r"\.(?:Add|Done|Wait)\(", body):
+                findings.append(
+                    builtin_finding(
+                        WAITGROUP_COPY_PATTERN,
+                        f"{func.group(1) or 'Function literal'} takes WaitGroup {name} by value",
+                        rel_path,
+                        index + 1,
+                        source_lines[index],
+                        config,
+                    )
+                )
+    findings.sort(key=lambda f: f.line)
+    return findings
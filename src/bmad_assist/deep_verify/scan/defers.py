@@ -0,0 +1,183 @@
+"""Detection of blocking channel sends in deferred functions for Go scans.
+
+A deferred function runs while its caller returns, often during shutdown
+when the goroutine that drained the channel has already stopped. An
+unguarded send then blocks forever and the return never completes::
+
+    func (w *Worker) run() {
+        defer func() {
+            w.results <- w.summary() // CC-131: blocks if nobody receives
+        }()
+        ...
+    }
+
+Sends inside ``defer func() { ... }()`` closures are reported unless the
+channel is made with a buffer in the same file (``make(chan T, n)`` with a
+non-zero ``n``) or the send is a case of a select that can give up: one with
+a ``default`` case, a ``<-ctx.Done()`` case or a ``time.After`` timeout::
+
+    defer func() {
+        select {
+        case w.results <- w.summary():
+        default: // safe: drops the summary if nobody receives
+        }
+    }()
+
+Sends in goroutines started by the deferred function do not block it and are
+not reported.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for unguarded channel sends in deferred closures
+DEFERRED_SEND_PATTERN = Pattern(
+    id=PatternId("CC-131-CODE-GO"),
+    domain=ArtifactDomain.CONCURRENCY,
+    signals=[],
+    severity=Severity.WARNING,
+    description="Deferred function sends on a channel - it blocks forever once the receiver stops",
+    remediation="Send in a select with a default or <-ctx.Done() case, or buffer the channel",
+    language="go",
+    effort=PatternEffort.MEDIUM,
+)
+
+# Confidence of CC-131 findings: the send blocks only once the receiver has
+# stopped listening, as on error paths, not when callers always drain the channel
+DEFERRED_SEND_CONFIDENCE = 0.7
+
+# Deferred closure: `defer func() {`, `defer func(err error) {`
+_DEFER_RE = re.compile(r"\bdefer[ \t]+func[ \t]*\(")
+
+# Send statement at the start of a statement, optionally a select case
+_SEND_RE = re.compile(
+    r"(?:^|(?<=[;{\n]))[ \t]*(case[ \t]+)?"
+    r"(?!case\b)([A-Za-z_][\w.]*(?:\[[^\]\n]*\])?)[ \t]*<-(?!-)"
+)
+
+# Buffered channel: `ch := make(chan T, n)`, `results: make(chan T, n)`
+_BUFFERED_RE = re.compile(
+    r"([A-Za-z_]\w*)[ \t]*(?::=|=|:)[ \t]*"
+    r"make\([ \t]*(?:<-[ \t]*)?chan\b[^,\n]*,[ \t]*([^)\n]+)\)"
+)
+
+# Select cases that let a select give up instead of blocking
+_GUARD_RE = re.compile(r"\bdefault[ \t]*:|\.Done\(\)|\bAfter\(")
+
+_SELECT_RE = re.compile(r"\bselect[ \t]*$")
+_FUNC_RE = re.compile(r"\bfunc\b[^{]*$")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _blocks(code: str, start: int, end: int) -> list[tuple[str, int, int]]:
+    """Return (kind, open, close) for the braces in code[start:end].
+
+    Kind is "select", "func" (a function literal) or "block".
+    """
+    blocks: list[tuple[str, int, int]] = []
+    stack: list[tuple[str, int]] = []
+    segment = start
+    for position in range(start, end):
+        char = code[position]
+        if char == "{":
+            before = code[segment:position]
+            kind = "select" if _SELECT_RE.search(before) else "block"
+            if _FUNC_RE.search(before):
+                kind = "func"
+            stack.append((kind, position))
+            segment = position + 1
+        elif char in "};\n":
+            if char == "}" and stack:
+                kind, opened = stack.pop()
+                blocks.append((kind, opened, position))
+            segment = position + 1
+    return blocks
+
+
+def _closing_brace(code: str, open_brace: int) -> int:
+    """Return the offset of the brace closing the one at open_brace (-1 if none)."""
+    depth = 0
+    for position in range(open_brace, len(code)):
+        if code[position] == "{":
+            depth += 1
+        elif code[position] == "}":
+            depth -= 1
+            if depth == 0:
+                return position
+    return -1
+
+
+def find_deferred_sends(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report unguarded channel sends in deferred closures of a Go file.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-131 findings in line order, one per send.
+
+    """
+    if not config.is_enabled(DEFERRED_SEND_PATTERN.id):
+        return []
+    code = "\n".join(_code_lines(text))
+    buffered = {m.group(1) for m in _BUFFERED_RE.finditer(code) if m.group(2).strip() != "0"}
+    source_lines = text.split("\n")
+    reported: set[int] = set()
+    findings: list[ScanFinding] = []
+    for defer in _DEFER_RE.finditer(code):
+        open_brace = code.find("{", defer.end())
+        close_brace = _closing_brace(code, open_brace) if open_brace != -1 else -1
+        if close_brace == -1:
+            continue
+        blocks = _blocks(code, open_brace + 1, close_brace)
+        for send in _SEND_RE.finditer(code, open_brace + 1, close_brace):
+            channel = send.group(2)
+            position = send.start(2)
+            enclosing = [b for b in blocks if b[1] < position < b[2]]
+            if any(kind == "func" for kind, _, _ in enclosing) or position in reported:
+                continue
+            if channel.split("[")[0].rsplit(".", 1)[-1] in buffered:
+                continue
+            if send.group(1):
+                selects = [b for b in enclosing if b[0] == "select"]
+                if not selects:
+                    continue
+                _, select_open, select_close = max(selects, key=lambda b: b[1])
+                if _GUARD_RE.search(code, select_open, select_close):
+                    continue
+            reported.add(position)
+            line = code.count("\n", 0, position)
+            findings.append(
+                builtin_finding(
+                    DEFERRED_SEND_PATTERN,
+                    f"Deferred function sends on {channel} without a guard",
+                    rel_path,
+                    line + 1,
+                    source_lines[line],
+                    config,
+                    DEFERRED_SEND_CONFIDENCE,
+                )
+            )
+    findings.sort(key=lambda f: f.line)
+    return findings
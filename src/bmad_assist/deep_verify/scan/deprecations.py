@@ -0,0 +1,181 @@
+"""Deprecated function detection for Go scans.
+
+Calls are resolved through the file's imports, so ``ioutil.ReadFile`` is
+reported only when ``ioutil`` names ``io/ioutil`` (aliases and dot imports
+included) and an unrelated local ``ioutil`` package is not.
+
+Deprecated functions are keyed by ``<import path>.<Func>`` and map to a
+replacement hint. ``ScanOptions.deprecated_funcs`` adds entries for internal
+deprecations and can override the hints of the default list.
+
+Example:
+    >>> from bmad_assist.deep_verify.scan import ScanOptions
+    >>> options = ScanOptions(
+    ...     deprecated_funcs={"example.com/shop/legacy.Charge": "billing.Charge"}
+    ... )
+
+"""
+
+from __future__ import annotations
+
+import re
+from collections.abc import Mapping
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    PatternPrecision,
+    Severity,
+)
+from bmad_assist.deep_verify.patterns.matcher import MatchContext
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for calls to deprecated functions
+DEPRECATED_FUNC_PATTERN = Pattern(
+    id=PatternId("CC-111-CODE-GO"),
+    domain=ArtifactDomain.TRANSFORM,
+    signals=[],
+    severity=Severity.WARNING,
+    description="Call to a deprecated function",
+    remediation="Switch to the suggested replacement",
+    language="go",
+    precision=PatternPrecision.HIGH,
+    effort=PatternEffort.MEDIUM,
+)
+
+# Curated standard library deprecations: "<import path>.<Func>" -> replacement
+DEFAULT_DEPRECATED_FUNCS: dict[str, str] = {
+    "io/ioutil.ReadAll": "io.ReadAll",
+    "io/ioutil.ReadDir": "os.ReadDir",
+    "io/ioutil.ReadFile": "os.ReadFile",
+    "io/ioutil.WriteFile": "os.WriteFile",
+    "io/ioutil.TempFile": "os.CreateTemp",
+    "io/ioutil.TempDir": "os.MkdirTemp",
+    "io/ioutil.NopCloser": "io.NopCloser",
+    "strings.Title": "cases.Title from golang.org/x/text/cases",
+    "bytes.Title": "cases.Title from golang.org/x/text/cases",
+    "math/rand.Seed": "rand.New(rand.NewSource(seed)); the global source is seeded automatically",
+    "math/rand.Read": "crypto/rand.Read",
+    "reflect.PtrTo": "reflect.PointerTo",
+    "crypto/x509.ParseCRL": "x509.ParseRevocationList",
+    "crypto/x509.ParseDERCRL": "x509.ParseRevocationList",
+    "crypto/elliptic.Marshal": "crypto/ecdh",
+    "crypto/elliptic.Unmarshal": "crypto/ecdh",
+    "crypto/elliptic.GenerateKey": "ecdh.Curve.GenerateKey or ecdsa.GenerateKey",
+    "crypto/dsa.GenerateKey": "crypto/ed25519 or crypto/ecdsa",
+    "net/http/httputil.NewClientConn": "net/http.Client",
+    "net/http/httputil.NewServerConn": "net/http.Server",
+    "runtime.GOROOT": "the GOROOT reported by `go env GOROOT`",
+}
+
+# Single import spec, e.g. `alias "path"`, `. "path"`, `"path"`
+_IMPORT_SPEC_RE = re.compile(r'^\s*(?:([\w.]+)\s+)?"([^"]+)"')
+_IMPORT_BLOCK_RE = re.compile(r"^import\s*\((.*?)^\)", re.MULTILINE | re.DOTALL)
+_IMPORT_LINE_RE = re.compile(r"^import\s+([^(\n][^\n]*)$", re.MULTILINE)
+
+# Major version path elements such as "v2"
+_MAJOR_VERSION_RE = re.compile(r"^v\d+$")
+
+
+def parse_go_imports(text: str) -> dict[str, str]:
+    """Map the local names of a Go file's imports to import paths.
+
+    Dot imports map from "." and blank imports are omitted.
+
+    Args:
+        text: Go source.
+
+    Returns:
+        Local name -> import path.
+
+    """
+    specs: list[str] = []
+    for block in _IMPORT_BLOCK_RE.finditer(text):
+        specs.extend(block.group(1).splitlines())
+    specs.extend(m.group(1) for m in _IMPORT_LINE_RE.finditer(text))
+
+    imports: dict[str, str] = {}
+    for spec in specs:
+        match = _IMPORT_SPEC_RE.match(spec)
+        if match is None:
+            continue
+        alias, path = match.groups()
+        if alias == "_":
+            continue
+        imports[alias or _default_name(path)] = path
+    return imports
+
+
+def _default_name(import_path: str) -> str:
+    """Return the package name Go assumes for an import path."""
+    parts = import_path.split("/")
+    if len(parts) > 1 and _MAJOR_VERSION_RE.match(parts[-1]):
+        return parts[-2]
+    return parts[-1]
+
+
+def find_deprecated_calls(
+    text: str,
+    rel_path: str,
+    config: ScanConfig,
+    deprecated: Mapping[str, str] | None = None,
+) -> list[ScanFinding]:
+    """Report uses of deprecated functions in a Go file.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+        deprecated: Deprecated functions (default: DEFAULT_DEPRECATED_FUNCS).
+
+    Returns:
+        CC-111 findings in line order.
+
+    """
+    if not config.is_enabled(DEPRECATED_FUNC_PATTERN.id):
+        return []
+    deprecated = DEFAULT_DEPRECATED_FUNCS if deprecated is None else deprecated
+
+    # Qualified references (calls or function values) and dot-imported calls
+    targets: dict[str, tuple[str, str]] = {}
+    alternatives: list[str] = []
+    for name, import_path in parse_go_imports(text).items():
+        for key, replacement in deprecated.items():
+            path, _, func = key.rpartition(".")
+            if path != import_path or not func:
+                continue
+            if name == ".":
+                targets[func] = (key, replacement)
+                alternatives.append(re.escape(func) + r"(?=\s*\()")
+            else:
+                targets[f"{name}.{func}"] = (key, replacement)
+                alternatives.append(re.escape(f"{name}.{func}") + r"\b")
+    if not targets:
+        return []
+
+    reference_re = re.compile(r"(?<![\w.])(?:" + "|".join(alternatives) + ")")
+    context = MatchContext.from_text(text)
+    findings: list[ScanFinding] = []
+    for match in reference_re.finditer(text):
+        line = context.get_line_number(match.start())
+        content = context.get_line_content(line)
+        before = content[: match.start() - context.line_offsets[line - 1]]
+        # Skip comments and string literals on the same line
+        if "//" in before or before.count('"') % 2:
+            continue
+        key, replacement = targets[match.group(0)]
+        findings.append(
+            builtin_finding(
+                DEPRECATED_FUNC_PATTERN,
+                f"{key} is deprecated",
+                rel_path,
+                line,
+                content,
+                config,
+                remediation=f"Use {replacement}" if replacement else None,
+            )
+        )
+    return findings
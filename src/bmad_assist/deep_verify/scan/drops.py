@@ -0,0 +1,156 @@
+"""Detection of select sends dropped by an empty default in Go scans.
+
+A send case next to an empty ``default`` never blocks: when the channel is
+full the value is discarded and nothing records it. That is sometimes the
+intent (best-effort notifications), but often a lost event nobody notices::
+
+    select {
+    case events <- ev: // CC-145: ev is lost when events is full
+    default:
+    }
+
+For each select, send cases (``case ch <- v:``) are reported when the
+select has a ``default`` clause without statements; a comment alone does
+not count. A default that logs or counts the drop, and a select without a
+default (a blocking send), are not reported. Intentional best-effort sends
+are suppressed with a reason::
+
+    case events <- ev: // deepverify:ignore CC-145 reason="best-effort notify"
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for select sends whose default branch drops the value silently
+SILENT_DROP_PATTERN = Pattern(
+    id=PatternId("CC-145-CODE-GO"),
+    domain=ArtifactDomain.CONCURRENCY,
+    signals=[],
+    severity=Severity.INFO,
+    description="Select send with an empty default - the value is dropped silently when full",
+    remediation="Make the drop explicit in default: log it or count it in a metric",
+    language="go",
+    effort=PatternEffort.LOW,
+)
+
+_SELECT_RE = re.compile(r"(?<![\w.])select[ \t]*\{")
+
+# Clause keyword of a select, at the select body's top level
+_CLAUSE_RE = re.compile(r"(?<![\w.])(case|default)\b")
+
+# Send case header between `case` and its colon: `ch <- v`, `s.out <- v`
+_SEND_RE = re.compile(r"^[ \t]*([^=:<\s][^=:<]*?)[ \t]*<-[ \t]*\S", re.DOTALL)
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+_OPENING = "([{"
+_CLOSING = ")]}"
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _clauses(code: str, start: int) -> list[tuple[int, str, str]]:
+    """Split the select body opening at start into its clauses.
+
+    Returns:
+        (header position, header, body) per clause, where the header is the
+        text between ``case`` and the clause colon ("" for ``default``).
+
+    """
+    depth = 0
+    marks: list[tuple[int, int]] = []  # keyword position, keyword end
+    position = start
+    while position < len(code):
+        char = code[position]
+        if char in _OPENING:
+            depth += 1
+        elif char in _CLOSING:
+            if depth == 0:
+                break
+            depth -= 1
+        elif depth == 0:
+            keyword = _CLAUSE_RE.match(code, position)
+            if keyword:
+                marks.append((position, keyword.end()))
+                position = keyword.end()
+                continue
+        position += 1
+    end = position
+    clauses: list[tuple[int, str, str]] = []
+    for number, (keyword_start, keyword_end) in enumerate(marks):
+        clause_end = marks[number + 1][0] if number + 1 < len(marks) else end
+        colon = _header_colon(code, keyword_end, clause_end)
+        header = code[keyword_end:colon] if code.startswith("case", keyword_start) else ""
+        clauses.append((keyword_start, header, code[colon + 1 : clause_end]))
+    return clauses
+
+
+def _header_colon(code: str, start: int, end: int) -> int:
+    """Return the position of the colon ending a clause header (skipping ``:=``)."""
+    depth = 0
+    for position in range(start, end):
+        char = code[position]
+        if char in _OPENING:
+            depth += 1
+        elif char in _CLOSING:
+            depth -= 1
+        elif char == ":" and depth == 0 and code[position + 1 : position + 2] != "=":
+            return position
+    return end
+
+
+def find_silent_drops(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report select send cases whose select has an empty default branch.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-145 findings in line order, one per send case.
+
+    """
+    if not config.is_enabled(SILENT_DROP_PATTERN.id):
+        return []
+    code = "\n".join(_code_lines(text))
+    source_lines = text.split("\n")
+    findings: list[ScanFinding] = []
+    for select in _SELECT_RE.finditer(code):
+        clauses = _clauses(code, select.end())
+        if not any(not header and not body.strip() for _, header, body in clauses):
+            continue
+        for position, header, _ in clauses:
+            send = _SEND_RE.match(header)
+            if not send:
+                continue
+            index = code.count("\n", 0, position)
+            channel = " ".join(send.group(1).split())
+            findings.append(
+                builtin_finding(
+                    SILENT_DROP_PATTERN,
+                    f"Send on {channel} dropped silently when full - the select default is empty",
+                    rel_path,
+                    index + 1,
+                    source_lines[index],
+                    config,
+                )
+            )
+    findings.sort(key=lambda f: f.line)
+    return findings
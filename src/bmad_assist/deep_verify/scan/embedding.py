@@ -0,0 +1,221 @@
+"""Detection of mutexes shared through struct embedding in Go scans.
+
+A struct embedding another struct gets its fields promoted, mutexes
+included. When methods of both types lock the promoted mutex, it is no
+longer clear which fields it guards, and an outer method that calls an
+inner one while holding it deadlocks::
+
+    type Conn struct {
+        mu  sync.Mutex
+        buf []byte
+    }
+
+    func (c *Conn) Write(p []byte) { c.mu.Lock(); ... }
+
+    type PooledConn struct {
+        Conn
+        idle bool
+    }
+
+    func (p *PooledConn) Release() {
+        p.mu.Lock() // CC-105: Conn's lock, also taken by Conn.Write
+        ...
+    }
+
+Struct types and methods are read from the same file, with type bodies and
+method bodies ending at the first column-0 closing brace, as gofmt writes
+them. A method of a type embedding another (by value or pointer) is
+reported at its first ``Lock``, ``RLock`` or ``TryLock`` of a
+``sync.Mutex`` or ``sync.RWMutex`` field promoted from the embedded type,
+when a method of the embedded type locks that field too. An outer field of
+the same name shadows the promoted one and is not reported. Sharing a lock
+this way is sometimes deliberate, so findings carry
+``PROMOTED_MUTEX_CONFIDENCE``.
+
+"""
+
+from __future__ import annotations
+
+import re
+from dataclasses import dataclass, field
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for outer methods locking a mutex promoted from an embedded struct
+PROMOTED_MUTEX_PATTERN = Pattern(
+    id=PatternId("CC-105-CODE-GO"),
+    domain=ArtifactDomain.CONCURRENCY,
+    signals=[],
+    severity=Severity.WARNING,
+    description="Outer type locks a mutex promoted from an embedded struct that also uses it",
+    remediation="Give the outer type its own mutex, or go through the embedded type's methods",
+    language="go",
+    effort=PatternEffort.HIGH,
+)
+
+# Confidence of CC-105 findings: embedding a type to share its lock with the
+# outer methods is an idiom of its own, about as common as the accidental case
+PROMOTED_MUTEX_CONFIDENCE = 0.6
+
+_STRUCT_RE = re.compile(r"^type[ \t]+([A-Za-z_]\w*)(?:\[[^\]\n]*\])?[ \t]+struct[ \t]*\{")
+
+# Field declaration: `mu sync.Mutex`, `a, b int`
+_FIELD_RE = re.compile(r"^[ \t]*([A-Za-z_]\w*(?:[ \t]*,[ \t]*[A-Za-z_]\w*)*)[ \t]+(\S.*?)[ \t]*$")
+
+# Embedded field: `Conn`, `*Store`, `pkg.Base`
+_EMBEDDED_RE = re.compile(r"^[ \t]*\*?(?:[A-Za-z_]\w*\.)?([A-Za-z_]\w*)[ \t]*$")
+
+# Method declaration: `func (c *Conn) Write(`
+_METHOD_RE = re.compile(
+    r"^func[ \t]*\([ \t]*([A-Za-z_]\w*)[ \t]+\*?[ \t]*([A-Za-z_]\w*)(?:\[[^\]\n]*\])?"
+    r"[ \t]*\)[ \t]*([A-Za-z_]\w*)"
+)
+
+# Struct tag after a field, blanked to "" by _code_lines
+_TAG_RE = re.compile(r'[ \t]*""[ \t]*$')
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+@dataclass(slots=True)
+class _Struct:
+    """A struct type: its mutex fields, other field names and embedded types."""
+
+    mutexes: set[str] = field(default_factory=set)
+    fields: set[str] = field(default_factory=set)
+    embedded: list[str] = field(default_factory=list)
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _structs(lines: list[str], mutex_re: re.Pattern[str]) -> dict[str, _Struct]:
+    """Collect the struct types of a file by name."""
+    structs: dict[str, _Struct] = {}
+    current: _Struct | None = None
+    depth = 0
+    for line in lines:
+        if current is None:
+            match = _STRUCT_RE.match(line)
+            if match is not None and not line.rstrip().endswith("}"):
+                current = structs.setdefault(match.group(1), _Struct())
+                depth = 0
+            continue
+        if line.startswith("}"):
+            current = None
+            continue
+        code = _TAG_RE.sub("", line)
+        if depth == 0:
+            embedded = _EMBEDDED_RE.match(code)
+            declared = _FIELD_RE.match(code)
+            if embedded is not None:
+                current.embedded.append(embedded.group(1))
+            elif declared is not None:
+                names = {n.strip() for n in declared.group(1).split(",")}
+                current.fields.update(names)
+                if mutex_re.fullmatch(declared.group(2)):
+                    current.mutexes.update(names)
+        depth += code.count("{") - code.count("}")
+    return structs
+
+
+def _method_locks(lines: list[str]) -> list[tuple[str, str, dict[str, int]]]:
+    """Return each method's type, name and first lock line per receiver field."""
+    methods: list[tuple[str, str, dict[str, int]]] = []
+    for start, line in enumerate(lines):
+        method = _METHOD_RE.match(line)
+        if method is None:
+            continue
+        receiver, type_name, name = method.groups()
+        lock_re = re.compile(
+            r"(?<![\w.])" + re.escape(receiver) + r"\.([A-Za-z_]\w*)\.(?:R|Try|TryR)?Lock\("
+        )
+        locks: dict[str, int] = {}
+        for index in range(start, len(lines)):
+            for lock in lock_re.finditer(lines[index]):
+                locks.setdefault(lock.group(1), index)
+            if lines[index].startswith("}") or (
+                index == start and lines[index].rstrip().endswith("}")
+            ):
+                break
+        methods.append((type_name, name, locks))
+    return methods
+
+
+def find_promoted_mutex_locks(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report methods locking a mutex that an embedded struct's methods also lock.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-105 findings in line order, one per outer method and mutex, at
+        PROMOTED_MUTEX_CONFIDENCE.
+
+    """
+    if not config.is_enabled(PROMOTED_MUTEX_PATTERN.id):
+        return []
+    aliases = [name for name, path in parse_go_imports(text).items() if path == "sync"]
+    if not aliases:
+        return []
+    mutex_re = re.compile(
+        "|".join(
+            r"(?:RW)?Mutex" if a == "." else re.escape(a) + r"\.(?:RW)?Mutex" for a in aliases
+        )
+    )
+    lines = _code_lines(text)
+    structs = _structs(lines, mutex_re)
+    methods = _method_locks(lines)
+    locked_by: dict[str, set[str]] = {}  # type -> mutex fields its methods lock
+    for type_name, _, locks in methods:
+        locked_by.setdefault(type_name, set()).update(locks)
+
+    source_lines = text.split("\n")
+    findings: list[ScanFinding] = []
+    for type_name, name, locks in methods:
+        outer = structs.get(type_name)
+        if outer is None:
+            continue
+        for mutex, index in locks.items():
+            if mutex in outer.fields:
+                continue  # the outer type's own field
+            inner = next(
+                (
+                    e
+                    for e in outer.embedded
+                    if e in structs
+                    and mutex in structs[e].mutexes
+                    and mutex in locked_by.get(e, ())
+                ),
+                None,
+            )
+            if inner is None:
+                continue
+            findings.append(
+                builtin_finding(
+                    PROMOTED_MUTEX_PATTERN,
+                    f"{type_name}.{name} locks {mutex} promoted from {inner}, "
+                    f"which {inner} methods also lock",
+                    rel_path,
+                    index + 1,
+                    source_lines[index],
+                    config,
+                    PROMOTED_MUTEX_CONFIDENCE,
+                )
+            )
+    findings.sort(key=lambda f: f.line)
+    return findings
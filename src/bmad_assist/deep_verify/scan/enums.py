@@ -0,0 +1,233 @@
+"""Non-exhaustive enum switch detection for Go scans.
+
+Flags a ``switch`` over a typed enum that leaves out some of the enum's
+constants and has no ``default`` case. Such a switch silently ignores
+members added to the enum later.
+
+Enums are typed constants declared in the same file, usually an ``iota``
+block::
+
+    type State int
+
+    const (
+        StateOpen State = iota
+        StateClosed
+        StateArchived
+    )
+
+Constants inherit the type of the previous line when they repeat its
+expression implicitly (``StateClosed``), as in Go. A switch is checked when
+every case value is a member of one enum; switches that mix in other
+expressions are left alone. Enums declared in other files of the package
+are not seen.
+
+Example:
+    >>> from bmad_assist.deep_verify.scan import parse_go_enums
+    >>> parse_go_enums(source)
+    {'State': ('StateOpen', 'StateClosed', 'StateArchived')}
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    PatternPrecision,
+    Severity,
+)
+from bmad_assist.deep_verify.patterns.matcher import MatchContext
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for enum switches that miss members and have no default
+ENUM_SWITCH_PATTERN = Pattern(
+    id=PatternId("CC-123-CODE-GO"),
+    domain=ArtifactDomain.TRANSFORM,
+    signals=[],
+    severity=Severity.WARNING,
+    description="Switch over an enum misses members and has no default - new members are ignored",
+    remediation="Add cases for the missing members, or a default case that handles or rejects them",
+    language="go",
+    precision=PatternPrecision.HIGH,
+    effort=PatternEffort.LOW,
+)
+
+# Top-level integer or string type declaration: `type State int`
+_ENUM_TYPE_RE = re.compile(
+    r"^type[ \t]+([A-Za-z_]\w*)[ \t]+"
+    r"(?:u?int(?:8|16|32|64)?|uintptr|byte|rune|string)[ \t]*(?://.*)?$",
+    re.MULTILINE,
+)
+
+# Top-level `const (` block, up to its column-0 closing parenthesis
+_CONST_BLOCK_RE = re.compile(r"^const[ \t]*\(\n(.*?)^\)", re.MULTILINE | re.DOTALL)
+
+# Constant spec inside a block: `Name [Type] [= expr]`
+_CONST_SPEC_RE = re.compile(r"^\s*([A-Za-z_]\w*)(?:\s+([A-Za-z_][\w.]*))?\s*(=.*)?$")
+
+# Expression switch: `switch x {`, `switch s := f(); s {` (not type or tagless switches)
+_SWITCH_RE = re.compile(r"(?<![\w.])switch\b([^{\n]*)\{")
+
+# Case clause at the start of a line within a switch body
+_CASE_RE = re.compile(r"^[ \t]*(case\b|default[ \t]*:)", re.MULTILINE)
+
+# String, rune and raw string literals plus comments, skipped when matching braces
+_SKIP_RE = re.compile(
+    r'"(?:\\.|[^"\\\n])*"|`[^`]*`|\'(?:\\.|[^\'\\\n])+\'|//[^\n]*|/\*.*?\*/', re.DOTALL
+)
+
+
+def _strip_comment(line: str) -> str:
+    """Remove a trailing line comment."""
+    return line.split("//", 1)[0].rstrip()
+
+
+def parse_go_enums(text: str) -> dict[str, tuple[str, ...]]:
+    """Collect typed constants of a Go file, grouped by type.
+
+    Args:
+        text: Go source.
+
+    Returns:
+        Constant names per integer or string type declared in the file, in
+        declaration order. Types with fewer than two constants are omitted.
+
+    """
+    types = set(_ENUM_TYPE_RE.findall(text))
+    members: dict[str, list[str]] = {}
+    for block in _CONST_BLOCK_RE.finditer(text):
+        current: str | None = None
+        for raw in block.group(1).split("\n"):
+            line = _strip_comment(raw)
+            spec = _CONST_SPEC_RE.match(line)
+            if spec is None:
+                if line.strip():
+                    current = None
+                continue
+            name, type_name, value = spec.groups()
+            if value is not None:
+                # A new expression starts a new run: typed, or untyped without a type
+                current = type_name
+            elif type_name is not None:
+                # `Name Type` without a value is not valid in a const block
+                current = None
+                continue
+            if current in types and name != "_":
+                members.setdefault(current, []).append(name)
+    return {t: tuple(names) for t, names in members.items() if len(names) >= 2}
+
+
+def _body_end(text: str, open_brace: int) -> int:
+    """Return the index of the brace closing the block at open_brace (len(text) if unbalanced)."""
+    depth = 0
+    i = open_brace
+    while i < len(text):
+        skipped = _SKIP_RE.match(text, i)
+        if skipped is not None:
+            i = skipped.end()
+            continue
+        if text[i] == "{":
+            depth += 1
+        elif text[i] == "}":
+            depth -= 1
+            if depth == 0:
+                return i
+        i += 1
+    return len(text)
+
+
+def _brace_delta(text: str, start: int, end: int) -> int:
+    """Return the change in brace depth from start to end."""
+    depth = 0
+    i = start
+    while i < end:
+        skipped = _SKIP_RE.match(text, i)
+        if skipped is not None:
+            i = skipped.end()
+            continue
+        if text[i] == "{":
+            depth += 1
+        elif text[i] == "}":
+            depth -= 1
+        i += 1
+    return depth
+
+
+def _case_values(text: str, start: int) -> list[str]:
+    """Return the comma-separated values of the case clause starting at start."""
+    colon = text.find(":", start)
+    if colon == -1:
+        return []
+    return [v.strip() for v in text[start:colon].split(",")]
+
+
+def find_enum_switches(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report switches over file-local enums that miss members without a default.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-123 findings in line order, one per switch.
+
+    """
+    if not config.is_enabled(ENUM_SWITCH_PATTERN.id):
+        return []
+    enums = parse_go_enums(text)
+    if not enums:
+        return []
+    owner = {name: enum for enum, names in enums.items() for name in names}
+
+    context = MatchContext.from_text(text)
+    findings: list[ScanFinding] = []
+    for switch in _SWITCH_RE.finditer(text):
+        header = switch.group(1)
+        if not header.strip() or ".(type)" in header:
+            continue
+        line = context.get_line_number(switch.start())
+        content = context.get_line_content(line)
+        before = content[: switch.start() - context.line_offsets[line - 1]]
+        # Skip comments and string literals on the same line
+        if "//" in before or before.count('"') % 2:
+            continue
+
+        body_start = switch.end() - 1
+        body_end = _body_end(text, body_start)
+        values: list[str] = []
+        has_default = False
+        depth, pos = 0, body_start
+        for clause in _CASE_RE.finditer(text, body_start + 1, body_end):
+            depth += _brace_delta(text, pos, clause.start())
+            pos = clause.start()
+            if depth != 1:
+                continue
+            if clause.group(1) != "case":
+                has_default = True
+                break
+            values.extend(_case_values(text, clause.end()))
+        if has_default or not values:
+            continue
+
+        enum = owner.get(values[0])
+        if enum is None or any(owner.get(v) != enum for v in values):
+            continue
+        missing = [name for name in enums[enum] if name not in values]
+        if missing:
+            findings.append(
+                builtin_finding(
+                    ENUM_SWITCH_PATTERN,
+                    f"Switch over {enum} has no default and misses {', '.join(missing)}",
+                    rel_path,
+                    line,
+                    content,
+                    config,
+                )
+            )
+    return findings
@@ -0,0 +1,232 @@
+"""Detection of secrets read with os.Getenv and used unchecked in Go scans.
+
+``os.Getenv`` returns "" for an unset variable, so a missing secret does not
+fail at startup: the client authenticates with an empty key, or a
+comparison against the secret accepts an empty token::
+
+    client := api.New(os.Getenv("API_KEY"))  // CC-146: "" when API_KEY is unset
+
+    if r.Header.Get("X-Token") == os.Getenv("WEBHOOK_TOKEN") { // CC-146
+        ...
+    }
+
+Calls are reported when the variable name, split at underscores, contains
+a secret or config word (``KEY``, ``TOKEN``, ``SECRET``, ``PASSWORD``,
+``DSN``, ``URL`` and similar) and the result is used without an empty
+check. A result compared with "" (or passed to ``len``) is checked; one
+assigned to a variable is checked when the function compares the variable
+with "" afterwards, and one assigned to a field or composite literal field
+when the file compares that field with "" anywhere. ``os.LookupEnv`` is
+never reported, and neither are returned results, which callers may check.
+Checks made outside the file are not seen, so findings carry
+``UNCHECKED_ENV_CONFIDENCE``.
+
+Projects add their own secret words with detector options::
+
+    detectors:
+      CC-146:
+        secret_words: [signing, webhook]
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from pydantic import Field, field_validator
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import (
+    DetectorOptions,
+    ScanConfig,
+    register_detector_options,
+)
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for secret environment variables used without a presence check
+UNCHECKED_ENV_PATTERN = Pattern(
+    id=PatternId("CC-146-CODE-GO"),
+    domain=ArtifactDomain.SECURITY,
+    signals=[],
+    severity=Severity.INFO,
+    description="Secret read with os.Getenv and used unchecked - an unset variable is \"\"",
+    remediation="Use os.LookupEnv and fail when the variable is missing, or reject \"\"",
+    language="go",
+    effort=PatternEffort.LOW,
+)
+
+# Confidence of CC-146 findings: secrets are recognized by variable name and
+# checks by a comparison with "", so validation in a separate loader is missed
+UNCHECKED_ENV_CONFIDENCE = 0.7
+
+# Words of variable names holding secrets or required config
+_SECRET_WORDS = frozenset(
+    {
+        "APIKEY",
+        "AUTH",
+        "CREDENTIALS",
+        "CREDS",
+        "DSN",
+        "KEY",
+        "PASS",
+        "PASSWD",
+        "PASSWORD",
+        "PRIVATE",
+        "PWD",
+        "SECRET",
+        "TOKEN",
+        "URL",
+    }
+)
+
+
+class UncheckedEnvOptions(DetectorOptions):
+    """CC-146 options.
+
+    Attributes:
+        secret_words: Extra variable name words treated as secrets, in any
+            case, added to the built-in words.
+
+    """
+
+    secret_words: list[str] = Field(
+        default_factory=list,
+        description="Extra variable name words treated as secrets (CC-146)",
+    )
+
+    @field_validator("secret_words", mode="after")
+    @classmethod
+    def validate_secret_words(cls, v: list[str]) -> list[str]:
+        """Validate that each word is a single underscore-free name part."""
+        invalid = [w for w in v if not w.isalnum()]
+        if invalid:
+            raise ValueError(
+                f"Invalid secret word(s): {', '.join(map(repr, invalid))}. "
+                "Names are split at underscores, so words are letters and digits only"
+            )
+        return v
+
+
+register_detector_options(UNCHECKED_ENV_PATTERN.id, UncheckedEnvOptions)
+
+# Assignment of a call result: `key := ...`, `var key = ...`, `s.key = ...`
+_ASSIGN_RE = re.compile(
+    r"^[ \t]*(?:if[ \t]+)?(?:var[ \t]+)?([A-Za-z_][\w.]*)(?:[ \t]+[\w.]+)?[ \t]*:?=(?!=)"
+)
+
+# Composite literal field before a call: `Token: `, `Config{Token: `
+_FIELD_RE = re.compile(r"(?<![\w.])([A-Za-z_]\w*):[ \t]*$")
+
+_FUNC_RE = re.compile(r"^func\b")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _is_secret_name(name: str, secret_words: frozenset[str]) -> bool:
+    """Return whether an environment variable name looks like a secret or config."""
+    return any(word in secret_words for word in name.upper().split("_"))
+
+
+def _empty_check_re(name: str, field: bool) -> re.Pattern[str]:
+    """Compile a matcher for comparisons of a variable or field with ""."""
+    ref = (r"[\w.]*\." if field else r"(?<![\w.])") + re.escape(name) + r"\b"
+    return re.compile(
+        rf'{ref}[ \t]*\)?[ \t]*[!=]=[ \t]*""|""[ \t]*[!=]=[ \t]*{ref}|len\([ \t]*{ref}[ \t]*\)'
+    )
+
+
+def _function_end(code_lines: list[str], index: int) -> int:
+    """Return the index after the last line of the function containing index."""
+    for end in range(index + 1, len(code_lines)):
+        if code_lines[end].startswith("}") or _FUNC_RE.match(code_lines[end]):
+            return end + 1
+    return len(code_lines)
+
+
+def find_unchecked_env_reads(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report secret-like environment variables read with os.Getenv and not checked.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-146 findings in line order, one per call, at
+        UNCHECKED_ENV_CONFIDENCE.
+
+    """
+    if not config.is_enabled(UNCHECKED_ENV_PATTERN.id):
+        return []
+    aliases = [a for a, path in parse_go_imports(text).items() if path == "os"]
+    aliases = [a for a in aliases if a not in (".", "_")]
+    if not aliases:
+        return []
+    qualified = "(?<![\\w.])(?:" + "|".join(map(re.escape, aliases)) + r")\.Getenv\("
+    code_call_re = re.compile(qualified + r'[ \t]*""[ \t]*\)')
+    name_re = re.compile(qualified + r'[ \t]*"(\w+)"[ \t]*\)')
+    options = config.detector_options(UNCHECKED_ENV_PATTERN.id, UncheckedEnvOptions)
+    secret_words = _SECRET_WORDS | {w.upper() for w in options.secret_words}
+    source_lines = text.split("\n")
+    code_lines = _code_lines(text)
+    code = "\n".join(code_lines)
+    findings: list[ScanFinding] = []
+    for index, line in enumerate(code_lines):
+        calls = list(code_call_re.finditer(line))
+        if not calls:
+            continue
+        names = name_re.findall(source_lines[index])
+        for call, name in zip(calls, names, strict=False):
+            if not _is_secret_name(name, secret_words):
+                continue
+            if _is_checked(line, call, code_lines, index, code):
+                continue
+            findings.append(
+                builtin_finding(
+                    UNCHECKED_ENV_PATTERN,
+                    f"{name} read with os.Getenv is used without checking that it is set",
+                    rel_path,
+                    index + 1,
+                    source_lines[index],
+                    config,
+                    UNCHECKED_ENV_CONFIDENCE,
+                )
+            )
+    return findings
+
+
+def _is_checked(
+    line: str, call: re.Match[str], code_lines: list[str], index: int, code: str
+) -> bool:
+    """Return whether a Getenv result is checked for "" or left to a caller."""
+    before, after = line[: call.start()], line[call.end() :]
+    if re.match(r'[ \t]*[!=]=[ \t]*""', after) or re.search(r'""[ \t]*[!=]=[ \t]*$', before):
+        return True
+    if re.search(r"(?<![\w.])len\([ \t]*$", before):
+        return True
+    if re.fullmatch(r"[ \t]*return[ \t]+", before):
+        return True
+    assign = _ASSIGN_RE.match(before)
+    if assign:
+        target = assign.group(1)
+        if "." in target:
+            return bool(_empty_check_re(target.rsplit(".", 1)[1], field=True).search(code))
+        scope = "\n".join(code_lines[index : _function_end(code_lines, index)])
+        return bool(_empty_check_re(target, field=False).search(scope))
+    field = _FIELD_RE.search(before)
+    if field:
+        return bool(_empty_check_re(field.group(1), field=True).search(code))
+    return False
@@ -0,0 +1,139 @@
+"""Detection of errgroup goroutines that ignore the group's context in Go scans.
+
+``errgroup.WithContext`` returns a group and a context that is cancelled as
+soon as one of the group's goroutines fails. Goroutines that keep using the
+parent context run to completion after a sibling has already failed, so the
+group loses its early cancellation::
+
+    g, gctx := errgroup.WithContext(ctx)
+    for _, url := range urls {
+        g.Go(func() error {
+            return fetch(ctx, url) // CC-151: use gctx
+        })
+    }
+    return g.Wait()
+
+Groups are the results of ``WithContext`` from
+``golang.org/x/sync/errgroup`` (resolved through the file's imports). A
+``g.Go`` function literal of such a group is reported when it references
+the parent context or creates a fresh one with ``context.Background()`` or
+``context.TODO()`` and does not reference the group's context. Literals
+that use no context at all, and groups whose context shadows the parent
+(``g, ctx := errgroup.WithContext(ctx)``), only report fresh contexts.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for errgroup goroutines that do not use the group's context
+GROUP_CONTEXT_PATTERN = Pattern(
+    id=PatternId("CC-151-CODE-GO"),
+    domain=ArtifactDomain.CONCURRENCY,
+    signals=[],
+    severity=Severity.WARNING,
+    description="errgroup goroutine ignores the group's context - a failure does not cancel it",
+    remediation="Use the context returned by errgroup.WithContext inside g.Go functions",
+    language="go",
+    effort=PatternEffort.LOW,
+)
+
+_ERRGROUP_PATH = "golang.org/x/sync/errgroup"
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _block_end(code: str, open_brace: int) -> int:
+    """Return the position of the brace closing the block opened at open_brace."""
+    depth = 0
+    for position in range(open_brace, len(code)):
+        if code[position] == "{":
+            depth += 1
+        elif code[position] == "}":
+            depth -= 1
+            if depth == 0:
+                return position
+    return len(code)
+
+
+def find_group_context_leaks(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report g.Go function literals that ignore their errgroup's context.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-151 findings in line order, one per function literal, at its
+        first reference to another context.
+
+    """
+    if not config.is_enabled(GROUP_CONTEXT_PATTERN.id):
+        return []
+    imports = parse_go_imports(text)
+    groups = [a for a, path in imports.items() if path == _ERRGROUP_PATH and a != "."]
+    if not groups:
+        return []
+    contexts = [a for a, path in imports.items() if path == "context" and a != "."]
+    fresh = "|".join(rf"{re.escape(a)}\.(?:Background|TODO)\(\)" for a in contexts)
+    with_context_re = re.compile(
+        r"(?<![\w.])([A-Za-z_]\w*)[ \t]*,[ \t]*([A-Za-z_]\w*)[ \t]*:?=[ \t]*(?:"
+        + "|".join(map(re.escape, groups))
+        + r")\.WithContext\([ \t]*([A-Za-z_]\w*)[ \t]*\)"
+    )
+
+    code = "\n".join(_code_lines(text))
+    source_lines = text.split("\n")
+    findings: list[ScanFinding] = []
+    for group in with_context_re.finditer(code):
+        name, group_ctx, parent = group.groups()
+        end = code.find("\n}", group.end())
+        end = len(code) if end < 0 else end
+        ignored = [] if parent == group_ctx else [rf"(?<![\w.]){re.escape(parent)}\b"]
+        if fresh:
+            ignored.append(fresh)
+        if not ignored:
+            continue
+        ignored_re = re.compile("|".join(ignored))
+        uses_group_re = re.compile(rf"(?<![\w.]){re.escape(group_ctx)}\b")
+        go_re = re.compile(
+            rf"(?<![\w.]){re.escape(name)}\.Go\([ \t]*func[ \t]*\([ \t]*\)[ \t]*error[ \t]*\{{"
+        )
+        for call in go_re.finditer(code, group.end(), end):
+            body = code[call.end() : _block_end(code, call.end() - 1)]
+            use = ignored_re.search(body)
+            if use is None or (group_ctx != "_" and uses_group_re.search(body)):
+                continue
+            index = code.count("\n", 0, call.end() + use.start())
+            expected = "the discarded group context" if group_ctx == "_" else group_ctx
+            findings.append(
+                builtin_finding(
+                    GROUP_CONTEXT_PATTERN,
+                    f"{name}.Go function uses {use.group(0)} instead of {expected}",
+                    rel_path,
+                    index + 1,
+                    source_lines[index],
+                    config,
+                )
+            )
+    findings.sort(key=lambda f: f.line)
+    return findings
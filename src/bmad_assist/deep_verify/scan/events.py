@@ -0,0 +1,63 @@
+"""Structured analysis events for Deep Verify scans.
+
+A scanner with ``ScanOptions.event_sink`` reports what happens to each file
+as typed events, for dashboards or for debugging why a finding did or did
+not appear::
+
+    events: list[AnalysisEvent] = []
+    Scanner(ScanOptions(event_sink=events.append)).scan(Path("."))
+    for event in events:
+        print(event.timestamp, event.kind.value, event.path, event.pattern_id or "")
+
+Per file a tree scan emits one of:
+
+- ``file_skipped``: not analyzed, with the reason (too large, no patterns
+  for its language, unreadable or generated);
+- ``cache_hit``: the cached result was reused;
+- ``file_started``, then one ``detector_fired`` per reported finding, then
+  ``file_finished`` with the finding count.
+
+Timestamps come from ``ScanOptions.clock``. With concurrent workers the
+files' events interleave; calls to the sink are serialized.
+
+"""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+from datetime import datetime
+from enum import Enum
+
+
+class AnalysisEventKind(str, Enum):
+    """Kinds of analysis events."""
+
+    FILE_STARTED = "file_started"
+    FILE_FINISHED = "file_finished"
+    DETECTOR_FIRED = "detector_fired"
+    FILE_SKIPPED = "file_skipped"
+    CACHE_HIT = "cache_hit"
+
+
+@dataclass(frozen=True, slots=True)
+class AnalysisEvent:
+    """One event of a scan's analysis.
+
+    Attributes:
+        kind: What happened.
+        timestamp: When it happened, per ScanOptions.clock.
+        path: File path relative to the scan root (POSIX separators).
+        pattern_id: Pattern of the finding, for detector_fired.
+        line: Line of the finding, for detector_fired.
+        findings: Findings of the file, for file_finished and cache_hit.
+        reason: Why the file was not analyzed, for file_skipped.
+
+    """
+
+    kind: AnalysisEventKind
+    timestamp: datetime
+    path: str
+    pattern_id: str | None = None
+    line: int | None = None
+    findings: int | None = None
+    reason: str | None = None
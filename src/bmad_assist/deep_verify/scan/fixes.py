@@ -0,0 +1,156 @@
+"""Automatic fixes for Deep Verify scan findings.
+
+Patterns may declare a ``fix``: a regex rewrite that resolves their findings
+mechanically (see ``PatternFix``). ``Scanner.fix`` applies the fixes of every
+unsuppressed finding in memory, re-analyzes each changed file and reports
+which findings the fixes resolved and which new ones they introduced, so
+callers can refuse to write fixes that make things worse.
+
+Example:
+    >>> from pathlib import Path
+    >>> from bmad_assist.deep_verify.scan import Scanner, write_fixes
+    >>> fixes = Scanner().fix(Path("."))
+    >>> if not any(f.introduced for f in fixes):
+    ...     write_fixes(fixes, Path("."))
+
+"""
+
+from __future__ import annotations
+
+import difflib
+import re
+from collections import Counter
+from collections.abc import Callable, Iterable
+from dataclasses import dataclass
+from pathlib import Path
+
+from bmad_assist.deep_verify.core.types import PatternFix
+from bmad_assist.deep_verify.patterns.matcher import MatchContext
+from bmad_assist.deep_verify.scan.types import ScanFinding, finding_fingerprint
+
+
+@dataclass(frozen=True, slots=True)
+class FileFix:
+    """Fixes applied to one file and their effect on its findings.
+
+    Attributes:
+        path: File path relative to the scan root.
+        original: File contents before the fixes.
+        fixed: File contents with the fixes applied.
+        applied: Findings whose fix was applied.
+        resolved: Findings no longer reported after the fixes.
+        introduced: Findings reported only after the fixes.
+        newline: Line ending of the file on disk, restored when written.
+
+    """
+
+    path: str
+    original: str
+    fixed: str
+    applied: tuple[ScanFinding, ...]
+    resolved: tuple[ScanFinding, ...]
+    introduced: tuple[ScanFinding, ...]
+    newline: str = "\n"
+
+    def __repr__(self) -> str:
+        """Return a string representation of the file fix."""
+        return (
+            f"FileFix(path={self.path!r}, applied={len(self.applied)}, "
+            f"resolved={len(self.resolved)}, introduced={len(self.introduced)})"
+        )
+
+    def diff(self) -> str:
+        """Return the fixes as a unified diff."""
+        return "".join(
+            difflib.unified_diff(
+                self.original.splitlines(keepends=True),
+                self.fixed.splitlines(keepends=True),
+                fromfile=f"a/{self.path}",
+                tofile=f"b/{self.path}",
+            )
+        )
+
+
+def apply_fixes(
+    text: str,
+    findings: Iterable[ScanFinding],
+    fix_for: Callable[[str], PatternFix | None],
+) -> tuple[str, list[ScanFinding]]:
+    """Apply the fixes of a file's findings to its text.
+
+    Each fix's ``find`` regex must match at the start of its finding's line;
+    findings whose fix does not match, or overlaps a fix already applied,
+    are left alone. Suppressed findings are never fixed.
+
+    Args:
+        text: File contents.
+        findings: Findings in the file.
+        fix_for: Returns the fix of a pattern ID (None if it has none).
+
+    Returns:
+        Fixed text and the findings whose fixes were applied, in line order.
+
+    """
+    context = MatchContext.from_text(text)
+    edits: list[tuple[int, int, str, ScanFinding]] = []
+    for finding in sorted(findings, key=lambda f: (f.line, f.pattern_id)):
+        fix = fix_for(finding.pattern_id)
+        if fix is None or finding.suppressed or not 1 <= finding.line <= len(context.lines):
+            continue
+        start = context.line_offsets[finding.line - 1]
+        match = re.compile(fix.find, re.MULTILINE).match(text, start)
+        if match is None or (edits and match.start() < edits[-1][1]):
+            continue
+        edits.append((match.start(), match.end(), match.expand(fix.replace), finding))
+
+    for start, end, replacement, _ in reversed(edits):
+        text = text[:start] + replacement + text[end:]
+    return text, [finding for *_, finding in edits]
+
+
+def compare_findings(
+    before: Iterable[ScanFinding], after: Iterable[ScanFinding]
+) -> tuple[list[ScanFinding], list[ScanFinding]]:
+    """Compare a file's findings before and after a change by fingerprint.
+
+    Returns:
+        Findings only in before (resolved) and only in after (introduced).
+
+    """
+
+    def only_in(items: list[ScanFinding], others: list[ScanFinding]) -> list[ScanFinding]:
+        remaining = Counter(finding_fingerprint(f) for f in others)
+        result: list[ScanFinding] = []
+        for finding in items:
+            key = finding_fingerprint(finding)
+            if remaining[key]:
+                remaining[key] -= 1
+            else:
+                result.append(finding)
+        return result
+
+    before_list, after_list = list(before), list(after)
+    return only_in(before_list, after_list), only_in(after_list, before_list)
+
+
+def write_fixes(fixes: Iterable[FileFix], root: Path) -> list[Path]:
+    """Write fixed files back under the scan root.
+
+    Args:
+        fixes: File fixes from Scanner.fix.
+        root: Scan root the fix paths are relative to (its directory for a
+            single-file scan).
+
+    Returns:
+        Files written.
+
+    Raises:
+        OSError: If a file cannot be written.
+
+    """
+    written: list[Path] = []
+    for fix in fixes:
+        path = root / fix.path
+        path.write_text(fix.fixed, encoding="utf-8", newline=fix.newline)
+        written.append(path)
+    return written
@@ -0,0 +1,208 @@
+"""Detection of random generators shared across goroutines in Go.
+
+A ``*rand.Rand`` is not safe for concurrent use, unlike the top-level
+``rand`` functions, which lock a shared source internally. Goroutines that
+draw from the same generator race on its state::
+
+    rng := rand.New(rand.NewSource(seed))
+    for _, job := range jobs {
+        go func() {
+            job.jitter = rng.Intn(100) // CC-143: rng shared by every goroutine
+        }()
+    }
+
+Generators are names declared in the file with type ``*rand.Rand``
+(variables, fields and parameters) or assigned from ``rand.New``, for
+``math/rand`` and ``math/rand/v2`` resolved through the file's import
+aliases. A method call on one (``rng.Intn``, ``s.rng.Float64``) inside a
+``go func`` literal is reported when the literal does not declare the
+generator itself and the generator is shared: the ``go`` statement runs in
+a loop, or the generator is also called outside the literal. Literals that
+take a lock (``.Lock()``) are treated as synchronized.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for *rand.Rand values called from several goroutines
+SHARED_RAND_PATTERN = Pattern(
+    id=PatternId("CC-143-CODE-GO"),
+    domain=ArtifactDomain.CONCURRENCY,
+    signals=[],
+    severity=Severity.WARNING,
+    description="*rand.Rand shared across goroutines without a lock - its state races",
+    remediation="Give each goroutine its own rand.New, guard the Rand with a mutex, "
+    "or use the top-level rand functions",
+    language="go",
+    effort=PatternEffort.MEDIUM,
+)
+
+# Import paths whose Rand type is not safe for concurrent use
+_RAND_PACKAGES = ("math/rand", "math/rand/v2")
+
+# go statement starting a function literal
+_GO_FUNC_RE = re.compile(r"(?<![\w.])go[ \t]+func\b")
+
+# Method call on a generator, optionally through a field: `rng.Intn(`, `s.rng.Intn(`
+_CALL_RE = re.compile(r"(?<![\w.])(?:([A-Za-z_]\w*)\.)?([A-Za-z_]\w*)\.[A-Z]\w*[ \t]*\(")
+
+# Package-level variable declaration
+_PACKAGE_VAR_RE = re.compile(r"^var[ \t]+([A-Za-z_]\w*)", re.MULTILINE)
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _body_span(code: str, start: int) -> tuple[int, int] | None:
+    """Return the span of the braced body opening on the line at start, if any."""
+    line_end = code.find("\n", start)
+    open_brace = code.find("{", start, len(code) if line_end < 0 else line_end)
+    if open_brace < 0:
+        return None
+    depth = 0
+    for position in range(open_brace, len(code)):
+        if code[position] == "{":
+            depth += 1
+        elif code[position] == "}":
+            depth -= 1
+            if depth == 0:
+                return open_brace + 1, position
+    return open_brace + 1, len(code)
+
+
+def _in_loop(code: str, position: int) -> bool:
+    """Return whether position is inside a for block of its function."""
+    depth = 0
+    for index in range(position - 1, -1, -1):
+        char = code[index]
+        if char == "}":
+            depth += 1
+        elif char == "{":
+            if depth > 0:
+                depth -= 1
+                continue
+            line_start = code.rfind("\n", 0, index) + 1
+            header = code[line_start:index].strip()
+            if header.startswith("for"):
+                return True
+            if code.startswith("func", line_start):
+                return False
+    return False
+
+
+def _func_span(code: str, position: int) -> tuple[int, int]:
+    """Return the span of the top-level declaration containing position."""
+    start = code.rfind("\nfunc", 0, position) + 1
+    end = code.find("\n}", position)
+    return start, len(code) if end < 0 else end
+
+
+def _generators(code: str, aliases: list[str]) -> set[str]:
+    """Return the names declared in the file as *rand.Rand generators."""
+    qualified = "|".join(r"(?<![\w.])" if a == "." else re.escape(a) + r"\." for a in aliases)
+    typed_re = re.compile(
+        rf"([A-Za-z_]\w*(?:[ \t]*,[ \t]*[A-Za-z_]\w*)*)[ \t]+\*(?:{qualified})Rand\b"
+    )
+    assigned_re = re.compile(
+        rf"(?:[A-Za-z_]\w*\.)?([A-Za-z_]\w*)[ \t]*:?=[ \t]*(?:{qualified})New\("
+    )
+    names = {name.strip() for group in typed_re.findall(code) for name in group.split(",")}
+    names.update(assigned_re.findall(code))
+    return names
+
+
+def find_shared_rands(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report *rand.Rand generators called from goroutines that share them.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-143 findings in line order, one per generator and goroutine.
+
+    """
+    if not config.is_enabled(SHARED_RAND_PATTERN.id):
+        return []
+    aliases = [a for a, path in parse_go_imports(text).items() if path in _RAND_PACKAGES]
+    if not aliases:
+        return []
+    code = "\n".join(_code_lines(text))
+    generators = _generators(code, aliases) - set(aliases)
+    if not generators:
+        return []
+    calls = [
+        (call.start(), call.group(1), call.group(2))
+        for call in _CALL_RE.finditer(code)
+        if call.group(2) in generators
+    ]
+    package_vars = set(_PACKAGE_VAR_RE.findall(code))
+    source_lines = text.split("\n")
+    findings: list[ScanFinding] = []
+    for go in _GO_FUNC_RE.finditer(code):
+        span = _body_span(code, go.end())
+        if span is None:
+            continue
+        start, end = span
+        body = code[start:end]
+        if ".Lock()" in body:
+            continue
+        in_loop = _in_loop(code, go.start())
+        func_start, func_end = _func_span(code, go.start())
+        reported: set[str] = set()
+        for position, owner, name in calls:
+            if not start <= position < end or name in reported:
+                continue
+            if owner is None and re.search(
+                rf"(?<![\w.]){re.escape(name)}[ \t]*:=|\bvar[ \t]+{re.escape(name)}\b", body
+            ):
+                continue  # the goroutine's own generator
+            # Locals are shared within their function; fields and package
+            # variables across the file
+            shared_start, shared_end = (
+                (func_start, func_end)
+                if owner is None and name not in package_vars
+                else (0, len(code))
+            )
+            outside = any(
+                other == name
+                and shared_start <= other_position < shared_end
+                and not start <= other_position < end
+                for other_position, _, other in calls
+            )
+            if not (in_loop or outside):
+                continue
+            reported.add(name)
+            index = code.count("\n", 0, position)
+            shown = f"{owner}.{name}" if owner else name
+            findings.append(
+                builtin_finding(
+                    SHARED_RAND_PATTERN,
+                    f"*rand.Rand {shown} used from several goroutines without a lock",
+                    rel_path,
+                    index + 1,
+                    source_lines[index],
+                    config,
+                )
+            )
+    findings.sort(key=lambda f: f.line)
+    return findings
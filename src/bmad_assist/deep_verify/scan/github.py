@@ -0,0 +1,85 @@
+"""GitHub Actions annotation export for Deep Verify scans.
+
+GitHub Actions turns workflow commands printed by a step into inline
+annotations on the pull request diff, without the code scanning (SARIF)
+upload pipeline::
+
+    ::error file=pkg/worker.go,line=42,title=CC-001-CODE-GO::Goroutine without join
+
+Command values are escaped as in the Actions toolkit: ``%``, carriage
+returns and newlines in the message, and additionally ``:`` and ``,`` in
+properties. See
+https://docs.github.com/en/actions/reference/workflow-commands-for-github-actions
+
+Example:
+    >>> from pathlib import Path
+    >>> import sys
+    >>> from bmad_assist.deep_verify.scan import Scanner, write_github_annotations
+    >>> report = Scanner().scan(Path("."))
+    >>> write_github_annotations(report, sys.stdout)
+
+"""
+
+from __future__ import annotations
+
+from typing import TextIO
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan.types import ScanFinding, ScanReport
+
+# Deep Verify severity -> GitHub workflow command (error, warning, notice)
+GITHUB_COMMAND: dict[Severity, str] = {
+    Severity.CRITICAL: "error",
+    Severity.ERROR: "error",
+    Severity.WARNING: "warning",
+    Severity.INFO: "notice",
+}
+
+
+def _escape_data(value: str) -> str:
+    """Escape a workflow command message."""
+    return value.replace("%", "%25").replace("\r", "%0D").replace("\n", "%0A")
+
+
+def _escape_property(value: str) -> str:
+    """Escape a workflow command property value."""
+    return _escape_data(value).replace(":", "%3A").replace(",", "%2C")
+
+
+def github_annotation(finding: ScanFinding) -> str:
+    """Convert a finding to a GitHub Actions workflow command.
+
+    The pattern ID is the annotation title; the message is the finding's
+    title, followed by its remediation on a new line when it has one.
+
+    Args:
+        finding: Scan finding to convert.
+
+    Returns:
+        Workflow command line without a trailing newline.
+
+    """
+    properties = {
+        "file": finding.path,
+        "line": str(finding.line),
+        "title": finding.pattern_id,
+    }
+    message = finding.title
+    if finding.remediation:
+        message += f"\n{finding.remediation}"
+    rendered = ",".join(f"{key}={_escape_property(value)}" for key, value in properties.items())
+    return f"::{GITHUB_COMMAND[finding.severity]} {rendered}::{_escape_data(message)}"
+
+
+def write_github_annotations(report: ScanReport, out: TextIO) -> None:
+    """Write a scan report as GitHub Actions workflow commands.
+
+    Args:
+        report: Scan report to write.
+        out: Text stream receiving one command per unsuppressed finding,
+            in report order.
+
+    """
+    for finding in report.unsuppressed_findings():
+        out.write(github_annotation(finding))
+        out.write("\n")
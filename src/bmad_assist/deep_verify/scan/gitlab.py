@@ -0,0 +1,77 @@
+"""GitLab Code Quality export for Deep Verify scans.
+
+GitLab merge requests render Code Quality reports: a JSON array of issues,
+each with a description, check name, fingerprint, severity and location.
+See https://docs.gitlab.com/ee/ci/testing/code_quality.html#code-quality-report-format
+
+Example:
+    >>> from pathlib import Path
+    >>> from bmad_assist.deep_verify.scan import Scanner, write_gitlab_code_quality
+    >>> report = Scanner().scan(Path("."))
+    >>> with open("gl-code-quality-report.json", "w") as f:
+    ...     write_gitlab_code_quality(report, f)
+
+"""
+
+from __future__ import annotations
+
+import json
+from typing import Any, TextIO
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan.types import ScanFinding, ScanReport, finding_fingerprint
+
+# Deep Verify severity -> GitLab severity (info, minor, major, critical, blocker)
+GITLAB_SEVERITY: dict[Severity, str] = {
+    Severity.CRITICAL: "critical",
+    Severity.ERROR: "major",
+    Severity.WARNING: "minor",
+    Severity.INFO: "info",
+}
+
+
+def gitlab_code_quality_issue(finding: ScanFinding) -> dict[str, Any]:
+    """Convert a finding to a GitLab Code Quality issue.
+
+    Args:
+        finding: Scan finding to convert.
+
+    Returns:
+        Issue dictionary in GitLab's Code Quality format.
+
+    """
+    return {
+        "description": f"{finding.pattern_id}: {finding.title}",
+        "check_name": finding.pattern_id,
+        "fingerprint": finding_fingerprint(finding),
+        "severity": GITLAB_SEVERITY[finding.severity],
+        "location": {
+            "path": finding.path,
+            "lines": {"begin": finding.line},
+        },
+    }
+
+
+def gitlab_code_quality_report(report: ScanReport) -> list[dict[str, Any]]:
+    """Convert a scan report to a GitLab Code Quality report.
+
+    Args:
+        report: Scan report to convert.
+
+    Returns:
+        List of issues, one per unsuppressed finding, in report order.
+
+    """
+    return [gitlab_code_quality_issue(f) for f in report.unsuppressed_findings()]
+
+
+def write_gitlab_code_quality(report: ScanReport, out: TextIO) -> None:
+    """Write a scan report as GitLab Code Quality JSON.
+
+    Args:
+        report: Scan report to write.
+        out: Text stream receiving the JSON document.
+
+    """
+    json.dump(gitlab_code_quality_report(report), out, indent=2)
+    out.write("\n")
@@ -0,0 +1,129 @@
+"""Detection of Go test helpers that do not call t.Helper().
+
+A helper that fails the test reports the failure at its own line unless it
+marks itself with ``t.Helper()``, so every failing caller points at the same
+line of the helper instead of the assertion that failed::
+
+    func assertStatus(t *testing.T, got, want int) {
+        if got != want {
+            t.Fatalf("status = %d, want %d", got, want) // CC-134: reported here
+        }
+    }
+
+In ``_test.go`` files, functions taking a ``*testing.T``, ``*testing.B``,
+``*testing.F`` or ``testing.TB`` parameter (resolved through the file's
+imports) are reported when they call one of its failure methods
+(``Error``, ``Errorf``, ``Fatal``, ``Fatalf``, ``Fail``, ``FailNow``) but
+never its ``Helper`` method. Test, benchmark and fuzz functions themselves
+are not helpers and are skipped.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    PatternPrecision,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for failing test helpers without t.Helper()
+TEST_HELPER_PATTERN = Pattern(
+    id=PatternId("CC-134-CODE-GO"),
+    domain=ArtifactDomain.TRANSFORM,
+    signals=[],
+    severity=Severity.INFO,
+    description="Test helper fails the test without t.Helper() - failures point at the helper",
+    remediation="Call t.Helper() first thing in the helper so failures report the caller's line",
+    language="go",
+    precision=PatternPrecision.HIGH,
+    effort=PatternEffort.LOW,
+)
+
+# testing methods that mark the test failed
+_FAILURE_METHODS = ("Error", "Errorf", "Fatal", "Fatalf", "Fail", "FailNow")
+
+# Function or method declaration with its parameters on the first line
+_FUNC_DECL_RE = re.compile(
+    r"^func[ \t]*(?:\([^)\n]*\)[ \t]*)?([A-Za-z_]\w*)(?:\[[^\]\n]*\])?[ \t]*\(([^)\n]*)\)"
+)
+
+# Test entry points run by go test: TestXxx, BenchmarkXxx, FuzzXxx
+_ENTRY_POINT_RE = re.compile(r"^(?:Test|Benchmark|Fuzz)(?![a-z])")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def find_unmarked_test_helpers(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report test helpers that fail the test without calling t.Helper().
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root; only ``_test.go`` files
+            are checked.
+        config: Effective config for the file.
+
+    Returns:
+        CC-134 findings in line order, one per helper.
+
+    """
+    if not rel_path.endswith("_test.go") or not config.is_enabled(TEST_HELPER_PATTERN.id):
+        return []
+    aliases = [name for name, path in parse_go_imports(text).items() if path == "testing"]
+    if not aliases:
+        return []
+    qualified = "|".join("" if a == "." else re.escape(a) + r"\." for a in aliases)
+    param_re = re.compile(
+        r"(?<![\w.])([A-Za-z_]\w*)[ \t]+(?:\*[ \t]*(?:" + qualified + r")[TBF]|(?:"
+        + qualified
+        + r")TB)\b"
+    )
+
+    lines = _code_lines(text)
+    source_lines = text.split("\n")
+    findings: list[ScanFinding] = []
+    for index, line in enumerate(lines):
+        decl = _FUNC_DECL_RE.match(line)
+        if decl is None or _ENTRY_POINT_RE.match(decl.group(1)):
+            continue
+        param = param_re.search(decl.group(2))
+        if param is None:
+            continue
+        # Body: the rest of a one-line function, or the lines up to its
+        # closing brace at column 0
+        end = index + 1
+        if line.rstrip().endswith("{"):
+            while end < len(lines) and not lines[end].startswith("}"):
+                end += 1
+        body = "\n".join([line[decl.end() :], *lines[index + 1 : end]])
+        name = re.escape(param.group(1))
+        fails = re.search(
+            r"(?<![\w.])" + name + r"\.(?:" + "|".join(_FAILURE_METHODS) + r")\(", body
+        )
+        if fails and not re.search(r"(?<![\w.])" + name + r"\.Helper\(\)", body):
+            helper, t = decl.group(1), param.group(1)
+            findings.append(
+                builtin_finding(
+                    TEST_HELPER_PATTERN,
+                    f"Test helper {helper} fails the test without calling {t}.Helper()",
+                    rel_path,
+                    index + 1,
+                    source_lines[index],
+                    config,
+                )
+            )
+    return findings
@@ -0,0 +1,167 @@
+"""Release history of the patterns a scan runs.
+
+After an upgrade, ``--since-version`` shows what the new release's patterns
+flag without re-triaging every finding: it runs only the library and
+built-in patterns added or changed after a given release::
+
+    options = apply_since_version(ScanOptions(), "0.4.27")
+    report = Scanner(options).scan(Path("."))
+
+``PATTERN_HISTORY`` lists, per pattern, the releases in which it was added
+or its detection changed (signals, severity or check logic; not metadata
+such as ``rationale``). Patterns it does not list date from
+``LIBRARY_RELEASE`` and have not changed since. When a pattern is added or
+its detection changes, add the upcoming release to its entry.
+
+As with presets, the selection is set as the base config's ``enable``
+list, intersected with any ``enable`` the base config already has.
+
+"""
+
+from __future__ import annotations
+
+from collections.abc import Mapping, Sequence
+from dataclasses import replace
+
+from bmad_assist.deep_verify.patterns.library import PatternLibrary, get_default_pattern_library
+from bmad_assist.deep_verify.scan.config import ScanConfig, matches_selector
+from bmad_assist.deep_verify.scan.scanner import BUILTIN_PATTERNS, ScanOptions
+
+# Release that introduced the pattern library
+LIBRARY_RELEASE = "0.4.18"
+
+# Releases in which each pattern was added or its detection changed, oldest first
+PATTERN_HISTORY: dict[str, tuple[str, ...]] = {
+    "CC-004-CODE-GO": (LIBRARY_RELEASE, "0.4.28"),
+    "CC-009-CODE-GO": ("0.4.28",),
+    "CC-039-CODE-GO": ("0.4.28",),
+    "CC-099-CODE-GO": ("0.4.28",),
+    "CC-100-CODE-GO": ("0.4.28",),
+    "CC-101-CODE-GO": ("0.4.28",),
+    "CC-102-CODE-GO": ("0.4.28",),
+    "CC-104-CODE-GO": ("0.4.28",),
+    "CC-105-CODE-GO": ("0.4.28",),
+    "CC-106-CODE-GO": ("0.4.28",),
+    "CC-107-CODE-GO": ("0.4.28",),
+    "CC-108-CODE-GO": ("0.4.28",),
+    "CC-109-CODE-GO": ("0.4.28",),
+    "CC-110-CODE-GO": ("0.4.28",),
+    "CC-111-CODE-GO": ("0.4.28",),
+    "CC-112-CODE-GO": ("0.4.28",),
+    "CC-113-CODE-GO": ("0.4.28",),
+    "CC-114-CODE-GO": ("0.4.28",),
+    "CC-115-CODE-GO": ("0.4.28",),
+    "CC-116-CODE-GO": ("0.4.28",),
+    "CC-117-CODE-GO": ("0.4.28",),
+    "CC-118-CODE-GO": ("0.4.28",),
+    "CC-119-CODE-GO": ("0.4.28",),
+    "CC-120-CODE-GO": ("0.4.28",),
+    "CC-121-CODE-GO": ("0.4.28",),
+    "CC-122-CODE-GO": ("0.4.28",),
+    "CC-123-CODE-GO": ("0.4.28",),
+    "CC-124-CODE-GO": ("0.4.28",),
+    "CC-125-CODE-GO": ("0.4.28",),
+    "CC-126-CODE-GO": ("0.4.28",),
+    "CC-127-CODE-GO": ("0.4.28",),
+    "CC-128-CODE-GO": ("0.4.28",),
+    "CC-129-CODE-GO": ("0.4.28",),
+    "CC-130-CODE-GO": ("0.4.28",),
+    "CC-131-CODE-GO": ("0.4.28",),
+    "CC-132-CODE-GO": ("0.4.28",),
+    "CC-133-CODE-GO": ("0.4.28",),
+    "CC-134-CODE-GO": ("0.4.28",),
+    "CC-135-CODE-GO": ("0.4.28",),
+    "CC-136-CODE-GO": ("0.4.28",),
+    "CC-137-CODE-GO": ("0.4.28",),
+    "CC-138-CODE-GO": ("0.4.28",),
+    "CC-139-CODE-GO": ("0.4.28",),
+    "CC-140-CODE-GO": ("0.4.28",),
+    "CC-141-CODE-GO": ("0.4.28",),
+    "CC-142-CODE-GO": ("0.4.28",),
+    "CC-143-CODE-GO": ("0.4.28",),
+    "CC-144-CODE-GO": ("0.4.28",),
+    "CC-145-CODE-GO": ("0.4.28",),
+    "CC-146-CODE-GO": ("0.4.28",),
+    "CC-147-CODE-GO": ("0.4.28",),
+    "CC-148-CODE-GO": ("0.4.28",),
+    "CC-149-CODE-GO": ("0.4.28",),
+    "CC-150-CODE-GO": ("0.4.28",),
+    "CC-151-CODE-GO": ("0.4.28",),
+    "CC-152-CODE-GO": ("0.4.28",),
+    "CC-153-CODE-GO": ("0.4.28",),
+}
+
+
+def parse_version(version: str) -> tuple[int, ...]:
+    """Parse an ``X.Y.Z`` release number into comparable integers.
+
+    Raises:
+        ValueError: If the version is not three dot-separated numbers.
+
+    """
+    parts = version.strip().removeprefix("v").split(".")
+    if len(parts) != 3 or not all(part.isdigit() for part in parts):
+        raise ValueError(f"Invalid version {version!r}; use X.Y.Z")
+    return tuple(int(part) for part in parts)
+
+
+def changed_since(
+    version: str,
+    library: PatternLibrary | None = None,
+    history: Mapping[str, Sequence[str]] | None = None,
+) -> list[str]:
+    """Return the IDs of patterns added or changed after a release, sorted.
+
+    Args:
+        version: Release to compare against, as ``X.Y.Z``.
+        library: Pattern library; None uses the default library.
+        history: Releases per pattern ID; None uses PATTERN_HISTORY.
+
+    Raises:
+        ValueError: If the version is invalid.
+
+    """
+    since = parse_version(version)
+    library = library if library is not None else get_default_pattern_library()
+    history = history if history is not None else PATTERN_HISTORY
+    patterns = (*library.get_all_patterns(), *BUILTIN_PATTERNS)
+    return sorted(
+        {
+            p.id
+            for p in patterns
+            if any(
+                parse_version(release) > since
+                for release in history.get(p.id, (LIBRARY_RELEASE,))
+            )
+        }
+    )
+
+
+def apply_since_version(
+    options: ScanOptions,
+    version: str,
+    library: PatternLibrary | None = None,
+    history: Mapping[str, Sequence[str]] | None = None,
+) -> ScanOptions:
+    """Return options that run only patterns added or changed after a release.
+
+    Args:
+        options: Options to adjust.
+        version: Release to compare against, as ``X.Y.Z``.
+        library: Pattern library the scan uses; None uses the default library.
+        history: Releases per pattern ID; None uses PATTERN_HISTORY.
+
+    Returns:
+        Options whose base config enables only the changed patterns.
+
+    Raises:
+        ValueError: If the version is invalid.
+
+    """
+    base = options.config if options.config is not None else ScanConfig()
+    enable = [
+        pattern_id
+        for pattern_id in changed_since(version, library, history)
+        if base.enable is None or any(matches_selector(pattern_id, s) for s in base.enable)
+    ]
+    return replace(options, config=base.model_copy(update={"enable": enable}))
@@ -0,0 +1,188 @@
+"""Interactive HTML reports for Deep Verify scans.
+
+The report is a single self-contained page for triaging findings in a
+browser: the serialized scan report (the ``--output json`` document) is
+embedded as a JSON data block, and a small inline script renders it as a
+table that can be filtered by pattern code, severity and file, and sorted
+by clicking a column header. No server, CDN or external resource is
+needed, so the file can be archived as a CI artifact and opened offline.
+
+Example:
+    >>> from pathlib import Path
+    >>> from bmad_assist.deep_verify.scan import Scanner, write_html
+    >>> report = Scanner().scan(Path("."))
+    >>> with open("deepverify.html", "w") as f:
+    ...     write_html(report, f)
+
+"""
+
+from __future__ import annotations
+
+import json
+from html import escape
+from typing import TextIO
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan.types import ScanReport, serialize_scan_report
+
+# id of the script element holding the embedded report
+HTML_DATA_ID = "deepverify-data"
+
+# Columns of the findings table: (finding key, header)
+_COLUMNS = (
+    ("severity", "Severity"),
+    ("pattern_id", "Code"),
+    ("path", "File"),
+    ("line", "Line"),
+    ("title", "Title"),
+    ("confidence", "Confidence"),
+)
+
+_STYLE = """
+body { font-family: system-ui, sans-serif; margin: 1.5em; color: #222; }
+h1 { font-size: 1.3em; }
+.controls { display: flex; gap: 1em; margin: 1em 0; }
+table { border-collapse: collapse; width: 100%; font-size: 0.9em; }
+th, td { border-bottom: 1px solid #ddd; padding: 0.3em 0.6em; text-align: left; }
+th { cursor: pointer; user-select: none; background: #f4f4f4; }
+th[aria-sort=ascending]::after { content: " \\25b2"; }
+th[aria-sort=descending]::after { content: " \\25bc"; }
+td.snippet { font-family: monospace; color: #555; }
+.critical, .error { color: #b00; font-weight: bold; }
+.warning { color: #a60; }
+.suppressed { opacity: 0.5; }
+"""
+
+# Renders the embedded report; values are inserted as text, never as markup
+_SCRIPT = """
+(function () {
+  var report = JSON.parse(document.getElementById("%(data_id)s").textContent);
+  var rank = {critical: 0, error: 1, warning: 2, info: 3};
+  var code = document.getElementById("filter-code");
+  var severity = document.getElementById("filter-severity");
+  var file = document.getElementById("filter-file");
+  var body = document.querySelector("#findings tbody");
+  var count = document.getElementById("count");
+  var headers = document.querySelectorAll("#findings th");
+  var sortKey = "severity";
+  var ascending = true;
+
+  function compare(a, b) {
+    var x = a[sortKey], y = b[sortKey];
+    if (sortKey === "severity") { x = rank[x]; y = rank[y]; }
+    if (x < y) { return ascending ? -1 : 1; }
+    if (x > y) { return ascending ? 1 : -1; }
+    return a.path < b.path ? -1 : a.path > b.path ? 1 : a.line - b.line;
+  }
+
+  function cell(row, text, className) {
+    var td = document.createElement("td");
+    td.textContent = text;
+    if (className) { td.className = className; }
+    row.appendChild(td);
+  }
+
+  function render() {
+    var codeText = code.value.trim().toLowerCase();
+    var fileText = file.value.trim().toLowerCase();
+    var shown = report.findings.filter(function (f) {
+      return f.pattern_id.toLowerCase().indexOf(codeText) !== -1 &&
+        (!severity.value || f.severity === severity.value) &&
+        f.path.toLowerCase().indexOf(fileText) !== -1;
+    }).sort(compare);
+    body.textContent = "";
+    shown.forEach(function (f) {
+      var row = document.createElement("tr");
+      if (f.suppressed) { row.className = "suppressed"; }
+      cell(row, f.severity, f.severity);
+      cell(row, f.pattern_id);
+      cell(row, f.path);
+      cell(row, String(f.line));
+      cell(row, f.title);
+      cell(row, f.confidence.toFixed(2));
+      cell(row, f.snippet, "snippet");
+      body.appendChild(row);
+    });
+    count.textContent = shown.length + " of " + report.findings.length + " finding(s)";
+    headers.forEach(function (th) {
+      th.removeAttribute("aria-sort");
+      if (th.dataset.key === sortKey) {
+        th.setAttribute("aria-sort", ascending ? "ascending" : "descending");
+      }
+    });
+  }
+
+  headers.forEach(function (th) {
+    if (!th.dataset.key) { return; }
+    th.addEventListener("click", function () {
+      ascending = th.dataset.key === sortKey ? !ascending : true;
+      sortKey = th.dataset.key;
+      render();
+    });
+  });
+  [code, severity, file].forEach(function (control) {
+    control.addEventListener("input", render);
+  });
+  render();
+})();
+"""
+
+
+def _data_json(report: ScanReport) -> str:
+    """Return the serialized report, safe to embed in a script element."""
+    text = json.dumps(serialize_scan_report(report), separators=(",", ":"))
+    return text.replace("&", "\\u0026").replace("<", "\\u003c").replace(">", "\\u003e")
+
+
+def report_html(report: ScanReport) -> str:
+    """Render a scan report as an interactive, self-contained HTML page.
+
+    Args:
+        report: Scan report to render.
+
+    Returns:
+        HTML document without a trailing newline.
+
+    """
+    title = escape(f"Deep Verify scan of {report.root}")
+    severities = "".join(f'<option value="{s.value}">{s.value}</option>' for s in Severity)
+    headers = "".join(f'<th data-key="{key}">{label}</th>' for key, label in _COLUMNS)
+    lines = [
+        "<!DOCTYPE html>",
+        '<html lang="en">',
+        "<head>",
+        '<meta charset="utf-8">',
+        f"<title>{title}</title>",
+        f"<style>{_STYLE}</style>",
+        "</head>",
+        "<body>",
+        f"<h1>{title}</h1>",
+        '<div class="controls">',
+        '<input id="filter-code" type="search" placeholder="Filter by code">',
+        '<select id="filter-severity"><option value="">All severities</option>'
+        f"{severities}</select>",
+        '<input id="filter-file" type="search" placeholder="Filter by file">',
+        '<span id="count"></span>',
+        "</div>",
+        '<table id="findings">',
+        f"<thead><tr>{headers}<th>Snippet</th></tr></thead>",
+        "<tbody></tbody>",
+        "</table>",
+        f'<script type="application/json" id="{HTML_DATA_ID}">{_data_json(report)}</script>',
+        f"<script>{_SCRIPT % {'data_id': HTML_DATA_ID}}</script>",
+        "</body>",
+        "</html>",
+    ]
+    return "\n".join(lines)
+
+
+def write_html(report: ScanReport, out: TextIO) -> None:
+    """Write a scan report as an interactive HTML page.
+
+    Args:
+        report: Scan report to render.
+        out: Text stream receiving the HTML document.
+
+    """
+    out.write(report_html(report))
+    out.write("\n")
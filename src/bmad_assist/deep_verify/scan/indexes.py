@@ -0,0 +1,191 @@
+"""Detection of goroutines writing elements through a captured loop index in Go scans.
+
+A goroutine started in a loop that writes ``xs[i]`` with the loop variable
+``i`` captured from the loop reads ``i`` concurrently with the loop
+advancing it. Before Go 1.22 every goroutine shares one ``i``, so several
+write the same element (often the last) while others are never written::
+
+    for i := range xs {
+        go func() {
+            xs[i] = f(i) // CC-147: i is the loop's, not this iteration's
+        }()
+    }
+
+Go 1.22 gives each iteration its own variable, but the write still depends
+on the module's language version; passing the index as a parameter makes
+each goroutine write its own element under any version::
+
+    for i := range xs {
+        go func(i int) {
+            xs[i] = f(i)
+        }(i)
+    }
+
+Loop variables are those declared by a ``for`` header (``i``, ``k, v :=
+range``). A write ``name[i] = ...`` (or ``op=``, ``++``, ``--``) inside a
+``go func`` literal in the loop body is reported when ``i`` is a loop
+variable that neither the literal's parameters, an ``i := i`` copy before
+the go statement, nor a declaration in the literal shadows, and ``name`` is
+not declared in the literal. Whether the write races depends on the Go
+version, so findings carry ``CAPTURED_INDEX_CONFIDENCE``.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for goroutine element writes indexed by a captured loop variable
+CAPTURED_INDEX_PATTERN = Pattern(
+    id=PatternId("CC-147-CODE-GO"),
+    domain=ArtifactDomain.CONCURRENCY,
+    signals=[],
+    severity=Severity.WARNING,
+    description="Goroutine writes an element indexed by a captured loop variable - data race",
+    remediation="Pass the index to the goroutine as a parameter: go func(i int) { ... }(i)",
+    language="go",
+    effort=PatternEffort.LOW,
+)
+
+# Confidence of CC-147 findings: the write races on modules before Go 1.22 and
+# only writes a shared index after it, and the module's Go version is not read
+CAPTURED_INDEX_CONFIDENCE = 0.7
+
+# for statement header up to its opening brace
+_FOR_RE = re.compile(r"(?<![\w.])for[ \t]+([^{\n]*)\{")
+
+# Range clause: `i := range`, `k, v := range`
+_RANGE_VARS_RE = re.compile(r"^([A-Za-z_]\w*)(?:[ \t]*,[ \t]*([A-Za-z_]\w*))?[ \t]*:=[ \t]*range\b")
+
+# Three-clause loop init: `i := 0;`
+_INIT_VAR_RE = re.compile(r"^([A-Za-z_]\w*)[ \t]*:=[^;]*;")
+
+# go statement starting a function literal, with its parameter list
+_GO_FUNC_RE = re.compile(r"(?<![\w.])go[ \t]+func[ \t]*\(([^)]*)\)")
+
+# Element write: `xs[i] = `, `s.xs[i] += `, `xs[i].n = `, `xs[i]++`
+_WRITE_RE = re.compile(
+    r"(?<![\w.])((?:[A-Za-z_]\w*\.)*[A-Za-z_]\w*)\[[ \t]*([A-Za-z_]\w*)[ \t]*\]"
+    r"(?:\.[A-Za-z_][\w.]*)?[ \t]*(?:(?:[-+*/%&|^]|<<|>>|&\^)?=(?!=)|\+\+|--)"
+)
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _block_end(code: str, open_brace: int) -> int:
+    """Return the position of the brace closing the block opened at open_brace."""
+    depth = 0
+    for position in range(open_brace, len(code)):
+        if code[position] == "{":
+            depth += 1
+        elif code[position] == "}":
+            depth -= 1
+            if depth == 0:
+                return position
+    return len(code)
+
+
+def _loop_vars(header: str) -> set[str]:
+    """Return the variables a for header declares."""
+    header = header.strip()
+    if match := _RANGE_VARS_RE.match(header):
+        names = {match.group(1), match.group(2)}
+    elif match := _INIT_VAR_RE.match(header):
+        names = {match.group(1)}
+    else:
+        return set()
+    return {name for name in names if name and name != "_"}
+
+
+def _params(params: str) -> set[str]:
+    """Return the parameter names of a function literal's parameter list."""
+    names: set[str] = set()
+    for part in params.split(","):
+        words = part.split()
+        if words and re.fullmatch(r"[A-Za-z_]\w*", words[0]):
+            names.add(words[0])
+    return names
+
+
+def _declares(code: str, name: str) -> bool:
+    """Return whether code declares name with := or var."""
+    ref = re.escape(name)
+    return bool(
+        re.search(rf"(?<![\w.]){ref}(?:[ \t]*,[ \t]*[A-Za-z_]\w*)*[ \t]*:=", code)
+        or re.search(rf"(?<![\w.])[A-Za-z_]\w*[ \t]*,[ \t]*{ref}[ \t]*:=", code)
+        or re.search(rf"(?<![\w.])var[ \t]+{ref}\b", code)
+    )
+
+
+def find_captured_index_writes(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report goroutines in loops writing elements through a captured loop variable.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-147 findings in line order, one per write, at
+        CAPTURED_INDEX_CONFIDENCE.
+
+    """
+    if not config.is_enabled(CAPTURED_INDEX_PATTERN.id):
+        return []
+    code = "\n".join(_code_lines(text))
+    source_lines = text.split("\n")
+    writes: dict[int, tuple[str, str]] = {}
+    for loop in _FOR_RE.finditer(code):
+        loop_vars = _loop_vars(loop.group(1))
+        if not loop_vars:
+            continue
+        body_start, body_end = loop.end(), _block_end(code, loop.end() - 1)
+        for go in _GO_FUNC_RE.finditer(code, body_start, body_end):
+            open_brace = code.find("{", go.end(), body_end)
+            if open_brace < 0:
+                continue
+            literal = code[open_brace + 1 : _block_end(code, open_brace)]
+            captured = {
+                name
+                for name in loop_vars - _params(go.group(1))
+                if not re.search(
+                    rf"(?<![\w.]){re.escape(name)}[ \t]*:=[ \t]*{re.escape(name)}\b",
+                    code[body_start : go.start()],
+                )
+                and not _declares(literal, name)
+            }
+            for write in _WRITE_RE.finditer(literal):
+                name, index = write.groups()
+                if index in captured and not _declares(literal, name.split(".", 1)[0]):
+                    writes.setdefault(open_brace + 1 + write.start(), (name, index))
+    findings: list[ScanFinding] = []
+    for position, (name, index) in sorted(writes.items()):
+        line = code.count("\n", 0, position)
+        findings.append(
+            builtin_finding(
+                CAPTURED_INDEX_PATTERN,
+                f"Goroutine writes {name}[{index}] through captured loop variable {index}",
+                rel_path,
+                line + 1,
+                source_lines[line],
+                config,
+                CAPTURED_INDEX_CONFIDENCE,
+            )
+        )
+    return findings
@@ -0,0 +1,231 @@
+"""Detection of string context keys in Go.
+
+Context values are looked up by key equality, so two packages that both
+store a value under the string ``"userID"`` overwrite each other's value.
+The idiom is an unexported key type, which no other package can construct::
+
+    ctx = context.WithValue(ctx, "userID", id) // CC-140: string key
+
+    type ctxKey string
+
+    const userIDKey ctxKey = "userID"
+
+    ctx = context.WithValue(ctx, userIDKey, id) // safe
+
+The key argument of ``context.WithValue`` (resolved through the file's
+import aliases) is reported when it is a string literal, a ``string(...)``
+conversion or a name whose type is ``string``. Names are typed from their
+declaration in the file: package-level constants and variables, and the
+parameters and local variables of the enclosing function, where an untyped
+string constant or a variable initialized from a string literal counts as
+``string``. Keys of other or unknown types are not reported.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    PatternPrecision,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for context.WithValue calls keyed by a string
+STRING_CONTEXT_KEY_PATTERN = Pattern(
+    id=PatternId("CC-140-CODE-GO"),
+    domain=ArtifactDomain.API,
+    signals=[],
+    severity=Severity.WARNING,
+    description="Context value keyed by a string - keys from other packages can collide",
+    remediation="Declare an unexported key type (type ctxKey struct{}) and key values by it",
+    language="go",
+    precision=PatternPrecision.HIGH,
+    effort=PatternEffort.LOW,
+)
+
+# Declarations: `const name = ...`, `var a, b string`, `name := ...`, and
+# specs inside `const ( ... )` / `var ( ... )` blocks (without the keyword)
+_DECL_RE = re.compile(
+    r"^[ \t]*(?:(?:const|var)[ \t]+)?"
+    r"([A-Za-z_]\w*(?:[ \t]*,[ \t]*[A-Za-z_]\w*)*)"
+    r"(?:[ \t]+([\w.*\[\]]+))?[ \t]*(?:(:?=)(?!=)[ \t]*(.*))?$"
+)
+_DECL_KEYWORD_RE = re.compile(r"^[ \t]*(?:const|var)[ \t]+\w")
+_DECL_BLOCK_RE = re.compile(r"^[ \t]*(?:const|var)[ \t]*\([ \t]*$")
+
+# Function header and its `name string` / `a, b string` parameters
+_FUNC_RE = re.compile(r"^func\b")
+_STRING_PARAMS_RE = re.compile(
+    r"[(,][ \t]*([A-Za-z_]\w*(?:[ \t]*,[ \t]*[A-Za-z_]\w*)*)[ \t]+string\b"
+)
+
+_IDENT_RE = re.compile(r"[A-Za-z_]\w*")
+_STRING_CONVERSION_RE = re.compile(r"string\(.*\)")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _call_args(line: str, open_paren: int) -> list[str]:
+    """Return the top-level arguments of the call whose "(" is at open_paren."""
+    args: list[str] = []
+    depth = 0
+    start = open_paren + 1
+    for position in range(open_paren, len(line)):
+        char = line[position]
+        if char in "([{":
+            depth += 1
+        elif char in ")]}":
+            depth -= 1
+            if depth == 0:
+                args.append(line[start:position])
+                return args
+        elif char == "," and depth == 1:
+            args.append(line[start:position])
+            start = position + 1
+    return []  # call continues on the next line
+
+
+def _is_string(type_name: str | None, value: str | None) -> bool | None:
+    """Return whether a declaration is of type string, or None if it is implicit.
+
+    A spec without type and value repeats the previous spec of its const block.
+    """
+    if type_name is not None:
+        return type_name == "string"
+    if value is None:
+        return None
+    return value.strip() == '""'
+
+
+def _params(header: str) -> set[str]:
+    """Return the string parameters of a function header."""
+    return {
+        name.strip()
+        for group in _STRING_PARAMS_RE.findall(header)
+        for name in group.split(",")
+    }
+
+
+class _Scope:
+    """Types of the names visible at a line: package level, then function."""
+
+    def __init__(self) -> None:
+        self.package: dict[str, bool] = {}
+        self.local: dict[str, bool] = {}
+        self._in_func = False
+        self._in_block = False
+        self._previous = False
+
+    def update(self, line: str) -> None:
+        """Record the declarations on one line."""
+        if _FUNC_RE.match(line):
+            self._in_func = True
+            self.local = dict.fromkeys(_params(line), True)
+            return
+        if line.startswith("}"):
+            self._in_func = False
+            self.local = {}
+            return
+        if _DECL_BLOCK_RE.match(line):
+            self._in_block = True
+            self._previous = False
+            return
+        if self._in_block:
+            if line.strip().startswith(")"):
+                self._in_block = False
+                return
+        elif not (_DECL_KEYWORD_RE.match(line) or ":=" in line):
+            return
+        decl = _DECL_RE.match(line)
+        if decl is None:
+            return
+        names, type_name, operator, value = decl.groups()
+        if type_name is None and operator is None and not self._in_block:
+            return
+        is_string = _is_string(type_name, value)
+        if is_string is None:
+            is_string = self._previous
+        self._previous = is_string
+        scope = self.local if self._in_func else self.package
+        for name in names.split(","):
+            scope[name.strip()] = is_string
+
+    def is_string(self, name: str) -> bool:
+        """Return whether a name is declared with type string."""
+        if name in self.local:
+            return self.local[name]
+        return self.package.get(name, False)
+
+
+def find_string_context_keys(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report context.WithValue calls whose key is a string.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-140 findings in line order, one per call.
+
+    """
+    if not config.is_enabled(STRING_CONTEXT_KEY_PATTERN.id):
+        return []
+    aliases = [a for a, path in parse_go_imports(text).items() if path == "context"]
+    if not aliases:
+        return []
+    call_re = re.compile(
+        "|".join(
+            r"(?<![\w.])WithValue[ \t]*\(" if a == "." else re.escape(a) + r"\.WithValue[ \t]*\("
+            for a in aliases
+        )
+    )
+    lines = _code_lines(text)
+    # Package-level declarations may follow their use
+    package_scope = _Scope()
+    for line in lines:
+        package_scope.update(line)
+    scope = _Scope()
+    scope.package = package_scope.package
+    source_lines = text.split("\n")
+    findings: list[ScanFinding] = []
+    for index, line in enumerate(lines):
+        for call in call_re.finditer(line):
+            args = _call_args(line, call.end() - 1)
+            if len(args) != 3:
+                continue
+            key = args[1].strip()
+            if key == '""':
+                what = "a string literal"
+            elif _STRING_CONVERSION_RE.fullmatch(key):
+                what = "a string conversion"
+            elif _IDENT_RE.fullmatch(key) and scope.is_string(key):
+                what = f"{key}, a string"
+            else:
+                continue
+            findings.append(
+                builtin_finding(
+                    STRING_CONTEXT_KEY_PATTERN,
+                    f"Context value keyed by {what} - use a custom key type",
+                    rel_path,
+                    index + 1,
+                    source_lines[index],
+                    config,
+                )
+            )
+        scope.update(line)
+    return findings
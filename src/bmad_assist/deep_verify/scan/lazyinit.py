@@ -0,0 +1,170 @@
+"""Detection of unguarded lazy map initialization in Go scans.
+
+A method that creates a map field on first use and then writes to it races
+when two goroutines call it: both can see ``nil`` and each assign a new
+map, losing the other's entry, and the write itself races with any other
+access to the map::
+
+    func (s *Store) Put(k, v string) {
+        if s.items == nil { // CC-149: check, make and write are unguarded
+            s.items = make(map[string]string)
+        }
+        s.items[k] = v
+    }
+
+Methods are reported when they compare ``recv.field`` with ``nil``, assign
+it a new map (``make(map...)`` or a ``map[...]`` literal) inside that
+``if``, and write ``recv.field[k]`` afterwards, without calling
+``.Lock()`` or a ``sync.Once`` ``Do`` before the nil check. Methods named
+``...Locked`` are assumed to run with the caller's lock held. Creating the
+map in a constructor is not lazy initialization and is never reported.
+
+The receiver is shared when its struct declares a ``sync.Mutex`` or
+``sync.RWMutex`` (other methods guard it, so this one should too); those
+findings have full confidence. Receivers without a mutex are reported at
+``LAZY_MAP_CONFIDENCE`` when the file starts goroutines, and not at all
+otherwise.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for maps created on first use and written without a lock
+LAZY_MAP_PATTERN = Pattern(
+    id=PatternId("CC-149-CODE-GO"),
+    domain=ArtifactDomain.CONCURRENCY,
+    signals=[],
+    severity=Severity.WARNING,
+    description="Map field created on first use and written without a lock - data race",
+    remediation="Create the map in the constructor, or lock the mutex before the nil check",
+    language="go",
+    effort=PatternEffort.LOW,
+)
+
+# Confidence of CC-149 findings on receivers without a mutex: goroutines in the
+# file suggest the receiver is shared, but they may never reach the method
+LAZY_MAP_CONFIDENCE = 0.6
+
+# Method with a named receiver: `func (s *Store) Put(`
+_METHOD_RE = re.compile(
+    r"^func[ \t]*\([ \t]*([A-Za-z_]\w*)[ \t]+\*?[ \t]*([A-Za-z_]\w*)[^)\n]*\)[ \t]*"
+    r"([A-Za-z_]\w*)",
+    re.MULTILINE,
+)
+
+# Struct declaration: `type Store struct {`
+_STRUCT_RE = re.compile(r"^type[ \t]+([A-Za-z_]\w*)[ \t]+struct[ \t]*\{", re.MULTILINE)
+
+_MUTEX_RE = re.compile(r"(?<![\w.])sync\.(?:RW)?Mutex\b")
+
+_GO_RE = re.compile(r"(?<![\w.])go[ \t]+[\w(]")
+
+# Lock or sync.Once call guarding what follows
+_GUARD_RE = re.compile(r"\.(?:Lock|Do)\(")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _block_end(code: str, open_brace: int) -> int:
+    """Return the position of the brace closing the block opened at open_brace."""
+    depth = 0
+    for position in range(open_brace, len(code)):
+        if code[position] == "{":
+            depth += 1
+        elif code[position] == "}":
+            depth -= 1
+            if depth == 0:
+                return position
+    return len(code)
+
+
+def _mutex_types(code: str) -> set[str]:
+    """Return the struct types of the file that declare a sync mutex field."""
+    types: set[str] = set()
+    for struct in _STRUCT_RE.finditer(code):
+        body = code[struct.end() : _block_end(code, struct.end() - 1)]
+        if _MUTEX_RE.search(body):
+            types.add(struct.group(1))
+    return types
+
+
+def find_unguarded_lazy_maps(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report methods that create a map field on first use and write it without a lock.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-149 findings in line order, one per nil check: confidence 1.0
+        when the receiver's struct has a mutex, LAZY_MAP_CONFIDENCE when
+        the file only starts goroutines.
+
+    """
+    if not config.is_enabled(LAZY_MAP_PATTERN.id):
+        return []
+    code = "\n".join(_code_lines(text))
+    source_lines = text.split("\n")
+    mutex_types = _mutex_types(code)
+    spawns = bool(_GO_RE.search(code))
+    findings: list[ScanFinding] = []
+    for method in _METHOD_RE.finditer(code):
+        receiver, type_name, name = method.groups()
+        if name.endswith("Locked") or (type_name not in mutex_types and not spawns):
+            continue
+        open_brace = code.find("{", method.end())
+        if open_brace < 0:
+            continue
+        body_end = _block_end(code, open_brace)
+        recv = re.escape(receiver)
+        nil_check_re = re.compile(
+            rf"(?<![\w.])if[ \t]+{recv}\.([A-Za-z_]\w*)[ \t]*==[ \t]*nil[ \t]*\{{"
+        )
+        for check in nil_check_re.finditer(code, open_brace, body_end):
+            field = re.escape(check.group(1))
+            if _GUARD_RE.search(code, open_brace, check.start()):
+                continue
+            then = code[check.end() : _block_end(code, check.end() - 1)]
+            if not re.search(
+                rf"(?<![\w.]){recv}\.{field}[ \t]*=[ \t]*(?:make\([ \t]*)?map\[", then
+            ):
+                continue
+            write_re = re.compile(
+                rf"(?<![\w.]){recv}\.{field}\[[^\]\n]*\][ \t]*(?:[-+*/%|&^]?=(?!=)|\+\+|--)"
+            )
+            if not write_re.search(code, check.end(), body_end):
+                continue
+            index = code.count("\n", 0, check.start())
+            confidence = 1.0 if type_name in mutex_types else LAZY_MAP_CONFIDENCE
+            findings.append(
+                builtin_finding(
+                    LAZY_MAP_PATTERN,
+                    f"Map {receiver}.{check.group(1)} created on first use and written "
+                    "without a lock",
+                    rel_path,
+                    index + 1,
+                    source_lines[index],
+                    config,
+                    confidence,
+                )
+            )
+    return findings
@@ -0,0 +1,149 @@
+"""Detection of unkeyed struct literals in Go scans.
+
+A composite literal that lists field values by position breaks, or silently
+assigns values to the wrong fields, when the struct gains or reorders
+fields. For structs from other packages that is outside the caller's
+control::
+
+    cfg := server.Config{true, 30, "x"}      // CC-126: unkeyed
+    cfg := server.Config{TLS: true, Port: 30} // keyed, safe
+
+As in ``go vet``'s composites check, only literals of imported types are
+reported by default: the package qualifier is resolved through the file's
+imports. Literals of struct types declared in the file can be included with
+``ScanOptions.unkeyed_local_structs``. Literals are recognized as gofmt
+writes them (``Type{`` with no space, so ``if v == pkg.Zero {`` is a
+block); imported slice and map types are not distinguished from structs.
+
+Example:
+    >>> from bmad_assist.deep_verify.scan import ScanOptions
+    >>> options = ScanOptions(unkeyed_local_structs=True)
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    PatternPrecision,
+    Severity,
+)
+from bmad_assist.deep_verify.patterns.matcher import MatchContext
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for composite literals that set struct fields by position
+UNKEYED_LITERAL_PATTERN = Pattern(
+    id=PatternId("CC-126-CODE-GO"),
+    domain=ArtifactDomain.TRANSFORM,
+    signals=[],
+    severity=Severity.WARNING,
+    description="Struct literal sets fields by position - it breaks when fields change",
+    remediation="Name the fields: Config{Enabled: true, Timeout: 30}",
+    language="go",
+    precision=PatternPrecision.HIGH,
+    effort=PatternEffort.LOW,
+)
+
+# Top-level struct type declaration: `type Config struct {`
+_STRUCT_TYPE_RE = re.compile(
+    r"^type[ \t]+([A-Za-z_]\w*)(?:\[[^\]\n]*\])?[ \t]+struct[ \t]*\{", re.MULTILINE
+)
+
+# String, rune and raw string literals plus comments, skipped when scanning elements
+_SKIP_RE = re.compile(
+    r'"(?:\\.|[^"\\\n])*"|`[^`]*`|\'(?:\\.|[^\'\\\n])+\'|//[^\n]*|/\*.*?\*/', re.DOTALL
+)
+
+_OPENERS = "([{"
+_CLOSERS = ")]}"
+
+
+def _is_unkeyed(text: str, open_brace: int) -> bool:
+    """Return whether the composite literal at open_brace has positional elements."""
+    depth = 0
+    element_has_key = False
+    element_empty = True
+    i = open_brace + 1
+    while i < len(text):
+        skipped = _SKIP_RE.match(text, i)
+        if skipped is not None:
+            if not skipped.group().startswith("/"):
+                element_empty = False
+            i = skipped.end()
+            continue
+        char = text[i]
+        if char in _OPENERS:
+            depth += 1
+            element_empty = False
+        elif char in _CLOSERS:
+            if depth == 0:
+                # Closing brace of the literal: check the last element
+                return not element_empty and not element_has_key
+            depth -= 1
+        elif depth == 0 and char == ",":
+            if not element_empty and not element_has_key:
+                return True
+            element_has_key, element_empty = False, True
+        elif depth == 0 and char == ":":
+            element_has_key = True
+        elif not char.isspace():
+            element_empty = False
+        i += 1
+    return False
+
+
+def find_unkeyed_literals(
+    text: str, rel_path: str, config: ScanConfig, include_local: bool = False
+) -> list[ScanFinding]:
+    """Report unkeyed composite literals of imported (and optionally local) structs.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+        include_local: Also report literals of struct types declared in the file.
+
+    Returns:
+        CC-126 findings in source order, one per literal.
+
+    """
+    if not config.is_enabled(UNKEYED_LITERAL_PATTERN.id):
+        return []
+    alternatives = [
+        re.escape(name) + r"\.[A-Za-z_]\w*" for name in parse_go_imports(text) if name != "."
+    ]
+    if include_local:
+        alternatives.extend(re.escape(name) for name in _STRUCT_TYPE_RE.findall(text))
+    if not alternatives:
+        return []
+
+    # `[]T{` and `map[K]T{` are slice and map literals of T, not T literals
+    literal_re = re.compile(r"(?<![\w.\]])(" + "|".join(alternatives) + r")(?:\[[^\]\n]*\])?\{")
+    context = MatchContext.from_text(text)
+    findings: list[ScanFinding] = []
+    for literal in literal_re.finditer(text):
+        line = context.get_line_number(literal.start())
+        content = context.get_line_content(line)
+        before = content[: literal.start() - context.line_offsets[line - 1]]
+        # Skip comments and string literals on the same line
+        if "//" in before or before.count('"') % 2:
+            continue
+        if _is_unkeyed(text, literal.end() - 1):
+            findings.append(
+                builtin_finding(
+                    UNKEYED_LITERAL_PATTERN,
+                    f"{literal.group(1)} literal sets fields by position",
+                    rel_path,
+                    line,
+                    content,
+                    config,
+                )
+            )
+    return findings
@@ -0,0 +1,231 @@
+"""Detection of mutex-holding structs copied by assignment in Go scans.
+
+Assigning a struct value copies its ``sync.Mutex`` bit for bit: a template
+copied while locked yields a value that is locked forever, and the copy
+never excludes the original::
+
+    var defaults = Config{Retries: 3} // Config has a mu sync.Mutex field
+
+    func newConfig() *Config {
+        cfg := defaults // CC-112: copies defaults.mu
+        return &cfg
+    }
+
+Struct types are read from the same file; a type holds a mutex when it has
+a ``sync.Mutex`` or ``sync.RWMutex`` field (resolved through the file's
+imports), embedded or named, or a field of another such type by value.
+Variables of those types are tracked by declaration (``var v T``,
+``v := T{...}``, ``v := &T{...}``, ``new(T)``) at package level and within
+each function, and two kinds of copies are reported:
+
+- an assignment or ``var`` declaration whose right-hand side is a value
+  variable of such a type (``cfg := defaults``) or a dereferenced pointer
+  to one (``cfg := *shared``);
+- a composite literal setting a mutex field from another value
+  (``Config{mu: src.mu}``).
+
+Assigning pointers and constructing fresh literals are safe. Copies in
+call arguments and ``range`` clauses are left to go vet's copylocks
+analysis.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for assignments copying a struct that holds a mutex
+MUTEX_COPY_PATTERN = Pattern(
+    id=PatternId("CC-112-CODE-GO"),
+    domain=ArtifactDomain.CONCURRENCY,
+    signals=[],
+    severity=Severity.ERROR,
+    description=(
+        "Struct containing a mutex copied by value - the copy shares the lock's current "
+        "state, not the lock"
+    ),
+    remediation="Construct each value with a fresh literal or constructor, share it by pointer",
+    language="go",
+    effort=PatternEffort.MEDIUM,
+)
+
+_STRUCT_RE = re.compile(r"^type[ \t]+([A-Za-z_]\w*)[ \t]+struct[ \t]*\{[ \t]*$")
+
+# Field declaration: `mu sync.Mutex`, `a, b int`, or an embedded type
+_FIELD_RE = re.compile(
+    r"^[ \t]*(?:([A-Za-z_]\w*(?:[ \t]*,[ \t]*[A-Za-z_]\w*)*)[ \t]+)?(\*?[A-Za-z_][\w.]*)[ \t]*$"
+)
+
+# Declaration of a variable from a type: `var v T`, `v := T{`, `v := &T{`, `v := new(T)`
+_DECL_RE = re.compile(
+    r"^[ \t]*(?:var[ \t]+)?(?!var\b)([A-Za-z_]\w*)[ \t]+(\*?)([A-Za-z_]\w*)[ \t]*(?:=|$)"
+    r"|^[ \t]*(?:var[ \t]+)?(?!var\b)([A-Za-z_]\w*)[ \t]*:?=[ \t]*(&?)([A-Za-z_]\w*)\{"
+    r"|^[ \t]*(?:var[ \t]+)?(?!var\b)([A-Za-z_]\w*)[ \t]*:?=[ \t]*(new)\(([A-Za-z_]\w*)\)"
+)
+
+# Assignment of a whole variable: `cfg := defaults`, `var cfg T = *shared`, `s.cfg = cfg`
+_COPY_RE = re.compile(
+    r"^[ \t]*(?:var[ \t]+([A-Za-z_]\w*)(?:[ \t]+[A-Za-z_]\w*)?|(\*?[A-Za-z_][\w.]*))"
+    r"[ \t]*:?=[ \t]*(\*?)([A-Za-z_]\w*)[ \t]*;?[ \t]*$"
+)
+
+_VAR_BLOCK_RE = re.compile(r"^var[ \t]*\([ \t]*$")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+# Struct tag after a field, blanked to "" by _code_lines
+_TAG_RE = re.compile(r'[ \t]*""[ \t]*$')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _mutex_holders(lines: list[str], mutex_re: re.Pattern[str]) -> dict[str, list[str]]:
+    """Return the file's struct types holding a mutex, with their mutex field names.
+
+    Types holding a mutex only through a field of another holder map to
+    an empty list.
+    """
+    fields: dict[str, list[tuple[str, str]]] = {}  # type -> (field name, field type)
+    current: list[tuple[str, str]] | None = None
+    depth = 0
+    for line in lines:
+        if current is None:
+            match = _STRUCT_RE.match(line)
+            if match is not None:
+                current = fields.setdefault(match.group(1), [])
+                depth = 0
+            continue
+        if line.startswith("}"):
+            current = None
+            continue
+        code = _TAG_RE.sub("", line)
+        declared = _FIELD_RE.match(code)
+        if depth == 0 and declared is not None:
+            names, field_type = declared.groups()
+            for name in (names or field_type.rsplit(".", 1)[-1]).split(","):
+                current.append((name.strip(), field_type))
+        depth += code.count("{") - code.count("}")
+
+    holders = {
+        name: [f for f, field_type in members if mutex_re.fullmatch(field_type)]
+        for name, members in fields.items()
+        if any(mutex_re.fullmatch(field_type) for _, field_type in members)
+    }
+    grown = True
+    while grown:  # types holding a holder by value hold its mutex too
+        grown = False
+        for name, members in fields.items():
+            if name not in holders and any(t in holders for _, t in members):
+                holders[name] = []
+                grown = True
+    return holders
+
+
+def find_mutex_struct_copies(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report assignments and literals that copy a struct holding a mutex.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-112 findings in line order, one per copy.
+
+    """
+    if not config.is_enabled(MUTEX_COPY_PATTERN.id):
+        return []
+    aliases = [name for name, path in parse_go_imports(text).items() if path == "sync"]
+    if not aliases:
+        return []
+    mutex_re = re.compile(
+        "|".join(
+            r"(?:RW)?Mutex" if a == "." else re.escape(a) + r"\.(?:RW)?Mutex" for a in aliases
+        )
+    )
+    lines = _code_lines(text)
+    holders = _mutex_holders(lines, mutex_re)
+    if not holders:
+        return []
+
+    source_lines = text.split("\n")
+    findings: list[ScanFinding] = []
+    package_vars: dict[str, str] = {}  # name -> type, "*T" for pointers
+    local_vars: dict[str, str] = {}
+    in_func = in_var_block = False
+    for index, line in enumerate(lines):
+        if line.startswith("func"):
+            in_func, local_vars = not line.rstrip().endswith("}"), {}
+        elif _VAR_BLOCK_RE.match(line):
+            in_var_block = True
+        elif line.startswith(("}", ")")):
+            in_func = in_var_block = False
+        scope = local_vars if in_func else package_vars
+
+        copied = _COPY_RE.match(line)
+        if copied is not None:
+            var_name, target, deref, source = copied.groups()
+            source_type = local_vars.get(source) or package_vars.get(source)
+            if source_type is not None:
+                value_type = source_type[1:] if deref else source_type
+                if (deref == "") == (not source_type.startswith("*")) and value_type in holders:
+                    findings.append(
+                        builtin_finding(
+                            MUTEX_COPY_PATTERN,
+                            f"Assignment copies {deref}{source}, a {value_type} holding a mutex",
+                            rel_path,
+                            index + 1,
+                            source_lines[index],
+                            config,
+                        )
+                    )
+                if var_name or (target and "." not in target and not target.startswith("*")):
+                    scope[var_name or target] = value_type
+            continue
+
+        declared = _DECL_RE.match(line)
+        if declared is not None and (in_func or in_var_block or line.startswith("var")):
+            groups = [g for g in declared.groups() if g is not None]
+            name, marker, type_name = groups
+            if type_name in holders:
+                scope[name] = f"*{type_name}" if marker in ("*", "&", "new") else type_name
+
+    code = "\n".join(lines)
+    for type_name, mutexes in holders.items():
+        if not mutexes:
+            continue
+        literal_re = re.compile(
+            r"(?<![\w.])" + re.escape(type_name) + r"\{[^{}]*?(?<![\w.])("
+            + "|".join(re.escape(m) for m in mutexes)
+            + r")[ \t]*:[ \t]*(\*?[A-Za-z_]\w*(?:\.\w+)*\.\1)\b"
+        )
+        for literal in literal_re.finditer(code):
+            index = code.count("\n", 0, literal.start(1))
+            findings.append(
+                builtin_finding(
+                    MUTEX_COPY_PATTERN,
+                    f"{type_name} literal copies mutex {literal.group(1)} from "
+                    f"{literal.group(2)}",
+                    rel_path,
+                    index + 1,
+                    source_lines[index],
+                    config,
+                )
+            )
+    findings.sort(key=lambda f: f.line)
+    return findings
@@ -0,0 +1,187 @@
+"""Detection of lost updates to struct values in Go maps.
+
+Map elements are not addressable: ``m[k].count++`` does not compile, and the
+usual workaround copies the value out, which makes the update vanish unless
+the copy is stored back::
+
+    func (s *Stats) record(name string) {
+        entry := s.byName[name]
+        entry.count++ // CC-133: modifies a copy; s.byName[name] is unchanged
+    }
+
+Maps whose values are not pointers (declared in the file as variables,
+fields or parameters of type ``map[K]V``, or made with ``make(map[K]V)`` or
+a ``map[K]V{...}`` literal) are checked for:
+
+- field assignments on an element in place (``m[k].field = x``), which the
+  compiler rejects but which appear in code under review;
+- copies (``v := m[k]``, ``v, ok := m[k]``) whose fields are assigned and
+  then never used again in the function: not stored back with
+  ``m[k] = v``, returned or passed on.
+
+The fix is to store the copy back or to use a map of pointers
+(``map[K]*V``), whose elements can be updated in place.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for map element fields updated without storing back
+MAP_VALUE_MUTATION_PATTERN = Pattern(
+    id=PatternId("CC-133-CODE-GO"),
+    domain=ArtifactDomain.TRANSFORM,
+    signals=[],
+    severity=Severity.WARNING,
+    description="Field of a map element is updated in place or on a copy that is never stored",
+    remediation="Store the modified copy back (m[k] = v) or use a map of pointers (map[K]*V)",
+    language="go",
+    effort=PatternEffort.LOW,
+)
+
+# Map with its value type: `m map[string]Entry`, `m := make(map[string]Entry)`,
+# `m = map[string]Entry{...}`
+_MAP_DECL_RE = re.compile(
+    r"\b([A-Za-z_]\w*)[ \t]*(?::=|=)?[ \t]*(?:make\([ \t]*)?map\[[^\]\n]+\][ \t]*"
+    r"(\*?[A-Za-z_][\w.]*)(?![\w.\[])"
+)
+
+# Named pointer types: `type EntryRef *Entry`, `type EntryRef = *Entry`
+_POINTER_TYPE_RE = re.compile(r"^[ \t]*type[ \t]+([A-Za-z_]\w*)[ \t]+(?:=[ \t]*)?\*")
+
+# Field assignment: `v.count = 1`, `v.count += 1`, `v.count++`
+_FIELD_ASSIGN = (
+    r"\.[A-Za-z_]\w*(?:\.[A-Za-z_]\w*)*[ \t]*"
+    r"(?:(?:&\^|<<|>>|[-+*/%|&^])?=(?!=)|\+\+|--)"
+)
+
+# Top-level function declaration
+_FUNC_RE = re.compile(r"^func\b")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _value_maps(lines: list[str]) -> set[str]:
+    """Return names of maps in the file whose values are not pointers."""
+    pointer_types = {m.group(1) for line in lines if (m := _POINTER_TYPE_RE.match(line))}
+    names: set[str] = set()
+    for line in lines:
+        for match in _MAP_DECL_RE.finditer(line):
+            name, value = match.groups()
+            if name not in ("make", "map") and not value.startswith("*"):
+                if value.rsplit(".", 1)[-1] not in pointer_types:
+                    names.add(name)
+    return names
+
+
+def _function_end(lines: list[str], start: int) -> int:
+    """Return the index after the top-level function containing lines[start]."""
+    for index in range(start + 1, len(lines)):
+        if lines[index].startswith("}") or _FUNC_RE.match(lines[index]):
+            return index
+    return len(lines)
+
+
+def find_map_value_mutations(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report map element fields updated in place or on a copy never stored back.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-133 findings in line order.
+
+    """
+    if not config.is_enabled(MAP_VALUE_MUTATION_PATTERN.id):
+        return []
+    lines = _code_lines(text)
+    maps = _value_maps(lines)
+    if not maps:
+        return []
+    element = (
+        r"(?<![\w.])(?:[A-Za-z_]\w*\.)*(?:"
+        + "|".join(re.escape(m) for m in sorted(maps))
+        + r")\[[^\]\n]+\]"
+    )
+    in_place_re = re.compile(r"^[ \t]*(" + element + r")" + _FIELD_ASSIGN)
+    copy_re = re.compile(
+        r"^[ \t]*([A-Za-z_]\w*)(?:[ \t]*,[ \t]*[A-Za-z_]\w*)?[ \t]*:?=[ \t]*("
+        + element
+        + r")[ \t]*$"
+    )
+
+    source_lines = text.split("\n")
+    findings: list[ScanFinding] = []
+    for index, line in enumerate(lines):
+        in_place = in_place_re.match(line)
+        if in_place:
+            title = f"Field of map element {in_place.group(1)} assigned in place"
+            findings.append(
+                builtin_finding(
+                    MAP_VALUE_MUTATION_PATTERN,
+                    title,
+                    rel_path,
+                    index + 1,
+                    source_lines[index],
+                    config,
+                )
+            )
+            continue
+        copy = copy_re.match(line)
+        if copy is None:
+            continue
+        variable, source = copy.groups()
+        mutation = _lost_update(lines, index, variable)
+        if mutation is not None:
+            title = f"Copy of {source} is modified but never stored back"
+            findings.append(
+                builtin_finding(
+                    MAP_VALUE_MUTATION_PATTERN,
+                    title,
+                    rel_path,
+                    mutation + 1,
+                    source_lines[mutation],
+                    config,
+                )
+            )
+    findings.sort(key=lambda f: f.line)
+    return findings
+
+
+def _lost_update(lines: list[str], copy_index: int, variable: str) -> int | None:
+    """Return the line index of a field update to a copy never used after it."""
+    name = re.escape(variable)
+    assign_re = re.compile(r"^[ \t]*" + name + _FIELD_ASSIGN)
+    use_re = re.compile(r"(?<![\w.])" + name + r"\b")
+    first_mutation: int | None = None
+    for index in range(copy_index + 1, _function_end(lines, copy_index)):
+        line = lines[index]
+        if assign_re.match(line):
+            if first_mutation is None:
+                first_mutation = index
+            # The right-hand side may read the copy: `v.n = v.n + 1`
+            continue
+        if re.match(r"^[ \t]*" + name + r"[ \t]*(?:,[^=]*)?:?=(?!=)", line):
+            break  # the copy is replaced by a new value
+        if first_mutation is not None and use_re.search(line):
+            return None
+    return first_mutation
@@ -0,0 +1,236 @@
+"""Reduction of a source file to a minimal reproducer of one finding.
+
+When a detector misfires on a large file, a bug report or corpus entry
+needs the smallest snippet that still triggers it. ``minimize_source``
+delta-debugs the file: it removes declarations, statements and other
+lines, largest units first, and keeps a removal only while the target
+finding is still reported at the same source line::
+
+    target = parse_finding_target("CC-004@42")
+    result = minimize_source(text, target, lambda t: scanner.scan_source(t, "go"))
+    print(result.text)
+
+A unit is a line together with the block it opens, up to the line that
+closes it (``} else {`` lines continue the unit), so functions, ``if``
+statements, ``import (...)`` groups and struct types are removed whole,
+and their inner lines one by one on later passes. The package clause and
+the target line are always kept. Every candidate must keep its brackets
+balanced, so each step leaves a file that still parses as blocks; a
+candidate may fail to compile, for example when an import becomes unused.
+
+"""
+
+from __future__ import annotations
+
+import re
+from collections.abc import Callable
+from dataclasses import dataclass
+
+from bmad_assist.deep_verify.scan.config import matches_selector
+from bmad_assist.deep_verify.scan.types import ScanFinding
+
+# `CC-004@42`, `cc-004-code-go@42`
+_TARGET_RE = re.compile(r"^([A-Za-z]+-\d+(?:-[A-Za-z]+)*)@(\d+)$")
+
+_PACKAGE_RE = re.compile(r"^[ \t]*package[ \t]+\w")
+
+_OPENERS = "([{"
+_CLOSERS = ")]}"
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+@dataclass(frozen=True, slots=True)
+class FindingTarget:
+    """A finding to preserve while minimizing: a pattern selector at a line.
+
+    Attributes:
+        selector: Pattern ID or base ID (see ``matches_selector``).
+        line: 1-based line of the finding in the original source.
+
+    """
+
+    selector: str
+    line: int
+
+    def __str__(self) -> str:
+        """Return the target in ``<pattern>@<line>`` form."""
+        return f"{self.selector}@{self.line}"
+
+
+@dataclass(frozen=True, slots=True)
+class MinimizedSource:
+    """Result of minimize_source.
+
+    Attributes:
+        text: Reduced source.
+        lines: 1-based original line numbers of the kept lines, in order.
+        finding: The target finding as reported on the reduced source.
+        checks: Number of candidates scanned.
+
+    """
+
+    text: str
+    lines: tuple[int, ...]
+    finding: ScanFinding
+    checks: int
+
+
+def parse_finding_target(spec: str) -> FindingTarget:
+    """Parse a ``<pattern>@<line>`` target such as ``CC-004@42``.
+
+    Raises:
+        ValueError: If spec is not a pattern ID followed by ``@`` and a
+            positive line number.
+
+    """
+    match = _TARGET_RE.match(spec.strip())
+    if match is None or int(match.group(2)) < 1:
+        raise ValueError(f"Invalid finding target: {spec!r}. Use <pattern>@<line>, e.g. CC-004@42")
+    return FindingTarget(selector=match.group(1).upper(), line=int(match.group(2)))
+
+
+def _code_line(line: str) -> str:
+    """Return a line with literals blanked and its line comment removed."""
+    return _LITERAL_RE.sub('""', line).split("//", 1)[0]
+
+
+def _depth_change(line: str) -> tuple[int, int]:
+    """Return a line's net bracket depth change and its lowest running depth."""
+    depth = lowest = 0
+    for char in _code_line(line):
+        if char in _OPENERS:
+            depth += 1
+        elif char in _CLOSERS:
+            depth -= 1
+            lowest = min(lowest, depth)
+    return depth, lowest
+
+
+def _balanced(lines: list[str]) -> bool:
+    """Check that brackets never close more than they opened and all are closed."""
+    depth = 0
+    for line in lines:
+        change, lowest = _depth_change(line)
+        if depth + lowest < 0:
+            return False
+        depth += change
+    return depth == 0
+
+
+def _units(lines: list[str], keep: set[int]) -> list[list[int]]:
+    """Return the removable units of lines: each line with the block it opens.
+
+    Args:
+        lines: Current lines.
+        keep: Positions that no unit may contain.
+
+    Returns:
+        Units as lists of positions, largest first, then in line order.
+
+    """
+    changes = [_depth_change(line) for line in lines]
+    units: list[list[int]] = []
+    for start, line in enumerate(lines):
+        first = _code_line(line).lstrip()[:1]
+        if start in keep or (first and first in _CLOSERS):
+            continue
+        depth = 0
+        end = start
+        for end in range(start, len(lines)):
+            depth += changes[end][0]
+            if depth <= 0:
+                break
+        unit = list(range(start, end + 1))
+        if depth == 0 and not keep.intersection(unit):
+            units.append(unit)
+    units.sort(key=lambda unit: (-len(unit), unit[0]))
+    return units
+
+
+def minimize_source(
+    text: str,
+    target: FindingTarget,
+    scan: Callable[[str], list[ScanFinding]],
+) -> MinimizedSource:
+    """Reduce source to the smallest form in which the target finding is still reported.
+
+    Passes over the units of the current source, largest first, drop each
+    unit whose removal keeps the brackets balanced and the target finding
+    reported (by a matching pattern, at the target's original line), until
+    a pass removes nothing. The result is 1-minimal: removing any single
+    remaining unit loses the finding.
+
+    Args:
+        text: Source to reduce.
+        target: Finding to preserve.
+        scan: Returns the findings of a candidate source, such as
+            ``lambda t: scanner.scan_source(t, "go", "main.go")``.
+
+    Returns:
+        The reduced source and the target finding on it.
+
+    Raises:
+        ValueError: If the target finding is not reported on the original
+            source.
+
+    """
+    trailing_newline = text.endswith("\n")
+    original = text.split("\n")
+    if trailing_newline:
+        original.pop()
+    checks = 0
+
+    def render(kept: list[int]) -> str:
+        body = "\n".join(original[index] for index in kept)
+        return body + "\n" if trailing_newline else body
+
+    def reported(kept: list[int]) -> ScanFinding | None:
+        nonlocal checks
+        if not _balanced([original[index] for index in kept]):
+            return None
+        checks += 1
+        line = kept.index(target.line - 1) + 1
+        for finding in scan(render(kept)):
+            if finding.line == line and matches_selector(finding.pattern_id, target.selector):
+                return finding
+        return None
+
+    kept = list(range(len(original)))
+    finding = reported(kept) if target.line <= len(original) else None
+    if finding is None:
+        raise ValueError(f"Finding {target} is not reported on the source")
+
+    removed = True
+    while removed:
+        removed = False
+        fixed = {
+            position
+            for position, index in enumerate(kept)
+            if index == target.line - 1 or _PACKAGE_RE.match(original[index])
+        }
+        lines = [original[index] for index in kept]
+        gone: set[int] = set()
+        for unit in _units(lines, fixed):
+            if gone.intersection(kept[position] for position in unit):
+                continue
+            dropped = set(unit)
+            candidate = [
+                index
+                for position, index in enumerate(kept)
+                if index not in gone and position not in dropped
+            ]
+            found = reported(candidate)
+            if found is not None:
+                finding = found
+                gone.update(kept[position] for position in unit)
+                removed = True
+        kept = [index for index in kept if index not in gone]
+
+    return MinimizedSource(
+        text=render(kept),
+        lines=tuple(index + 1 for index in kept),
+        finding=finding,
+        checks=checks,
+    )
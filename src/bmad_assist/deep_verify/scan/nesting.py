@@ -0,0 +1,126 @@
+"""Detection of locks taken in a loop under an outer lock in Go scans.
+
+Locking each element of a collection while a lock on the collection is
+held keeps the outer lock for the whole loop, stalling every other user,
+and fixes a lock order that any path locking an element first and then
+the collection inverts::
+
+    func (r *Registry) Flush() {
+        r.mu.Lock()
+        defer r.mu.Unlock()
+        for _, s := range r.sessions {
+            s.mu.Lock() // CC-144: r.mu held for every iteration
+            s.flush()
+            s.mu.Unlock()
+        }
+    }
+
+Locks are tracked through each function in source order as for CC-137:
+``X.Lock()`` and ``X.RLock()`` hold ``X`` until a matching non-deferred
+unlock, and a deferred unlock holds it to the end of the function. A lock
+of another mutex inside a ``for`` body is reported when a mutex locked
+before the loop is still held. Whether the pattern is a hazard depends on
+the lock order elsewhere, so findings carry ``LOOP_LOCK_CONFIDENCE``.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for locks taken in a loop body while an outer lock is held
+LOOP_LOCK_PATTERN = Pattern(
+    id=PatternId("CC-144-CODE-GO"),
+    domain=ArtifactDomain.CONCURRENCY,
+    signals=[],
+    severity=Severity.INFO,
+    description="Lock taken in a loop while an outer lock is held - long hold, fixed lock order",
+    remediation="Copy what the loop needs under the outer lock, unlock, then lock each element",
+    language="go",
+    effort=PatternEffort.HIGH,
+)
+
+# Confidence of CC-144 findings: nested locking deadlocks only if another path
+# takes the two mutexes in the opposite order, which lies outside the function
+LOOP_LOCK_CONFIDENCE = 0.7
+
+# Lock or unlock call, possibly deferred: `c.mu.Lock()`, `defer mu.RUnlock()`
+_LOCK_RE = re.compile(
+    r"(?<![\w.])(defer[ \t]+)?((?:[A-Za-z_]\w*\.)*[A-Za-z_]\w*)\.(R?Lock|R?Unlock)\(\)"
+)
+
+# Loop header opening its body on the same line
+_FOR_RE = re.compile(r"^[ \t]*for\b.*\{[ \t]*$")
+
+_FUNC_RE = re.compile(r"^func\b")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def find_loop_locks(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report locks taken inside a loop while a lock taken before the loop is held.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-144 findings in line order, one per lock call, at
+        LOOP_LOCK_CONFIDENCE.
+
+    """
+    if not config.is_enabled(LOOP_LOCK_PATTERN.id):
+        return []
+    source_lines = text.split("\n")
+    findings: list[ScanFinding] = []
+    held: list[str] = []  # in locking order
+    loops: list[tuple[int, list[str]]] = []  # body depth, locks held on entry
+    depth = 0
+    for index, line in enumerate(_code_lines(text)):
+        if _FUNC_RE.match(line):
+            held, loops, depth = [], [], 0
+        for lock in _LOCK_RE.finditer(line):
+            deferred, mutex, method = lock.groups()
+            if method.endswith("Unlock"):
+                if not deferred and mutex in held:
+                    held.remove(mutex)
+                continue
+            if loops:
+                outer = [m for m in loops[-1][1] if m in held and m != mutex]
+                if outer:
+                    findings.append(
+                        builtin_finding(
+                            LOOP_LOCK_PATTERN,
+                            f"{mutex} locked in a loop while holding {outer[0]}",
+                            rel_path,
+                            index + 1,
+                            source_lines[index],
+                            config,
+                            LOOP_LOCK_CONFIDENCE,
+                        )
+                    )
+            if mutex not in held:
+                held.append(mutex)
+        depth += line.count("{") - line.count("}")
+        while loops and depth < loops[-1][0]:
+            loops.pop()
+        if _FOR_RE.match(line):
+            loops.append((depth, list(held)))
+    return findings
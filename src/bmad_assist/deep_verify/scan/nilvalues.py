@@ -0,0 +1,212 @@
+"""Detection of dereferences of possibly-nil values read from Go maps.
+
+Indexing a map with an absent key yields the zero value of its element
+type, which is nil for pointers and interfaces. Calling a method on it or
+reading a field panics at run time::
+
+    func (s *Server) dispatch(name string, req *Request) error {
+        return s.handlers[name].Serve(req) // CC-152: nil if name is unknown
+    }
+
+Maps whose values are pointers or interfaces (declared in the file as
+variables, fields or parameters of type ``map[K]*V``, ``map[K]any``,
+``map[K]error`` or ``map[K]I`` for a pointer or interface type ``I``
+declared in the file) are checked for:
+
+- selectors on an element (``m[k].Method()``, ``m[k].field``);
+- selectors on a single-value copy (``v := m[k]``) before ``v`` is
+  compared with nil.
+
+Elements are not reported when the function has already checked the same
+element with a comma-ok read (``_, ok := m[k]``) or a nil comparison, or
+when ``k`` is the key of a ``range`` over the map. Comma-ok copies
+(``v, ok := m[k]``) are never reported.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for selectors on map elements that are nil for absent keys
+NIL_MAP_VALUE_PATTERN = Pattern(
+    id=PatternId("CC-152-CODE-GO"),
+    domain=ArtifactDomain.TRANSFORM,
+    signals=[],
+    severity=Severity.WARNING,
+    description="Pointer or interface read from a map is dereferenced without a presence check",
+    remediation="Read the element with v, ok := m[k] and handle a missing key before using v",
+    language="go",
+    effort=PatternEffort.LOW,
+)
+
+# Confidence of CC-152 findings: an absent key yields a nil element, but keys a
+# constructor or init function fills in are known present and are not tracked
+NIL_MAP_VALUE_CONFIDENCE = 0.7
+
+# Map with its value type: `m map[string]*Handler`, `m := make(map[string]any)`
+_MAP_DECL_RE = re.compile(
+    r"\b([A-Za-z_]\w*)[ \t]*(?::=|=)?[ \t]*(?:make\([ \t]*)?map\[[^\]\n]+\][ \t]*"
+    r"(\*|interface[ \t]*\{[ \t]*\}|[A-Za-z_][\w.]*(?![\w.\[]))"
+)
+
+# Named pointer and interface types: `type Ref *Entry`, `type Handler interface {`
+_NILABLE_TYPE_RE = re.compile(
+    r"^[ \t]*type[ \t]+([A-Za-z_]\w*)[ \t]+(?:=[ \t]*)?(?:\*|interface[ \t]*\{)"
+)
+
+# Predeclared interface types
+_BUILTIN_INTERFACES = frozenset({"any", "error"})
+
+# Top-level function declaration
+_FUNC_RE = re.compile(r"^func\b")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _nilable_maps(lines: list[str]) -> set[str]:
+    """Return names of maps in the file whose values are pointers or interfaces."""
+    nilable_types = {m.group(1) for line in lines if (m := _NILABLE_TYPE_RE.match(line))}
+    nilable_types |= _BUILTIN_INTERFACES
+    names: set[str] = set()
+    for line in lines:
+        for match in _MAP_DECL_RE.finditer(line):
+            name, value = match.groups()
+            if name in ("make", "map"):
+                continue
+            if value == "*" or value.startswith("interface") or value in nilable_types:
+                names.add(name)
+    return names
+
+
+def _function_bounds(lines: list[str], index: int) -> tuple[int, int]:
+    """Return the line range of the top-level function containing lines[index]."""
+    start = index
+    while start > 0 and not _FUNC_RE.match(lines[start]):
+        start -= 1
+    for end in range(index + 1, len(lines)):
+        if lines[end].startswith("}") or _FUNC_RE.match(lines[end]):
+            return start, end
+    return start, len(lines)
+
+
+def _checked(code: str, element: str, name: str, key: str) -> bool:
+    """Return whether code checks element's presence or ranges over its map by key."""
+    escaped = re.escape(element)
+    if re.search(rf",[ \t]*[A-Za-z_]\w*[ \t]*:?=[ \t]*{escaped}", code):
+        return True
+    if re.search(rf"{escaped}[ \t]*[!=]=[ \t]*nil\b|\bnil[ \t]*[!=]=[ \t]*{escaped}", code):
+        return True
+    range_re = rf"\bfor[ \t]+{re.escape(key)}\b[^\n]*:=[ \t]*range[ \t]+[\w.]*\b{name}[ \t]*\{{"
+    return re.search(range_re, code) is not None
+
+
+def find_nil_map_values(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report selectors on pointer or interface map elements without a presence check.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-152 findings in line order, one per unchecked element or copy.
+
+    """
+    if not config.is_enabled(NIL_MAP_VALUE_PATTERN.id):
+        return []
+    lines = _code_lines(text)
+    maps = _nilable_maps(lines)
+    if not maps:
+        return []
+    names = "|".join(re.escape(m) for m in sorted(maps))
+    element = rf"(?<![\w.])((?:[A-Za-z_]\w*\.)*({names})\[([^\[\]\n]+)\])"
+    selector_re = re.compile(element + r"\.([A-Za-z_]\w*)[ \t]*(\()?")
+    copy_re = re.compile(r"^[ \t]*([A-Za-z_]\w*)[ \t]*:?=[ \t]*" + element + r"[ \t]*$")
+
+    source_lines = text.split("\n")
+    findings: list[ScanFinding] = []
+    for index, line in enumerate(lines):
+        for use in selector_re.finditer(line):
+            source, name, key, selector, call = use.groups()
+            start, _ = _function_bounds(lines, index)
+            before = "\n".join([*lines[start:index], line[: use.start()]])
+            if _checked(before, source, name, key.strip()):
+                continue
+            title = _title(selector, call is not None, source)
+            findings.append(
+                builtin_finding(
+                    NIL_MAP_VALUE_PATTERN,
+                    title,
+                    rel_path,
+                    index + 1,
+                    source_lines[index],
+                    config,
+                    NIL_MAP_VALUE_CONFIDENCE,
+                )
+            )
+        copy = copy_re.match(line)
+        if copy is None:
+            continue
+        variable, source, name, key = copy.groups()
+        start, end = _function_bounds(lines, index)
+        if _checked("\n".join(lines[start:index]), source, name, key.strip()):
+            continue
+        unchecked = _unchecked_use(lines[index + 1 : end], variable)
+        if unchecked is not None:
+            offset, use = unchecked
+            title = _title(use.group(1), use.group(2) is not None, f"{variable} from {source}")
+            use_index = index + 1 + offset
+            findings.append(
+                builtin_finding(
+                    NIL_MAP_VALUE_PATTERN,
+                    title,
+                    rel_path,
+                    use_index + 1,
+                    source_lines[use_index],
+                    config,
+                    NIL_MAP_VALUE_CONFIDENCE,
+                )
+            )
+    findings.sort(key=lambda f: f.line)
+    return findings
+
+
+def _unchecked_use(lines: list[str], variable: str) -> tuple[int, re.Match[str]] | None:
+    """Return the first selector on a copy, with its line offset, before a nil check."""
+    name = re.escape(variable)
+    nil_check_re = re.compile(
+        rf"(?<![\w.]){name}[ \t]*[!=]=[ \t]*nil\b|\bnil[ \t]*[!=]=[ \t]*{name}\b"
+    )
+    reassign_re = re.compile(rf"^[ \t]*{name}[ \t]*(?:,[^=]*)?:?=(?!=)")
+    selector_re = re.compile(rf"(?<![\w.]){name}\.([A-Za-z_]\w*)[ \t]*(\()?")
+    for offset, line in enumerate(lines):
+        if nil_check_re.search(line) or reassign_re.match(line):
+            return None
+        use = selector_re.search(line)
+        if use is not None:
+            return offset, use
+    return None
+
+
+def _title(selector: str, call: bool, source: str) -> str:
+    """Return the title of a finding for a method call or field access."""
+    if call:
+        return f"Method {selector} called on {source} without a presence check"
+    return f"Field {selector} of {source} used without a presence check"
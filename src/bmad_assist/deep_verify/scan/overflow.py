@@ -0,0 +1,181 @@
+"""Detection of unchecked size arithmetic in Go allocations.
+
+Sizes computed from values read off the wire can overflow: a header that
+declares ``count`` records of ``width`` bytes makes ``count*width`` wrap to
+a small number, or a huge one exhausts memory::
+
+    func decode(hdr Header, r io.Reader) ([]byte, error) {
+        buf := make([]byte, hdr.Count*hdr.Width) // CC-135: no bounds check
+        _, err := io.ReadFull(r, buf)
+        return buf, err
+    }
+
+A ``make`` length or capacity is reported when it multiplies or adds a
+variable: an operand that is not a literal, a ``len`` or ``cap`` call, a
+constant declared in the file or a name qualified by an imported package
+(``math.MaxInt32``). Allocations are safe when the function compares one of
+those variables (``if n > maxRecords``) or clamps it with ``min`` before
+the ``make``::
+
+    if hdr.Count > maxRecords || hdr.Width > maxWidth {
+        return nil, errTooLarge
+    }
+    buf := make([]byte, hdr.Count*hdr.Width)
+
+Bounds checked by a caller are not seen, so findings carry
+``SIZE_OVERFLOW_CONFIDENCE``; a ``--threshold`` above it drops them.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for make sizes computed without a bounds check
+SIZE_OVERFLOW_PATTERN = Pattern(
+    id=PatternId("CC-135-CODE-GO"),
+    domain=ArtifactDomain.SECURITY,
+    signals=[],
+    severity=Severity.INFO,
+    description="Allocation size multiplies or adds unchecked values - it can overflow",
+    remediation="Check each operand against a maximum before computing the size",
+    language="go",
+    effort=PatternEffort.MEDIUM,
+)
+
+# Confidence of CC-135 findings: operands count as untrusted unless the same
+# function bounds them, though many were validated before the call
+SIZE_OVERFLOW_CONFIDENCE = 0.7
+
+_MAKE_RE = re.compile(r"(?<![\w.])make\(")
+
+# Binary * or + between operands (not a pointer type or unary plus)
+_ARITHMETIC_RE = re.compile(r"[\w)\]][ \t]*[*+][ \t]*[\w(]")
+
+# len and cap calls, whose results are bounded by memory already held
+_LEN_RE = re.compile(r"(?<![\w.])(?:len|cap)\([^()]*\)")
+
+# Identifiers and selectors that are not called
+_OPERAND_RE = re.compile(r"(?<![\w.])([A-Za-z_]\w*(?:\.[A-Za-z_]\w*)*)(?![\w.]|[ \t]*\()")
+
+# Constants: `const maxSize = 1 << 20` and names inside `const ( ... )`
+_CONST_RE = re.compile(r"^[ \t]*const[ \t]+([A-Za-z_]\w*)")
+_CONST_BLOCK_RE = re.compile(r"^[ \t]*const[ \t]*\([ \t]*$")
+_BLOCK_NAME_RE = re.compile(r"^[ \t]*([A-Za-z_]\w*)\b")
+
+_FUNC_RE = re.compile(r"^func\b")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _constants(lines: list[str]) -> set[str]:
+    """Return the names of constants declared in the file."""
+    names: set[str] = set()
+    in_block = False
+    for line in lines:
+        if in_block:
+            if line.strip().startswith(")"):
+                in_block = False
+            elif match := _BLOCK_NAME_RE.match(line):
+                names.add(match.group(1))
+        elif _CONST_BLOCK_RE.match(line):
+            in_block = True
+        elif match := _CONST_RE.match(line):
+            names.add(match.group(1))
+    return names
+
+
+def _call_args(line: str, open_paren: int) -> list[str]:
+    """Return the top-level arguments of the call whose "(" is at open_paren."""
+    args: list[str] = []
+    depth = 0
+    start = open_paren + 1
+    for position in range(open_paren, len(line)):
+        char = line[position]
+        if char in "([{":
+            depth += 1
+        elif char in ")]}":
+            depth -= 1
+            if depth == 0:
+                args.append(line[start:position])
+                return args
+        elif char == "," and depth == 1:
+            args.append(line[start:position])
+            start = position + 1
+    return []  # call continues on the next line
+
+
+def find_size_overflows(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report make sizes that multiply or add variables without a bounds check.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-135 findings in line order, one per size argument.
+
+    """
+    if not config.is_enabled(SIZE_OVERFLOW_PATTERN.id):
+        return []
+    lines = _code_lines(text)
+    constants = _constants(lines)
+    packages = set(parse_go_imports(text))
+    source_lines = text.split("\n")
+    findings: list[ScanFinding] = []
+    func_start = 0
+    for index, line in enumerate(lines):
+        if _FUNC_RE.match(line):
+            func_start = index
+        for make in _MAKE_RE.finditer(line):
+            for size in _call_args(line, make.end() - 1)[1:]:
+                size = size.strip()
+                if not _ARITHMETIC_RE.search(size):
+                    continue
+                variables = {
+                    name
+                    for name in _OPERAND_RE.findall(_LEN_RE.sub("0", size))
+                    if name not in constants and name.split(".", 1)[0] not in packages
+                }
+                if variables and not _checked(lines[func_start:index], variables):
+                    findings.append(
+                        builtin_finding(
+                            SIZE_OVERFLOW_PATTERN,
+                            f"make size {size} may overflow without a bounds check",
+                            rel_path,
+                            index + 1,
+                            source_lines[index],
+                            config,
+                            SIZE_OVERFLOW_CONFIDENCE,
+                        )
+                    )
+    return findings
+
+
+def _checked(lines: list[str], variables: set[str]) -> bool:
+    """Return whether lines compare or clamp any of the variables."""
+    names = "|".join(re.escape(v) for v in sorted(variables))
+    guard_re = re.compile(
+        r"\b(?:if|for|case)\b.*(?:(?<![\w.])(?:" + names + r")(?![\w.])[^{]*[<>]"
+        r"|[<>][^{]*(?<![\w.])(?:" + names + r")(?![\w.]))"
+        r"|(?<![\w.])min\([^)]*(?<![\w.])(?:" + names + r")(?![\w.])"
+    )
+    return any(guard_re.search(line) for line in lines)
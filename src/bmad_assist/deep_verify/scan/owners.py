@@ -0,0 +1,364 @@
+"""CODEOWNERS routing for Deep Verify scans.
+
+Findings are attributed to teams with the repository's CODEOWNERS file, in
+GitHub or GitLab syntax, and split into one report per owner so each team
+receives only its own findings.
+
+Matching follows the CODEOWNERS rules:
+    - The last matching rule wins; a matching rule without owners leaves the
+      path unowned.
+    - ``*`` and ``?`` match within a path segment, ``**`` across segments.
+    - A leading ``/`` (or any ``/`` before the last character) anchors the
+      pattern to the repository root; other patterns match at any depth.
+    - A trailing ``/`` matches everything under a directory, as does a
+      pattern whose last segment has no wildcard. ``docs/*`` matches only
+      files directly in ``docs``.
+    - GitLab ``[Section]`` headers start independent rule sets whose owners
+      combine. Section default owners apply to rules listed without owners.
+
+Example:
+    >>> from pathlib import Path
+    >>> from bmad_assist.deep_verify.scan import CodeOwners, Scanner, write_owner_reports
+    >>> report = Scanner().scan(Path("."))
+    >>> owners = CodeOwners.load(Path(".github/CODEOWNERS"))
+    >>> write_owner_reports(report, owners, Path("reports/by-owner"))
+
+"""
+
+from __future__ import annotations
+
+import json
+import re
+from dataclasses import dataclass
+from functools import lru_cache
+from pathlib import Path
+
+from bmad_assist.deep_verify.scan.types import ScanReport, scan_report_json
+
+# Where GitHub and GitLab look for CODEOWNERS, relative to the repository root
+CODEOWNERS_LOCATIONS = (".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS", ".gitlab/CODEOWNERS")
+
+# Report key for files no rule assigns an owner
+UNOWNED = "unowned"
+
+# Name of the routing index written next to the owner reports
+OWNER_INDEX_FILENAME = "index.json"
+
+# Pattern and owner tokens: runs of non-space characters or escaped spaces
+_TOKEN_RE = re.compile(r"(?:\\.|\S)+")
+
+# GitLab section header: [Name], ^[Name] (optional), [Name][2] (approvals)
+_SECTION_RE = re.compile(r"^\^?\[([^\]]+)\](?:\[\d+\])?(.*)$")
+
+# Characters kept in owner report file names
+_FILENAME_UNSAFE_RE = re.compile(r"[^\w.-]+")
+
+
+@dataclass(frozen=True, slots=True)
+class OwnerRule:
+    """A CODEOWNERS line assigning owners to a path pattern.
+
+    Attributes:
+        pattern: Path pattern as written (escapes removed).
+        owners: Owners such as "@org/team" or "dev@example.com" (empty =
+            explicitly unowned).
+        section: GitLab section name ("" outside sections).
+        line: 1-based line number in the CODEOWNERS file.
+
+    """
+
+    pattern: str
+    owners: tuple[str, ...]
+    section: str = ""
+    line: int = 0
+
+    def matches(self, path: str) -> bool:
+        """Return whether a repository-relative POSIX path matches the pattern."""
+        return _pattern_regex(self.pattern).fullmatch(path) is not None
+
+
+@lru_cache(maxsize=1024)
+def _pattern_regex(pattern: str) -> re.Pattern[str]:
+    """Translate a CODEOWNERS pattern to a regex over repository paths."""
+    dir_only = pattern.endswith("/")
+    body = pattern.strip("/")
+    anchored = pattern.startswith("/") or "/" in body
+
+    parts: list[str] = []
+    i = 0
+    while i < len(body):
+        if body.startswith("**/", i):
+            parts.append("(?:.*/)?")
+            i += 3
+        elif body.startswith("**", i):
+            parts.append(".*")
+            i += 2
+        elif body[i] == "*":
+            parts.append("[^/]*")
+            i += 1
+        elif body[i] == "?":
+            parts.append("[^/]")
+            i += 1
+        else:
+            parts.append(re.escape(body[i]))
+            i += 1
+
+    prefix = "" if anchored else "(?:.*/)?"
+    last_segment = body.rsplit("/", 1)[-1]
+    if dir_only:
+        suffix = "/.*"
+    elif any(c in last_segment for c in "*?"):
+        suffix = ""
+    else:
+        suffix = "(?:/.*)?"
+    return re.compile(f"{prefix}{''.join(parts)}{suffix}", re.DOTALL)
+
+
+def _unescape(token: str) -> str:
+    return re.sub(r"\\(.)", r"\1", token)
+
+
+def parse_codeowners(text: str) -> list[OwnerRule]:
+    """Parse CODEOWNERS text into rules, in file order.
+
+    Args:
+        text: CODEOWNERS contents.
+
+    Returns:
+        Rules with section default owners already applied.
+
+    """
+    rules: list[OwnerRule] = []
+    section = ""
+    section_owners: tuple[str, ...] = ()
+    for number, raw in enumerate(text.splitlines(), start=1):
+        line = raw.strip()
+        if not line or line.startswith("#"):
+            continue
+
+        header = _SECTION_RE.match(line)
+        if header is not None:
+            section = header.group(1).strip()
+            section_owners = tuple(_owner_tokens(header.group(2)))
+            continue
+
+        tokens = _TOKEN_RE.findall(line)
+        owners = tuple(_owner_tokens(" ".join(tokens[1:])))
+        if section and not owners:
+            owners = section_owners
+        rules.append(OwnerRule(_unescape(tokens[0]), owners, section, number))
+    return rules
+
+
+def _owner_tokens(text: str) -> list[str]:
+    """Return the owners in the rest of a line, up to an inline comment."""
+    owners: list[str] = []
+    for token in _TOKEN_RE.findall(text):
+        if token.startswith("#"):
+            break
+        owners.append(token)
+    return owners
+
+
+class CodeOwners:
+    """Owners of repository paths from a CODEOWNERS file.
+
+    Attributes:
+        rules: Rules in file order.
+        root: Repository root the patterns are relative to, if known.
+
+    """
+
+    def __init__(self, rules: list[OwnerRule], root: Path | None = None) -> None:
+        """Initialize from parsed rules.
+
+        Args:
+            rules: Rules in file order.
+            root: Repository root the patterns are relative to.
+
+        """
+        self.rules = rules
+        self.root = root
+
+    def __repr__(self) -> str:
+        """Return a string representation of the owners file."""
+        return f"CodeOwners(rules={len(self.rules)}, root={self.root!r})"
+
+    @classmethod
+    def load(cls, path: Path) -> CodeOwners:
+        """Load a CODEOWNERS file.
+
+        The repository root is the file's directory, or its parent for files
+        in ``.github``, ``.gitlab`` or ``docs``.
+
+        Raises:
+            OSError: If the file cannot be read.
+
+        """
+        root = path.parent
+        if root.name in (".github", ".gitlab", "docs"):
+            root = root.parent
+        return cls(parse_codeowners(path.read_text(encoding="utf-8")), root)
+
+    def prefix_for(self, directory: Path) -> str:
+        """Return a scan directory relative to the repository root.
+
+        Returns:
+            POSIX path for split_by_owner ("" for the root itself, or when the
+            directory is outside the repository or the root is unknown).
+
+        """
+        if self.root is None:
+            return ""
+        try:
+            prefix = directory.resolve().relative_to(self.root.resolve()).as_posix()
+        except ValueError:
+            return ""
+        return "" if prefix == "." else prefix
+
+    def owners_for(self, path: str) -> tuple[str, ...]:
+        """Return the owners of a repository-relative POSIX path.
+
+        Within each section the last matching rule wins; owners of different
+        sections are combined in section order.
+        """
+        winners: dict[str, OwnerRule] = {}
+        for rule in self.rules:
+            if rule.matches(path):
+                winners[rule.section.lower()] = rule
+
+        owners: list[str] = []
+        for rule in winners.values():
+            for owner in rule.owners:
+                if owner not in owners:
+                    owners.append(owner)
+        return tuple(owners)
+
+
+def find_codeowners(start: Path) -> Path | None:
+    """Find the CODEOWNERS file governing a directory.
+
+    Searches the standard locations in the directory and each ancestor,
+    stopping at the repository root (the first directory containing ``.git``).
+
+    Returns:
+        Path to the CODEOWNERS file, or None if there is none.
+
+    """
+    start = start.resolve()
+    for directory in (start, *start.parents):
+        for location in CODEOWNERS_LOCATIONS:
+            candidate = directory / location
+            if candidate.is_file():
+                return candidate
+        if (directory / ".git").exists():
+            break
+    return None
+
+
+def split_by_owner(
+    report: ScanReport, owners: CodeOwners, prefix: str = ""
+) -> dict[str, ScanReport]:
+    """Split a scan report into one report per owner.
+
+    Files with several owners appear in each of their reports; files without
+    owners are collected under UNOWNED.
+
+    Args:
+        report: Scan report to split.
+        owners: Owners of the repository.
+        prefix: Path of the scan root relative to the repository root, for
+            scans of a subdirectory (see CodeOwners.prefix_for).
+
+    Returns:
+        Reports keyed by owner, sorted by owner with UNOWNED last.
+
+    """
+    prefix = prefix.strip("/")
+    cache: dict[str, tuple[str, ...]] = {}
+
+    def owners_of(rel_path: str) -> tuple[str, ...]:
+        if rel_path not in cache:
+            repo_path = f"{prefix}/{rel_path}" if prefix else rel_path
+            cache[rel_path] = owners.owners_for(repo_path) or (UNOWNED,)
+        return cache[rel_path]
+
+    reports: dict[str, ScanReport] = {}
+
+    def report_for(owner: str) -> ScanReport:
+        if owner not in reports:
+            reports[owner] = ScanReport(
+                root=report.root,
+                started_at=report.started_at,
+                duration_ms=report.duration_ms,
+                issue_grouping=report.issue_grouping,
+                labels=report.labels,
+            )
+        return reports[owner]
+
+    for rel_path in report.files_scanned:
+        for owner in owners_of(rel_path):
+            owned = report_for(owner)
+            owned.files_scanned.append(rel_path)
+            if rel_path in report.file_packages:
+                owned.file_packages[rel_path] = report.file_packages[rel_path]
+    for rel_path in report.skipped_large_files:
+        for owner in owners_of(rel_path):
+            report_for(owner).skipped_large_files.append(rel_path)
+    for finding in report.findings:
+        for owner in owners_of(finding.path):
+            report_for(owner).findings.append(finding)
+
+    return dict(sorted(reports.items(), key=lambda item: (item[0] == UNOWNED, item[0])))
+
+
+def owner_report_filename(owner: str) -> str:
+    """Return the report file name for an owner ("@org/team" -> "org-team.json")."""
+    name = _FILENAME_UNSAFE_RE.sub("-", owner.lstrip("@")).strip("-.")
+    return f"{name or UNOWNED}.json"
+
+
+def write_owner_reports(
+    report: ScanReport, owners: CodeOwners, out_dir: Path, prefix: str = ""
+) -> dict[str, Path]:
+    """Write one JSON scan report per owner plus a routing index.
+
+    The index (``index.json``) maps each owner, in sorted order, to its
+    report file and counts (suppressed findings are not counted). Owners whose names reduce
+    to the same file name get numbered suffixes.
+
+    Args:
+        report: Scan report to split.
+        owners: Owners of the repository.
+        out_dir: Directory for the reports (created if missing).
+        prefix: Path of the scan root relative to the repository root.
+
+    Returns:
+        Report file written for each owner.
+
+    Raises:
+        OSError: If a report cannot be written.
+
+    """
+    out_dir.mkdir(parents=True, exist_ok=True)
+    written: dict[str, Path] = {}
+    index: dict[str, dict[str, object]] = {}
+    for owner, owned in split_by_owner(report, owners, prefix).items():
+        filename = owner_report_filename(owner)
+        stem, suffix = filename.removesuffix(".json"), 2
+        while filename in {p.name for p in written.values()}:
+            filename = f"{stem}-{suffix}.json"
+            suffix += 1
+        path = out_dir / filename
+        path.write_text(scan_report_json(owned) + "\n", encoding="utf-8")
+        written[owner] = path
+        index[owner] = {
+            "report": path.name,
+            "files": len(owned.files_scanned),
+            "findings": len(owned.unsuppressed_findings()),
+        }
+
+    index_path = out_dir / OWNER_INDEX_FILENAME
+    index_data = {"owners": dict(sorted(index.items()))}
+    index_path.write_text(json.dumps(index_data, indent=2) + "\n", encoding="utf-8")
+    return written
@@ -0,0 +1,73 @@
+"""Package attribution for scanned files.
+
+Go files are attributed to their import path: the ``module`` path from the
+nearest ``go.mod`` joined with the file's directory relative to it. Files of
+other languages, and Go files outside any module, are attributed to their
+directory relative to the scan root.
+"""
+
+from __future__ import annotations
+
+import re
+from pathlib import Path, PurePosixPath
+
+# "module example.com/app" line in go.mod (path may be quoted)
+_MODULE_RE = re.compile(r'^\s*module\s+"?([^\s"]+)"?', re.MULTILINE)
+
+
+def directory_package(rel_path: str) -> str:
+    """Return the directory of a relative file path ("." for the root)."""
+    return str(PurePosixPath(rel_path).parent)
+
+
+class PackageResolver:
+    """Resolve the package of scanned files.
+
+    Attributes:
+        _modules: Cache of directory -> (module dir, module path) or None.
+
+    """
+
+    def __init__(self) -> None:
+        """Initialize an empty resolver."""
+        self._modules: dict[Path, tuple[Path, str] | None] = {}
+
+    def resolve(self, path: Path, rel_path: str, language: str | None) -> str:
+        """Return the package of a file.
+
+        Args:
+            path: File on disk.
+            rel_path: Path relative to the scan root.
+            language: Detected language of the file.
+
+        Returns:
+            Go import path, or the directory relative to the scan root.
+
+        """
+        if language == "go":
+            module = self._module_for(path.resolve().parent)
+            if module is not None:
+                module_dir, module_path = module
+                rel_dir = path.resolve().parent.relative_to(module_dir).as_posix()
+                return module_path if rel_dir == "." else f"{module_path}/{rel_dir}"
+        return directory_package(rel_path)
+
+    def _module_for(self, directory: Path) -> tuple[Path, str] | None:
+        """Find the nearest go.mod at or above a directory."""
+        if directory in self._modules:
+            return self._modules[directory]
+
+        module: tuple[Path, str] | None = None
+        go_mod = directory / "go.mod"
+        if go_mod.is_file():
+            try:
+                match = _MODULE_RE.search(go_mod.read_text(encoding="utf-8", errors="replace"))
+            except OSError:
+                match = None
+            if match is not None:
+                module = (directory, match.group(1))
+        if module is None and directory.parent != directory:
+            module = self._module_for(directory.parent)
+
+        self._modules[directory] = module
+        return module
@@ -0,0 +1,164 @@
+"""Detection of panic and recover used as control flow in Go scans.
+
+Recovering a panic and switching on the recovered value turns ``panic`` into
+a non-local goto: callees unwind to a distant caller that decides what
+happens next, invisible in every signature in between::
+
+    defer func() {
+        if r := recover(); r != nil {
+            switch e := r.(type) { // CC-132: routes on the recovered value
+            case stopWalk:
+                err = nil
+            case walkError:
+                err = e.err
+            default:
+                panic(r)
+            }
+        }
+    }()
+
+A ``switch`` on the value bound from ``recover()``, as a type switch or an
+expression switch, is reported when one of its cases does more than log or
+re-panic. Recovering to log the crash is safe::
+
+    if r := recover(); r != nil {
+        switch r.(type) {
+        case error:
+            log.Printf("worker crashed: %v", r)
+        default:
+            panic(r)
+        }
+    }
+
+The check is a heuristic: recursive-descent parsers use this idiom on
+purpose. Findings carry a low fixed confidence
+(``PANIC_ROUTE_CONFIDENCE``), and deliberate uses are silenced with a
+``deepverify:ignore CC-132`` comment giving a ``reason``.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for switches routing on a recovered panic value
+PANIC_ROUTE_PATTERN = Pattern(
+    id=PatternId("CC-132-CODE-GO"),
+    domain=ArtifactDomain.TRANSFORM,
+    signals=[],
+    severity=Severity.INFO,
+    description="Recovered panic value drives a switch - panic is being used as control flow",
+    remediation="Return errors (or a sentinel) through the call chain instead of panicking",
+    language="go",
+    effort=PatternEffort.HIGH,
+)
+
+# Confidence of CC-132 findings: recursive-descent parsers and tree walkers
+# route on recovered values by design, so a finding asks for review, not a fix
+PANIC_ROUTE_CONFIDENCE = 0.6
+
+# Recovered value: `r := recover()`, `if r := recover(); r != nil {`
+_RECOVER_RE = re.compile(r"\b([A-Za-z_]\w*)[ \t]*:?=[ \t]*recover\(\)")
+
+# Statements that only report the panic: log calls and re-panics
+_LOGGING_RE = re.compile(
+    r"^(?:(?:[A-Za-z_]\w*\.)*(?:[A-Za-z_]\w*[lL]og\w*|[lL]og\w*|slog|fmt)\."
+    r"\w+\(|(?:[A-Za-z_]\w*\.)*(?:Print|Printf|Println|Fprint|Fprintf|Fprintln)\(|panic\()"
+)
+
+# Case labels, optionally followed by a statement on the same line
+_CASE_RE = re.compile(r"^(?:case\b[^:]*|default[ \t]*):")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _routes(body: list[str]) -> bool:
+    """Return whether a switch body has a statement other than logging or panic."""
+    for line in body:
+        statement = _CASE_RE.sub("", line.strip(), count=1).strip().rstrip("{}").strip()
+        if statement and not _LOGGING_RE.match(statement):
+            return True
+    return False
+
+
+def find_panic_routes(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report switches that route control flow on a recovered panic value.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-132 findings in line order, one per switch.
+
+    """
+    if not config.is_enabled(PANIC_ROUTE_PATTERN.id):
+        return []
+    lines = _code_lines(text)
+    source_lines = text.split("\n")
+    reported: set[int] = set()
+    findings: list[ScanFinding] = []
+    for index, line in enumerate(lines):
+        recovered = _RECOVER_RE.search(line)
+        if recovered is None:
+            continue
+        name = re.escape(recovered.group(1))
+        switch_re = re.compile(
+            r"^[ \t]*switch[ \t]+(?:[A-Za-z_]\w*[ \t]*:=[ \t]*)?"
+            + name
+            + r"(?:\.\(type\))?[ \t]*\{[ \t]*$"
+        )
+        # Scan the rest of the block holding the recover call
+        depth = 0
+        for number in range(index, len(lines)):
+            if number > index and switch_re.match(lines[number]) and number not in reported:
+                body = _switch_body(lines, number)
+                if _routes(body):
+                    reported.add(number)
+                    findings.append(
+                        builtin_finding(
+                            PANIC_ROUTE_PATTERN,
+                            f"Switch on recovered value {recovered.group(1)} uses panic as "
+                            "control flow",
+                            rel_path,
+                            number + 1,
+                            source_lines[number],
+                            config,
+                            PANIC_ROUTE_CONFIDENCE,
+                        )
+                    )
+            depth += lines[number].count("{") - lines[number].count("}")
+            if depth < 0:
+                break
+    findings.sort(key=lambda f: f.line)
+    return findings
+
+
+def _switch_body(lines: list[str], start: int) -> list[str]:
+    """Return the lines of the switch opening on lines[start], up to its closing brace."""
+    depth = 0
+    body: list[str] = []
+    for number in range(start, len(lines)):
+        depth += lines[number].count("{") - lines[number].count("}")
+        if number > start:
+            if depth <= 0:
+                break
+            body.append(lines[number])
+    return body
@@ -0,0 +1,189 @@
+"""Detection of file paths built with Sprintf or string concatenation in Go.
+
+Joining path elements by hand hardcodes the separator and doubles it when
+an element already ends in one (``"out/" + "/" + name``); ``filepath.Join``
+uses the platform separator and cleans the result::
+
+    func load(dir, name string) ([]byte, error) {
+        path := fmt.Sprintf("%s/%s", dir, name)
+        return os.ReadFile(path) // CC-139: use filepath.Join(dir, name)
+    }
+
+A path argument of an ``os`` (or ``io/ioutil``) file function such as
+``os.Open``, ``os.ReadFile`` or ``os.MkdirAll`` is reported when it is a
+``fmt.Sprintf`` call whose format contains ``/`` or a ``+`` concatenation
+with a string literal containing ``/``, either directly or through a
+variable assigned such a value earlier in the same function. Imports are
+resolved through the file's import aliases.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for hand-built paths passed to file functions
+PATH_CONCAT_PATTERN = Pattern(
+    id=PatternId("CC-139-CODE-GO"),
+    domain=ArtifactDomain.STORAGE,
+    signals=[],
+    severity=Severity.INFO,
+    description="File path built with Sprintf or + and a hardcoded separator",
+    remediation="Build the path with filepath.Join, which uses the platform separator",
+    language="go",
+    effort=PatternEffort.LOW,
+)
+
+# File functions and the indices of their path arguments, by import path
+PATH_SINKS: dict[str, dict[str, tuple[int, ...]]] = {
+    "os": {
+        "Chdir": (0,),
+        "Chmod": (0,),
+        "Create": (0,),
+        "Lstat": (0,),
+        "Mkdir": (0,),
+        "MkdirAll": (0,),
+        "Open": (0,),
+        "OpenFile": (0,),
+        "ReadDir": (0,),
+        "ReadFile": (0,),
+        "Remove": (0,),
+        "RemoveAll": (0,),
+        "Rename": (0, 1),
+        "Stat": (0,),
+        "WriteFile": (0,),
+    },
+    "io/ioutil": {
+        "ReadDir": (0,),
+        "ReadFile": (0,),
+        "WriteFile": (0,),
+    },
+}
+
+# Assignment of one variable: `path := ...`, `path = ...`
+_ASSIGN_RE = re.compile(
+    r"^[ \t]*(?:var[ \t]+)?([A-Za-z_]\w*)(?:[ \t]+\w+)?[ \t]*:?=(?!=)[ \t]*(.*)$"
+)
+
+_FUNC_RE = re.compile(r"^func\b")
+_IDENT_RE = re.compile(r"[A-Za-z_]\w*")
+
+# String literals containing a slash become "/", other literals ""
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+_SLASH_CONCAT_RE = re.compile(r'\+[ \t]*"/"|"/"[ \t]*\+')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals reduced and line comments removed."""
+    return [
+        _LITERAL_RE.sub(lambda m: '"/"' if "/" in m.group(0)[1:-1] else '""', line).split(
+            "//", 1
+        )[0]
+        for line in text.split("\n")
+    ]
+
+
+def _call_args(line: str, open_paren: int) -> list[str]:
+    """Return the top-level arguments of the call whose "(" is at open_paren."""
+    args: list[str] = []
+    depth = 0
+    start = open_paren + 1
+    for position in range(open_paren, len(line)):
+        char = line[position]
+        if char in "([{":
+            depth += 1
+        elif char in ")]}":
+            depth -= 1
+            if depth == 0:
+                args.append(line[start:position])
+                return args
+        elif char == "," and depth == 1:
+            args.append(line[start:position])
+            start = position + 1
+    return []  # call continues on the next line
+
+
+def find_concatenated_paths(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report file function paths built with Sprintf or string concatenation.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-139 findings in line order, one per path argument.
+
+    """
+    if not config.is_enabled(PATH_CONCAT_PATTERN.id):
+        return []
+    imports = parse_go_imports(text)
+    sinks: dict[str, tuple[int, ...]] = {}
+    for alias, path in imports.items():
+        for func, indices in PATH_SINKS.get(path, {}).items():
+            sinks[func if alias == "." else f"{alias}.{func}"] = indices
+    if not sinks:
+        return []
+    sprintf_re = re.compile(
+        "|".join(
+            (r"(?<![\w.])Sprintf" if a == "." else re.escape(a) + r"\.Sprintf")
+            + r'\([ \t]*"/"'
+            for a, path in imports.items()
+            if path == "fmt"
+        )
+        or r"(?!)"
+    )
+    sink_re = re.compile(
+        r"(?<![\w.])(" + "|".join(re.escape(s) for s in sorted(sinks, key=len, reverse=True))
+        + r")[ \t]*\("
+    )
+
+    def built_with(expression: str) -> str | None:
+        if sprintf_re.search(expression):
+            return "fmt.Sprintf"
+        if _SLASH_CONCAT_RE.search(expression):
+            return "concatenation"
+        return None
+
+    source_lines = text.split("\n")
+    findings: list[ScanFinding] = []
+    built: dict[str, str] = {}  # variables holding built paths in this function
+    for index, line in enumerate(_code_lines(text)):
+        if _FUNC_RE.match(line):
+            built = {}
+        for call in sink_re.finditer(line):
+            args = _call_args(line, call.end() - 1)
+            for arg_index in sinks[call.group(1)]:
+                if arg_index >= len(args):
+                    continue
+                arg = args[arg_index].strip()
+                how = built.get(arg) if _IDENT_RE.fullmatch(arg) else built_with(arg)
+                if how:
+                    findings.append(
+                        builtin_finding(
+                            PATH_CONCAT_PATTERN,
+                            f"{call.group(1)} path built with {how}, not filepath.Join",
+                            rel_path,
+                            index + 1,
+                            source_lines[index],
+                            config,
+                        )
+                    )
+        if assign := _ASSIGN_RE.match(line):
+            name, value = assign.groups()
+            if how := built_with(value):
+                built[name] = how
+            else:
+                built.pop(name, None)
+    return findings
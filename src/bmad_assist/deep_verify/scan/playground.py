@@ -0,0 +1,57 @@
+"""Browser entry point for Deep Verify scans.
+
+Exposes a single ``analyze(source) -> json_report`` function for the online
+playground, which runs the scanner in the browser under Pyodide (CPython
+compiled to WebAssembly). The analysis path reads nothing but the bundled
+pattern data: no files, sockets, subprocesses, or databases. Modules that need
+them (``scan.server``, ``scan.sqlite``, the LLM providers) are never imported
+on this path.
+
+Example (JavaScript):
+    >>> await pyodide.loadPackage("micropip")
+    >>> await pyodide.pyimport("micropip").install("bmad-assist")
+    >>> const { analyze } = pyodide.pyimport("bmad_assist.deep_verify.scan.playground")
+    >>> const report = JSON.parse(analyze(source))
+
+"""
+
+from __future__ import annotations
+
+import json
+from functools import lru_cache
+
+from bmad_assist.deep_verify.scan.scanner import Scanner
+from bmad_assist.deep_verify.scan.types import ScanReport, serialize_scan_report
+
+# Path reported on playground findings
+PLAYGROUND_PATH = "main.go"
+
+
+@lru_cache(maxsize=1)
+def _scanner() -> Scanner:
+    """Return the shared scanner (patterns are compiled once per page)."""
+    return Scanner()
+
+
+def analyze(source: str, language: str = "go", path: str = PLAYGROUND_PATH) -> str:
+    """Scan source text and return the report as JSON.
+
+    Args:
+        source: Source code to scan.
+        language: Language of the source (e.g., "go").
+        path: Path reported on findings.
+
+    Returns:
+        JSON of the serialized ScanReport, as ``verify scan --output json``
+        and the HTTP server produce.
+
+    Raises:
+        TypeError: If source is not a string.
+
+    """
+    if not isinstance(source, str):
+        raise TypeError(f"source must be a string, got {type(source).__name__}")
+    findings = _scanner().scan_source(source, language.lower(), rel_path=path)
+    report = ScanReport(root=path, findings=findings, files_scanned=[path])
+    return json.dumps(serialize_scan_report(report))
+
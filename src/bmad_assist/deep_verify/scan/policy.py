@@ -0,0 +1,146 @@
+"""Path-based severity policy for Deep Verify scans.
+
+Path rules adjust finding severities by location, so one finding can be
+critical in ``pkg/api/`` and informational in ``examples/``.
+
+Precedence:
+    1. The pattern's default severity.
+    2. ``severity`` overrides from ``.deepverify.yaml`` (nearest file wins).
+    3. Build target rules (see target_arch_rules), such as raising 64-bit
+       atomic alignment findings for 32-bit ``ScanOptions.target_arch``.
+    4. Path rules from ``ScanOptions.path_severity_rules``, applied in order.
+       Every matching rule applies on top of the previous result, and a
+       ``drop`` rule removes the finding entirely.
+
+Example:
+    >>> from bmad_assist.deep_verify.core.types import Severity
+    >>> from bmad_assist.deep_verify.scan import PathRule, ScanOptions
+    >>> rules = (
+    ...     PathRule("examples/*", action="set", severity=Severity.INFO),
+    ...     PathRule("pkg/*", action="raise", patterns=("CC-",)),
+    ...     PathRule("internal/experimental/*", action="drop"),
+    ... )
+    >>> options = ScanOptions(path_severity_rules=rules)
+
+"""
+
+from __future__ import annotations
+
+from dataclasses import dataclass, replace
+from fnmatch import fnmatchcase
+from typing import Literal
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan.config import matches_selector
+from bmad_assist.deep_verify.scan.types import ScanFinding
+
+# Severities from least to most severe
+SEVERITY_LADDER: tuple[Severity, ...] = (
+    Severity.INFO,
+    Severity.WARNING,
+    Severity.ERROR,
+    Severity.CRITICAL,
+)
+
+# GOARCH values with 32-bit words, where 64-bit atomics need manual alignment
+GOARCH_32BIT: frozenset[str] = frozenset({"386", "arm", "mips", "mipsle"})
+
+# Misaligned 64-bit atomic field (crashes only on 32-bit targets)
+ATOMIC_ALIGNMENT_PATTERN = "CC-117-CODE-GO"
+
+
+@dataclass(frozen=True, slots=True)
+class PathRule:
+    """Severity adjustment for findings under matching paths.
+
+    Attributes:
+        glob: fnmatch-style glob over the finding's POSIX path relative to
+            the scan root. ``*`` also matches ``/``, so ``examples/*``
+            covers the whole subtree.
+        action: "raise" or "lower" by ``steps``, "set" to ``severity``, or
+            "drop" to remove the finding.
+        steps: Severity levels to move for raise/lower (clamped at the ends).
+        severity: Target severity for "set".
+        patterns: Pattern selectors the rule applies to (empty = all).
+
+    """
+
+    glob: str
+    action: Literal["raise", "lower", "set", "drop"]
+    steps: int = 1
+    severity: Severity | None = None
+    patterns: tuple[str, ...] = ()
+
+    def __post_init__(self) -> None:
+        """Validate the rule."""
+        if self.action == "set" and self.severity is None:
+            raise ValueError(f"PathRule {self.glob!r}: action 'set' requires a severity")
+        if self.steps < 0:
+            raise ValueError(f"PathRule {self.glob!r}: steps must be non-negative")
+
+    def applies_to(self, finding: ScanFinding) -> bool:
+        """Check whether the rule covers a finding."""
+        if not fnmatchcase(finding.path, self.glob):
+            return False
+        return not self.patterns or any(
+            matches_selector(finding.pattern_id, s) for s in self.patterns
+        )
+
+
+def _shift(severity: Severity, steps: int) -> Severity:
+    """Move a severity along the ladder, clamping at either end."""
+    index = SEVERITY_LADDER.index(severity) + steps
+    return SEVERITY_LADDER[max(0, min(index, len(SEVERITY_LADDER) - 1))]
+
+
+def apply_path_rules(
+    findings: list[ScanFinding], rules: tuple[PathRule, ...]
+) -> list[ScanFinding]:
+    """Apply path severity rules to findings.
+
+    Args:
+        findings: Findings with config severities already applied.
+        rules: Rules applied in order.
+
+    Returns:
+        Adjusted findings, without dropped ones.
+
+    """
+    if not rules:
+        return findings
+
+    result: list[ScanFinding] = []
+    for finding in findings:
+        severity: Severity | None = finding.severity
+        for rule in rules:
+            if severity is None or not rule.applies_to(finding):
+                continue
+            if rule.action == "drop":
+                severity = None
+            elif rule.action == "set":
+                severity = rule.severity
+            elif rule.action == "raise":
+                severity = _shift(severity, rule.steps)
+            else:
+                severity = _shift(severity, -rule.steps)
+        if severity is None:
+            continue
+        if severity != finding.severity:
+            finding = replace(finding, severity=severity)
+        result.append(finding)
+    return result
+
+
+def target_arch_rules(goarch: str | None) -> tuple[PathRule, ...]:
+    """Return the severity rules implied by a build target.
+
+    Args:
+        goarch: Target GOARCH (e.g., "arm"), or None if unknown.
+
+    Returns:
+        Rules raising platform-specific findings that crash on the target.
+
+    """
+    if goarch is None or goarch.lower() not in GOARCH_32BIT:
+        return ()
+    return (PathRule("*", action="raise", steps=2, patterns=(ATOMIC_ALIGNMENT_PATTERN,)),)
@@ -0,0 +1,145 @@
+"""Detection of sleep-based poll loops in context-aware Go functions.
+
+``time.Sleep`` cannot be interrupted, so a poll loop that sleeps between
+iterations keeps running for up to a full interval after its context is
+cancelled, delaying graceful shutdown::
+
+    func (w *Worker) Run(ctx context.Context) {
+        for {
+            w.poll(ctx)
+            time.Sleep(w.interval) // CC-150: ignores ctx cancellation
+        }
+    }
+
+A ``time.Ticker`` in a ``select`` that also receives from ``ctx.Done()``
+waits for the same interval and returns as soon as the context is done.
+
+An infinite ``for {`` loop is reported when its last statement is a
+``time.Sleep`` call (resolved through the file's imports) and the function
+it is in takes a ``context.Context``. Sleeps inside nested blocks of the
+loop (a retry backoff in an ``if``) and loops in functions without a
+context are not reported.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for infinite loops ending in time.Sleep in context-aware functions
+SLEEP_POLL_PATTERN = Pattern(
+    id=PatternId("CC-150-CODE-GO"),
+    domain=ArtifactDomain.CONCURRENCY,
+    signals=[],
+    severity=Severity.INFO,
+    description="Poll loop sleeps with time.Sleep - the sleep ignores context cancellation",
+    remediation="Use a time.Ticker and select on ticker.C and ctx.Done() instead of sleeping",
+    language="go",
+    effort=PatternEffort.LOW,
+)
+
+# Function or method declaration with its parameters on the first line
+_FUNC_DECL_RE = re.compile(
+    r"^func[ \t]*(?:\([^)\n]*\)[ \t]*)?([A-Za-z_]\w*)(?:\[[^\]\n]*\])?[ \t]*\(([^)\n]*)\)",
+    re.MULTILINE,
+)
+
+# Infinite loop: `for {`
+_LOOP_RE = re.compile(r"(?<![\w.])for[ \t]*\{")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _block_end(code: str, open_brace: int) -> int:
+    """Return the position of the brace closing the block opened at open_brace."""
+    depth = 0
+    for position in range(open_brace, len(code)):
+        if code[position] == "{":
+            depth += 1
+        elif code[position] == "}":
+            depth -= 1
+            if depth == 0:
+                return position
+    return len(code)
+
+
+def _qualified(aliases: list[str], name: str) -> str:
+    """Return a regex alternation of name qualified by each import alias."""
+    return "|".join(
+        rf"(?<![\w.]){name}" if alias == "." else rf"(?<![\w.]){re.escape(alias)}\.{name}"
+        for alias in aliases
+    )
+
+
+def find_sleep_polls(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report infinite loops ending in time.Sleep in functions taking a context.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-150 findings in line order, one per sleep.
+
+    """
+    if not config.is_enabled(SLEEP_POLL_PATTERN.id):
+        return []
+    imports = parse_go_imports(text)
+    time_aliases = [name for name, path in imports.items() if path == "time"]
+    context_aliases = [name for name, path in imports.items() if path == "context"]
+    if not time_aliases or not context_aliases:
+        return []
+    sleep_re = re.compile(rf"^[ \t]*(?:{_qualified(time_aliases, 'Sleep')})[ \t]*\(")
+    context_re = re.compile(_qualified(context_aliases, "Context") + r"\b")
+
+    code = "\n".join(_code_lines(text))
+    source_lines = text.split("\n")
+    findings: list[ScanFinding] = []
+    for function in _FUNC_DECL_RE.finditer(code):
+        if not context_re.search(function.group(2)):
+            continue
+        open_brace = code.find("{", function.end())
+        if open_brace < 0:
+            continue
+        body_end = _block_end(code, open_brace)
+        for loop in _LOOP_RE.finditer(code, open_brace, body_end):
+            loop_end = _block_end(code, loop.end() - 1)
+            body = code[loop.end() : loop_end]
+            statements = [line for line in body.split("\n") if line.strip()]
+            if not statements or not sleep_re.match(statements[-1]):
+                continue
+            last_start = body.rfind(statements[-1])
+            if body.count("{", 0, last_start) != body.count("}", 0, last_start):
+                continue
+            index = code.count("\n", 0, loop.end() + last_start)
+            findings.append(
+                builtin_finding(
+                    SLEEP_POLL_PATTERN,
+                    f"{function.group(1)} polls with time.Sleep, which ignores context "
+                    "cancellation",
+                    rel_path,
+                    index + 1,
+                    source_lines[index],
+                    config,
+                )
+            )
+    findings.sort(key=lambda f: f.line)
+    return findings
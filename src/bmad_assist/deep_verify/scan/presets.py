@@ -0,0 +1,77 @@
+"""Named scan presets for Deep Verify.
+
+A preset adjusts ScanOptions for a common use case. ``high-signal`` is for
+a first scan of a codebase: it runs only patterns rated
+``precision: high`` (in YAML, or ``precision=PatternPrecision.HIGH`` on
+built-in checks) and raises the confidence threshold to
+``HIGH_SIGNAL_THRESHOLD``, so nearly every finding is a real bug::
+
+    options = apply_preset(ScanOptions(), HIGH_SIGNAL_PRESET)
+    report = Scanner(options).scan(Path("."))
+
+The selection is set as the base config's ``enable`` list, intersected with
+any ``enable`` the base config already has. ``.deepverify.yaml`` files that
+set ``enable`` replace it for their subtree, as with any base config.
+
+"""
+
+from __future__ import annotations
+
+from dataclasses import replace
+
+from bmad_assist.deep_verify.core.types import PatternPrecision
+from bmad_assist.deep_verify.patterns.library import PatternLibrary
+from bmad_assist.deep_verify.scan.config import ScanConfig, matches_selector
+from bmad_assist.deep_verify.scan.scanner import ScanOptions, known_patterns
+
+# Preset running only high-precision patterns at a high confidence threshold
+HIGH_SIGNAL_PRESET = "high-signal"
+
+# Confidence floor of the high-signal preset
+HIGH_SIGNAL_THRESHOLD = 0.9
+
+# Names accepted by apply_preset
+PRESETS: tuple[str, ...] = (HIGH_SIGNAL_PRESET,)
+
+
+def high_precision_ids(library: PatternLibrary | None = None) -> list[str]:
+    """Return the IDs of known patterns rated high precision, sorted (see known_patterns).
+
+    Args:
+        library: Pattern library; None uses the default library.
+
+    """
+    return sorted({p.id for p in known_patterns(library) if p.precision == PatternPrecision.HIGH})
+
+
+def apply_preset(
+    options: ScanOptions, name: str, library: PatternLibrary | None = None
+) -> ScanOptions:
+    """Return options adjusted by a named preset.
+
+    Args:
+        options: Options to adjust.
+        name: Preset name (see PRESETS).
+        library: Pattern library the scan uses; None uses the default library.
+
+    Returns:
+        Options whose threshold is at least the preset's floor and whose base
+        config enables only the preset's patterns.
+
+    Raises:
+        ValueError: If the preset is unknown.
+
+    """
+    if name != HIGH_SIGNAL_PRESET:
+        raise ValueError(f"Unknown preset {name!r}; use one of: {', '.join(PRESETS)}")
+    base = options.config if options.config is not None else ScanConfig()
+    enable = [
+        pattern_id
+        for pattern_id in high_precision_ids(library)
+        if base.enable is None or any(matches_selector(pattern_id, s) for s in base.enable)
+    ]
+    return replace(
+        options,
+        threshold=max(options.threshold, HIGH_SIGNAL_THRESHOLD),
+        config=base.model_copy(update={"enable": enable}),
+    )
@@ -0,0 +1,111 @@
+"""Pushing Deep Verify scan metrics to a Prometheus pushgateway.
+
+Teams alerting on code health in their existing monitoring push a gauge per
+metric after each scan. All metrics count unsuppressed findings:
+
+    deepverify_findings                    all findings
+    deepverify_findings_by_severity        per ``severity`` label
+    deepverify_findings_by_domain          per ``domain`` label
+
+Every severity and domain is pushed, with 0 when it has no findings, so
+series do not disappear when the last finding of a kind is fixed. The
+optional ``repo`` and ``branch`` labels join ``job`` in the grouping key,
+so scans of different repositories or branches keep separate metrics.
+
+Requires the optional ``prometheus-client`` dependency
+(``pip install bmad-assist[prometheus]``); the rest of the scan package does
+not import this module.
+
+Example:
+    >>> report = Scanner().scan(Path("."))
+    >>> push_report_metrics(
+    ...     report, "http://pushgateway:9091", "deepverify", repo="app", branch="main"
+    ... )
+
+"""
+
+from __future__ import annotations
+
+try:
+    from prometheus_client import CollectorRegistry, Gauge, push_to_gateway
+except ImportError as e:  # pragma: no cover - depends on the environment
+    raise ImportError(
+        "Pushing Deep Verify metrics requires prometheus-client "
+        "(pip install bmad-assist[prometheus])"
+    ) from e
+
+from bmad_assist.deep_verify.core.exceptions import DeepVerifyError
+from bmad_assist.deep_verify.core.types import ArtifactDomain, Severity
+from bmad_assist.deep_verify.scan.types import ScanReport
+
+# Seconds to wait for the pushgateway
+PUSH_TIMEOUT = 30.0
+
+
+class MetricsPushError(DeepVerifyError):
+    """Raised when metrics cannot be pushed to the pushgateway."""
+
+
+def report_registry(report: ScanReport) -> CollectorRegistry:
+    """Build a registry holding the gauges pushed for a report."""
+    registry = CollectorRegistry()
+    findings = report.unsuppressed_findings()
+    total = Gauge("deepverify_findings", "Unsuppressed Deep Verify findings", registry=registry)
+    total.set(len(findings))
+    by_severity = Gauge(
+        "deepverify_findings_by_severity",
+        "Unsuppressed Deep Verify findings per severity",
+        ["severity"],
+        registry=registry,
+    )
+    severities = report.severity_counts()
+    for severity in Severity:
+        by_severity.labels(severity.value).set(severities.get(severity, 0))
+    by_domain = Gauge(
+        "deepverify_findings_by_domain",
+        "Unsuppressed Deep Verify findings per domain",
+        ["domain"],
+        registry=registry,
+    )
+    for domain in ArtifactDomain:
+        by_domain.labels(domain.value).set(sum(1 for f in findings if f.domain == domain))
+    return registry
+
+
+def push_report_metrics(
+    report: ScanReport,
+    gateway_url: str,
+    job: str,
+    repo: str | None = None,
+    branch: str | None = None,
+    timeout: float = PUSH_TIMEOUT,
+) -> None:
+    """Push a report's finding counts to a Prometheus pushgateway.
+
+    Replaces the metrics previously pushed under the same job, repo and
+    branch.
+
+    Args:
+        report: Scan report.
+        gateway_url: Pushgateway address, e.g. ``http://pushgateway:9091``.
+        job: Job label of the pushed metrics.
+        repo: Repository label, added to the grouping key when given.
+        branch: Branch label, added to the grouping key when given.
+        timeout: Seconds to wait for the pushgateway.
+
+    Raises:
+        MetricsPushError: If the pushgateway cannot be reached or rejects
+            the metrics.
+
+    """
+    grouping_key = {name: value for name, value in (("repo", repo), ("branch", branch)) if value}
+    try:
+        push_to_gateway(
+            gateway_url,
+            job=job,
+            registry=report_registry(report),
+            grouping_key=grouping_key,
+            timeout=timeout,
+        )
+    except OSError as e:
+        raise MetricsPushError(f"Cannot push metrics to {gateway_url}: {e}") from e
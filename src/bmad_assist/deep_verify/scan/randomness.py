@@ -0,0 +1,224 @@
+"""Detection of math/rand values used as secrets in Go scans.
+
+``math/rand`` is predictable: its output can be reconstructed from a few
+observed values, so tokens, session IDs, nonces and passwords must come
+from ``crypto/rand``. This check follows ``math/rand`` results through a
+file and flags them where they reach a name that looks like a secret::
+
+    b := make([]byte, 16)
+    rand.Read(b)                        // b is now tainted
+    token := hex.EncodeToString(b)      // CC-124: token from math/rand
+
+A value is tainted when it is a ``math/rand`` call result, a buffer filled
+by ``rand.Read``, or derived from a tainted variable or from a function
+in the file that returns a tainted value. Taint is tracked per top-level
+function and ignores control flow. A sink is an assignment, composite
+literal field or ``return`` (in a function named like a secret) whose
+name, lowercased and without underscores, ends with a secret name:
+``sessionID`` and ``csrf_token`` match, ``jitter`` does not.
+``ScanOptions.random_secret_names`` extends the default names.
+
+Example:
+    >>> from bmad_assist.deep_verify.scan import ScanOptions
+    >>> options = ScanOptions(random_secret_names=("invitecode",))
+
+"""
+
+from __future__ import annotations
+
+import re
+from collections.abc import Iterable
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.sensitive import normalize_sensitive_names
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for math/rand values that reach secret-like names
+WEAK_RANDOM_PATTERN = Pattern(
+    id=PatternId("CC-124-CODE-GO"),
+    domain=ArtifactDomain.SECURITY,
+    signals=[],
+    severity=Severity.ERROR,
+    description="Secret generated with math/rand - the value is predictable",
+    remediation="Generate secrets with crypto/rand (rand.Read, rand.Text or rand.Int)",
+    language="go",
+    effort=PatternEffort.LOW,
+)
+
+# Confidence of CC-124 findings: a value counts as a secret by its name alone,
+# and names such as token or key also label values nobody needs to guess
+WEAK_RANDOM_CONFIDENCE = 0.6
+
+# Name suffixes of values that must be unpredictable (lowercase, no underscores)
+DEFAULT_RANDOM_SECRET_NAMES: tuple[str, ...] = (
+    "token",
+    "secret",
+    "nonce",
+    "salt",
+    "password",
+    "passwd",
+    "sessionid",
+    "apikey",
+    "otp",
+)
+
+# Import paths of the predictable generators
+_MATH_RAND_PATHS = frozenset({"math/rand", "math/rand/v2"})
+
+# Top-level function declaration: `func Name(`, `func (r *T) Name(`
+_FUNC_RE = re.compile(r"^func\s*(?:\([^)]*\)\s*)?(\w+)")
+
+# Assignment or declaration: `a, b := rhs`, `x.f = rhs`, `b[i] = rhs`, `var t T = rhs`
+_ASSIGN_RE = re.compile(
+    r"^\s*(?:var\s+)?([\w.]+(?:\[[^\]\n]*\])*(?:\s*,\s*[\w.]+(?:\[[^\]\n]*\])*)*)"
+    r"(?:\s+[\w.\[\]*]+)?\s*(?::=|(?<![=!<>:])=(?!=))\s*(.+)$"
+)
+
+# Statement keywords that the assignment pattern would mistake for names
+_KEYWORDS = frozenset({"if", "for", "switch", "select", "go", "defer", "case", "else"})
+
+# Composite literal field: `Token: rhs,`
+_FIELD_RE = re.compile(r"^\s*(\w+):\s+(.+?),?$")
+
+# Return statement
+_RETURN_RE = re.compile(r"^\s*return\s+(.+)$")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _normalize(name: str) -> str:
+    """Lowercase a name and drop underscores."""
+    return name.replace("_", "").lower()
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _refs(names: Iterable[str], call: bool) -> re.Pattern[str] | None:
+    """Compile a matcher for references to names (as calls if call is set)."""
+    ordered = sorted(names)
+    if not ordered:
+        return None
+    suffix = r"\s*\(" if call else r"\b"
+    return re.compile(r"(?<![\w.])(?:" + "|".join(map(re.escape, ordered)) + ")" + suffix)
+
+
+def find_weak_random_secrets(
+    text: str,
+    rel_path: str,
+    config: ScanConfig,
+    names: Iterable[str] = DEFAULT_RANDOM_SECRET_NAMES,
+) -> list[ScanFinding]:
+    """Report math/rand values that reach secret-like names in a Go file.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+        names: Secret name suffixes (default: DEFAULT_RANDOM_SECRET_NAMES).
+
+    Returns:
+        CC-124 findings in line order, at most one per line.
+
+    """
+    if not config.is_enabled(WEAK_RANDOM_PATTERN.id):
+        return []
+    suffixes = normalize_sensitive_names(names)
+    aliases = [a for a, path in parse_go_imports(text).items() if path in _MATH_RAND_PATHS]
+    if not aliases or not suffixes:
+        return []
+
+    rand_call = re.compile(r"(?<![\w.])(?:" + "|".join(map(re.escape, aliases)) + r")\.(\w+)\s*\(")
+    source_lines = text.split("\n")
+    lines = _code_lines(text)
+
+    # Functions returning tainted values, to a fixpoint (helpers may follow their callers)
+    tainted_funcs: set[str] = set()
+    while True:
+        found = {
+            func
+            for func, _, _, tainted in _walk(lines, rand_call, tainted_funcs)
+            if func is not None and tainted
+        }
+        if found <= tainted_funcs:
+            break
+        tainted_funcs |= found
+
+    findings: list[ScanFinding] = []
+    for _, number, sink, tainted in _walk(lines, rand_call, tainted_funcs):
+        if not tainted or sink is None or not _normalize(sink).endswith(suffixes):
+            continue
+        if findings and findings[-1].line == number:
+            continue
+        findings.append(
+            builtin_finding(
+                WEAK_RANDOM_PATTERN,
+                f"math/rand value used for {sink}",
+                rel_path,
+                number,
+                source_lines[number - 1],
+                config,
+                WEAK_RANDOM_CONFIDENCE,
+            )
+        )
+    return findings
+
+
+def _walk(
+    lines: list[str], rand_call: re.Pattern[str], tainted_funcs: set[str]
+) -> Iterable[tuple[str | None, int, str | None, bool]]:
+    """Yield (function, line, sink name, tainted) for assignments, fields and returns.
+
+    For returns the sink is the enclosing function's name, and the function
+    is reported only for returns (so callers can collect tainted functions).
+    """
+    func_calls = _refs(tainted_funcs, call=True)
+    func: str | None = None
+    tainted_vars: set[str] = set()
+    var_refs: re.Pattern[str] | None = None
+
+    def is_tainted(expr: str) -> bool:
+        return bool(
+            rand_call.search(expr)
+            or (func_calls is not None and func_calls.search(expr))
+            or (var_refs is not None and var_refs.search(expr))
+        )
+
+    for number, line in enumerate(lines, start=1):
+        if declared := _FUNC_RE.match(line):
+            func, tainted_vars, var_refs = declared.group(1), set(), None
+            continue
+        if ret := _RETURN_RE.match(line):
+            yield func, number, func, is_tainted(ret.group(1))
+            continue
+
+        # rand.Read(buf) fills buf with predictable bytes
+        for call in rand_call.finditer(line):
+            if call.group(1) == "Read":
+                buffer = re.match(r"\s*([\w.]+)\s*[,)]", line[call.end() :])
+                if buffer is not None:
+                    tainted_vars.add(buffer.group(1))
+                    var_refs = _refs(tainted_vars, call=False)
+
+        target = _ASSIGN_RE.match(line) or _FIELD_RE.match(line)
+        if target is None or target.group(1) in _KEYWORDS:
+            continue
+        tainted = is_tainted(target.group(2))
+        # Index expressions taint (and are named by) the indexed variable
+        sinks = [name.split("[", 1)[0].strip() for name in target.group(1).split(",")]
+        if tainted:
+            tainted_vars.update(s for s in sinks if s not in ("_", "err"))
+            var_refs = _refs(tainted_vars, call=False)
+        for sink in sinks:
+            yield None, number, sink.rsplit(".", 1)[-1], tainted
@@ -0,0 +1,148 @@
+"""Detection of value receivers that mutate a copy in Go scans.
+
+A method with a value receiver gets a copy of the value, so assigning to a
+field of the receiver changes the copy and is lost when the method returns.
+On a type whose other methods use pointer receivers the mutation was almost
+certainly meant to stick::
+
+    func (c *Counter) Inc() { c.n++ }
+
+    func (c Counter) Reset() {
+        c.n = 0 // CC-125: lost, Reset mutates a copy of Counter
+    }
+
+Receivers are read from method declarations in the same file: a type is
+checked when it has at least one pointer-receiver method, and each of its
+value-receiver methods is reported at the first direct assignment to a
+receiver field (``c.n = 0``, ``c.n += 1``, ``c.n++``). Writes through the
+copy that may reach shared data (``c.items[k] = v``, ``c.cfg.limit = 1``)
+are not reported. Methods declared in other files of the
+package are not seen.
+
+Example:
+    >>> from bmad_assist.deep_verify.scan import parse_go_receivers
+    >>> parse_go_receivers(source)
+    {'Counter': {'Inc': True, 'Reset': False}}
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for value-receiver methods that assign to receiver fields
+VALUE_RECEIVER_PATTERN = Pattern(
+    id=PatternId("CC-125-CODE-GO"),
+    domain=ArtifactDomain.TRANSFORM,
+    signals=[],
+    severity=Severity.ERROR,
+    description="Value receiver method assigns to a field - the write goes to a copy and is lost",
+    remediation="Use a pointer receiver, like the type's other methods",
+    language="go",
+    effort=PatternEffort.LOW,
+)
+
+# Method declaration: `func (c *Counter) Inc(`, `func (s Stack[T]) Len(`
+_METHOD_RE = re.compile(
+    r"^func[ \t]*\([ \t]*(?:([A-Za-z_]\w*)[ \t]+)?(\*?)[ \t]*([A-Za-z_]\w*)(?:\[[^\]\n]*\])?"
+    r"[ \t]*\)[ \t]*([A-Za-z_]\w*)[ \t]*[\[(]",
+    re.MULTILINE,
+)
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def parse_go_receivers(text: str) -> dict[str, dict[str, bool]]:
+    """Collect the methods of a Go file, grouped by receiver type.
+
+    Args:
+        text: Go source.
+
+    Returns:
+        Method name -> whether the receiver is a pointer, per receiver type,
+        in declaration order.
+
+    """
+    methods: dict[str, dict[str, bool]] = {}
+    for method in _METHOD_RE.finditer(text):
+        _, star, type_name, name = method.groups()
+        methods.setdefault(type_name, {})[name] = bool(star)
+    return methods
+
+
+def _field_assignment(receiver: str) -> re.Pattern[str]:
+    """Compile a matcher for direct assignments to fields of receiver."""
+    field = r"(?<![\w.])" + re.escape(receiver) + r"\.([A-Za-z_]\w*)"
+    return re.compile(
+        field + r"[ \t]*(?:(?:[-+*/%&|^]|<<|>>|&\^)?=(?!=)|\+\+|--)"
+        r"|" + field + r"[ \t]*,[^=\n]*(?<![:=!<>])=(?!=)"
+    )
+
+
+def find_value_receiver_mutations(
+    text: str, rel_path: str, config: ScanConfig
+) -> list[ScanFinding]:
+    """Report value-receiver methods that assign to fields on pointer-method types.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-125 findings in line order, one per method.
+
+    """
+    if not config.is_enabled(VALUE_RECEIVER_PATTERN.id):
+        return []
+    receivers = parse_go_receivers(text)
+    pointer_types = {t for t, methods in receivers.items() if any(methods.values())}
+    if not pointer_types:
+        return []
+
+    source_lines = text.split("\n")
+    lines = _code_lines(text)
+    findings: list[ScanFinding] = []
+    for method in _METHOD_RE.finditer(text):
+        receiver, star, type_name, name = method.groups()
+        if star or type_name not in pointer_types or receiver in (None, "_"):
+            continue
+        assignment = _field_assignment(receiver)
+        start = text.count("\n", 0, method.start())
+        # Bodies end at the first column-0 closing brace (or on the line, for
+        # one-line methods), as gofmt writes them
+        for index in range(start, len(lines)):
+            field = assignment.search(lines[index])
+            if field is not None:
+                findings.append(
+                    builtin_finding(
+                        VALUE_RECEIVER_PATTERN,
+                        f"{type_name}.{name} has a value receiver and assigns to "
+                        f"{receiver}.{field.group(1) or field.group(2)}",
+                        rel_path,
+                        index + 1,
+                        source_lines[index],
+                        config,
+                    )
+                )
+                break
+            line = lines[index]
+            if line.startswith("}") or (index == start and line.rstrip().endswith("}")):
+                break
+    return findings
@@ -0,0 +1,31 @@
+"""gRPC service for Deep Verify scans.
+
+``deepverify.proto`` defines the ``deepverify.v1.Analyzer`` service: clients
+stream file contents to ``Analyze`` and receive findings as a stream of
+``Finding`` messages, so services in any language can request analysis.
+
+The message codec in this package has no extra dependencies. The server and
+client helpers live in ``rpc.server`` and require the optional ``grpcio``
+dependency (``pip install bmad-assist[grpc]``).
+
+Example:
+    >>> from bmad_assist.deep_verify.scan.rpc.server import AnalyzerServer
+    >>> AnalyzerServer(host="0.0.0.0", port=50051).serve_forever()
+
+"""
+
+from bmad_assist.deep_verify.scan.rpc.messages import (
+    AnalyzeRequest,
+    decode_analyze_request,
+    decode_finding,
+    encode_analyze_request,
+    encode_finding,
+)
+
+__all__ = [
+    "AnalyzeRequest",
+    "decode_analyze_request",
+    "decode_finding",
+    "encode_analyze_request",
+    "encode_finding",
+]
@@ -0,0 +1,211 @@
+"""Protobuf encoding of the Analyzer service messages.
+
+Messages follow ``deepverify.proto`` and are encoded with the protobuf wire
+format directly, so the service needs neither protoc nor the protobuf
+runtime. Unknown fields are skipped on decode, and default values (empty
+strings, zero numbers) are omitted on encode, as in proto3.
+"""
+
+from __future__ import annotations
+
+import struct
+from dataclasses import dataclass
+
+from bmad_assist.deep_verify.core.types import ArtifactDomain, PatternEffort, PatternId, Severity
+from bmad_assist.deep_verify.scan.types import ScanFinding, finding_fingerprint
+
+# Wire types used by the service messages
+_VARINT = 0
+_FIXED64 = 1
+_LENGTH_DELIMITED = 2
+_FIXED32 = 5
+
+_UINT64_MASK = (1 << 64) - 1
+
+
+@dataclass(frozen=True, slots=True)
+class AnalyzeRequest:
+    """A file to analyze (``deepverify.v1.AnalyzeRequest``).
+
+    Attributes:
+        path: Path reported on findings; selects the language if none is given.
+        source: File contents.
+        language: Language such as "go" (empty = detect from path and source).
+
+    """
+
+    path: str = ""
+    source: str = ""
+    language: str = ""
+
+
+def _encode_varint(value: int) -> bytes:
+    value &= _UINT64_MASK
+    out = bytearray()
+    while True:
+        byte = value & 0x7F
+        value >>= 7
+        if value:
+            out.append(byte | 0x80)
+        else:
+            out.append(byte)
+            return bytes(out)
+
+
+def _decode_varint(data: bytes, pos: int) -> tuple[int, int]:
+    result = 0
+    shift = 0
+    while True:
+        if pos >= len(data):
+            raise ValueError("Truncated varint")
+        byte = data[pos]
+        pos += 1
+        result |= (byte & 0x7F) << shift
+        if not byte & 0x80:
+            return result, pos
+        shift += 7
+        if shift >= 64:
+            raise ValueError("Varint too long")
+
+
+def _key(number: int, wire_type: int) -> bytes:
+    return _encode_varint((number << 3) | wire_type)
+
+
+def _string_field(number: int, value: str | None) -> bytes:
+    if not value:
+        return b""
+    data = value.encode("utf-8")
+    return _key(number, _LENGTH_DELIMITED) + _encode_varint(len(data)) + data
+
+
+def _int_field(number: int, value: int) -> bytes:
+    if not value:
+        return b""
+    return _key(number, _VARINT) + _encode_varint(value)
+
+
+def _double_field(number: int, value: float) -> bytes:
+    if not value:
+        return b""
+    return _key(number, _FIXED64) + struct.pack("<d", value)
+
+
+def _decode_fields(data: bytes) -> dict[int, int | bytes]:
+    """Decode a message into its last value per field number."""
+    fields: dict[int, int | bytes] = {}
+    pos = 0
+    while pos < len(data):
+        key, pos = _decode_varint(data, pos)
+        number, wire_type = key >> 3, key & 0x7
+        value: int | bytes
+        if wire_type == _VARINT:
+            value, pos = _decode_varint(data, pos)
+        elif wire_type == _FIXED64:
+            value, pos = data[pos : pos + 8], pos + 8
+        elif wire_type == _LENGTH_DELIMITED:
+            length, pos = _decode_varint(data, pos)
+            value, pos = data[pos : pos + length], pos + length
+        elif wire_type == _FIXED32:
+            value, pos = data[pos : pos + 4], pos + 4
+        else:
+            raise ValueError(f"Unsupported wire type {wire_type}")
+        if pos > len(data):
+            raise ValueError("Truncated message")
+        fields[number] = value
+    return fields
+
+
+def _string(fields: dict[int, int | bytes], number: int) -> str:
+    value = fields.get(number, b"")
+    return value.decode("utf-8") if isinstance(value, bytes) else ""
+
+
+def _int32(fields: dict[int, int | bytes], number: int) -> int:
+    value = fields.get(number, 0)
+    if not isinstance(value, int):
+        return 0
+    value &= 0xFFFFFFFF
+    return value - (1 << 32) if value & 0x80000000 else value
+
+
+def _double(fields: dict[int, int | bytes], number: int) -> float:
+    value = fields.get(number, b"")
+    if not isinstance(value, bytes) or len(value) != 8:
+        return 0.0
+    result: float = struct.unpack("<d", value)[0]
+    return result
+
+
+def encode_analyze_request(request: AnalyzeRequest) -> bytes:
+    """Encode an AnalyzeRequest message."""
+    return (
+        _string_field(1, request.path)
+        + _string_field(2, request.source)
+        + _string_field(3, request.language)
+    )
+
+
+def decode_analyze_request(data: bytes) -> AnalyzeRequest:
+    """Decode an AnalyzeRequest message.
+
+    Raises:
+        ValueError: If the message is malformed.
+
+    """
+    fields = _decode_fields(data)
+    return AnalyzeRequest(
+        path=_string(fields, 1), source=_string(fields, 2), language=_string(fields, 3)
+    )
+
+
+def encode_finding(finding: ScanFinding) -> bytes:
+    """Encode a ScanFinding as a Finding message."""
+    return b"".join(
+        (
+            _string_field(1, finding.pattern_id),
+            _string_field(2, finding.severity.value),
+            _string_field(3, finding.title),
+            _string_field(4, finding.description),
+            _string_field(5, finding.path),
+            _int_field(6, finding.line),
+            _string_field(7, finding.snippet),
+            _double_field(8, finding.confidence),
+            _string_field(9, finding.domain.value),
+            _string_field(10, finding.language),
+            _string_field(11, finding.remediation),
+            _string_field(12, finding_fingerprint(finding)),
+            _int_field(13, int(finding.suppressed)),
+            _string_field(14, finding.suppression_reason),
+            _string_field(15, finding.effort.value if finding.effort else None),
+        )
+    )
+
+
+def decode_finding(data: bytes) -> ScanFinding:
+    """Decode a Finding message into a ScanFinding.
+
+    The fingerprint field is not stored; it is derived from the finding.
+
+    Raises:
+        ValueError: If the message is malformed or has an unknown severity,
+            domain or effort.
+
+    """
+    fields = _decode_fields(data)
+    return ScanFinding(
+        pattern_id=PatternId(_string(fields, 1)),
+        severity=Severity(_string(fields, 2)),
+        title=_string(fields, 3),
+        description=_string(fields, 4),
+        path=_string(fields, 5),
+        line=_int32(fields, 6),
+        snippet=_string(fields, 7),
+        confidence=_double(fields, 8),
+        domain=ArtifactDomain(_string(fields, 9)),
+        language=_string(fields, 10),
+        remediation=_string(fields, 11) or None,
+        suppressed=bool(fields.get(13, 0)),
+        suppression_reason=_string(fields, 14) or None,
+        effort=PatternEffort(_string(fields, 15)) if fields.get(15) else None,
+    )
@@ -0,0 +1,179 @@
+"""gRPC server for the Deep Verify Analyzer service.
+
+Implements ``deepverify.v1.Analyzer`` from ``deepverify.proto``. Requires the
+optional ``grpcio`` dependency (``pip install bmad-assist[grpc]``); the rest
+of the scan package does not import this module.
+
+Example:
+    >>> server = AnalyzerServer(port=50051)
+    >>> server.start()
+    >>> with grpc.insecure_channel(server.address) as channel:
+    ...     for finding in analyze(channel, [AnalyzeRequest("main.go", source)]):
+    ...         print(finding.pattern_id, finding.line)
+    >>> server.shutdown()
+
+"""
+
+from __future__ import annotations
+
+import logging
+from collections.abc import Iterable, Iterator
+from concurrent import futures
+from pathlib import Path
+from typing import Any
+
+try:
+    import grpc
+except ImportError as e:  # pragma: no cover - depends on the environment
+    raise ImportError(
+        "The Deep Verify gRPC server requires grpcio (pip install bmad-assist[grpc])"
+    ) from e
+
+from bmad_assist.deep_verify.core.language_detector import LanguageDetector
+from bmad_assist.deep_verify.patterns.library import PatternLibrary
+from bmad_assist.deep_verify.scan.cache import ScanCache
+from bmad_assist.deep_verify.scan.rpc.messages import (
+    AnalyzeRequest,
+    decode_analyze_request,
+    decode_finding,
+    encode_analyze_request,
+    encode_finding,
+)
+from bmad_assist.deep_verify.scan.scanner import ScanOptions, Scanner
+from bmad_assist.deep_verify.scan.types import ScanFinding
+
+logger = logging.getLogger(__name__)
+
+# Fully qualified service name from deepverify.proto
+SERVICE_NAME = "deepverify.v1.Analyzer"
+
+# Method path used by clients
+ANALYZE_METHOD = f"/{SERVICE_NAME}/Analyze"
+
+# Default number of concurrently served streams
+DEFAULT_MAX_WORKERS = 4
+
+
+class AnalyzerServer:
+    """gRPC server exposing the scanner as ``deepverify.v1.Analyzer``.
+
+    Attributes:
+        _scanner: Scanner shared by all streams.
+        _detector: Language detector for requests without a language.
+        _server: Underlying gRPC server.
+        _host: Bound interface.
+        _port: Bound port.
+
+    """
+
+    def __init__(
+        self,
+        options: ScanOptions | None = None,
+        host: str = "127.0.0.1",
+        port: int = 0,
+        library: PatternLibrary | None = None,
+        cache: ScanCache | None = None,
+        max_workers: int = DEFAULT_MAX_WORKERS,
+    ) -> None:
+        """Initialize and bind the server.
+
+        Args:
+            options: Scan options for every request.
+            host: Interface to bind.
+            port: Port to bind (0 picks a free port).
+            library: Pattern library (defaults to the default library).
+            cache: Result cache for the scanner, if any.
+            max_workers: Number of concurrently served streams.
+
+        Raises:
+            RuntimeError: If the address cannot be bound.
+
+        """
+        self._scanner = Scanner(options, library=library, cache=cache)
+        self._detector = LanguageDetector()
+        self._server = grpc.server(futures.ThreadPoolExecutor(max_workers=max_workers))
+        self._server.add_generic_rpc_handlers((self._handler(),))
+        self._host = host
+        self._port = self._server.add_insecure_port(f"{host}:{port}")
+        if not self._port:
+            raise RuntimeError(f"Failed to bind gRPC server to {host}:{port}")
+
+    def __repr__(self) -> str:
+        """Return a string representation of the server."""
+        return f"AnalyzerServer(address={self.address!r})"
+
+    @property
+    def address(self) -> str:
+        """Bound ``host:port``, usable as a channel target."""
+        return f"{self._host}:{self._port}"
+
+    def start(self) -> None:
+        """Start serving on background threads."""
+        self._server.start()
+        logger.info("Deep Verify gRPC server listening on %s", self.address)
+
+    def serve_forever(self) -> None:
+        """Start serving and block until the server stops."""
+        self.start()
+        self._server.wait_for_termination()
+
+    def shutdown(self, grace: float | None = 5.0) -> None:
+        """Stop serving, giving in-flight streams `grace` seconds to finish."""
+        self._server.stop(grace).wait()
+        logger.info("Deep Verify gRPC server stopped")
+
+    def analyze(self, requests: Iterable[AnalyzeRequest]) -> Iterator[ScanFinding]:
+        """Analyze requests in order, yielding each file's findings.
+
+        Requests whose language cannot be determined or has no code
+        patterns yield no findings, as unknown files do in tree scans.
+        """
+        for request in requests:
+            language = request.language.lower() or self._detect_language(request)
+            if language is None:
+                logger.debug("No language for %s, skipping", request.path or "<source>")
+                continue
+            yield from self._scanner.scan_source(
+                request.source, language, rel_path=request.path or "<source>"
+            )
+
+    def _detect_language(self, request: AnalyzeRequest) -> str | None:
+        info = self._detector.detect(Path(request.path or "<source>"), content=request.source)
+        return None if info.is_unknown else info.language
+
+    def _handler(self) -> Any:
+        def analyze(requests: Iterator[AnalyzeRequest], context: Any) -> Iterator[ScanFinding]:
+            try:
+                yield from self.analyze(requests)
+            except ValueError as e:
+                context.abort(grpc.StatusCode.INVALID_ARGUMENT, str(e))
+
+        return grpc.method_handlers_generic_handler(
+            SERVICE_NAME,
+            {
+                "Analyze": grpc.stream_stream_rpc_method_handler(
+                    analyze,
+                    request_deserializer=decode_analyze_request,
+                    response_serializer=encode_finding,
+                )
+            },
+        )
+
+
+def analyze(channel: Any, requests: Iterable[AnalyzeRequest]) -> Iterator[ScanFinding]:
+    """Call Analyze over a gRPC channel.
+
+    Args:
+        channel: gRPC channel connected to an Analyzer server.
+        requests: Files to analyze.
+
+    Returns:
+        Iterator over the streamed findings.
+
+    """
+    call = channel.stream_stream(
+        ANALYZE_METHOD,
+        request_serializer=encode_analyze_request,
+        response_deserializer=decode_finding,
+    )
+    return iter(call(iter(requests)))
@@ -0,0 +1,299 @@
+"""SARIF export for Deep Verify scans.
+
+GitHub code scanning and other SARIF consumers accept a SARIF 2.1.0 log:
+one run with the tool's rules and a result per finding, located by file
+URI and start line. See https://docs.oasis-open.org/sarif/sarif/v2.1.0/
+
+Given the report of a previous scan as a baseline, every result carries a
+``baselineState``: ``new`` for findings not in the baseline, ``unchanged``
+for findings in both and ``absent`` for baseline findings that are gone,
+matched by fingerprint as in ``compare_findings``. Delta mode keeps only
+the changes (``new`` and ``absent`` results) for incremental uploads.
+
+SARIF logs from other scanners (gosec, staticcheck, ...) can be merged into
+a report with ``import_sarif``, so one report, export or diff covers every
+tool. Imported findings are tagged with their tool.
+
+Example:
+    >>> from pathlib import Path
+    >>> from bmad_assist.deep_verify.scan import Scanner, deserialize_scan_report, write_sarif
+    >>> report = Scanner().scan(Path("."))
+    >>> previous = deserialize_scan_report(json.loads(Path("base.json").read_text()))
+    >>> with open("deepverify.sarif", "w") as f:
+    ...     write_sarif(report, f, baseline=previous, delta_only=True)
+    >>> with open("gosec.sarif") as f:
+    ...     report = import_sarif(report, f, tool="gosec")
+
+"""
+
+from __future__ import annotations
+
+import json
+from dataclasses import replace
+from pathlib import Path, PurePosixPath
+from typing import Any, TextIO
+from urllib.parse import unquote, urlparse
+
+from bmad_assist.deep_verify.core.language_detector import EXTENSION_MAP
+from bmad_assist.deep_verify.core.types import ArtifactDomain, PatternId, Severity
+from bmad_assist.deep_verify.scan.fixes import compare_findings
+from bmad_assist.deep_verify.scan.types import ScanFinding, ScanReport, finding_fingerprint
+
+SARIF_SCHEMA = "https://json.schemastore.org/sarif-2.1.0.json"
+SARIF_VERSION = "2.1.0"
+
+# Deep Verify severity -> SARIF result level (error, warning, note)
+SARIF_LEVEL: dict[Severity, str] = {
+    Severity.CRITICAL: "error",
+    Severity.ERROR: "error",
+    Severity.WARNING: "warning",
+    Severity.INFO: "note",
+}
+
+# SARIF result level -> Deep Verify severity, for imported results
+SARIF_SEVERITY: dict[str, Severity] = {
+    "error": Severity.ERROR,
+    "warning": Severity.WARNING,
+    "note": Severity.INFO,
+    "none": Severity.INFO,
+}
+
+
+def sarif_result(finding: ScanFinding, baseline_state: str | None = None) -> dict[str, Any]:
+    """Convert a finding to a SARIF result.
+
+    Args:
+        finding: Scan finding to convert.
+        baseline_state: SARIF baselineState ("new", "unchanged" or
+            "absent"), omitted when None.
+
+    Returns:
+        Result object with rule, level, message, location and fingerprint.
+
+    """
+    result: dict[str, Any] = {
+        "ruleId": finding.pattern_id,
+        "level": SARIF_LEVEL[finding.severity],
+        "message": {"text": finding.title},
+        "locations": [
+            {
+                "physicalLocation": {
+                    "artifactLocation": {"uri": finding.path},
+                    "region": {"startLine": finding.line},
+                }
+            }
+        ],
+        "partialFingerprints": {"deepVerify/v1": finding_fingerprint(finding)},
+    }
+    if finding.tool is not None:
+        result["properties"] = {"tool": finding.tool}
+    if baseline_state is not None:
+        result["baselineState"] = baseline_state
+    return result
+
+
+def _rules(findings: list[ScanFinding]) -> list[dict[str, Any]]:
+    """Build the driver's rule list for the reported pattern IDs, sorted by ID."""
+    rules: dict[str, dict[str, Any]] = {}
+    for finding in findings:
+        if finding.pattern_id in rules:
+            continue
+        rule: dict[str, Any] = {
+            "id": finding.pattern_id,
+            "shortDescription": {"text": finding.description or finding.title},
+        }
+        if finding.remediation:
+            rule["help"] = {"text": finding.remediation}
+        rules[finding.pattern_id] = rule
+    return [rules[rule_id] for rule_id in sorted(rules)]
+
+
+def sarif_log(
+    report: ScanReport, baseline: ScanReport | None = None, delta_only: bool = False
+) -> dict[str, Any]:
+    """Convert a scan report to a SARIF log.
+
+    Args:
+        report: Scan report to convert.
+        baseline: Report of an earlier scan; sets baselineState on results
+            and adds ``absent`` results for baseline findings that are gone.
+        delta_only: Drop ``unchanged`` results (requires a baseline).
+
+    Returns:
+        SARIF log with one run and rules for the reported pattern IDs.
+        Results for the report's unsuppressed findings come first, in
+        report order, followed by absent results in baseline order. The
+        report's labels become the run's properties.
+
+    Raises:
+        ValueError: If delta_only is set without a baseline.
+
+    """
+    if delta_only and baseline is None:
+        raise ValueError("delta_only requires a baseline report")
+
+    findings = report.unsuppressed_findings()
+    emitted: list[tuple[ScanFinding, str | None]]
+    if baseline is None:
+        emitted = [(f, None) for f in findings]
+    else:
+        absent, introduced = compare_findings(baseline.unsuppressed_findings(), findings)
+        new_ids = {id(f) for f in introduced}
+        emitted = [
+            (f, "new" if id(f) in new_ids else "unchanged")
+            for f in findings
+            if id(f) in new_ids or not delta_only
+        ]
+        emitted.extend((f, "absent") for f in absent)
+
+    run: dict[str, Any] = {
+        "tool": {"driver": {"name": "deep-verify", "rules": _rules([f for f, _ in emitted])}},
+        "results": [sarif_result(f, state) for f, state in emitted],
+    }
+    if report.labels:
+        run["properties"] = dict(sorted(report.labels.items()))
+    return {"$schema": SARIF_SCHEMA, "version": SARIF_VERSION, "runs": [run]}
+
+
+def write_sarif(
+    report: ScanReport,
+    out: TextIO,
+    baseline: ScanReport | None = None,
+    delta_only: bool = False,
+) -> None:
+    """Write a scan report as SARIF JSON.
+
+    Args:
+        report: Scan report to write.
+        out: Text stream receiving the JSON document.
+        baseline: Report of an earlier scan (see sarif_log).
+        delta_only: Write only new and absent results (see sarif_log).
+
+    Raises:
+        ValueError: If delta_only is set without a baseline.
+
+    """
+    json.dump(sarif_log(report, baseline, delta_only), out, indent=2)
+    out.write("\n")
+
+
+def import_sarif(
+    report: ScanReport,
+    stream: TextIO,
+    tool: str,
+    domain: ArtifactDomain = ArtifactDomain.SECURITY,
+) -> ScanReport:
+    """Merge another tool's SARIF results into a scan report.
+
+    Results are read from every run of the log. Levels map back to
+    severities (``note`` and ``none`` become info; a missing level falls back
+    to the rule's default, then ``warning``), accepted SARIF suppressions
+    mark findings suppressed, and absolute file URIs under the report root
+    are made relative to it. Results without a file location and ``absent``
+    baseline results are skipped.
+
+    Args:
+        report: Report to merge into.
+        stream: Text stream with the SARIF JSON document.
+        tool: Name of the tool that produced the log, set on every
+            imported finding.
+        domain: Domain of the imported findings (SARIF has no equivalent).
+
+    Returns:
+        Copy of the report with the imported findings added, sorted by
+        path, line and pattern ID.
+
+    Raises:
+        ValueError: If the document is not a SARIF log or a result is
+            malformed.
+
+    """
+    try:
+        data = json.load(stream)
+    except json.JSONDecodeError as e:
+        raise ValueError(f"Invalid SARIF JSON: {e}") from None
+    runs = data.get("runs") if isinstance(data, dict) else None
+    if not isinstance(runs, list):
+        raise ValueError("Not a SARIF log: missing runs")
+
+    root = Path(report.root).resolve()
+    imported: list[ScanFinding] = []
+    for run in runs:
+        try:
+            rules = run.get("tool", {}).get("driver", {}).get("rules") or []
+            for result in run.get("results") or []:
+                finding = _imported_finding(result, rules, root, tool, domain)
+                if finding is not None:
+                    imported.append(finding)
+        except (AttributeError, IndexError, KeyError, TypeError) as e:
+            raise ValueError(f"Malformed SARIF result: {e!r}") from None
+
+    findings = sorted(
+        [*report.findings, *imported], key=lambda f: (f.path, f.line, f.pattern_id)
+    )
+    return replace(report, findings=findings)
+
+
+def _imported_finding(
+    result: dict[str, Any],
+    rules: list[dict[str, Any]],
+    root: Path,
+    tool: str,
+    domain: ArtifactDomain,
+) -> ScanFinding | None:
+    """Convert one SARIF result to a finding, or None if it has no file location."""
+    if result.get("baselineState") == "absent" or not result.get("locations"):
+        return None
+    physical = result["locations"][0].get("physicalLocation") or {}
+    uri = (physical.get("artifactLocation") or {}).get("uri")
+    if not uri:
+        return None
+    region = physical.get("region") or {}
+
+    rule: dict[str, Any] = {}
+    if "ruleIndex" in result:
+        rule = rules[result["ruleIndex"]]
+    elif "ruleId" in result:
+        rule = next((r for r in rules if r.get("id") == result["ruleId"]), {})
+    level = result.get("level") or (rule.get("defaultConfiguration") or {}).get("level")
+
+    snippet = (region.get("snippet") or {}).get("text", "").strip()
+    accepted = [
+        s for s in result.get("suppressions") or [] if s.get("status", "accepted") == "accepted"
+    ]
+    path = _relative_path(uri, root)
+    language = EXTENSION_MAP.get(PurePosixPath(path).suffix.lower(), ("unknown",))[0]
+    description = _text(rule.get("fullDescription")) or _text(rule.get("shortDescription"))
+    return ScanFinding(
+        pattern_id=PatternId(result.get("ruleId") or rule.get("id") or tool),
+        severity=SARIF_SEVERITY[level or "warning"],
+        title=_text(result.get("message")) or description,
+        description=description,
+        path=path,
+        line=region.get("startLine", 1),
+        snippet=snippet.splitlines()[0].strip() if snippet else "",
+        confidence=1.0,
+        domain=domain,
+        language=language,
+        remediation=_text(rule.get("help")) or None,
+        suppressed=bool(accepted),
+        suppression_reason=accepted[0].get("justification") if accepted else None,
+        tool=tool,
+    )
+
+
+def _text(message: dict[str, Any] | None) -> str:
+    """Return the text of a SARIF message or multiformat string ("" if absent)."""
+    return (message or {}).get("text", "")
+
+
+def _relative_path(uri: str, root: Path) -> str:
+    """Return a result's file path, relative to the scan root when under it."""
+    parsed = urlparse(uri)
+    path = unquote(parsed.path if parsed.scheme == "file" else uri)
+    if Path(path).is_absolute():
+        try:
+            return Path(path).resolve().relative_to(root).as_posix()
+        except ValueError:
+            return path
+    return PurePosixPath(path).as_posix()
@@ -0,0 +1,1370 @@
+"""Source tree scanner for Deep Verify.
+
+The scanner walks a directory, detects each file's language and matches the
+language-specific code patterns against it. It is deterministic and makes no
+LLM calls, which makes it suitable for CI gating.
+
+Example:
+    >>> from pathlib import Path
+    >>> from bmad_assist.deep_verify.scan import Scanner
+    >>> report = Scanner().scan(Path("services"))
+    >>> for finding in report.findings:
+    ...     print(f"{finding.path}:{finding.line} {finding.pattern_id}")
+
+"""
+
+from __future__ import annotations
+
+import hashlib
+import json
+import logging
+import os
+import random
+import re
+import threading
+from collections.abc import Callable, Iterable
+from concurrent.futures import Future, ThreadPoolExecutor
+from contextlib import ExitStack
+from dataclasses import dataclass, field, replace
+from datetime import UTC, date, datetime
+from fnmatch import fnmatchcase
+from functools import partial
+from pathlib import Path, PurePosixPath
+
+from bmad_assist.deep_verify.core.language_detector import LanguageDetector
+from bmad_assist.deep_verify.core.types import ArtifactDomain, Pattern, PatternFix, Severity
+from bmad_assist.deep_verify.patterns.library import PatternLibrary, get_default_pattern_library
+from bmad_assist.deep_verify.patterns.matcher import MatchContext, PatternMatcher
+from bmad_assist.deep_verify.patterns.types import PatternMatchResult
+from bmad_assist.deep_verify.scan.bitwise import BITWISE_CONDITION_PATTERN, find_bitwise_conditions
+from bmad_assist.deep_verify.scan.bodies import UNBOUNDED_BODY_PATTERN, find_unbounded_body_reads
+from bmad_assist.deep_verify.scan.cache import ScanCache
+from bmad_assist.deep_verify.scan.cancellation import (
+    UNCANCELLABLE_LOOP_PATTERN,
+    find_uncancellable_loops,
+)
+from bmad_assist.deep_verify.scan.channels import ONE_WAY_CHANNEL_PATTERN, find_one_way_channels
+from bmad_assist.deep_verify.scan.clocks import TIME_IDIOM_PATTERN, find_time_idioms
+from bmad_assist.deep_verify.scan.config import ScanConfig, ScanConfigResolver, matches_selector
+from bmad_assist.deep_verify.scan.contexts import CONTEXT_FIELD_PATTERN, find_context_fields
+from bmad_assist.deep_verify.scan.conversions import (
+    NARROWING_CONVERSION_PATTERN,
+    find_narrowing_conversions,
+)
+from bmad_assist.deep_verify.scan.copies import WAITGROUP_COPY_PATTERN, find_waitgroup_copies
+from bmad_assist.deep_verify.scan.defers import DEFERRED_SEND_PATTERN, find_deferred_sends
+from bmad_assist.deep_verify.scan.deprecations import (
+    DEFAULT_DEPRECATED_FUNCS,
+    DEPRECATED_FUNC_PATTERN,
+    find_deprecated_calls,
+)
+from bmad_assist.deep_verify.scan.drops import SILENT_DROP_PATTERN, find_silent_drops
+from bmad_assist.deep_verify.scan.embedding import (
+    PROMOTED_MUTEX_PATTERN,
+    find_promoted_mutex_locks,
+)
+from bmad_assist.deep_verify.scan.enums import ENUM_SWITCH_PATTERN, find_enum_switches
+from bmad_assist.deep_verify.scan.environment import (
+    UNCHECKED_ENV_PATTERN,
+    find_unchecked_env_reads,
+)
+from bmad_assist.deep_verify.scan.errgroups import GROUP_CONTEXT_PATTERN, find_group_context_leaks
+from bmad_assist.deep_verify.scan.events import AnalysisEvent, AnalysisEventKind
+from bmad_assist.deep_verify.scan.fixes import FileFix, apply_fixes, compare_findings
+from bmad_assist.deep_verify.scan.generators import SHARED_RAND_PATTERN, find_shared_rands
+from bmad_assist.deep_verify.scan.helpers import TEST_HELPER_PATTERN, find_unmarked_test_helpers
+from bmad_assist.deep_verify.scan.indexes import (
+    CAPTURED_INDEX_PATTERN,
+    find_captured_index_writes,
+)
+from bmad_assist.deep_verify.scan.keys import STRING_CONTEXT_KEY_PATTERN, find_string_context_keys
+from bmad_assist.deep_verify.scan.lazyinit import LAZY_MAP_PATTERN, find_unguarded_lazy_maps
+from bmad_assist.deep_verify.scan.literals import (
+    UNKEYED_LITERAL_PATTERN,
+    find_unkeyed_literals,
+)
+from bmad_assist.deep_verify.scan.lockcopies import MUTEX_COPY_PATTERN, find_mutex_struct_copies
+from bmad_assist.deep_verify.scan.maps import (
+    MAP_VALUE_MUTATION_PATTERN,
+    find_map_value_mutations,
+)
+from bmad_assist.deep_verify.scan.nesting import LOOP_LOCK_PATTERN, find_loop_locks
+from bmad_assist.deep_verify.scan.nilvalues import NIL_MAP_VALUE_PATTERN, find_nil_map_values
+from bmad_assist.deep_verify.scan.overflow import SIZE_OVERFLOW_PATTERN, find_size_overflows
+from bmad_assist.deep_verify.scan.packages import PackageResolver, directory_package
+from bmad_assist.deep_verify.scan.panics import PANIC_ROUTE_PATTERN, find_panic_routes
+from bmad_assist.deep_verify.scan.paths import PATH_CONCAT_PATTERN, find_concatenated_paths
+from bmad_assist.deep_verify.scan.policy import (
+    SEVERITY_LADDER,
+    PathRule,
+    apply_path_rules,
+    target_arch_rules,
+)
+from bmad_assist.deep_verify.scan.polls import SLEEP_POLL_PATTERN, find_sleep_polls
+from bmad_assist.deep_verify.scan.randomness import (
+    DEFAULT_RANDOM_SECRET_NAMES,
+    WEAK_RANDOM_PATTERN,
+    find_weak_random_secrets,
+)
+from bmad_assist.deep_verify.scan.receivers import (
+    VALUE_RECEIVER_PATTERN,
+    find_value_receiver_mutations,
+)
+from bmad_assist.deep_verify.scan.selects import (
+    CROSS_CASE_RECEIVE_PATTERN,
+    find_cross_case_receives,
+)
+from bmad_assist.deep_verify.scan.sensitive import (
+    DEFAULT_SENSITIVE_NAMES,
+    SENSITIVE_LOG_PATTERN,
+    find_sensitive_logs,
+    normalize_sensitive_names,
+)
+from bmad_assist.deep_verify.scan.snippets import wrap_go_snippet
+from bmad_assist.deep_verify.scan.spawns import LOCKED_SPAWN_PATTERN, find_locked_spawns
+from bmad_assist.deep_verify.scan.suppressions import SUPPRESSION_PATTERN, apply_suppressions
+from bmad_assist.deep_verify.scan.timers import TIMER_SELECT_PATTERN, find_timer_selects
+from bmad_assist.deep_verify.scan.types import (
+    IssueGrouping,
+    ScanFinding,
+    ScanReport,
+    validate_labels,
+)
+from bmad_assist.deep_verify.scan.valuekeys import (
+    DUPLICATE_CONTEXT_KEY_PATTERN,
+    find_duplicate_context_keys,
+)
+from bmad_assist.deep_verify.scan.visibility import filter_exported
+from bmad_assist.deep_verify.scan.waitgroups import UNBOUNDED_WAIT_PATTERN, find_unbounded_waits
+
+logger = logging.getLogger(__name__)
+
+# Checks implemented in code rather than pattern signals
+BUILTIN_PATTERNS: tuple[Pattern, ...] = (
+    DEPRECATED_FUNC_PATTERN,
+    SENSITIVE_LOG_PATTERN,
+    ENUM_SWITCH_PATTERN,
+    WEAK_RANDOM_PATTERN,
+    VALUE_RECEIVER_PATTERN,
+    UNKEYED_LITERAL_PATTERN,
+    CONTEXT_FIELD_PATTERN,
+    TIMER_SELECT_PATTERN,
+    UNBOUNDED_WAIT_PATTERN,
+    BITWISE_CONDITION_PATTERN,
+    DEFERRED_SEND_PATTERN,
+    PANIC_ROUTE_PATTERN,
+    MAP_VALUE_MUTATION_PATTERN,
+    TEST_HELPER_PATTERN,
+    SIZE_OVERFLOW_PATTERN,
+    WAITGROUP_COPY_PATTERN,
+    LOCKED_SPAWN_PATTERN,
+    CROSS_CASE_RECEIVE_PATTERN,
+    PATH_CONCAT_PATTERN,
+    STRING_CONTEXT_KEY_PATTERN,
+    TIME_IDIOM_PATTERN,
+    UNBOUNDED_BODY_PATTERN,
+    SHARED_RAND_PATTERN,
+    LOOP_LOCK_PATTERN,
+    SILENT_DROP_PATTERN,
+    UNCHECKED_ENV_PATTERN,
+    CAPTURED_INDEX_PATTERN,
+    NARROWING_CONVERSION_PATTERN,
+    LAZY_MAP_PATTERN,
+    SLEEP_POLL_PATTERN,
+    GROUP_CONTEXT_PATTERN,
+    NIL_MAP_VALUE_PATTERN,
+    DUPLICATE_CONTEXT_KEY_PATTERN,
+    UNCANCELLABLE_LOOP_PATTERN,
+    PROMOTED_MUTEX_PATTERN,
+    MUTEX_COPY_PATTERN,
+    ONE_WAY_CHANNEL_PATTERN,
+)
+
+# Detector of a check implemented in code: (text, rel_path, config) -> findings
+BuiltinDetector = Callable[[str, str, ScanConfig], list[ScanFinding]]
+
+# Directories never descended into
+DEFAULT_EXCLUDED_DIRS: frozenset[str] = frozenset(
+    {".git", ".hg", ".svn", ".venv", "venv", "__pycache__", "node_modules", "vendor"}
+)
+
+# Directory holding vendored Go dependencies (see ScanOptions.vendor_allowlist)
+VENDOR_DIR = "vendor"
+
+# Maximum title length, matching PatternMatchMethod
+MAX_TITLE_LENGTH = 80
+
+# Files larger than this are usually generated and skipped (bytes)
+DEFAULT_MAX_FILE_BYTES = 512 * 1024
+
+# Concurrent file reads; loading mostly waits on IO, so exceed the CPU count
+DEFAULT_LOAD_CONCURRENCY = 16
+
+# Files with at least this many lines split their detectors across workers
+DEFAULT_FANOUT_MIN_LINES = 10_000
+
+# Severities that cap_findings samples, with their weights: a
+# warning is twice as likely to be kept as an info. Other severities are kept.
+CAP_SAMPLE_WEIGHTS: dict[Severity, int] = {Severity.WARNING: 2, Severity.INFO: 1}
+
+# Seed of cap_findings' sampling, fixed so capped reports are reproducible
+DEFAULT_SAMPLE_SEED = 0
+
+# Generated-file marker (https://go.dev/s/generatedcode), also used by other
+# generators in "#" comments
+GENERATED_HEADER_RE = re.compile(r"^(?://|#)\s*Code generated .* DO NOT EDIT\.?\s*$")
+
+
+def known_patterns(library: PatternLibrary | None = None) -> tuple[Pattern, ...]:
+    """Return every pattern a scan can report.
+
+    These are the library's patterns, the checks implemented in code
+    (BUILTIN_PATTERNS) and the suppression governance pattern (CC-103).
+
+    Args:
+        library: Pattern library; None uses the default library.
+
+    """
+    library = library if library is not None else get_default_pattern_library()
+    return (*library.get_all_patterns(), *BUILTIN_PATTERNS, SUPPRESSION_PATTERN)
+
+
+def is_generated_source(text: str) -> bool:
+    """Check whether source text carries a generated-code header.
+
+    The marker must be a line comment in the file's leading comment block
+    (before the first code line).
+    """
+    for line in text.splitlines():
+        stripped = line.strip()
+        if not stripped:
+            continue
+        if not stripped.startswith(("//", "#")):
+            return False
+        if GENERATED_HEADER_RE.match(stripped):
+            return True
+    return False
+
+
+def read_change_manifest(path: Path) -> frozenset[str]:
+    """Read a change manifest for ScanOptions.changed_files.
+
+    The manifest lists one path per line, relative to the scan root. Blank
+    lines and lines starting with ``#`` are ignored; backslashes and
+    leading ``./`` are normalized away.
+
+    Raises:
+        OSError: If the file cannot be read.
+
+    """
+    changed: set[str] = set()
+    for line in path.read_text(encoding="utf-8").splitlines():
+        entry = line.strip()
+        if entry and not entry.startswith("#"):
+            changed.add(PurePosixPath(entry.replace("\\", "/")).as_posix())
+    return frozenset(changed)
+
+
+def cap_findings(
+    findings: list[ScanFinding], limit: int, seed: int = DEFAULT_SAMPLE_SEED
+) -> tuple[list[ScanFinding], dict[Severity, int]]:
+    """Cap findings at limit without dropping critical or error findings.
+
+    Critical and error findings are always kept, even beyond the limit.
+    The remaining budget goes to the severities in ``CAP_SAMPLE_WEIGHTS``
+    in proportion to their counts times their weights, and each keeps
+    findings evenly spaced through the list, so the sample spans the
+    whole tree rather than its first files. Where the spacing starts is
+    drawn from seed, so the same seed keeps the same findings.
+
+    Args:
+        findings: Findings in report order.
+        limit: Most findings to keep.
+        seed: Seed of the sampling.
+
+    Returns:
+        The kept findings in their original order, and the number of
+        dropped findings per severity (zero counts omitted).
+
+    """
+    if len(findings) <= limit:
+        return list(findings), {}
+    pools: dict[Severity, list[int]] = {severity: [] for severity in CAP_SAMPLE_WEIGHTS}
+    kept: list[int] = []
+    for index, finding in enumerate(findings):
+        pools.get(finding.severity, kept).append(index)
+    budget = max(limit - len(kept), 0)
+    weight_left = sum(CAP_SAMPLE_WEIGHTS[s] * len(pool) for s, pool in pools.items())
+    dropped: dict[Severity, int] = {}
+    rng = random.Random(seed)
+    for severity, pool in pools.items():
+        weight = CAP_SAMPLE_WEIGHTS[severity] * len(pool)
+        quota = min(len(pool), budget * weight // weight_left) if weight else 0
+        budget -= quota
+        weight_left -= weight
+        if quota:
+            shift = rng.randrange(len(pool))
+            kept.extend(pool[(k * len(pool) + shift) // quota] for k in range(quota))
+        if quota < len(pool):
+            dropped[severity] = len(pool) - quota
+    return [findings[index] for index in sorted(kept)], dropped
+
+
+def cap_report(report: ScanReport, limit: int, seed: int = DEFAULT_SAMPLE_SEED) -> ScanReport:
+    """Cap a report's unsuppressed findings at limit (see cap_findings).
+
+    Apply it last, after baselines and imported findings: suppressed
+    findings, including those a baseline accepts, are kept and do not
+    use the budget, so only findings that can fail the scan are sampled.
+
+    Args:
+        report: Report to cap.
+        limit: Most unsuppressed findings to keep.
+        seed: Seed of the sampling.
+
+    Returns:
+        The report with the sample and its ``dropped_findings`` counts.
+
+    Raises:
+        ValueError: If limit is negative.
+
+    """
+    if limit < 0:
+        raise ValueError(f"max_findings must be non-negative, got {limit}")
+    active = [f for f in report.findings if not f.suppressed]
+    kept, dropped = cap_findings(active, limit, seed)
+    if not dropped:
+        return report
+    sampled = {id(f) for f in kept}
+    findings = [f for f in report.findings if f.suppressed or id(f) in sampled]
+    return replace(report, findings=findings, dropped_findings=dropped)
+
+
+def _utc_now() -> datetime:
+    """Return the current UTC time (default scan clock)."""
+    return datetime.now(UTC)
+
+
+def _summary_finding(finding: ScanFinding) -> ScanFinding:
+    """Strip a finding to its summary fields (see ScanOptions.summary_only)."""
+    return replace(finding, title="", description="", snippet="", remediation=None)
+
+
+def _without_version(key: tuple[object, ...]) -> tuple[object, ...]:
+    """Drop the file modification time and size from a cache key (see Scanner._cache_key)."""
+    return key[:2] + key[4:]
+
+
+@dataclass(frozen=True, slots=True)
+class ScanOptions:
+    """Options controlling a scan.
+
+    Attributes:
+        threshold: Minimum pattern confidence (0.0-1.0).
+        config: Base config applied beneath any ``.deepverify.yaml`` files.
+        use_config_files: Whether per-directory ``.deepverify.yaml`` files are read.
+        excluded_dirs: Directory names that are never scanned.
+        clock: Source of the report's start time and duration. Inject a fixed
+            clock for deterministic metadata in tests.
+        reproducible: Report zero durations regardless of the clock.
+        max_file_bytes: Files larger than this are skipped and listed in
+            ``ScanReport.skipped_large_files``. None disables the limit.
+        path_severity_rules: Path-based severity adjustments, applied in
+            order after config severity overrides (see scan.policy).
+        load_concurrency: Worker threads reading files (IO-bound phase).
+        analyze_concurrency: Worker threads matching patterns (CPU-bound
+            phase); None uses the CPU count. With 1, analysis runs on the
+            calling thread, which keeps regex timeouts active when that is
+            the main thread.
+        fanout_min_lines: Files with at least this many lines run their
+            detectors concurrently, split across analyze_concurrency
+            workers over one shared, read-only match context; results merge
+            in pattern order. None disables the fan-out.
+        deprecated_funcs: Extra deprecated Go functions for CC-111, keyed
+            by ``<import path>.<Func>`` with a replacement hint; merged over
+            the curated default list (see scan.deprecations).
+        show_suppressed: Report findings covered by ``deepverify:ignore``
+            comments, marked suppressed, instead of dropping them.
+        include_generated: Analyze generated files (those with a
+            ``Code generated ... DO NOT EDIT.`` header) instead of skipping
+            them.
+        generated_detectors: Selectors for the detectors that run on
+            generated files when include_generated is set. None runs only
+            concurrency detectors (races, leaks, deadlocks), skipping style
+            and quality checks that generated code routinely trips.
+        target_arch: GOARCH the scanned code is built for. On 32-bit targets
+            (386, arm, mips, mipsle) misaligned 64-bit atomics (CC-117) are
+            raised to critical.
+        sensitive_names: Extra field names, matched as suffixes, that CC-119
+            treats as secrets when logged; added to the default list
+            (see scan.sensitive).
+        random_secret_names: Extra variable and field names, matched as
+            suffixes, that CC-124 treats as secrets when they hold math/rand
+            values; added to the default list (see scan.randomness).
+        unkeyed_local_structs: Also report unkeyed literals (CC-126) of
+            struct types declared in the scanned file, not only of imported
+            types (see scan.literals).
+        changed_files: Files known to have changed since the cached results
+            were recorded, relative to the scan root (POSIX separators),
+            for example from a CI change manifest. When set, only these
+            files and the other files in their directories (packages) are
+            re-analyzed; the rest reuse their latest cached result even
+            if their size or modification time differs, as after a fresh
+            checkout. Files without a cached result are analyzed. Listed
+            files that no longer exist still mark their directory.
+        exported_only: Report only findings inside exported Go functions,
+            methods and types, dropping those in unexported internals
+            (see scan.visibility). Other languages are unaffected.
+        finding_filter: Custom predicate deciding which findings to keep,
+            called with each finding and the report it belongs to (all
+            findings, before filtering) so rules can depend on other
+            findings. It runs last: after suppressions, config severity
+            overrides and path severity rules, on the final findings of
+            ``scan`` and ``scan_source``. Findings for which it returns
+            False are dropped.
+        ratchet_dates: Dates from which findings of a domain fail a scan,
+            for tightening the gate one domain at a time. Before its date
+            (per clock, at scan start) a domain's findings are reported but
+            listed in ``ScanReport.ratcheted_domains`` and left out of
+            ``ScanReport.fatal_findings``. Domains without a date are
+            always fatal.
+        gate_policy: Custom gate deciding whether a scan fails, called with
+            the finished report (see Scanner.failed). When set it fully
+            controls the outcome, and so the CLI exit code: the fail-on
+            severity is ignored, and ratchets apply only if the policy
+            consults ``ScanReport.fatal_findings``. Use it for non-linear
+            policies, such as failing on five warnings or weighting
+            domains.
+        summary_only: Keep only what counts and gates need: findings carry
+            their pattern ID, severity, path and line (plus confidence,
+            domain and language), with empty titles, descriptions and
+            snippets and no remediation. Pattern matches skip the source
+            line lookup. For CI runs that only need counts and pass/fail;
+            fingerprints, which hash the snippet, are not meaningful.
+        event_sink: Callable receiving a typed AnalysisEvent for each step
+            of a tree scan: files skipped, reused from the cache, started
+            and finished, and each finding reported (see scan.events).
+            Calls are serialized, also with concurrent workers.
+        vendor_allowlist: fnmatch-style globs over import paths of vendored
+            Go packages (``vendor/<import path>``) that are scanned although
+            ``vendor`` is an excluded dir, for example internal forks such
+            as ``github.com/acme/*``. A glob's ``*`` also matches ``/``, so
+            it covers subpackages. Other vendored packages stay skipped.
+        issue_grouping: How ``ScanReport.issues`` groups findings into
+            tracker issues: one per finding (default), per file, per rule
+            (pattern), or per rule and file.
+        labels: Caller metadata attached to the report, such as tenant,
+            repository and commit, for multi-tenant storage and filtering.
+            Written to JSON reports under ``labels`` and to SARIF run
+            properties; keys are checked by ``validate_labels``.
+
+    """
+
+    threshold: float = PatternMatcher.DEFAULT_THRESHOLD
+    config: ScanConfig | None = None
+    use_config_files: bool = True
+    excluded_dirs: frozenset[str] = DEFAULT_EXCLUDED_DIRS
+    clock: Callable[[], datetime] = _utc_now
+    reproducible: bool = False
+    max_file_bytes: int | None = DEFAULT_MAX_FILE_BYTES
+    path_severity_rules: tuple[PathRule, ...] = ()
+    load_concurrency: int = DEFAULT_LOAD_CONCURRENCY
+    analyze_concurrency: int | None = None
+    fanout_min_lines: int | None = DEFAULT_FANOUT_MIN_LINES
+    deprecated_funcs: dict[str, str] = field(default_factory=dict)
+    show_suppressed: bool = False
+    include_generated: bool = False
+    generated_detectors: tuple[str, ...] | None = None
+    target_arch: str | None = None
+    sensitive_names: tuple[str, ...] = ()
+    random_secret_names: tuple[str, ...] = ()
+    unkeyed_local_structs: bool = False
+    changed_files: frozenset[str] | None = None
+    exported_only: bool = False
+    finding_filter: Callable[[ScanFinding, ScanReport], bool] | None = None
+    ratchet_dates: dict[ArtifactDomain, date] = field(default_factory=dict)
+    gate_policy: Callable[[ScanReport], bool] | None = None
+    summary_only: bool = False
+    event_sink: Callable[[AnalysisEvent], None] | None = None
+    vendor_allowlist: tuple[str, ...] = ()
+    issue_grouping: IssueGrouping = IssueGrouping.PER_FINDING
+    labels: dict[str, str] = field(default_factory=dict)
+
+
+@dataclass(slots=True)
+class _LoadedFile:
+    """A file read by the load phase and awaiting analysis.
+
+    Attributes:
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+        today: Date used for suppression expiry checks.
+        language: Detected language.
+        patterns: Patterns that run for the file.
+        text: File contents.
+        cache_key: Key under which the result is cached, if caching.
+        builtins: Checks implemented in code (see BUILTIN_PATTERNS) that run.
+        warnings: Detectors that could not run fully on the file, filled by
+            the analyze phase.
+
+    """
+
+    rel_path: str
+    config: ScanConfig
+    today: date
+    language: str
+    patterns: list[Pattern] = field(default_factory=list)
+    text: str = ""
+    cache_key: tuple[object, ...] | None = None
+    builtins: tuple[Pattern, ...] = BUILTIN_PATTERNS
+    warnings: list[str] = field(default_factory=list)
+
+
+class Scanner:
+    """Pattern-only scanner over files and directories.
+
+    Attributes:
+        _options: Scan options.
+        _library: Pattern library providing code patterns.
+        _detector: Language detector for scanned files.
+        _cache: Optional per-file result cache shared across scans.
+        _deprecated_funcs: Deprecated Go functions reported as CC-111.
+        _sensitive_names: Field name suffixes reported as CC-119 when logged.
+        _random_secret_names: Name suffixes reported as CC-124 when they hold
+            math/rand values.
+        _path_rules: Build target rules followed by the path severity rules.
+        _changed_dirs: Directories of ScanOptions.changed_files.
+        _options_key: Hash of the options affecting findings, included in
+            cache keys.
+        _event_lock: Serializes calls to ScanOptions.event_sink.
+
+    """
+
+    def __init__(
+        self,
+        options: ScanOptions | None = None,
+        library: PatternLibrary | None = None,
+        cache: ScanCache | None = None,
+    ) -> None:
+        """Initialize the scanner.
+
+        Args:
+            options: Scan options (defaults to ScanOptions()).
+            library: Pattern library (defaults to the default library).
+            cache: Result cache; unchanged files are not re-analyzed.
+
+        Raises:
+            ValueError: If the threshold is not between 0.0 and 1.0,
+                max_file_bytes is negative, a concurrency or
+                fanout_min_lines is below 1, or a label is invalid.
+
+        """
+        self._options = options or ScanOptions()
+        if not 0.0 <= self._options.threshold <= 1.0:
+            raise ValueError(
+                f"threshold must be between 0.0 and 1.0, got {self._options.threshold}"
+            )
+        if self._options.max_file_bytes is not None and self._options.max_file_bytes < 0:
+            raise ValueError(
+                f"max_file_bytes must be non-negative, got {self._options.max_file_bytes}"
+            )
+        for name in ("load_concurrency", "analyze_concurrency", "fanout_min_lines"):
+            value = getattr(self._options, name)
+            if value is not None and value < 1:
+                raise ValueError(f"{name} must be at least 1, got {value}")
+        validate_labels(self._options.labels)
+        self._library = library if library is not None else get_default_pattern_library()
+        self._detector = LanguageDetector()
+        self._cache = cache
+        self._deprecated_funcs = {**DEFAULT_DEPRECATED_FUNCS, **self._options.deprecated_funcs}
+        self._sensitive_names = normalize_sensitive_names(
+            (*DEFAULT_SENSITIVE_NAMES, *self._options.sensitive_names)
+        )
+        self._random_secret_names = normalize_sensitive_names(
+            (*DEFAULT_RANDOM_SECRET_NAMES, *self._options.random_secret_names)
+        )
+        self._changed_dirs = frozenset(
+            directory_package(p) for p in self._options.changed_files or ()
+        )
+        self._path_rules = (
+            *target_arch_rules(self._options.target_arch),
+            *self._options.path_severity_rules,
+        )
+        # Detector of each check in BUILTIN_PATTERNS, bound to the scan's options
+        self._builtin_detectors: dict[str, BuiltinDetector] = {
+            DEPRECATED_FUNC_PATTERN.id: partial(
+                find_deprecated_calls, deprecated=self._deprecated_funcs
+            ),
+            SENSITIVE_LOG_PATTERN.id: partial(find_sensitive_logs, names=self._sensitive_names),
+            ENUM_SWITCH_PATTERN.id: find_enum_switches,
+            WEAK_RANDOM_PATTERN.id: partial(
+                find_weak_random_secrets, names=self._random_secret_names
+            ),
+            VALUE_RECEIVER_PATTERN.id: find_value_receiver_mutations,
+            UNKEYED_LITERAL_PATTERN.id: partial(
+                find_unkeyed_literals, include_local=self._options.unkeyed_local_structs
+            ),
+            CONTEXT_FIELD_PATTERN.id: find_context_fields,
+            TIMER_SELECT_PATTERN.id: find_timer_selects,
+            UNBOUNDED_WAIT_PATTERN.id: find_unbounded_waits,
+            BITWISE_CONDITION_PATTERN.id: find_bitwise_conditions,
+            DEFERRED_SEND_PATTERN.id: find_deferred_sends,
+            PANIC_ROUTE_PATTERN.id: find_panic_routes,
+            MAP_VALUE_MUTATION_PATTERN.id: find_map_value_mutations,
+            TEST_HELPER_PATTERN.id: find_unmarked_test_helpers,
+            SIZE_OVERFLOW_PATTERN.id: find_size_overflows,
+            WAITGROUP_COPY_PATTERN.id: find_waitgroup_copies,
+            LOCKED_SPAWN_PATTERN.id: find_locked_spawns,
+            CROSS_CASE_RECEIVE_PATTERN.id: find_cross_case_receives,
+            PATH_CONCAT_PATTERN.id: find_concatenated_paths,
+            STRING_CONTEXT_KEY_PATTERN.id: find_string_context_keys,
+            TIME_IDIOM_PATTERN.id: find_time_idioms,
+            UNBOUNDED_BODY_PATTERN.id: find_unbounded_body_reads,
+            SHARED_RAND_PATTERN.id: find_shared_rands,
+            LOOP_LOCK_PATTERN.id: find_loop_locks,
+            SILENT_DROP_PATTERN.id: find_silent_drops,
+            UNCHECKED_ENV_PATTERN.id: find_unchecked_env_reads,
+            CAPTURED_INDEX_PATTERN.id: find_captured_index_writes,
+            NARROWING_CONVERSION_PATTERN.id: find_narrowing_conversions,
+            LAZY_MAP_PATTERN.id: find_unguarded_lazy_maps,
+            SLEEP_POLL_PATTERN.id: find_sleep_polls,
+            GROUP_CONTEXT_PATTERN.id: find_group_context_leaks,
+            NIL_MAP_VALUE_PATTERN.id: find_nil_map_values,
+            DUPLICATE_CONTEXT_KEY_PATTERN.id: find_duplicate_context_keys,
+            UNCANCELLABLE_LOOP_PATTERN.id: find_uncancellable_loops,
+            PROMOTED_MUTEX_PATTERN.id: find_promoted_mutex_locks,
+            MUTEX_COPY_PATTERN.id: find_mutex_struct_copies,
+            ONE_WAY_CHANNEL_PATTERN.id: find_one_way_channels,
+        }
+        # Options that change a file's findings, part of every cache key
+        options_json = json.dumps(
+            {
+                "threshold": self._options.threshold,
+                "show_suppressed": self._options.show_suppressed,
+                "include_generated": self._options.include_generated,
+                "generated_detectors": self._options.generated_detectors,
+                "deprecated_funcs": self._deprecated_funcs,
+                "sensitive_names": self._sensitive_names,
+                "random_secret_names": self._random_secret_names,
+                "unkeyed_local_structs": self._options.unkeyed_local_structs,
+                "exported_only": self._options.exported_only,
+                "summary_only": self._options.summary_only,
+                "path_severity_rules": [repr(r) for r in self._path_rules],
+            },
+            sort_keys=True,
+        )
+        self._options_key = hashlib.sha256(options_json.encode("utf-8")).hexdigest()
+        self._event_lock = threading.Lock()
+
+    def __repr__(self) -> str:
+        """Return a string representation of the scanner."""
+        return f"Scanner(patterns={len(self._library)}, threshold={self._options.threshold:.2f})"
+
+    def scan(self, root: Path) -> ScanReport:
+        """Scan a file or directory.
+
+        Args:
+            root: File or directory to scan.
+
+        Returns:
+            ScanReport with findings sorted by path, line and pattern ID.
+
+        Raises:
+            FileNotFoundError: If root does not exist.
+            ConfigError: If a ``.deepverify.yaml`` file is invalid.
+
+        """
+        if not root.exists():
+            raise FileNotFoundError(f"Scan path not found: {root}")
+
+        started_at = self._options.clock()
+
+        base_dir = root if root.is_dir() else root.parent
+        resolver = ScanConfigResolver(
+            base_dir,
+            base=self._options.config,
+            use_files=self._options.use_config_files,
+        )
+
+        candidates: list[tuple[Path, str, ScanConfig]] = []
+        skipped_large_files: list[str] = []
+        for path in self._iter_files(root):
+            rel_path = path.relative_to(base_dir).as_posix()
+            if self._is_too_large(path):
+                logger.debug("Skipping large file %s", rel_path)
+                self._emit(
+                    AnalysisEventKind.FILE_SKIPPED,
+                    rel_path,
+                    reason=f"larger than {self._options.max_file_bytes} bytes",
+                )
+                skipped_large_files.append(rel_path)
+                continue
+            candidates.append((path, rel_path, resolver.resolve(path)))
+
+        findings: list[ScanFinding] = []
+        files_scanned: list[str] = []
+        file_packages: dict[str, str] = {}
+        packages = PackageResolver()
+        results, detector_warnings = self._scan_files(
+            candidates, started_at.date(), self._reusable_results()
+        )
+        for (path, rel_path, _), file_findings in zip(candidates, results, strict=True):
+            if file_findings is None:
+                continue
+            files_scanned.append(rel_path)
+            findings.extend(file_findings)
+            language = self._detector.detect(path).language
+            file_packages[rel_path] = packages.resolve(path, rel_path, language)
+
+        findings.sort(key=lambda f: (f.path, f.line, f.pattern_id))
+        duration_ms = 0
+        if not self._options.reproducible:
+            elapsed = self._options.clock() - started_at
+            duration_ms = max(int(elapsed.total_seconds() * 1000), 0)
+        report = self._filter_report(
+            ScanReport(
+                root=str(root),
+                findings=findings,
+                files_scanned=files_scanned,
+                skipped_large_files=skipped_large_files,
+                started_at=started_at,
+                duration_ms=duration_ms,
+                file_packages=file_packages,
+                detector_warnings=detector_warnings,
+                ratcheted_domains=self._ratcheted_domains(started_at.date()),
+                issue_grouping=self._options.issue_grouping,
+                labels=dict(self._options.labels),
+            )
+        )
+        logger.debug(
+            "Scanned %d files, %d findings", len(report.files_scanned), len(report.findings)
+        )
+        return report
+
+    def _ratcheted_domains(self, today: date) -> list[ArtifactDomain]:
+        """Return the domains whose ratchet date is after today, sorted."""
+        return sorted(
+            (d for d, since in self._options.ratchet_dates.items() if since > today),
+            key=lambda d: d.value,
+        )
+
+    def _filter_report(self, report: ScanReport) -> ScanReport:
+        """Apply ScanOptions.finding_filter to a finished report."""
+        keep = self._options.finding_filter
+        if keep is None:
+            return report
+        return replace(report, findings=[f for f in report.findings if keep(f, report)])
+
+    def failed(self, report: ScanReport, fail_on: Severity | None = Severity.ERROR) -> bool:
+        """Decide whether a scan report fails the gate.
+
+        Args:
+            report: Report returned by this scanner.
+            fail_on: Lowest severity that fails; None never fails. Ignored
+                when ScanOptions.gate_policy is set.
+
+        Returns:
+            The gate policy's verdict if one is set, else whether any fatal
+            finding (see ``ScanReport.fatal_findings``) is at least as
+            severe as fail_on.
+
+        """
+        if self._options.gate_policy is not None:
+            return self._options.gate_policy(report)
+        if fail_on is None:
+            return False
+        rank = SEVERITY_LADDER.index(fail_on)
+        return any(SEVERITY_LADDER.index(f.severity) >= rank for f in report.fatal_findings())
+
+    def fix(self, root: Path) -> list[FileFix]:
+        """Apply the fixes of a tree's findings in memory and re-analyze them.
+
+        Scans root, applies the ``fix`` of every unsuppressed finding whose
+        pattern declares one, then re-scans each changed file under its
+        config to learn which findings the fixes resolved or introduced.
+        Nothing is written (see write_fixes).
+
+        Args:
+            root: File or directory to fix.
+
+        Returns:
+            One FileFix per changed file, in path order.
+
+        Raises:
+            FileNotFoundError: If root does not exist.
+            ConfigError: If a ``.deepverify.yaml`` file is invalid.
+
+        """
+        report = self.scan(root)
+        base_dir = root if root.is_dir() else root.parent
+        resolver = ScanConfigResolver(
+            base_dir,
+            base=self._options.config,
+            use_files=self._options.use_config_files,
+        )
+        by_path: dict[str, list[ScanFinding]] = {}
+        for finding in report.unsuppressed_findings():
+            by_path.setdefault(finding.path, []).append(finding)
+
+        fixes: list[FileFix] = []
+        for rel_path, findings in by_path.items():
+            if not any(self._fix_for(f.pattern_id) for f in findings):
+                continue
+            path = base_dir / rel_path
+            try:
+                raw = path.read_bytes().decode("utf-8")
+            except (OSError, UnicodeDecodeError) as e:
+                logger.warning("Skipping fixes for %s: %s", rel_path, e)
+                continue
+            original = raw.replace("\r\n", "\n")
+            fixed, applied = apply_fixes(original, findings, self._fix_for)
+            if not applied:
+                continue
+            after = self.scan_source(
+                fixed, findings[0].language, rel_path, config=resolver.resolve(path)
+            )
+            resolved, introduced = compare_findings(
+                findings, [f for f in after if not f.suppressed]
+            )
+            fixes.append(
+                FileFix(
+                    path=rel_path,
+                    original=original,
+                    fixed=fixed,
+                    applied=tuple(applied),
+                    resolved=tuple(resolved),
+                    introduced=tuple(introduced),
+                    newline="\r\n" if "\r\n" in raw else "\n",
+                )
+            )
+        return fixes
+
+    def _fix_for(self, pattern_id: str) -> PatternFix | None:
+        """Return the fix declared by a library pattern, if any."""
+        pattern = self._library.get_pattern(pattern_id)
+        return pattern.fix if pattern is not None else None
+
+    def _iter_files(self, root: Path) -> list[Path]:
+        """List files under root in deterministic order, skipping excluded dirs."""
+        if root.is_file():
+            return [root]
+
+        files: list[Path] = []
+        for dirpath, dirnames, filenames in os.walk(root):
+            files.extend(Path(dirpath) / name for name in sorted(filenames))
+            if self._is_allowlisting(dirnames):
+                files.extend(self._iter_vendored(Path(dirpath) / VENDOR_DIR))
+            dirnames[:] = sorted(d for d in dirnames if d not in self._options.excluded_dirs)
+        return files
+
+    def _is_allowlisting(self, dirnames: list[str]) -> bool:
+        """Check whether an excluded vendor dir among dirnames has allowlisted packages."""
+        return (
+            bool(self._options.vendor_allowlist)
+            and VENDOR_DIR in dirnames
+            and VENDOR_DIR in self._options.excluded_dirs
+        )
+
+    def _iter_vendored(self, vendor: Path) -> list[Path]:
+        """List the files of allowlisted packages under a vendor dir."""
+        files: list[Path] = []
+        for dirpath, dirnames, filenames in os.walk(vendor):
+            dirnames[:] = sorted(d for d in dirnames if d not in self._options.excluded_dirs)
+            import_path = Path(dirpath).relative_to(vendor).as_posix()
+            if any(fnmatchcase(import_path, g) for g in self._options.vendor_allowlist):
+                files.extend(Path(dirpath) / name for name in sorted(filenames))
+        return files
+
+    def _is_too_large(self, path: Path) -> bool:
+        """Check whether a file exceeds max_file_bytes."""
+        limit = self._options.max_file_bytes
+        if limit is None:
+            return False
+        try:
+            return path.stat().st_size > limit
+        except OSError:
+            return False
+
+    def _scan_files(
+        self,
+        candidates: list[tuple[Path, str, ScanConfig]],
+        today: date,
+        reusable: dict[tuple[object, ...], list[ScanFinding] | None] | None = None,
+    ) -> tuple[list[list[ScanFinding] | None], list[str]]:
+        """Load and analyze files, each phase on its own worker pool.
+
+        Files are analyzed as soon as they are loaded, so reads overlap
+        with pattern matching.
+
+        Args:
+            candidates: Tuples of (path, relative path, effective config).
+            today: Date used for suppression expiry checks.
+            reusable: Cached results of unchanged files by version-free key
+                (see _reusable_results), or None.
+
+        Returns:
+            Per-candidate findings in candidate order (None for files that
+            were not analyzed: unknown language, no code patterns, or
+            unreadable), and warnings for detectors that could not run
+            fully, in candidate order.
+
+        """
+        load_workers = self._options.load_concurrency
+        analyze_workers = self._options.analyze_concurrency or os.cpu_count() or 1
+
+        def load(candidate: tuple[Path, str, ScanConfig]) -> _LoadedFile | list[ScanFinding] | None:
+            return self._load_file(*candidate, today, reusable)
+
+        with ExitStack() as stack:
+            loaded: Iterable[_LoadedFile | list[ScanFinding] | None]
+            if load_workers > 1 and len(candidates) > 1:
+                loader = stack.enter_context(
+                    ThreadPoolExecutor(load_workers, thread_name_prefix="deepverify-load")
+                )
+                loaded = loader.map(load, candidates)
+            else:
+                loaded = map(load, candidates)
+
+            analyzer: ThreadPoolExecutor | None = None
+            if analyze_workers > 1 and len(candidates) > 1:
+                analyzer = stack.enter_context(
+                    ThreadPoolExecutor(analyze_workers, thread_name_prefix="deepverify-analyze")
+                )
+
+            pending: list[Future[list[ScanFinding]] | list[ScanFinding] | None] = []
+            analyzed: list[_LoadedFile] = []
+            for item in loaded:
+                if not isinstance(item, _LoadedFile):
+                    pending.append(item)
+                    continue
+                analyzed.append(item)
+                if analyzer is not None:
+                    pending.append(analyzer.submit(self._analyze_loaded, item))
+                else:
+                    pending.append(self._analyze_loaded(item))
+            results = [r.result() if isinstance(r, Future) else r for r in pending]
+        return results, [warning for item in analyzed for warning in item.warnings]
+
+    def _load_file(
+        self,
+        path: Path,
+        rel_path: str,
+        config: ScanConfig,
+        today: date,
+        reusable: dict[tuple[object, ...], list[ScanFinding] | None] | None = None,
+    ) -> _LoadedFile | list[ScanFinding] | None:
+        """Read a file for analysis (load phase).
+
+        Args:
+            path: File to scan.
+            rel_path: Path relative to the scan root.
+            config: Effective config for the file.
+            today: Date used for suppression expiry checks.
+            reusable: Cached results of unchanged files by version-free key,
+                used when ScanOptions.changed_files does not cover the file.
+
+        Returns:
+            The loaded file, cached findings for an unchanged file, or None
+            if the file is not analyzed (unknown language, no code patterns,
+            unreadable, or generated without include_generated).
+
+        """
+        cache_key = None
+        if self._cache is not None:
+            cache_key = self._cache_key(path, rel_path, config, today)
+            if self._is_changed(rel_path):
+                found, cached = False, None
+            else:
+                found, cached = self._cache.get(cache_key)
+                identity = _without_version(cache_key)
+                if not found and reusable is not None and identity in reusable:
+                    # Unchanged per the manifest: trust the result of an earlier version
+                    found, cached = True, reusable[identity]
+                    self._cache_put(cache_key, cached)
+            if found:
+                self._emit(AnalysisEventKind.CACHE_HIT, rel_path, findings=len(cached or ()))
+                return None if cached is None else list(cached)
+
+        language = self._detector.detect(path).language
+        patterns = self._patterns_for(language, config, rel_path)
+        if not patterns:
+            self._emit(
+                AnalysisEventKind.FILE_SKIPPED, rel_path, reason=f"no patterns for {language}"
+            )
+            self._cache_put(cache_key, None)
+            return None
+
+        try:
+            text = path.read_text(encoding="utf-8", errors="replace")
+        except OSError as e:
+            logger.warning("Skipping unreadable file %s: %s", path, e)
+            self._emit(AnalysisEventKind.FILE_SKIPPED, rel_path, reason=f"unreadable: {e}")
+            self._cache_put(cache_key, None)
+            return None
+
+        builtins = BUILTIN_PATTERNS
+        if is_generated_source(text):
+            if not self._options.include_generated:
+                logger.debug("Skipping generated file %s", rel_path)
+                self._emit(AnalysisEventKind.FILE_SKIPPED, rel_path, reason="generated")
+                self._cache_put(cache_key, None)
+                return None
+            patterns = [p for p in patterns if self._runs_on_generated(p)]
+            builtins = tuple(p for p in BUILTIN_PATTERNS if self._runs_on_generated(p))
+            if not patterns and not builtins:
+                self._emit(
+                    AnalysisEventKind.FILE_SKIPPED,
+                    rel_path,
+                    reason="generated, no detectors selected",
+                )
+                self._cache_put(cache_key, [])
+                return []
+
+        return _LoadedFile(rel_path, config, today, language, patterns, text, cache_key, builtins)
+
+    def _runs_on_generated(self, pattern: Pattern) -> bool:
+        """Check whether a detector runs on generated files."""
+        selectors = self._options.generated_detectors
+        if selectors is None:
+            return pattern.domain == ArtifactDomain.CONCURRENCY
+        return any(matches_selector(pattern.id, s) for s in selectors)
+
+    def _analyze_loaded(self, item: _LoadedFile) -> list[ScanFinding]:
+        """Analyze a loaded file (analyze phase) and cache the result.
+
+        Incomplete results (with detector warnings) are not cached, so the
+        detectors run again on the next scan.
+        """
+        self._emit(AnalysisEventKind.FILE_STARTED, item.rel_path)
+        findings = self._analyze(
+            item.text,
+            item.rel_path,
+            item.language,
+            item.patterns,
+            item.config,
+            item.today,
+            builtins=item.builtins,
+            warnings=item.warnings,
+        )
+        if not item.warnings:
+            self._cache_put(item.cache_key, findings)
+        for finding in findings:
+            self._emit(
+                AnalysisEventKind.DETECTOR_FIRED,
+                item.rel_path,
+                pattern_id=finding.pattern_id,
+                line=finding.line,
+            )
+        self._emit(AnalysisEventKind.FILE_FINISHED, item.rel_path, findings=len(findings))
+        return findings
+
+    def _emit(
+        self,
+        kind: AnalysisEventKind,
+        rel_path: str,
+        *,
+        pattern_id: str | None = None,
+        line: int | None = None,
+        findings: int | None = None,
+        reason: str | None = None,
+    ) -> None:
+        """Send an event to ScanOptions.event_sink, if set."""
+        sink = self._options.event_sink
+        if sink is None:
+            return
+        event = AnalysisEvent(
+            kind, self._options.clock(), rel_path, pattern_id, line, findings, reason
+        )
+        with self._event_lock:
+            sink(event)
+
+    def _cache_put(
+        self, key: tuple[object, ...] | None, findings: list[ScanFinding] | None
+    ) -> None:
+        """Store a result when caching is enabled."""
+        if self._cache is not None and key is not None:
+            self._cache.put(key, None if findings is None else list(findings))
+
+    def _is_changed(self, rel_path: str) -> bool:
+        """Check whether ScanOptions.changed_files requires re-analyzing a file."""
+        changed = self._options.changed_files
+        if changed is None:
+            return False
+        return rel_path in changed or directory_package(rel_path) in self._changed_dirs
+
+    def _reusable_results(self) -> dict[tuple[object, ...], list[ScanFinding] | None] | None:
+        """Index cached results by version-free key when changed_files is set.
+
+        The most recently used result wins when a file has several versions.
+        """
+        if self._cache is None or self._options.changed_files is None:
+            return None
+        return {
+            _without_version(key): findings
+            for key, findings in self._cache.items()
+            if isinstance(key, tuple)
+        }
+
+    def _cache_key(
+        self, path: Path, rel_path: str, config: ScanConfig, today: date
+    ) -> tuple[object, ...]:
+        """Build the cache key identifying one version of a file."""
+        try:
+            stat = path.stat()
+            version: tuple[int, int] = (stat.st_mtime_ns, stat.st_size)
+        except OSError:
+            version = (-1, -1)
+        return (
+            str(path.resolve()),
+            rel_path,
+            *version,
+            config.model_dump_json(),
+            today.isoformat(),
+            self._options_key,
+        )
+
+    def scan_source(
+        self,
+        text: str,
+        language: str,
+        rel_path: str = "<source>",
+        patterns: list[Pattern] | None = None,
+        config: ScanConfig | None = None,
+    ) -> list[ScanFinding]:
+        """Scan source text that does not live in a scanned tree.
+
+        Suppressions, config overrides and the finding filter apply as they
+        do for files.
+
+        Args:
+            text: Source code to scan.
+            language: Language of the source (e.g., "go").
+            rel_path: Path reported on findings; also selects patterns limited
+                to certain files (such as ``*_test.go``).
+            patterns: Patterns to run, library patterns or built-in checks
+                (default: enabled patterns for language and path, plus
+                built-in checks such as CC-111).
+            config: Effective config (default: ScanOptions.config or empty).
+
+        Returns:
+            Findings sorted by line and pattern ID.
+
+        """
+        config = config or self._options.config or ScanConfig()
+        if patterns is None:
+            builtins = BUILTIN_PATTERNS
+            patterns = self._patterns_for(language, config, rel_path)
+        else:
+            builtins = tuple(p for p in patterns if p.id in self._builtin_detectors)
+            patterns = [p for p in patterns if p.id not in self._builtin_detectors]
+        if not patterns and not builtins:
+            return []
+        findings = self._analyze(
+            text,
+            rel_path,
+            language,
+            patterns,
+            config,
+            self._options.clock().date(),
+            builtins=builtins,
+        )
+        findings.sort(key=lambda f: (f.line, f.pattern_id))
+        if self._options.finding_filter is None:
+            return findings
+        report = ScanReport(root=rel_path, findings=findings, files_scanned=[rel_path])
+        return self._filter_report(report).findings
+
+    def scan_snippet(
+        self,
+        text: str,
+        language: str,
+        rel_path: str = "<source>",
+        line_offset: int = 0,
+        config: ScanConfig | None = None,
+    ) -> list[ScanFinding]:
+        """Scan a fragment of a file, such as an editor selection.
+
+        Go fragments are wrapped into a minimal file first (see
+        wrap_go_snippet); other languages are scanned as given. Findings
+        are reported at their lines in the original file, and findings on
+        wrapper lines are dropped.
+
+        Args:
+            text: Source fragment.
+            language: Language of the source (e.g., "go").
+            rel_path: Path of the original file, reported on findings.
+            line_offset: Number of lines before the fragment in the original
+                file (the fragment's first line is line_offset + 1).
+            config: Effective config (default: ScanOptions.config or empty).
+
+        Returns:
+            Findings sorted by line and pattern ID.
+
+        Raises:
+            ValueError: If line_offset is negative or a Go fragment cannot be
+                wrapped (unbalanced braces).
+
+        """
+        if line_offset < 0:
+            raise ValueError(f"line_offset must not be negative, got {line_offset}")
+        last_line = text.count("\n") + 1
+        prefix_lines = 0
+        if language == "go":
+            text, prefix_lines = wrap_go_snippet(text)
+            last_line += prefix_lines
+        findings = self.scan_source(text, language, rel_path=rel_path, config=config)
+        return [
+            replace(f, line=f.line - prefix_lines + line_offset)
+            for f in findings
+            if prefix_lines < f.line <= last_line
+        ]
+
+    def _patterns_for(
+        self, language: str | None, config: ScanConfig, rel_path: str
+    ) -> list[Pattern]:
+        """Return the library patterns that run on a file under a config."""
+        return [
+            p
+            for p in self._library.get_all_patterns()
+            if p.language == language
+            and p.applies_to(rel_path)
+            and config.is_enabled(p.id, opt_in=p.opt_in)
+        ]
+
+    def _analyze(
+        self,
+        text: str,
+        rel_path: str,
+        language: str,
+        patterns: list[Pattern],
+        config: ScanConfig,
+        today: date,
+        builtins: tuple[Pattern, ...] = BUILTIN_PATTERNS,
+        warnings: list[str] | None = None,
+    ) -> list[ScanFinding]:
+        """Match patterns against text, then apply suppressions and path rules.
+
+        Checks implemented in code (``builtins``, such as CC-111) run as well
+        on files of their language when the config enables them (opt-in
+        checks only when it opts in); like pattern matches, their findings
+        below the confidence threshold are dropped. Findings carry their pattern's effort rating.
+        Detectors that could not run fully (see PatternMatcher.skipped) are
+        logged and appended to ``warnings``.
+        """
+        context = MatchContext.from_text(text)
+        results, skipped = self._match(patterns, context)
+        for pattern_id, reason in sorted(skipped.items()):
+            message = f"{pattern_id} skipped for {rel_path}: {reason}"
+            logger.warning("Detector %s", message)
+            if warnings is not None:
+                warnings.append(message)
+        findings = [
+            self._convert_match(result, rel_path, language, context, config)
+            for result in results
+        ]
+        for pattern in builtins:
+            if pattern.language == language and config.is_enabled(
+                pattern.id, opt_in=pattern.opt_in
+            ):
+                detector = self._builtin_detectors[pattern.id]
+                findings.extend(
+                    f
+                    for f in detector(text, rel_path, config)
+                    if f.confidence >= self._options.threshold
+                )
+        if self._options.exported_only:
+            findings = filter_exported(findings, text, language)
+        findings = apply_suppressions(
+            findings,
+            text,
+            rel_path,
+            language,
+            config,
+            today,
+            keep_suppressed=self._options.show_suppressed,
+        )
+        findings = apply_path_rules(findings, self._path_rules)
+        efforts = {p.id: p.effort for p in (*patterns, *builtins, SUPPRESSION_PATTERN)}
+        findings = [replace(f, effort=efforts.get(f.pattern_id)) for f in findings]
+        if self._options.summary_only:
+            findings = [_summary_finding(f) for f in findings]
+        return findings
+
+    def _match(
+        self, patterns: list[Pattern], context: MatchContext
+    ) -> tuple[list[PatternMatchResult], dict[str, str]]:
+        """Match patterns against a file, fanning out over workers for large files.
+
+        Detectors only read the shared context, so contiguous slices of the
+        pattern list run concurrently. Concatenating the slices in order and
+        sorting stably by confidence gives the same result as a serial run.
+
+        Returns:
+            Match results, and the reason per pattern that could not be
+            fully evaluated (see PatternMatcher.skipped).
+
+        """
+        workers = min(self._options.analyze_concurrency or os.cpu_count() or 1, len(patterns))
+        min_lines = self._options.fanout_min_lines
+        if workers <= 1 or min_lines is None or len(context.lines) < min_lines:
+            matcher = PatternMatcher(patterns, threshold=self._options.threshold)
+            return matcher.match_context(context), matcher.skipped
+
+        size = -(-len(patterns) // workers)
+        matchers = [
+            PatternMatcher(patterns[i : i + size], threshold=self._options.threshold)
+            for i in range(0, len(patterns), size)
+        ]
+        with ThreadPoolExecutor(len(matchers), thread_name_prefix="deepverify-detect") as pool:
+            parts = pool.map(lambda m: m.match_context(context), matchers)
+            results = [result for part in parts for result in part]
+        results.sort(key=lambda r: r.confidence, reverse=True)
+        return results, {k: v for m in matchers for k, v in m.skipped.items()}
+
+    def _convert_match(
+        self,
+        result: PatternMatchResult,
+        rel_path: str,
+        language: str,
+        context: MatchContext,
+        config: ScanConfig,
+    ) -> ScanFinding:
+        """Convert a PatternMatchResult to a located ScanFinding.
+
+        The finding is placed on the last line reached by any matched signal,
+        since the most specific signals usually match closest to the defect.
+
+        """
+        pattern: Pattern = result.pattern
+        line = max((ms.line_number for ms in result.matched_signals), default=1)
+        if self._options.summary_only:
+            return ScanFinding(
+                pattern_id=pattern.id,
+                severity=config.severity_for(pattern),
+                title="",
+                description="",
+                path=rel_path,
+                line=line,
+                snippet="",
+                confidence=result.confidence,
+                domain=pattern.domain,
+                language=language,
+            )
+
+        title = pattern.description or f"Pattern {pattern.id} matched"
+        if len(title) > MAX_TITLE_LENGTH:
+            title = title[: MAX_TITLE_LENGTH - 3] + "..."
+
+        return ScanFinding(
+            pattern_id=pattern.id,
+            severity=config.severity_for(pattern),
+            title=title,
+            description=pattern.description or "Pattern matched",
+            path=rel_path,
+            line=line,
+            snippet=context.get_line_content(line).strip(),
+            confidence=result.confidence,
+            domain=pattern.domain,
+            language=language,
+            remediation=pattern.remediation,
+        )
@@ -0,0 +1,183 @@
+"""Detection of select receive variables used in another branch in Go scans.
+
+The variable a select case receives into holds the received value only in
+that case. Used in another branch it is the zero value, or whatever an
+outer variable of the same name last held::
+
+    var msg Message
+    select {
+    case msg := <-inbox:
+        handle(msg)
+    default:
+        handle(msg) // CC-138: msg is the outer zero value, not a received one
+    }
+
+For each select, the names bound by its receive cases (``case v := <-ch``,
+``case v, ok = <-ch``) are reported where another clause of the same
+select, ``default`` or another case, reads them before assigning them. A
+clause that binds the same name in its own receive is not reported.
+
+"""
+
+from __future__ import annotations
+
+import re
+from dataclasses import dataclass, field
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for receive variables read outside their select case
+CROSS_CASE_RECEIVE_PATTERN = Pattern(
+    id=PatternId("CC-138-CODE-GO"),
+    domain=ArtifactDomain.CONCURRENCY,
+    signals=[],
+    severity=Severity.WARNING,
+    description="Variable received in one select case is read in another branch - no value there",
+    remediation="Use the received value only in its case, or receive it again in the other branch",
+    language="go",
+    effort=PatternEffort.MEDIUM,
+)
+
+# Confidence of CC-138 findings: another clause reading a case-bound name almost
+# always sees the zero value; only an outer variable meant on purpose is safe
+CROSS_CASE_RECEIVE_CONFIDENCE = 0.8
+
+# Receive case binding names: `case v := <-ch:`, `case v, ok = <-ch:`
+_RECEIVE_CASE_RE = re.compile(r"^[ \t]*case[ \t]+([\w \t,]+?)[ \t]*:?=[ \t]*<-")
+
+# Clause header of a select: `case ...:` or `default:`
+_CLAUSE_RE = re.compile(r"^[ \t]*(case\b|default[ \t]*:)")
+
+_SELECT_RE = re.compile(r"\bselect\b")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+@dataclass(slots=True)
+class _Clause:
+    """One case or default clause of a select.
+
+    Attributes:
+        line: 0-based index of the clause header.
+        is_default: Whether the clause is ``default``.
+        bound: Names the clause's receive binds.
+        body: (line index, code) pairs of the clause body, header excluded.
+
+    """
+
+    line: int
+    is_default: bool
+    bound: list[str]
+    body: list[tuple[int, str]] = field(default_factory=list)
+
+
+@dataclass(slots=True)
+class _Select:
+    """Clauses seen so far in one select statement."""
+
+    clauses: list[_Clause] = field(default_factory=list)
+
+
+def _parse_clause(index: int, line: str) -> tuple[_Clause, str]:
+    """Parse a clause header into a clause and the code after its colon."""
+    receive = _RECEIVE_CASE_RE.match(line)
+    bound = []
+    if receive:
+        bound = [n.strip() for n in receive.group(1).split(",") if n.strip() not in ("", "_")]
+    clause = _Clause(index, is_default=not line.lstrip().startswith("case"), bound=bound)
+    colon = re.search(r":(?!=)", line)  # skips the colon of :=
+    return clause, line[colon.end() :] if colon else ""
+
+
+def find_cross_case_receives(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report select receive variables read in another clause of the select.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-138 findings in line order, one per variable and clause.
+
+    """
+    if not config.is_enabled(CROSS_CASE_RECEIVE_PATTERN.id):
+        return []
+    source_lines = text.split("\n")
+    # Open blocks, innermost last: a _Select or "block"
+    stack: list[str | _Select] = []
+    findings: list[ScanFinding] = []
+    for index, line in enumerate(_code_lines(text)):
+        header, rest = None, line
+        if stack and isinstance(stack[-1], _Select) and _CLAUSE_RE.match(line):
+            clause, rest = _parse_clause(index, line)
+            header = stack[-1]
+            header.clauses.append(clause)
+        for block in stack:
+            if isinstance(block, _Select) and block.clauses:
+                block.clauses[-1].body.append((index, rest if block is header else line))
+
+        start = 0
+        for position, char in enumerate(line):
+            if char == "{":
+                segment = line[start:position]
+                start = position + 1
+                stack.append(_Select() if _SELECT_RE.search(segment) else "block")
+            elif char == "}":
+                start = position + 1
+                if not stack:
+                    continue
+                block = stack.pop()
+                if isinstance(block, _Select):
+                    findings.extend(_cross_case_reads(block, rel_path, source_lines, config))
+    findings.sort(key=lambda f: f.line)
+    return findings
+
+
+def _cross_case_reads(
+    select: _Select, rel_path: str, source_lines: list[str], config: ScanConfig
+) -> list[ScanFinding]:
+    """Report the reads of each clause's received names in the other clauses."""
+    findings: list[ScanFinding] = []
+    for receiver in select.clauses:
+        for name in receiver.bound:
+            name_re = re.compile(r"(?<![\w.])" + re.escape(name) + r"\b(?![ \t]*:)")
+            assign_re = re.compile(
+                r"(?<![\w.])" + re.escape(name) + r"[ \t]*(?:,[\w \t,]*)?:?=(?!=)"
+            )
+            for other in select.clauses:
+                if other is receiver or name in other.bound:
+                    continue
+                for index, code in other.body:
+                    if assign_re.search(code):
+                        break
+                    if name_re.search(code):
+                        branch = "the default branch" if other.is_default else "another case"
+                        findings.append(
+                            builtin_finding(
+                                CROSS_CASE_RECEIVE_PATTERN,
+                                f"{name} received in the case at line {receiver.line + 1} "
+                                f"is read in {branch}",
+                                rel_path,
+                                index + 1,
+                                source_lines[index],
+                                config,
+                                CROSS_CASE_RECEIVE_CONFIDENCE,
+                            )
+                        )
+                        break
+    return findings
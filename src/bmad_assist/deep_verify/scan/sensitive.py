@@ -0,0 +1,161 @@
+"""Sensitive field logging detection for Go scans.
+
+Flags logging calls (``log``, ``log/slog`` and ``fmt.Print*``) whose
+arguments read a field or method named like a secret, such as
+``log.Printf("%s", user.Password)``. Calls are resolved through the file's
+imports, as in ``scan.deprecations``, and names inside string literals do
+not count.
+
+A selector is sensitive when its last identifier, lowercased and without
+underscores, ends with a sensitive name: ``cfg.APIKey``, ``req.AccessToken``
+and ``u.Password()`` match, ``stats.TokenCount`` does not.
+``ScanOptions.sensitive_names`` extends the default names.
+
+Example:
+    >>> from bmad_assist.deep_verify.scan import ScanOptions
+    >>> options = ScanOptions(sensitive_names=("ssn", "cardnumber"))
+
+"""
+
+from __future__ import annotations
+
+import re
+from collections.abc import Iterable
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.patterns.matcher import MatchContext
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for logging calls that print sensitive fields
+SENSITIVE_LOG_PATTERN = Pattern(
+    id=PatternId("CC-119-CODE-GO"),
+    domain=ArtifactDomain.SECURITY,
+    signals=[],
+    severity=Severity.ERROR,
+    description="Logging call prints a sensitive field - secrets end up in log files",
+    remediation="Log a redacted or derived value instead, or drop the field from the log call",
+    language="go",
+    effort=PatternEffort.LOW,
+)
+
+# Field name suffixes treated as secrets (lowercase, no underscores)
+DEFAULT_SENSITIVE_NAMES: tuple[str, ...] = (
+    "password",
+    "passwd",
+    "secret",
+    "token",
+    "apikey",
+    "privatekey",
+    "credential",
+    "credentials",
+)
+
+# Logging functions per import path
+_LOG_FUNCS: dict[str, str] = {
+    "log": r"(?:Print|Fatal|Panic)\w*",
+    "log/slog": r"(?:Debug|Info|Warn|Error|Log)\w*",
+    "fmt": r"F?Print\w*",
+}
+
+# Selector after an identifier, call or index: `.Name`
+_SELECTOR_RE = re.compile(r"(?<=[\w)\]])\.([A-Za-z_]\w*)")
+
+# String and rune literals, replaced before selectors are searched
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def normalize_sensitive_names(names: Iterable[str]) -> tuple[str, ...]:
+    """Lowercase names and drop underscores and duplicates, keeping order."""
+    normalized: list[str] = []
+    for name in names:
+        key = name.replace("_", "").lower()
+        if key and key not in normalized:
+            normalized.append(key)
+    return tuple(normalized)
+
+
+def _call_arguments(text: str, open_paren: int) -> str:
+    """Return the source between a call's parentheses (to the end of text if unbalanced)."""
+    depth = 0
+    i = open_paren
+    while i < len(text):
+        literal = _LITERAL_RE.match(text, i)
+        if literal is not None:
+            i = literal.end()
+            continue
+        if text[i] in "([{":
+            depth += 1
+        elif text[i] in ")]}":
+            depth -= 1
+            if depth == 0:
+                return text[open_paren + 1 : i]
+        i += 1
+    return text[open_paren + 1 :]
+
+
+def find_sensitive_logs(
+    text: str,
+    rel_path: str,
+    config: ScanConfig,
+    names: Iterable[str] = DEFAULT_SENSITIVE_NAMES,
+) -> list[ScanFinding]:
+    """Report logging calls that print sensitive fields in a Go file.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+        names: Sensitive name suffixes (default: DEFAULT_SENSITIVE_NAMES).
+
+    Returns:
+        CC-119 findings in line order, one per logging call.
+
+    """
+    if not config.is_enabled(SENSITIVE_LOG_PATTERN.id):
+        return []
+    suffixes = normalize_sensitive_names(names)
+
+    alternatives = [
+        re.escape(name) + r"\." + _LOG_FUNCS[path]
+        for name, path in parse_go_imports(text).items()
+        if path in _LOG_FUNCS and name != "."
+    ]
+    if not alternatives or not suffixes:
+        return []
+
+    call_re = re.compile(r"(?<![\w.])(?:" + "|".join(alternatives) + r")\s*\(")
+    context = MatchContext.from_text(text)
+    findings: list[ScanFinding] = []
+    for call in call_re.finditer(text):
+        line = context.get_line_number(call.start())
+        content = context.get_line_content(line)
+        before = content[: call.start() - context.line_offsets[line - 1]]
+        # Skip comments and string literals on the same line
+        if "//" in before or before.count('"') % 2:
+            continue
+        arguments = _LITERAL_RE.sub('""', _call_arguments(text, call.end() - 1))
+        fields = [
+            m.group(1)
+            for m in _SELECTOR_RE.finditer(arguments)
+            if m.group(1).replace("_", "").lower().endswith(suffixes)
+        ]
+        if fields:
+            findings.append(
+                builtin_finding(
+                    SENSITIVE_LOG_PATTERN,
+                    f"Logging call prints sensitive field {fields[0]}",
+                    rel_path,
+                    line,
+                    content,
+                    config,
+                )
+            )
+    return findings
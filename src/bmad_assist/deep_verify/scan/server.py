@@ -0,0 +1,276 @@
+"""HTTP daemon serving Deep Verify scans.
+
+A long-lived scan server keeps the pattern library loaded and the per-file
+result cache warm, so IDE and bot integrations avoid process startup and
+re-analysis of unchanged files on every request.
+
+Endpoints:
+    GET /health
+        ``{"status": "ok", "patterns": N, "cache": {...}}``
+    POST /analyze
+        Body ``{"path": "services/payments"}`` scans a file or directory
+        under the server root. Body ``{"source": "...", "language": "go"}``
+        scans source text (optional ``"path"`` names it in findings). With
+        ``"line_offset": N`` the source is a fragment starting after line N
+        of that file, such as an editor selection; findings are reported
+        at their lines in the file.
+        Responds with a serialized ScanReport.
+
+Errors are reported as ``{"error": "..."}`` with a 4xx/5xx status.
+
+Example:
+    >>> server = ScanServer(Path("."), port=0)
+    >>> server.start()
+    >>> print(server.url)
+    >>> server.shutdown()
+
+"""
+
+from __future__ import annotations
+
+import json
+import logging
+import threading
+from http import HTTPStatus
+from http.server import BaseHTTPRequestHandler, ThreadingHTTPServer
+from pathlib import Path
+from typing import Any
+
+from bmad_assist.core.exceptions import ConfigError
+from bmad_assist.deep_verify.patterns.library import PatternLibrary, get_default_pattern_library
+from bmad_assist.deep_verify.scan.cache import ScanCache
+from bmad_assist.deep_verify.scan.scanner import ScanOptions, Scanner
+from bmad_assist.deep_verify.scan.types import ScanReport, serialize_scan_report
+
+logger = logging.getLogger(__name__)
+
+# Largest accepted request body (bytes)
+MAX_BODY_BYTES = 10 * 1024 * 1024
+
+
+class AnalyzeError(Exception):
+    """Raised for analyze requests that cannot be served."""
+
+    def __init__(self, status: HTTPStatus, message: str) -> None:
+        """Initialize with the HTTP status to report."""
+        super().__init__(message)
+        self.status = status
+
+
+class ScanServer:
+    """HTTP server exposing the scanner.
+
+    Attributes:
+        _root: Directory that path requests must stay within (resolved).
+        _library: Pattern library shared by all requests.
+        _cache: Result cache shared by all requests.
+        _scanner: Scanner shared by all requests.
+        _httpd: Underlying HTTP server.
+        _thread: Background serving thread, if started with start().
+
+    """
+
+    def __init__(
+        self,
+        root: Path,
+        options: ScanOptions | None = None,
+        host: str = "127.0.0.1",
+        port: int = 0,
+        library: PatternLibrary | None = None,
+        cache: ScanCache | None = None,
+    ) -> None:
+        """Initialize and bind the server.
+
+        Args:
+            root: Directory that path requests must stay within.
+            options: Scan options for every request.
+            host: Interface to bind.
+            port: Port to bind (0 picks a free port).
+            library: Pattern library (defaults to the default library).
+            cache: Result cache (defaults to a new ScanCache).
+
+        Raises:
+            OSError: If the address cannot be bound.
+
+        """
+        self._root = root.resolve()
+        self._library = library if library is not None else get_default_pattern_library()
+        self._cache = cache if cache is not None else ScanCache()
+        self._scanner = Scanner(options, library=self._library, cache=self._cache)
+        self._httpd = ThreadingHTTPServer((host, port), _Handler)
+        # Let in-flight requests finish when the server closes
+        self._httpd.daemon_threads = False
+        self._httpd.block_on_close = True
+        self._httpd.scan_server = self  # type: ignore[attr-defined]
+        self._thread: threading.Thread | None = None
+
+    def __repr__(self) -> str:
+        """Return a string representation of the server."""
+        return f"ScanServer(url={self.url!r}, root={str(self._root)!r})"
+
+    @property
+    def url(self) -> str:
+        """Base URL of the bound server."""
+        host, port = self._httpd.server_address[:2]
+        return f"http://{host}:{port}"
+
+    def serve_forever(self) -> None:
+        """Serve requests until shutdown() is called, then close the socket."""
+        logger.info("Deep Verify scan server listening on %s", self.url)
+        try:
+            self._httpd.serve_forever()
+        finally:
+            self._httpd.server_close()
+
+    def start(self) -> None:
+        """Serve requests on a background thread."""
+        self._thread = threading.Thread(
+            target=self.serve_forever, name="deepverify-scan-server", daemon=True
+        )
+        self._thread.start()
+
+    def shutdown(self) -> None:
+        """Stop serving, wait for in-flight requests, and close the socket.
+
+        Must not be called from a request handler thread.
+        """
+        self._httpd.shutdown()
+        if self._thread is not None:
+            self._thread.join()
+            self._thread = None
+        logger.info("Deep Verify scan server stopped")
+
+    def health(self) -> dict[str, Any]:
+        """Return the health payload."""
+        return {
+            "status": "ok",
+            "patterns": len(self._library),
+            "cache": {
+                "entries": len(self._cache),
+                "hits": self._cache.hits,
+                "misses": self._cache.misses,
+            },
+        }
+
+    def analyze(self, payload: Any) -> dict[str, Any]:
+        """Serve an analyze request.
+
+        Args:
+            payload: Decoded JSON request body.
+
+        Returns:
+            Serialized ScanReport.
+
+        Raises:
+            AnalyzeError: If the request is invalid or the scan fails.
+
+        """
+        if not isinstance(payload, dict):
+            raise AnalyzeError(HTTPStatus.BAD_REQUEST, "Request body must be a JSON object")
+
+        if "source" in payload:
+            return serialize_scan_report(self._analyze_source(payload))
+        if "path" in payload:
+            return serialize_scan_report(self._analyze_path(payload["path"]))
+        raise AnalyzeError(HTTPStatus.BAD_REQUEST, "Request needs a 'path' or 'source' field")
+
+    def _analyze_source(self, payload: dict[str, Any]) -> ScanReport:
+        """Scan source text from the request body."""
+        source = payload["source"]
+        language = payload.get("language")
+        name = payload.get("path", "<source>")
+        if not isinstance(source, str) or not isinstance(name, str):
+            raise AnalyzeError(HTTPStatus.BAD_REQUEST, "'source' and 'path' must be strings")
+        if not isinstance(language, str) or not language:
+            raise AnalyzeError(HTTPStatus.BAD_REQUEST, "Source requests need a 'language'")
+
+        if "line_offset" not in payload:
+            findings = self._scanner.scan_source(source, language.lower(), rel_path=name)
+            return ScanReport(root=name, findings=findings, files_scanned=[name])
+        line_offset = payload["line_offset"]
+        if not isinstance(line_offset, int) or isinstance(line_offset, bool):
+            raise AnalyzeError(HTTPStatus.BAD_REQUEST, "'line_offset' must be an integer")
+        try:
+            findings = self._scanner.scan_snippet(
+                source, language.lower(), rel_path=name, line_offset=line_offset
+            )
+        except ValueError as e:
+            raise AnalyzeError(HTTPStatus.UNPROCESSABLE_ENTITY, str(e)) from e
+        return ScanReport(root=name, findings=findings, files_scanned=[name])
+
+    def _analyze_path(self, raw_path: Any) -> ScanReport:
+        """Scan a file or directory under the server root."""
+        if not isinstance(raw_path, str) or not raw_path:
+            raise AnalyzeError(HTTPStatus.BAD_REQUEST, "'path' must be a non-empty string")
+
+        path = (self._root / raw_path).resolve()
+        if path != self._root and self._root not in path.parents:
+            raise AnalyzeError(HTTPStatus.FORBIDDEN, f"Path is outside the server root: {raw_path}")
+
+        try:
+            return self._scanner.scan(path)
+        except FileNotFoundError as e:
+            raise AnalyzeError(HTTPStatus.NOT_FOUND, str(e)) from e
+        except ConfigError as e:
+            raise AnalyzeError(HTTPStatus.UNPROCESSABLE_ENTITY, f"Config error: {e}") from e
+
+
+class _Handler(BaseHTTPRequestHandler):
+    """Request handler routing to the owning ScanServer."""
+
+    server_version = "DeepVerifyScan/1.0"
+
+    @property
+    def _scan_server(self) -> ScanServer:
+        return self.server.scan_server  # type: ignore[attr-defined,no-any-return]
+
+    def do_GET(self) -> None:  # noqa: N802 - http.server naming
+        """Handle GET requests."""
+        if self.path == "/health":
+            self._send_json(HTTPStatus.OK, self._scan_server.health())
+        else:
+            self._send_error(HTTPStatus.NOT_FOUND, f"Unknown endpoint: {self.path}")
+
+    def do_POST(self) -> None:  # noqa: N802 - http.server naming
+        """Handle POST requests."""
+        if self.path != "/analyze":
+            self._send_error(HTTPStatus.NOT_FOUND, f"Unknown endpoint: {self.path}")
+            return
+
+        try:
+            length = int(self.headers.get("Content-Length", "0"))
+        except ValueError:
+            self._send_error(HTTPStatus.BAD_REQUEST, "Invalid Content-Length")
+            return
+        if length > MAX_BODY_BYTES:
+            self._send_error(HTTPStatus.REQUEST_ENTITY_TOO_LARGE, "Request body too large")
+            return
+
+        try:
+            payload = json.loads(self.rfile.read(length) or b"null")
+        except (json.JSONDecodeError, UnicodeDecodeError):
+            self._send_error(HTTPStatus.BAD_REQUEST, "Request body is not valid JSON")
+            return
+
+        try:
+            self._send_json(HTTPStatus.OK, self._scan_server.analyze(payload))
+        except AnalyzeError as e:
+            self._send_error(e.status, str(e))
+        except Exception as e:
+            logger.exception("Analyze request failed")
+            self._send_error(HTTPStatus.INTERNAL_SERVER_ERROR, f"Analysis failed: {e}")
+
+    def log_message(self, format: str, *args: Any) -> None:  # noqa: A002
+        """Route access logs to the module logger."""
+        logger.debug("%s - %s", self.address_string(), format % args)
+
+    def _send_error(self, status: HTTPStatus, message: str) -> None:
+        self._send_json(status, {"error": message})
+
+    def _send_json(self, status: HTTPStatus, body: dict[str, Any]) -> None:
+        data = json.dumps(body).encode("utf-8")
+        self.send_response(status)
+        self.send_header("Content-Type", "application/json")
+        self.send_header("Content-Length", str(len(data)))
+        self.end_headers()
+        self.wfile.write(data)
@@ -0,0 +1,186 @@
+"""Ed25519 signing of Deep Verify scan reports.
+
+A signed report lets downstream consumers check that scan results were not
+altered in transit. The signature covers the report's canonical bytes:
+serialized JSON with sorted keys, no insignificant whitespace, and findings,
+scanned files and skipped files in sorted order, so the same report always
+signs to the same bytes regardless of scan order.
+
+Requires the optional ``cryptography`` dependency
+(``pip install bmad-assist[signing]``); the rest of the scan package does not
+import this module.
+
+Example:
+    >>> from cryptography.hazmat.primitives.asymmetric.ed25519 import Ed25519PrivateKey
+    >>> key = Ed25519PrivateKey.generate()
+    >>> signed = sign_report(report, key)
+    >>> Path("report.signed.json").write_text(json.dumps(serialize_signed_report(signed)))
+    >>> verified = verify_signed_report(key.public_key(), signed)
+
+"""
+
+from __future__ import annotations
+
+import base64
+import binascii
+import hashlib
+import json
+from dataclasses import dataclass
+from typing import Any
+
+try:
+    from cryptography.exceptions import InvalidSignature
+    from cryptography.hazmat.primitives.asymmetric.ed25519 import (
+        Ed25519PrivateKey,
+        Ed25519PublicKey,
+    )
+    from cryptography.hazmat.primitives.serialization import Encoding, PublicFormat
+except ImportError as e:  # pragma: no cover - depends on the environment
+    raise ImportError(
+        "Deep Verify report signing requires cryptography (pip install bmad-assist[signing])"
+    ) from e
+
+from bmad_assist.deep_verify.core.exceptions import DeepVerifyError
+from bmad_assist.deep_verify.scan.types import (
+    ScanReport,
+    deserialize_scan_report,
+    serialize_scan_report,
+)
+
+# Signature algorithm recorded in serialized signed reports
+SIGNATURE_ALGORITHM = "ed25519"
+
+
+class ReportSignatureError(DeepVerifyError):
+    """Raised when a signed report fails verification."""
+
+
+@dataclass(frozen=True, slots=True)
+class SignedReport:
+    """A scan report with a detached Ed25519 signature.
+
+    Attributes:
+        payload: Canonical report bytes the signature covers.
+        signature: Ed25519 signature over payload (64 bytes).
+        key_id: Fingerprint of the signing public key (see public_key_id).
+
+    """
+
+    payload: bytes
+    signature: bytes
+    key_id: str
+
+    def __repr__(self) -> str:
+        """Return a string representation of the signed report."""
+        return f"SignedReport(key_id={self.key_id!r}, payload={len(self.payload)} bytes)"
+
+    def report(self) -> ScanReport:
+        """Decode the payload without verifying it (see verify_signed_report)."""
+        return deserialize_scan_report(json.loads(self.payload))
+
+
+def _canonical_json(data: Any) -> bytes:
+    """Encode JSON deterministically: sorted keys, compact separators, UTF-8."""
+    return json.dumps(
+        data, sort_keys=True, separators=(",", ":"), ensure_ascii=False, allow_nan=False
+    ).encode("utf-8")
+
+
+def canonical_report_bytes(report: ScanReport) -> bytes:
+    """Return the canonical bytes of a report, as signed by sign_report.
+
+    Findings are ordered by path, line, pattern ID and fingerprint; file lists
+    are sorted.
+    """
+    data = serialize_scan_report(report)
+    data["files_scanned"] = sorted(data["files_scanned"])
+    data["skipped_large_files"] = sorted(data["skipped_large_files"])
+    data["findings"] = sorted(
+        data["findings"],
+        key=lambda f: (f["path"], f["line"], f["pattern_id"], f["fingerprint"]),
+    )
+    return _canonical_json(data)
+
+
+def public_key_id(public_key: Ed25519PublicKey) -> str:
+    """Return a short fingerprint of a public key (first 16 hex digits of its SHA-256)."""
+    raw = public_key.public_bytes(Encoding.Raw, PublicFormat.Raw)
+    return hashlib.sha256(raw).hexdigest()[:16]
+
+
+def sign_report(report: ScanReport, private_key: Ed25519PrivateKey) -> SignedReport:
+    """Sign a report's canonical bytes.
+
+    Args:
+        report: Report to sign.
+        private_key: Ed25519 signing key.
+
+    Returns:
+        Signed report.
+
+    """
+    payload = canonical_report_bytes(report)
+    return SignedReport(
+        payload=payload,
+        signature=private_key.sign(payload),
+        key_id=public_key_id(private_key.public_key()),
+    )
+
+
+def verify_signed_report(public_key: Ed25519PublicKey, signed: SignedReport) -> ScanReport:
+    """Verify a signed report and return the report it covers.
+
+    Args:
+        public_key: Ed25519 key the report is expected to be signed with.
+        signed: Signed report.
+
+    Returns:
+        The verified report.
+
+    Raises:
+        ReportSignatureError: If the signature does not match the payload
+            and key.
+
+    """
+    try:
+        public_key.verify(signed.signature, signed.payload)
+    except InvalidSignature as e:
+        raise ReportSignatureError(
+            f"Report signature is not valid for key {public_key_id(public_key)}"
+        ) from e
+    return signed.report()
+
+
+def serialize_signed_report(signed: SignedReport) -> dict[str, Any]:
+    """Serialize SignedReport to a dictionary for JSON output."""
+    return {
+        "algorithm": SIGNATURE_ALGORITHM,
+        "key_id": signed.key_id,
+        "signature": base64.b64encode(signed.signature).decode("ascii"),
+        "report": json.loads(signed.payload),
+    }
+
+
+def deserialize_signed_report(data: dict[str, Any]) -> SignedReport:
+    """Deserialize a dictionary to SignedReport.
+
+    The payload is re-encoded canonically, so any change to the report
+    contents (including finding order) invalidates the signature.
+
+    Raises:
+        ReportSignatureError: If the algorithm is not Ed25519 or the
+            signature is not valid base64.
+
+    """
+    algorithm = data.get("algorithm")
+    if algorithm != SIGNATURE_ALGORITHM:
+        raise ReportSignatureError(f"Unsupported signature algorithm: {algorithm!r}")
+    try:
+        signature = base64.b64decode(data["signature"], validate=True)
+    except (binascii.Error, TypeError) as e:
+        raise ReportSignatureError("Report signature is not valid base64") from e
+    return SignedReport(
+        payload=_canonical_json(data["report"]),
+        signature=signature,
+        key_id=data.get("key_id", ""),
+    )
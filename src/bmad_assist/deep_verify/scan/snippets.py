@@ -0,0 +1,81 @@
+"""Wrapping of Go source fragments for snippet scans.
+
+An editor selection is rarely a complete Go file: it may be a few
+declarations without a ``package`` clause, or statements cut from a
+function body. ``wrap_go_snippet`` turns such a fragment into a minimal
+file so that detectors written for whole files (function bodies ending at a
+column-0 brace, declarations at the top level) see familiar structure::
+
+    package snippet
+
+    func snippet() {
+        go worker(jobs) // the selected statements
+    }
+
+Fragments must have balanced braces; a selection that starts or ends inside
+a block cannot be wrapped and is rejected. The wrapper adds no imports, so
+checks that resolve package names through imports (such as CC-111) only
+run when the selection includes the import block.
+
+Example:
+    >>> wrapped, prefix_lines = wrap_go_snippet("go worker(jobs)\\n")
+    >>> prefix_lines
+    3
+
+"""
+
+from __future__ import annotations
+
+import re
+
+# Package clause of a complete file
+_PACKAGE_RE = re.compile(r"^package[ \t]+\w+", re.MULTILINE)
+
+# Top-level declaration keywords; any other first line starts statements
+_DECL_RE = re.compile(r"^(?:import|func|type|var|const)\b")
+
+# String and rune literals, blanked before counting braces
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+_PACKAGE_CLAUSE = "package snippet\n"
+_FUNC_OPEN = "\nfunc snippet() {\n"
+_FUNC_CLOSE = "\n}\n"
+
+
+def wrap_go_snippet(text: str) -> tuple[str, int]:
+    """Wrap a Go fragment into a minimal file.
+
+    Complete files are returned unchanged, top-level declarations get a
+    package clause and statements are also wrapped in a function.
+
+    Args:
+        text: Go fragment.
+
+    Returns:
+        Tuple of (wrapped source, number of lines added before the fragment).
+
+    Raises:
+        ValueError: If the fragment's braces are unbalanced.
+
+    """
+    depth = 0
+    first_code: str | None = None
+    for number, line in enumerate(text.split("\n"), start=1):
+        code = _LITERAL_RE.sub('""', line).split("//", 1)[0]
+        if first_code is None and code.strip():
+            first_code = code
+        for char in code:
+            depth += {"{": 1, "}": -1}.get(char, 0)
+            if depth < 0:
+                raise ValueError(
+                    f"Snippet is not a complete Go fragment: unmatched '}}' on line {number}"
+                )
+    if depth > 0:
+        raise ValueError(f"Snippet is not a complete Go fragment: {depth} unclosed '{{'")
+
+    if _PACKAGE_RE.search(text):
+        return text, 0
+    if first_code is None or _DECL_RE.match(first_code):
+        return _PACKAGE_CLAUSE + text, _PACKAGE_CLAUSE.count("\n")
+    prefix = _PACKAGE_CLAUSE + _FUNC_OPEN
+    return prefix + text + _FUNC_CLOSE, prefix.count("\n")
@@ -0,0 +1,181 @@
+"""Detection of goroutines started while holding a mutex in Go scans.
+
+A goroutine started inside a critical section that locks the same mutex
+blocks until the spawner unlocks it. If the spawner then waits for the
+goroutine before unlocking, neither can proceed::
+
+    func (c *Cache) Refresh() {
+        c.mu.Lock()
+        defer c.mu.Unlock()
+        done := make(chan struct{})
+        go func() { // CC-137: blocks on c.mu, held by Refresh
+            c.mu.Lock()
+            c.items = load()
+            c.mu.Unlock()
+            close(done)
+        }()
+        <-done
+    }
+
+Locks are tracked through each function in source order: ``X.Lock()`` and
+``X.RLock()`` hold ``X`` until a matching non-deferred unlock, and a
+deferred unlock holds it to the end of the function. A ``go`` statement
+while a lock is held is reported with full confidence when the goroutine
+locks the same mutex: a function literal locking the same expression, or a
+function or method of the file locking a mutex of the same field name
+(``go c.flush()`` with ``flush`` locking ``c.mu``). Other goroutines
+started under a lock are reported at ``LOCKED_SPAWN_CONFIDENCE``, which
+stricter ``--threshold`` values drop.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for go statements inside a critical section
+LOCKED_SPAWN_PATTERN = Pattern(
+    id=PatternId("CC-137-CODE-GO"),
+    domain=ArtifactDomain.CONCURRENCY,
+    signals=[],
+    severity=Severity.WARNING,
+    description="Goroutine started while holding a mutex it also locks - deadlocks if awaited",
+    remediation="Start the goroutine after unlocking, or pass it the data it needs",
+    language="go",
+    effort=PatternEffort.HIGH,
+)
+
+# Confidence of CC-137 findings whose goroutine does not lock the held mutex:
+# starting it under the lock is a smell, but a deadlock needs a wait on that lock
+LOCKED_SPAWN_CONFIDENCE = 0.6
+
+# Lock or unlock call, possibly deferred: `c.mu.Lock()`, `defer mu.RUnlock()`
+_LOCK_RE = re.compile(
+    r"(?<![\w.])(defer[ \t]+)?((?:[A-Za-z_]\w*\.)*[A-Za-z_]\w*)\.(R?Lock|R?Unlock)\(\)"
+)
+
+# go statement: a function literal, or a call of a named function or method
+_GO_RE = re.compile(
+    r"(?<![\w.])go[ \t]+(?:(func)\b|(?:[A-Za-z_]\w*\.)*([A-Za-z_]\w*)[ \t]*\()"
+)
+
+# Function or method declaration: `func name(`, `func (c *Cache) name(`
+_FUNC_DECL_RE = re.compile(r"^func[ \t]*(?:\([^)\n]*\)[ \t]*)?([A-Za-z_]\w*)")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _acquired(body: str) -> set[str]:
+    """Return the mutexes a function body locks."""
+    return {m.group(2) for m in _LOCK_RE.finditer(body) if m.group(3).endswith("Lock")}
+
+
+def _func_locks(lines: list[str]) -> dict[str, set[str]]:
+    """Return the field names of the mutexes each function of the file locks."""
+    locks: dict[str, set[str]] = {}
+    name = None
+    for line in lines:
+        if decl := _FUNC_DECL_RE.match(line):
+            name = decl.group(1)
+            locks.setdefault(name, set())
+        if name is not None:
+            locks[name].update(m.rsplit(".", 1)[-1] for m in _acquired(line))
+        if line.startswith("}"):
+            name = None
+    return locks
+
+
+def _literal_end(lines: list[str], index: int, start: int) -> tuple[str, int]:
+    """Return the body of the function literal from lines[index][start:] and its last line."""
+    depth = 0
+    body: list[str] = []
+    for end in range(index, len(lines)):
+        segment = lines[end][start:] if end == index else lines[end]
+        for position, char in enumerate(segment):
+            if char == "{":
+                depth += 1
+            elif char == "}":
+                depth -= 1
+                if depth == 0:
+                    body.append(segment[:position])
+                    return "\n".join(body), end
+        body.append(segment)
+    return "\n".join(body), len(lines) - 1
+
+
+def find_locked_spawns(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report go statements executed while a mutex is held.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-137 findings in line order: confidence 1.0 when the goroutine
+        locks a held mutex, LOCKED_SPAWN_CONFIDENCE otherwise.
+
+    """
+    if not config.is_enabled(LOCKED_SPAWN_PATTERN.id):
+        return []
+    lines = _code_lines(text)
+    func_locks = _func_locks(lines)
+    source_lines = text.split("\n")
+    findings: list[ScanFinding] = []
+    held: list[str] = []  # in locking order
+    index = 0
+    while index < len(lines):
+        line = lines[index]
+        if _FUNC_DECL_RE.match(line):
+            held = []
+        go = _GO_RE.search(line)
+        for lock in _LOCK_RE.finditer(line, 0, go.start() if go else len(line)):
+            deferred, mutex, method = lock.groups()
+            if method.endswith("Lock") and mutex not in held:
+                held.append(mutex)
+            elif method.endswith("Unlock") and not deferred and mutex in held:
+                held.remove(mutex)
+        if go is None:
+            index += 1
+            continue
+        end = index
+        if go.group(1):
+            body, end = _literal_end(lines, index, go.end())
+            relocked = [m for m in held if m in _acquired(body)]
+        else:
+            fields = func_locks.get(go.group(2), set())
+            relocked = [m for m in held if m.rsplit(".", 1)[-1] in fields]
+        if held:
+            if relocked:
+                title = f"Goroutine started while holding {relocked[0]} locks it again"
+            else:
+                title = f"Goroutine started while holding {held[0]}"
+            findings.append(
+                builtin_finding(
+                    LOCKED_SPAWN_PATTERN,
+                    title,
+                    rel_path,
+                    index + 1,
+                    source_lines[index],
+                    config,
+                    1.0 if relocked else LOCKED_SPAWN_CONFIDENCE,
+                )
+            )
+        index = end + 1
+    return findings
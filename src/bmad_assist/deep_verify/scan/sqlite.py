@@ -0,0 +1,168 @@
+"""SQLite export for Deep Verify scans.
+
+Writes scan reports to a SQLite database so findings can be queried,
+trended and joined with other data using plain SQL. Each report becomes a
+row in ``runs``; its findings are rows in ``findings`` keyed by ``run_id``.
+Suppressed findings (``ScanOptions.show_suppressed``) are not stored.
+Runs also record the commit they scanned, a composite health score and the
+estimated remediation effort in hours, which ``load_trend`` reads back per
+commit (see ``scan.trend``).
+
+Example:
+    >>> from pathlib import Path
+    >>> from bmad_assist.deep_verify.scan import Scanner, write_sqlite
+    >>> run_id = write_sqlite(Scanner().scan(Path(".")), Path("deepverify.db"), append=True)
+
+Querying trends::
+
+    SELECT r.started_at, f.severity, COUNT(*)
+    FROM findings f JOIN runs r ON r.id = f.run_id
+    GROUP BY r.id, f.severity;
+
+    SELECT commit_sha, health_score FROM runs ORDER BY id;
+
+    SELECT effort, COUNT(*) FROM findings WHERE run_id = ? GROUP BY effort;
+
+"""
+
+from __future__ import annotations
+
+import sqlite3
+from contextlib import closing
+from pathlib import Path
+
+from bmad_assist.deep_verify.scan.trend import health_score
+from bmad_assist.deep_verify.scan.types import ScanReport, finding_fingerprint
+
+# Stored in PRAGMA user_version; bump when the schema changes
+SQLITE_SCHEMA_VERSION = 3
+
+# Columns (table, definition) that upgrade each older schema version to the
+# next, added in turn on append; missing tables are created whole from _SCHEMA
+_MIGRATIONS = {
+    1: (("runs", "commit_sha TEXT"), ("runs", "health_score REAL")),
+    2: (("runs", "effort_hours REAL"), ("findings", "effort TEXT")),
+}
+
+_SCHEMA = """
+CREATE TABLE IF NOT EXISTS runs (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    root TEXT NOT NULL,
+    started_at TEXT,
+    duration_ms INTEGER NOT NULL,
+    files_scanned INTEGER NOT NULL,
+    skipped_large_files INTEGER NOT NULL,
+    findings INTEGER NOT NULL,
+    commit_sha TEXT,
+    health_score REAL,
+    effort_hours REAL
+);
+CREATE TABLE IF NOT EXISTS findings (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    run_id INTEGER NOT NULL REFERENCES runs(id) ON DELETE CASCADE,
+    fingerprint TEXT NOT NULL,
+    pattern_id TEXT NOT NULL,
+    severity TEXT NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL,
+    path TEXT NOT NULL,
+    line INTEGER NOT NULL,
+    snippet TEXT NOT NULL,
+    confidence REAL NOT NULL,
+    domain TEXT NOT NULL,
+    language TEXT NOT NULL,
+    remediation TEXT,
+    effort TEXT
+);
+CREATE INDEX IF NOT EXISTS findings_run_id ON findings(run_id);
+CREATE INDEX IF NOT EXISTS findings_pattern_id ON findings(pattern_id);
+CREATE INDEX IF NOT EXISTS runs_commit_sha ON runs(commit_sha);
+"""
+
+
+def write_sqlite(
+    report: ScanReport, path: Path, append: bool = False, commit_sha: str | None = None
+) -> int:
+    """Write a scan report to a SQLite database.
+
+    Appending to a database of an older schema version upgrades it in place;
+    its earlier runs have no commit SHA, health score or effort estimate.
+
+    Args:
+        report: Scan report to write.
+        path: Database file (created if missing).
+        append: Add a new run to existing data instead of replacing it.
+        commit_sha: Commit the report scanned (see trend.current_commit).
+
+    Returns:
+        ID of the inserted run row.
+
+    Raises:
+        ValueError: If the database was written with a different schema version.
+        sqlite3.Error: If the database cannot be written.
+
+    """
+    findings = report.unsuppressed_findings()
+    with closing(sqlite3.connect(path)) as conn, conn:
+        version = conn.execute("PRAGMA user_version").fetchone()[0]
+        if version not in (0, SQLITE_SCHEMA_VERSION, *_MIGRATIONS):
+            raise ValueError(
+                f"{path}: unsupported Deep Verify schema version {version} "
+                f"(expected {SQLITE_SCHEMA_VERSION})"
+            )
+        if not append:
+            conn.execute("DROP TABLE IF EXISTS findings")
+            conn.execute("DROP TABLE IF EXISTS runs")
+        elif version in _MIGRATIONS:
+            tables = {row[0] for row in conn.execute("SELECT name FROM sqlite_master")}
+            for from_version in range(version, SQLITE_SCHEMA_VERSION):
+                for table, column in _MIGRATIONS[from_version]:
+                    if table in tables:
+                        conn.execute(f"ALTER TABLE {table} ADD COLUMN {column}")
+        for statement in _SCHEMA.split(";"):
+            if statement.strip():
+                conn.execute(statement)
+        conn.execute(f"PRAGMA user_version = {SQLITE_SCHEMA_VERSION}")
+
+        cursor = conn.execute(
+            "INSERT INTO runs (root, started_at, duration_ms, files_scanned, "
+            "skipped_large_files, findings, commit_sha, health_score, effort_hours) "
+            "VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+            (
+                report.root,
+                report.started_at.isoformat() if report.started_at else None,
+                report.duration_ms,
+                len(report.files_scanned),
+                len(report.skipped_large_files),
+                len(findings),
+                commit_sha,
+                health_score(report),
+                report.estimated_effort(),
+            ),
+        )
+        run_id = cursor.lastrowid or 0
+        conn.executemany(
+            "INSERT INTO findings (run_id, fingerprint, pattern_id, severity, title, "
+            "description, path, line, snippet, confidence, domain, language, remediation, "
+            "effort) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+            [
+                (
+                    run_id,
+                    finding_fingerprint(f),
+                    f.pattern_id,
+                    f.severity.value,
+                    f.title,
+                    f.description,
+                    f.path,
+                    f.line,
+                    f.snippet,
+                    f.confidence,
+                    f.domain.value,
+                    f.language,
+                    f.remediation,
+                    f.effort.value if f.effort else None,
+                )
+                for f in findings
+            ],
+        )
+        return run_id
@@ -0,0 +1,242 @@
+"""Inline suppressions for Deep Verify scans.
+
+A ``deepverify:ignore`` comment silences findings on its own line, or on the
+next line when the comment stands alone::
+
+    mu.Lock() // deepverify:ignore CC-002 reason="unlocked in Close"
+
+    // deepverify:ignore CC-001-CODE-GO reason=fire-and-forget owner=payments expires=2026-06-30
+    go audit(event)
+
+Pattern selectors follow ``.deepverify.yaml`` rules; a suppression without
+selectors covers every pattern. ``key=value`` tokens carry governance fields
+(``reason``, ``owner``, ``expires`` as an ISO date).
+
+Governance:
+    When the scan config lists ``suppression_fields``, each suppression is
+    audited. Suppressions lacking a required field, or with an invalid or
+    past ``expires`` date, produce a CC-103 meta-finding at the comment.
+    Expired suppressions also stop suppressing.
+
+Auditing:
+    With ``keep_suppressed`` (``ScanOptions.show_suppressed``), covered
+    findings are kept, marked suppressed and carrying the suppression's
+    ``reason``, instead of being dropped.
+
+"""
+
+from __future__ import annotations
+
+import re
+import shlex
+from dataclasses import dataclass, field, replace
+from datetime import date
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig, matches_selector
+from bmad_assist.deep_verify.scan.types import ScanFinding
+
+# Comment directive, for "//" and "#" comment languages
+_DIRECTIVE_RE = re.compile(r"(?://|#)\s*deepverify:ignore\b(?P<args>.*)$")
+
+# Pattern reported for suppressions that fail governance checks
+SUPPRESSION_PATTERN = Pattern(
+    id=PatternId("CC-103"),
+    domain=ArtifactDomain.TRANSFORM,
+    signals=[],
+    severity=Severity.ERROR,
+    description="Suppression missing required governance fields or expired",
+    remediation='Add the required fields (e.g. reason="...") or renew the expires date',
+    effort=PatternEffort.LOW,
+)
+
+
+@dataclass(frozen=True, slots=True)
+class Suppression:
+    """A parsed ``deepverify:ignore`` comment.
+
+    Attributes:
+        line: 1-based line of the comment.
+        target_line: 1-based line whose findings are suppressed.
+        selectors: Pattern selectors (empty = all patterns).
+        fields: Governance fields such as reason, owner and expires.
+        text: Stripped source line holding the comment.
+
+    """
+
+    line: int
+    target_line: int
+    selectors: tuple[str, ...] = ()
+    fields: dict[str, str] = field(default_factory=dict)
+    text: str = ""
+
+    def covers(self, finding: ScanFinding) -> bool:
+        """Check whether this suppression silences a finding."""
+        if finding.line != self.target_line:
+            return False
+        return not self.selectors or any(
+            matches_selector(finding.pattern_id, s) for s in self.selectors
+        )
+
+
+def parse_suppressions(text: str) -> list[Suppression]:
+    """Parse all suppression comments in a file.
+
+    Args:
+        text: File contents.
+
+    Returns:
+        Suppressions in line order.
+
+    """
+    suppressions: list[Suppression] = []
+    for index, line in enumerate(text.splitlines()):
+        match = _DIRECTIVE_RE.search(line)
+        if match is None:
+            continue
+
+        try:
+            tokens = shlex.split(match.group("args"))
+        except ValueError:
+            # Unbalanced quotes: fall back to plain whitespace splitting
+            tokens = match.group("args").split()
+
+        selectors: list[str] = []
+        fields: dict[str, str] = {}
+        for token in tokens:
+            key, sep, value = token.partition("=")
+            if sep:
+                fields[key.strip().lower()] = value.strip()
+            else:
+                selectors.extend(s for s in token.split(",") if s)
+
+        # A comment alone on its line applies to the next line
+        standalone = not line[: match.start()].strip()
+        suppressions.append(
+            Suppression(
+                line=index + 1,
+                target_line=index + 2 if standalone else index + 1,
+                selectors=tuple(selectors),
+                fields=fields,
+                text=line.strip(),
+            )
+        )
+    return suppressions
+
+
+def suppression_problems(
+    suppression: Suppression, required: list[str], today: date
+) -> tuple[list[str], bool]:
+    """Audit a suppression against governance rules.
+
+    Args:
+        suppression: Suppression to audit.
+        required: Field names every suppression must set.
+        today: Date used for expiry checks.
+
+    Returns:
+        Tuple of (problem descriptions, whether the suppression expired).
+
+    """
+    problems = [
+        f"missing {name}=" for name in required if not suppression.fields.get(name)
+    ]
+    expired = False
+    expires = suppression.fields.get("expires")
+    if expires:
+        try:
+            expired = date.fromisoformat(expires) < today
+        except ValueError:
+            problems.append(f"invalid expires={expires} (use YYYY-MM-DD)")
+        else:
+            if expired:
+                problems.append(f"expired on {expires}")
+    return problems, expired
+
+
+def apply_suppressions(
+    findings: list[ScanFinding],
+    text: str,
+    rel_path: str,
+    language: str,
+    config: ScanConfig,
+    today: date,
+    keep_suppressed: bool = False,
+) -> list[ScanFinding]:
+    """Drop suppressed findings and add governance meta-findings.
+
+    Args:
+        findings: Findings for one file.
+        text: File contents.
+        rel_path: Path relative to the scan root.
+        language: Language of the file.
+        config: Effective config for the file.
+        today: Date used for expiry checks.
+        keep_suppressed: Keep suppressed findings, marked as suppressed.
+
+    Returns:
+        Remaining findings plus CC-103 meta-findings.
+
+    """
+    if "deepverify:ignore" not in text:
+        return findings
+
+    suppressions = parse_suppressions(text)
+    active: list[Suppression] = []
+    meta: list[ScanFinding] = []
+    for suppression in suppressions:
+        if not config.suppression_fields:
+            active.append(suppression)
+            continue
+
+        problems, expired = suppression_problems(
+            suppression, config.suppression_fields, today
+        )
+        if not expired:
+            active.append(suppression)
+        if problems and config.is_enabled(SUPPRESSION_PATTERN.id):
+            meta.append(_meta_finding(suppression, problems, rel_path, language, config))
+
+    kept: list[ScanFinding] = []
+    for finding in findings:
+        covering = next((s for s in active if s.covers(finding)), None)
+        if covering is None:
+            kept.append(finding)
+        elif keep_suppressed:
+            kept.append(
+                replace(
+                    finding,
+                    suppressed=True,
+                    suppression_reason=covering.fields.get("reason") or None,
+                )
+            )
+    return kept + meta
+
+
+def _meta_finding(
+    suppression: Suppression,
+    problems: list[str],
+    rel_path: str,
+    language: str,
+    config: ScanConfig,
+) -> ScanFinding:
+    """Build a CC-103 finding for a non-compliant suppression."""
+    return ScanFinding(
+        pattern_id=SUPPRESSION_PATTERN.id,
+        severity=config.severity_for(SUPPRESSION_PATTERN),
+        title=f"Suppression {', '.join(problems)}",
+        description=SUPPRESSION_PATTERN.description or "",
+        path=rel_path,
+        line=suppression.line,
+        snippet=suppression.text,
+        confidence=1.0,
+        domain=SUPPRESSION_PATTERN.domain,
+        language=language,
+        remediation=SUPPRESSION_PATTERN.remediation,
+    )
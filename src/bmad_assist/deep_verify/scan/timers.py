@@ -0,0 +1,191 @@
+"""Detection of per-call time.After timers in Go select statements.
+
+``time.After`` allocates a new timer each time it is evaluated. In a select
+that also waits on a receive, the receive usually fires first and the timer
+lives on until it expires, so a consumer called once per message keeps one
+timer per call alive for the whole timeout::
+
+    func (q *Queue) Consume() (string, error) {
+        select {
+        case msg := <-q.messages:
+            return msg, nil
+        case <-time.After(30 * time.Second): // CC-128: a timer per call
+            return "", ErrTimeout
+        }
+    }
+
+A select is reported when a ``time.After`` case (resolved through the file's
+imports) competes with a receive case and there is no ``default``. Receives
+from ``Done()`` channels are cancellation signals rather than the case that
+usually wins and do not count. Selects inside a ``for`` loop are reported as
+allocating per iteration, others per call of the enclosing function.
+Reusing one ``time.Timer`` (``timer.Reset`` before each select) is not
+reported. Modules on Go 1.23 or later free unreferenced timers, so there the
+cost is allocation churn rather than memory held until expiry.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for time.After cases racing a receive in a select
+TIMER_SELECT_PATTERN = Pattern(
+    id=PatternId("CC-128-CODE-GO"),
+    domain=ArtifactDomain.CONCURRENCY,
+    signals=[],
+    severity=Severity.WARNING,
+    description="time.After in a select with a receive keeps a timer alive after the receive wins",
+    remediation="Reuse one time.Timer: Reset it before each select, Stop it when a receive wins",
+    language="go",
+    effort=PatternEffort.LOW,
+)
+
+# Confidence of CC-128 findings: the timer is wasted only when another case
+# usually fires first, which is inferred from the shape of the select
+TIMER_SELECT_CONFIDENCE = 0.7
+
+# Receive case: `case <-ch:`, `case msg := <-ch:`, `case v, ok = <-ch:`
+_RECEIVE_CASE_RE = re.compile(r"^[ \t]*case[ \t]+(?:[\w \t,]+(?::=|=)[ \t]*)?<-(.*):")
+
+# Receive from a cancellation channel: `<-ctx.Done()`
+_DONE_RE = re.compile(r"\.Done\(\)[ \t]*$")
+
+_DEFAULT_RE = re.compile(r"^[ \t]*default[ \t]*:")
+
+# Named function or method declaration: `func Consume(`, `func (q *Queue) Consume(`
+_FUNC_NAME_RE = re.compile(r"^func[ \t]*(?:\([^)]*\)[ \t]*)?([A-Za-z_]\w*)")
+
+_SELECT_RE = re.compile(r"\bselect\b")
+_FOR_RE = re.compile(r"\bfor\b")
+_FUNC_RE = re.compile(r"\bfunc\b")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+class _Select:
+    """Cases seen so far in one select statement."""
+
+    def __init__(self, in_loop: bool, function: str | None) -> None:
+        self.in_loop = in_loop
+        self.function = function
+        self.timer_lines: list[int] = []
+        self.receives = 0
+        self.has_default = False
+
+
+def find_timer_selects(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report time.After cases that race a receive in a select without default.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-128 findings in line order, one per time.After case.
+
+    """
+    if not config.is_enabled(TIMER_SELECT_PATTERN.id):
+        return []
+    aliases = [name for name, path in parse_go_imports(text).items() if path == "time"]
+    if not aliases:
+        return []
+    qualified = [r"(?<![\w.])After" if a == "." else re.escape(a) + r"\.After" for a in aliases]
+    timer_re = re.compile(
+        r"^[ \t]*case[ \t]+(?:[\w \t,]+(?::=|=)[ \t]*)?<-[ \t]*(?:"
+        + "|".join(qualified)
+        + r")[ \t]*\("
+    )
+
+    source_lines = text.split("\n")
+    # Open blocks, innermost last: "for", "func", "block" or a _Select
+    stack: list[str | _Select] = []
+    # Names of the enclosing functions, innermost last (None for literals)
+    functions: list[str | None] = []
+    findings: list[ScanFinding] = []
+    for index, line in enumerate(_code_lines(text)):
+        if stack and isinstance(stack[-1], _Select):
+            select = stack[-1]
+            if timer_re.match(line):
+                select.timer_lines.append(index)
+            elif (receive := _RECEIVE_CASE_RE.match(line)) and not _DONE_RE.search(
+                receive.group(1)
+            ):
+                select.receives += 1
+            elif _DEFAULT_RE.match(line):
+                select.has_default = True
+
+        start = 0
+        for position, char in enumerate(line):
+            if char == "{":
+                segment = line[start:position]
+                start = position + 1
+                if _SELECT_RE.search(segment):
+                    stack.append(_Select(_in_loop(stack), functions[-1] if functions else None))
+                elif _FOR_RE.search(segment):
+                    stack.append("for")
+                elif _FUNC_RE.search(segment):
+                    name = _FUNC_NAME_RE.match(line)
+                    stack.append("func")
+                    functions.append(name.group(1) if name else None)
+                else:
+                    stack.append("block")
+            elif char == "}":
+                start = position + 1
+                if not stack:
+                    continue
+                block = stack.pop()
+                if block == "func":
+                    functions.pop()
+                elif isinstance(block, _Select) and block.receives and not block.has_default:
+                    findings.extend(
+                        builtin_finding(
+                            TIMER_SELECT_PATTERN,
+                            _title(block),
+                            rel_path,
+                            timer + 1,
+                            source_lines[timer],
+                            config,
+                            TIMER_SELECT_CONFIDENCE,
+                        )
+                        for timer in block.timer_lines
+                    )
+    findings.sort(key=lambda f: f.line)
+    return findings
+
+
+def _in_loop(stack: list[str | _Select]) -> bool:
+    """Return whether the innermost open function body has an open for loop."""
+    for block in reversed(stack):
+        if block == "func":
+            return False
+        if block == "for":
+            return True
+    return False
+
+
+def _title(select: _Select) -> str:
+    """Return the CC-128 title for a time.After case of select."""
+    if select.in_loop:
+        return "time.After in a select inside a loop allocates a timer per iteration"
+    if select.function:
+        return f"time.After allocates a timer on every call to {select.function}"
+    return "time.After allocates a timer on every call"
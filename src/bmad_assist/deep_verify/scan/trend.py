@@ -0,0 +1,172 @@
+"""Code-health trend of Deep Verify scans over commits.
+
+Every run written with ``write_sqlite`` records a composite health score and,
+when known, the commit it scanned. ``load_trend`` reads those runs back in
+commit order so the score can be charted across history.
+
+The health score is 100 for a tree without findings and falls as the
+severity-weighted findings per scanned file grow::
+
+    H = 100 / (1 + Σ(severity_weight × confidence) / files_scanned)
+
+with the severity weights of ``core.scoring`` (critical=4, error=2,
+warning=1, info=0.5). Normalizing by file count keeps scores comparable as
+the tree grows. Suppressed findings do not count.
+
+Runs also record the estimated hours to fix their findings
+(``ScanReport.estimated_effort``), so the trend shows whether the backlog
+grows or shrinks in work, not just in count.
+
+Example:
+    >>> from pathlib import Path
+    >>> from bmad_assist.deep_verify.scan import load_trend
+    >>> for point in load_trend(Path("deepverify.db"), repo=Path(".")):
+    ...     print(point.commit_sha[:7], point.health_score, point.delta)
+
+"""
+
+from __future__ import annotations
+
+import sqlite3
+import subprocess
+from contextlib import closing
+from dataclasses import dataclass
+from pathlib import Path
+
+from bmad_assist.deep_verify.core.scoring import SEVERITY_WEIGHTS
+from bmad_assist.deep_verify.scan.types import ScanReport
+
+# Seconds to wait for git before treating the directory as untracked
+_GIT_TIMEOUT = 30
+
+
+def health_score(report: ScanReport) -> float:
+    """Return the composite health score of a report (0-100, higher is healthier)."""
+    penalty = sum(
+        SEVERITY_WEIGHTS[f.severity] * f.confidence for f in report.unsuppressed_findings()
+    )
+    files = max(len(report.files_scanned), 1)
+    return round(100.0 / (1.0 + penalty / files), 2)
+
+
+def _git(repo: Path, *args: str) -> str | None:
+    """Run git in a directory and return its output, or None if git fails."""
+    try:
+        result = subprocess.run(
+            ["git", "-C", str(repo), *args],
+            capture_output=True,
+            text=True,
+            timeout=_GIT_TIMEOUT,
+            check=False,
+        )
+    except (OSError, subprocess.TimeoutExpired):
+        return None
+    return result.stdout if result.returncode == 0 else None
+
+
+def current_commit(repo: Path) -> str | None:
+    """Return the SHA of the commit checked out in a directory.
+
+    Returns:
+        Full commit SHA, or None if the directory is not in a git work tree
+        (or git is not installed).
+
+    """
+    out = _git(repo, "rev-parse", "HEAD")
+    if out is None:
+        return None
+    return out.strip() or None
+
+
+def commit_history(repo: Path) -> list[str] | None:
+    """Return the SHAs reachable from HEAD, oldest first.
+
+    Returns:
+        Commit SHAs, or None if the directory is not in a git work tree.
+
+    """
+    out = _git(repo, "log", "--reverse", "--format=%H")
+    return out.split() if out is not None else None
+
+
+@dataclass(frozen=True, slots=True)
+class TrendPoint:
+    """Health of one commit, from its latest recorded run.
+
+    Attributes:
+        commit_sha: Commit the run scanned.
+        run_id: ID of the run row in the database.
+        started_at: ISO 8601 start time of the run, if recorded.
+        health_score: Composite health score (see health_score).
+        findings: Unsuppressed findings in the run.
+        delta: Change in health score from the previous commit (None for
+            the first point).
+        effort_hours: Estimated hours to fix the run's findings (None for
+            runs written before schema version 3).
+
+    """
+
+    commit_sha: str
+    run_id: int
+    started_at: str | None
+    health_score: float
+    findings: int
+    delta: float | None = None
+    effort_hours: float | None = None
+
+
+def load_trend(db: Path, repo: Path | None = None, limit: int | None = None) -> list[TrendPoint]:
+    """Read the health score of each scanned commit from a SQLite database.
+
+    Runs are keyed by commit SHA; when a commit was scanned more than once
+    its latest run is used. With a repository, points follow the commit
+    order of ``git log`` and commits outside HEAD's history are left out;
+    otherwise (or when git is unavailable) they follow the order the runs
+    were recorded. Runs without a commit SHA or health score (written before
+    schema version 2) are ignored.
+
+    Args:
+        db: Database written by write_sqlite.
+        repo: Repository whose history orders the commits.
+        limit: Keep only the most recent points.
+
+    Returns:
+        Points in commit order, each with its delta from the previous one.
+
+    Raises:
+        FileNotFoundError: If the database does not exist.
+        sqlite3.Error: If the database cannot be read.
+
+    """
+    if not db.is_file():
+        raise FileNotFoundError(f"Database not found: {db}")
+    with closing(sqlite3.connect(db)) as conn:
+        columns = {row[1] for row in conn.execute("PRAGMA table_info(runs)")}
+        if not {"commit_sha", "health_score"} <= columns:
+            return []
+        effort = "effort_hours" if "effort_hours" in columns else "NULL"
+        rows = conn.execute(
+            f"SELECT id, commit_sha, started_at, health_score, findings, {effort} FROM runs "
+            "WHERE commit_sha IS NOT NULL AND health_score IS NOT NULL ORDER BY id"
+        ).fetchall()
+
+    latest: dict[str, tuple[int, str | None, float, int, float | None]] = {}
+    for run_id, sha, started_at, score, findings, effort_hours in rows:
+        latest.pop(sha, None)
+        latest[sha] = (run_id, started_at, score, findings, effort_hours)
+
+    order = list(latest)
+    history = commit_history(repo) if repo is not None else None
+    if history is not None:
+        order = [sha for sha in history if sha in latest]
+    if limit is not None:
+        order = order[-limit:] if limit > 0 else []
+
+    points: list[TrendPoint] = []
+    previous: float | None = None
+    for sha in order:
+        run_id, started_at, score, findings, effort_hours = latest[sha]
+        delta = None if previous is None else round(score - previous, 2)
+        points.append(TrendPoint(sha, run_id, started_at, score, findings, delta, effort_hours))
+        previous = score
+    return points
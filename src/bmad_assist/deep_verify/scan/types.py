@@ -0,0 +1,643 @@
+"""Type definitions for Deep Verify scans.
+
+Scans run the deterministic pattern library over a source tree without any
+LLM calls. Findings carry file locations so they can be exported to CI
+formats.
+
+All dataclasses are frozen; serialization functions are standalone,
+following deep_verify.core.types.
+"""
+
+from __future__ import annotations
+
+import hashlib
+import json
+import re
+from dataclasses import dataclass, field
+from datetime import datetime
+from enum import Enum
+from pathlib import PurePosixPath
+from typing import TYPE_CHECKING, Any
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+    _deserialize_enum,
+    _serialize_enum,
+)
+
+if TYPE_CHECKING:
+    from bmad_assist.deep_verify.scan.config import ScanConfig
+
+# Indentation of JSON reports ("" renders compact single-line JSON)
+DEFAULT_JSON_INDENT = "  "
+
+# Severities from least to most severe
+_SEVERITY_ORDER = (Severity.INFO, Severity.WARNING, Severity.ERROR, Severity.CRITICAL)
+
+# Estimated hours to remediate one finding of each effort rating
+EFFORT_HOURS = {PatternEffort.LOW: 0.5, PatternEffort.MEDIUM: 2.0, PatternEffort.HIGH: 8.0}
+
+# Run label keys: a letter, then letters, digits, "_", "-" or "."
+_LABEL_KEY_RE = re.compile(r"^[A-Za-z][A-Za-z0-9_.-]*$")
+
+# Label keys that SARIF defines in property bags, where labels are written
+RESERVED_LABEL_KEYS = frozenset({"tags"})
+
+# Prefix of label keys reserved for Deep Verify's own properties
+RESERVED_LABEL_PREFIX = "deepverify."
+
+# Styles of ScanReport.notification: plain text or chat markdown (*bold*, `code`)
+NOTIFICATION_STYLES = ("plain", "markdown")
+
+# Files listed by ScanReport.notification, worst first
+NOTIFICATION_MAX_FILES = 5
+
+# Length limit of notifications, below Slack's 3000-character section limit
+NOTIFICATION_MAX_CHARS = 2900
+
+# Headline icon by worst severity, and the count noun of each severity
+_NOTIFICATION_ICONS = {
+    Severity.CRITICAL: "🚨",
+    Severity.ERROR: "🚨",
+    Severity.WARNING: "⚠️",
+    Severity.INFO: "ℹ️",
+}
+_SEVERITY_NOUNS = {
+    Severity.CRITICAL: ("critical", "critical"),
+    Severity.ERROR: ("error", "errors"),
+    Severity.WARNING: ("warning", "warnings"),
+    Severity.INFO: ("info", "info"),
+}
+
+
+class IssueGrouping(str, Enum):
+    """How ScanReport.issues groups findings into tracker issues."""
+
+    PER_FINDING = "per-finding"  # One issue per finding fingerprint
+    PER_FILE = "per-file"  # One issue per file, across patterns
+    PER_RULE = "per-rule"  # One issue per pattern, across files
+    PER_RULE_PER_FILE = "per-rule-per-file"  # One issue per pattern and file
+
+
+@dataclass(frozen=True, slots=True)
+class ScanFinding:
+    """A pattern match located in a scanned file.
+
+    Attributes:
+        pattern_id: Matched pattern ID (e.g., "CC-001-CODE-GO").
+        severity: Effective severity (after config overrides).
+        title: Brief finding title.
+        description: Pattern description.
+        path: File path relative to the scan root (POSIX separators).
+        line: 1-based line number of the finding.
+        snippet: Stripped source line at ``line``.
+        confidence: Match confidence 0.0-1.0.
+        domain: Domain of the matched pattern.
+        language: Language of the scanned file.
+        remediation: Optional remediation guidance.
+        effort: The matched pattern's remediation effort rating (None for
+            unrated patterns and imported findings).
+        suppressed: Whether a ``deepverify:ignore`` comment covers the
+            finding. Suppressed findings are reported only with
+            ``ScanOptions.show_suppressed`` and never fail a scan.
+        suppression_reason: The covering suppression's ``reason`` field.
+        tool: Scanner that reported the finding when it was imported from
+            another tool's SARIF output (None for Deep Verify's own).
+
+    """
+
+    pattern_id: PatternId
+    severity: Severity
+    title: str
+    description: str
+    path: str
+    line: int
+    snippet: str
+    confidence: float
+    domain: ArtifactDomain
+    language: str
+    remediation: str | None = None
+    effort: PatternEffort | None = None
+    suppressed: bool = False
+    suppression_reason: str | None = None
+    tool: str | None = None
+
+    def __repr__(self) -> str:
+        """Return a string representation of the finding."""
+        suppressed = ", suppressed" if self.suppressed else ""
+        return (
+            f"ScanFinding(pattern_id={self.pattern_id!r}, severity={self.severity.value!r}, "
+            f"location={self.path}:{self.line}{suppressed})"
+        )
+
+
+@dataclass(frozen=True, slots=True)
+class ScanReport:
+    """Result of scanning a source tree.
+
+    Attributes:
+        root: Scan root as given by the caller.
+        findings: Findings sorted by path, line, and pattern ID.
+        files_scanned: Relative paths of files that were analyzed.
+        skipped_large_files: Relative paths of files skipped for exceeding
+            the size limit.
+        started_at: When the scan started, per the scan clock.
+        duration_ms: Scan duration in milliseconds (0 in reproducible mode).
+        file_packages: Package of each analyzed file: the Go import path for
+            Go files in a module, otherwise the file's directory.
+        detector_warnings: Detectors that could not run fully, such as
+            "CC-108-CODE-GO skipped for a.go: regex timed out after 5.0s".
+            Their findings may be missing from the report.
+        ratcheted_domains: Domains whose ``ScanOptions.ratchet_dates`` date
+            had not arrived when the scan started. Their findings are
+            reported but do not fail the scan (see ``fatal_findings``).
+        issue_grouping: How ``issues`` groups findings, from
+            ``ScanOptions.issue_grouping``.
+        labels: Caller metadata of the run, such as tenant, repository and
+            commit, from ``ScanOptions.labels``.
+        dropped_findings: Findings left out per severity by
+            ``cap_report``; empty when nothing was dropped.
+
+    """
+
+    root: str
+    findings: list[ScanFinding] = field(default_factory=list)
+    files_scanned: list[str] = field(default_factory=list)
+    skipped_large_files: list[str] = field(default_factory=list)
+    started_at: datetime | None = None
+    duration_ms: int = 0
+    file_packages: dict[str, str] = field(default_factory=dict)
+    detector_warnings: list[str] = field(default_factory=list)
+    ratcheted_domains: list[ArtifactDomain] = field(default_factory=list)
+    issue_grouping: IssueGrouping = IssueGrouping.PER_FINDING
+    labels: dict[str, str] = field(default_factory=dict)
+    dropped_findings: dict[Severity, int] = field(default_factory=dict)
+
+    def __repr__(self) -> str:
+        """Return a string representation of the report."""
+        return (
+            f"ScanReport(root={self.root!r}, files={len(self.files_scanned)}, "
+            f"findings={len(self.findings)})"
+        )
+
+    def unsuppressed_findings(self) -> list[ScanFinding]:
+        """Return findings not covered by a suppression, in report order."""
+        return [f for f in self.findings if not f.suppressed]
+
+    def fatal_findings(self) -> list[ScanFinding]:
+        """Return findings that can fail the scan, in report order.
+
+        These are the unsuppressed findings outside ``ratcheted_domains``;
+        callers still apply their own severity gate (``--fail-on``).
+        """
+        return [
+            f
+            for f in self.findings
+            if not f.suppressed and f.domain not in self.ratcheted_domains
+        ]
+
+    def severity_counts(self) -> dict[Severity, int]:
+        """Count unsuppressed findings per severity (zero counts omitted)."""
+        return _severity_counts(self.findings)
+
+    def effort_counts(self) -> dict[PatternEffort, int]:
+        """Count unsuppressed findings per effort rating (unrated and zero counts omitted)."""
+        counts: dict[PatternEffort, int] = {}
+        for finding in self.unsuppressed_findings():
+            if finding.effort is not None:
+                counts[finding.effort] = counts.get(finding.effort, 0) + 1
+        return counts
+
+    def estimated_effort(self) -> float:
+        """Return the estimated hours to remediate the unsuppressed findings.
+
+        Each rated finding counts its ``EFFORT_HOURS``; unrated findings
+        count nothing.
+        """
+        return sum(EFFORT_HOURS[effort] * n for effort, n in self.effort_counts().items())
+
+    def fingerprints(self) -> list[str]:
+        """Return the distinct fingerprints of the report's findings, sorted.
+
+        External finding databases can reconcile a run against this set:
+        open findings whose fingerprint is absent have been resolved.
+        """
+        return sorted({finding_fingerprint(f) for f in self.findings})
+
+    def issues(self) -> list[ScanIssue]:
+        """Group unsuppressed findings into tracker issues per ``issue_grouping``.
+
+        Each issue has a stable fingerprint, so a tracker integration can
+        open, update and close one ticket per issue across runs. Per-finding
+        issues use the finding fingerprint (findings sharing one form a
+        single issue); grouped issues hash the grouping and its key.
+
+        Returns:
+            Issues in the report order of their first finding.
+
+        """
+        grouping = self.issue_grouping
+        issues: dict[str, ScanIssue] = {}
+        for finding in self.unsuppressed_findings():
+            pattern_id = None if grouping == IssueGrouping.PER_FILE else finding.pattern_id
+            path = None if grouping == IssueGrouping.PER_RULE else finding.path
+            if grouping == IssueGrouping.PER_FINDING:
+                fingerprint = finding_fingerprint(finding)
+            else:
+                key = "\0".join((grouping.value, pattern_id or "", path or ""))
+                fingerprint = hashlib.sha256(key.encode("utf-8")).hexdigest()
+            issue = issues.setdefault(
+                fingerprint,
+                ScanIssue(fingerprint=fingerprint, pattern_id=pattern_id, path=path),
+            )
+            issue.findings.append(finding)
+        return list(issues.values())
+
+    def notification(self, style: str = "plain", max_files: int = NOTIFICATION_MAX_FILES) -> str:
+        """Summarize the report as a short chat message for CI notifications.
+
+        The headline counts unsuppressed findings per severity, such as
+        "🚨 3 critical, 12 warnings in 450 files". The files with the worst
+        and most findings follow, then how many other files have findings;
+        file lines are dropped from the end to keep the message within
+        ``NOTIFICATION_MAX_CHARS``.
+
+        Args:
+            style: One of ``NOTIFICATION_STYLES``; "markdown" bolds the
+                headline and quotes paths for Slack and Teams.
+            max_files: Most files to list.
+
+        Returns:
+            Message without a trailing newline.
+
+        Raises:
+            ValueError: If style is not a known notification style.
+
+        """
+        if style not in NOTIFICATION_STYLES:
+            valid = ", ".join(NOTIFICATION_STYLES)
+            raise ValueError(f"Unknown notification style: {style!r}. Use one of: {valid}")
+        markdown = style == "markdown"
+        files = len(self.files_scanned)
+        scope = f"in {files} file{'' if files == 1 else 's'}"
+        counts = self.severity_counts()
+        if not counts:
+            headline = f"✅ No findings {scope}"
+            return f"*{headline}*" if markdown else headline
+        parts: list[str] = []
+        for severity in reversed(_SEVERITY_ORDER):
+            if severity in counts:
+                singular, plural = _SEVERITY_NOUNS[severity]
+                parts.append(f"{counts[severity]} {singular if counts[severity] == 1 else plural}")
+        worst = max(counts, key=_SEVERITY_ORDER.index)
+        headline = f"{_NOTIFICATION_ICONS[worst]} {', '.join(parts)} {scope}"
+        if markdown:
+            headline = f"*{headline}*"
+
+        per_file: dict[str, list[ScanFinding]] = {}
+        for finding in self.unsuppressed_findings():
+            per_file.setdefault(finding.path, []).append(finding)
+        ranked = sorted(
+            per_file.items(),
+            key=lambda item: (
+                -max(_SEVERITY_ORDER.index(f.severity) for f in item[1]),
+                -len(item[1]),
+                item[0],
+            ),
+        )
+        file_lines: list[str] = []
+        for path, findings in ranked[:max_files]:
+            shown = f"`{path}`" if markdown else path
+            noun = "finding" if len(findings) == 1 else "findings"
+            file_lines.append(f"• {shown}: {len(findings)} {noun}")
+        while True:
+            message = "\n".join([headline, *file_lines])
+            hidden = len(ranked) - len(file_lines)
+            if hidden:
+                message += f"\n… and {hidden} more file{'' if hidden == 1 else 's'}"
+            if len(message) <= NOTIFICATION_MAX_CHARS or not file_lines:
+                return message[:NOTIFICATION_MAX_CHARS]
+            file_lines.pop()
+
+    def package_of(self, rel_path: str) -> str:
+        """Return the package of a file, defaulting to its directory."""
+        return self.file_packages.get(rel_path) or str(PurePosixPath(rel_path).parent)
+
+    def by_package(self) -> dict[str, PackageReport]:
+        """Group files and findings by package.
+
+        Returns:
+            Package reports keyed and ordered by package name.
+
+        """
+        files: dict[str, list[str]] = {}
+        for rel_path in self.files_scanned:
+            files.setdefault(self.package_of(rel_path), []).append(rel_path)
+        findings: dict[str, list[ScanFinding]] = {}
+        for finding in self.findings:
+            findings.setdefault(self.package_of(finding.path), []).append(finding)
+
+        return {
+            package: PackageReport(
+                package=package,
+                files=files.get(package, []),
+                findings=findings.get(package, []),
+            )
+            for package in sorted(files.keys() | findings.keys())
+        }
+
+
+@dataclass(frozen=True, slots=True)
+class PackageReport:
+    """Findings and files of one package in a scan.
+
+    Attributes:
+        package: Go import path, or directory for other languages.
+        files: Relative paths of the package's analyzed files.
+        findings: The package's findings, in report order.
+
+    """
+
+    package: str
+    files: list[str] = field(default_factory=list)
+    findings: list[ScanFinding] = field(default_factory=list)
+
+    def __repr__(self) -> str:
+        """Return a string representation of the package report."""
+        return (
+            f"PackageReport(package={self.package!r}, files={len(self.files)}, "
+            f"findings={len(self.findings)})"
+        )
+
+    def severity_counts(self) -> dict[Severity, int]:
+        """Count unsuppressed findings per severity (zero counts omitted)."""
+        return _severity_counts(self.findings)
+
+
+@dataclass(frozen=True, slots=True)
+class ScanIssue:
+    """Findings reported together as one tracker issue (see ScanReport.issues).
+
+    Attributes:
+        fingerprint: Stable issue fingerprint, a hex-encoded SHA-256 digest.
+        pattern_id: Pattern of the member findings; None for per-file issues.
+        path: File of the member findings; None for per-rule issues.
+        findings: Member findings, in report order.
+
+    """
+
+    fingerprint: str
+    pattern_id: PatternId | None = None
+    path: str | None = None
+    findings: list[ScanFinding] = field(default_factory=list)
+
+    def __repr__(self) -> str:
+        """Return a string representation of the issue."""
+        return (
+            f"ScanIssue(pattern_id={self.pattern_id!r}, path={self.path!r}, "
+            f"findings={len(self.findings)})"
+        )
+
+    @property
+    def severity(self) -> Severity:
+        """Return the highest severity among the member findings."""
+        return max((f.severity for f in self.findings), key=_SEVERITY_ORDER.index)
+
+
+def _severity_counts(findings: list[ScanFinding]) -> dict[Severity, int]:
+    """Count unsuppressed findings per severity (zero counts omitted)."""
+    counts: dict[Severity, int] = {}
+    for finding in findings:
+        if finding.suppressed:
+            continue
+        counts[finding.severity] = counts.get(finding.severity, 0) + 1
+    return counts
+
+
+def finding_fingerprint(finding: ScanFinding) -> str:
+    """Compute a stable fingerprint for a finding.
+
+    The fingerprint hashes the file path, pattern ID and whitespace-normalized
+    snippet. It deliberately excludes the line number, so a finding keeps its
+    fingerprint when unrelated edits shift it up or down the file.
+
+    Args:
+        finding: Finding to fingerprint.
+
+    Returns:
+        Hex-encoded SHA-256 digest.
+
+    """
+    snippet = " ".join(finding.snippet.split())
+    key = "\0".join((finding.path, finding.pattern_id, snippet))
+    return hashlib.sha256(key.encode("utf-8")).hexdigest()
+
+
+def builtin_finding(
+    pattern: Pattern,
+    title: str,
+    rel_path: str,
+    line: int,
+    snippet: str,
+    config: ScanConfig,
+    confidence: float = 1.0,
+    remediation: str | None = None,
+) -> ScanFinding:
+    """Build a finding of a check implemented in code (see BUILTIN_PATTERNS).
+
+    Args:
+        pattern: Pattern of the check.
+        title: Finding title.
+        rel_path: Path relative to the scan root.
+        line: 1-based line of the finding.
+        snippet: Source line; surrounding whitespace is stripped.
+        config: Effective config for the file, for severity overrides.
+        confidence: How likely the finding is a real issue; scans drop
+            findings below their threshold.
+        remediation: Finding-specific remediation; None uses the pattern's.
+
+    """
+    return ScanFinding(
+        pattern_id=pattern.id,
+        severity=config.severity_for(pattern),
+        title=title,
+        description=pattern.description or "",
+        path=rel_path,
+        line=line,
+        snippet=snippet.strip(),
+        confidence=confidence,
+        domain=pattern.domain,
+        language=pattern.language,
+        remediation=remediation or pattern.remediation,
+    )
+
+
+def serialize_scan_finding(finding: ScanFinding) -> dict[str, Any]:
+    """Serialize ScanFinding to a dictionary.
+
+    The tool is included only for imported findings and suppression
+    fields only for suppressed findings.
+    """
+    data: dict[str, Any] = {
+        "fingerprint": finding_fingerprint(finding),
+        "pattern_id": finding.pattern_id,
+        "severity": _serialize_enum(finding.severity),
+        "title": finding.title,
+        "description": finding.description,
+        "path": finding.path,
+        "line": finding.line,
+        "snippet": finding.snippet,
+        "confidence": finding.confidence,
+        "domain": _serialize_enum(finding.domain),
+        "language": finding.language,
+        "remediation": finding.remediation,
+        "effort": _serialize_enum(finding.effort) if finding.effort else None,
+    }
+    if finding.tool is not None:
+        data["tool"] = finding.tool
+    if finding.suppressed:
+        data["suppressed"] = True
+        data["suppression_reason"] = finding.suppression_reason
+    return data
+
+
+def deserialize_scan_finding(data: dict[str, Any]) -> ScanFinding:
+    """Deserialize a dictionary to ScanFinding."""
+    return ScanFinding(
+        pattern_id=PatternId(data["pattern_id"]),
+        severity=_deserialize_enum(data["severity"], Severity),
+        title=data["title"],
+        description=data["description"],
+        path=data["path"],
+        line=data["line"],
+        snippet=data.get("snippet", ""),
+        confidence=data["confidence"],
+        domain=_deserialize_enum(data["domain"], ArtifactDomain),
+        language=data["language"],
+        remediation=data.get("remediation"),
+        effort=(
+            _deserialize_enum(data["effort"], PatternEffort) if data.get("effort") else None
+        ),
+        suppressed=data.get("suppressed", False),
+        suppression_reason=data.get("suppression_reason"),
+        tool=data.get("tool"),
+    )
+
+
+def serialize_scan_report(report: ScanReport) -> dict[str, Any]:
+    """Serialize ScanReport to a dictionary for JSON output.
+
+    Map-typed fields are sorted by key so that equal reports serialize
+    identically regardless of scan order. ``ratcheted_domains``,
+    ``labels`` and ``dropped_findings`` are included only when set, and
+    ``issue_grouping`` only when not per-finding.
+    """
+    data: dict[str, Any] = {
+        "root": report.root,
+        "started_at": report.started_at.isoformat() if report.started_at else None,
+        "duration_ms": report.duration_ms,
+        "files_scanned": report.files_scanned,
+        "skipped_large_files": report.skipped_large_files,
+        "file_packages": dict(sorted(report.file_packages.items())),
+        "detector_warnings": report.detector_warnings,
+    }
+    if report.ratcheted_domains:
+        data["ratcheted_domains"] = [_serialize_enum(d) for d in report.ratcheted_domains]
+    if report.issue_grouping != IssueGrouping.PER_FINDING:
+        data["issue_grouping"] = _serialize_enum(report.issue_grouping)
+    if report.labels:
+        data["labels"] = dict(sorted(report.labels.items()))
+    if report.dropped_findings:
+        data["dropped_findings"] = {
+            _serialize_enum(s): n
+            for s, n in sorted(report.dropped_findings.items(), key=lambda item: item[0].value)
+        }
+    data["findings"] = [serialize_scan_finding(f) for f in report.findings]
+    return data
+
+
+def scan_report_json(report: ScanReport, indent: str = DEFAULT_JSON_INDENT) -> str:
+    """Render a ScanReport as canonical JSON.
+
+    Field order is fixed and documented, so reports diff cleanly in review
+    and can be compared byte for byte in golden tests:
+
+    - report: ``root``, ``started_at``, ``duration_ms``, ``files_scanned``,
+      ``skipped_large_files``, ``file_packages`` (sorted by path),
+      ``detector_warnings``, then ``ratcheted_domains`` when set,
+      ``issue_grouping`` when not per-finding, ``labels`` (sorted by key)
+      when set, ``dropped_findings`` (sorted by key) when set,
+      ``findings``
+    - finding: ``fingerprint``, ``pattern_id``, ``severity``, ``title``,
+      ``description``, ``path``, ``line``, ``snippet``, ``confidence``,
+      ``domain``, ``language``, ``remediation``, ``effort``, then ``tool``
+      for findings imported from another scanner and ``suppressed`` and
+      ``suppression_reason`` for suppressed findings
+
+    Combine with a fixed ``ScanOptions.clock`` (or ``reproducible``) for
+    byte-identical output across runs.
+
+    Args:
+        report: Report to render.
+        indent: Indentation per nesting level; "" renders compact
+            single-line JSON without whitespace.
+
+    Returns:
+        JSON text without a trailing newline.
+
+    """
+    data = serialize_scan_report(report)
+    if not indent:
+        return json.dumps(data, separators=(",", ":"))
+    return json.dumps(data, indent=indent)
+
+
+def deserialize_scan_report(data: dict[str, Any]) -> ScanReport:
+    """Deserialize a dictionary to ScanReport."""
+    started_at = data.get("started_at")
+    return ScanReport(
+        root=data["root"],
+        findings=[deserialize_scan_finding(f) for f in data.get("findings", [])],
+        files_scanned=data.get("files_scanned", []),
+        skipped_large_files=data.get("skipped_large_files", []),
+        started_at=datetime.fromisoformat(started_at) if started_at else None,
+        duration_ms=data.get("duration_ms", 0),
+        file_packages=data.get("file_packages", {}),
+        detector_warnings=data.get("detector_warnings", []),
+        ratcheted_domains=[
+            _deserialize_enum(d, ArtifactDomain) for d in data.get("ratcheted_domains", [])
+        ],
+        issue_grouping=_deserialize_enum(
+            data.get("issue_grouping", IssueGrouping.PER_FINDING.value), IssueGrouping
+        ),
+        labels=data.get("labels", {}),
+        dropped_findings={
+            _deserialize_enum(s, Severity): n for s, n in data.get("dropped_findings", {}).items()
+        },
+    )
+
+
+def validate_labels(labels: dict[str, str]) -> None:
+    """Check run labels before they are attached to a report.
+
+    Keys start with a letter followed by letters, digits, "_", "-" or ".",
+    and must not be a key SARIF reserves (``RESERVED_LABEL_KEYS``) or start
+    with ``RESERVED_LABEL_PREFIX``. Values must be strings.
+
+    Raises:
+        ValueError: If a key or value is invalid.
+
+    """
+    for key, value in labels.items():
+        if not isinstance(key, str) or not _LABEL_KEY_RE.match(key):
+            raise ValueError(
+                f"Invalid label key {key!r}: use a letter followed by letters, digits, _, - or ."
+            )
+        if key.lower() in RESERVED_LABEL_KEYS or key.lower().startswith(RESERVED_LABEL_PREFIX):
+            raise ValueError(f"Label key {key!r} is reserved")
+        if not isinstance(value, str):
+            raise ValueError(f"Label {key!r} must be a string, got {type(value).__name__}")
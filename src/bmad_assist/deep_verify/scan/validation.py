@@ -0,0 +1,232 @@
+"""Validation of ``.deepverify.yaml`` files without running a scan.
+
+Scans load a config leniently: unknown keys are ignored and selectors that
+match no pattern simply select nothing, so a typo silently disables a rule.
+``validate_scan_config`` reports such mistakes with their line numbers::
+
+    for issue in validate_scan_config(Path(".deepverify.yaml")):
+        print(issue)  # .deepverify.yaml:3: disable: unknown selector 'CC-9999'
+
+Reported are YAML syntax errors, unknown keys, values of the wrong type,
+selectors that match no pattern of the library or the built-in checks,
+invalid severity names and suppression fields, ``detectors`` entries that
+name no configurable detector or whose options do not validate, and
+contradictions: an
+``enable`` or ``opt_in`` entry whose patterns are all disabled, or an
+``opt_in`` entry that selects no opt-in pattern.
+
+"""
+
+from __future__ import annotations
+
+import difflib
+from dataclasses import dataclass
+from pathlib import Path
+from typing import get_args
+
+import yaml
+from pydantic import ValidationError
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.patterns.library import PatternLibrary
+from bmad_assist.deep_verify.scan.config import (
+    DETECTOR_OPTIONS,
+    ScanConfig,
+    SuppressionField,
+    detector_for,
+    matches_selector,
+)
+from bmad_assist.deep_verify.scan.scanner import known_patterns
+
+# Keys holding lists of pattern selectors
+_SELECTOR_KEYS = ("enable", "disable", "opt_in")
+
+# Values accepted by suppression_fields
+_SUPPRESSION_FIELDS: tuple[str, ...] = get_args(SuppressionField)
+
+
+@dataclass(frozen=True, slots=True)
+class ConfigIssue:
+    """A problem found in a scan config file.
+
+    Attributes:
+        path: Config file path, as given.
+        line: 1-based line of the offending entry, or None if the file
+            could not be read.
+        key: Config key the issue is about, such as ``disable`` or
+            ``severity.CC-001``; empty for file-level issues.
+        message: What is wrong and how to fix it.
+
+    """
+
+    path: str
+    line: int | None
+    key: str
+    message: str
+
+    def __str__(self) -> str:
+        """Return the issue as ``path:line: key: message``."""
+        location = self.path if self.line is None else f"{self.path}:{self.line}"
+        if not self.key:
+            return f"{location}: {self.message}"
+        return f"{location}: {self.key}: {self.message}"
+
+
+class _Validator:
+    """Collects the issues of one config file."""
+
+    def __init__(self, path: Path, library: PatternLibrary | None) -> None:
+        self._path = str(path)
+        self._patterns = {p.id: p for p in known_patterns(library)}
+        self.issues: list[ConfigIssue] = []
+
+    def report(self, node: yaml.Node | None, key: str, message: str) -> None:
+        """Record an issue at a node's line."""
+        line = node.start_mark.line + 1 if node is not None else None
+        self.issues.append(ConfigIssue(self._path, line, key, message))
+
+    def selected(self, selector: str) -> list[str]:
+        """Return the IDs of the known patterns a selector covers."""
+        return [pid for pid in self._patterns if matches_selector(pid, selector)]
+
+    def validate(self, root: yaml.Node) -> None:
+        """Check a composed config document."""
+        if not isinstance(root, yaml.MappingNode):
+            self.report(root, "", "config must be a mapping of keys to values")
+            return
+        selectors: dict[str, list[yaml.ScalarNode]] = {}
+        for key_node, value_node in root.value:
+            key = str(key_node.value)
+            if key not in ScanConfig.model_fields:
+                hint = difflib.get_close_matches(key, list(ScanConfig.model_fields), n=1)
+                suffix = f"; did you mean '{hint[0]}'?" if hint else ""
+                self.report(key_node, key, f"unknown key{suffix}")
+            elif key in _SELECTOR_KEYS:
+                selectors[key] = self._strings(key, value_node)
+            elif key == "severity":
+                self._severity(value_node)
+            elif key == "detectors":
+                self._detectors(value_node)
+            elif key == "suppression_fields":
+                for item in self._strings(key, value_node):
+                    if item.value not in _SUPPRESSION_FIELDS:
+                        valid = ", ".join(_SUPPRESSION_FIELDS)
+                        self.report(item, key, f"unknown field '{item.value}'; use {valid}")
+            else:
+                self._strings(key, value_node)
+        for key in _SELECTOR_KEYS:
+            for item in selectors.get(key, []):
+                if not self.selected(item.value):
+                    self._unknown_selector(item, key)
+        self._contradictions(selectors)
+
+    def _strings(self, key: str, node: yaml.Node) -> list[yaml.ScalarNode]:
+        """Return the items of a list of strings, reporting other values."""
+        if isinstance(node, yaml.ScalarNode) and node.tag == "tag:yaml.org,2002:null":
+            return []
+        if not isinstance(node, yaml.SequenceNode):
+            self.report(node, key, "must be a list of strings")
+            return []
+        items: list[yaml.ScalarNode] = []
+        for item in node.value:
+            if isinstance(item, yaml.ScalarNode) and item.tag == "tag:yaml.org,2002:str":
+                items.append(item)
+            else:
+                self.report(item, key, "list entries must be strings")
+        return items
+
+    def _severity(self, node: yaml.Node) -> None:
+        """Check the severity overrides mapping."""
+        if not isinstance(node, yaml.MappingNode):
+            self.report(node, "severity", "must be a mapping of selectors to severities")
+            return
+        valid = [s.value for s in Severity]
+        for key_node, value_node in node.value:
+            selector = str(key_node.value)
+            key = f"severity.{selector}"
+            if not self.selected(selector):
+                self._unknown_selector(key_node, key)
+            if not isinstance(value_node, yaml.ScalarNode) or value_node.value not in valid:
+                shown = value_node.value if isinstance(value_node, yaml.ScalarNode) else "?"
+                self.report(
+                    value_node, key, f"invalid severity '{shown}'; use {', '.join(valid)}"
+                )
+
+    def _detectors(self, node: yaml.Node) -> None:
+        """Check each detector's options against its registered options model."""
+        if not isinstance(node, yaml.MappingNode):
+            self.report(node, "detectors", "must be a mapping of detectors to options")
+            return
+        for key_node, value_node in node.value:
+            selector = str(key_node.value)
+            key = f"detectors.{selector}"
+            pattern_id = detector_for(selector)
+            if pattern_id is None:
+                configurable = ", ".join(sorted(DETECTOR_OPTIONS))
+                self.report(key_node, key, f"not a configurable detector; use {configurable}")
+                continue
+            try:
+                DETECTOR_OPTIONS[pattern_id].model_validate(
+                    yaml.safe_load(yaml.serialize(value_node)) or {}
+                )
+            except ValidationError as e:
+                for error in e.errors():
+                    field = ".".join(str(part) for part in error["loc"])
+                    self.report(value_node, f"{key}.{field}" if field else key, error["msg"])
+
+    def _unknown_selector(self, node: yaml.ScalarNode, key: str) -> None:
+        """Report a selector that matches no known pattern."""
+        hint = difflib.get_close_matches(node.value.upper(), list(self._patterns), n=1)
+        suffix = f"; did you mean '{hint[0]}'?" if hint else ""
+        self.report(node, key, f"unknown selector '{node.value}' matches no pattern{suffix}")
+
+    def _contradictions(self, selectors: dict[str, list[yaml.ScalarNode]]) -> None:
+        """Report enable and opt_in entries that cannot take effect."""
+        disabled = {
+            pid for item in selectors.get("disable", []) for pid in self.selected(item.value)
+        }
+        for key in ("enable", "opt_in"):
+            for item in selectors.get(key, []):
+                ids = self.selected(item.value)
+                if ids and all(pid in disabled for pid in ids):
+                    self.report(item, key, f"'{item.value}' is also disabled by 'disable'")
+        for item in selectors.get("opt_in", []):
+            ids = self.selected(item.value)
+            if ids and not any(self._patterns[pid].opt_in for pid in ids):
+                self.report(
+                    item,
+                    "opt_in",
+                    f"'{item.value}' selects no opt-in pattern; list it under 'enable'",
+                )
+
+
+def validate_scan_config(
+    path: Path, library: PatternLibrary | None = None
+) -> list[ConfigIssue]:
+    """Check a ``.deepverify.yaml`` file without scanning.
+
+    Args:
+        path: Config file to check.
+        library: Pattern library selectors are checked against; None uses
+            the default library. Built-in checks are always known.
+
+    Returns:
+        Issues in file order; empty for a valid config.
+
+    """
+    try:
+        text = path.read_text(encoding="utf-8")
+    except OSError as e:
+        return [ConfigIssue(str(path), None, "", f"cannot read file: {e}")]
+    try:
+        root = yaml.compose(text, Loader=yaml.SafeLoader)
+    except yaml.YAMLError as e:
+        mark = getattr(e, "problem_mark", None)
+        line = mark.line + 1 if mark is not None else None
+        problem = getattr(e, "problem", None) or str(e)
+        return [ConfigIssue(str(path), line, "", f"invalid YAML: {problem}")]
+    if root is None:
+        return []
+    validator = _Validator(path, library)
+    validator.validate(root)
+    return sorted(validator.issues, key=lambda issue: issue.line or 0)
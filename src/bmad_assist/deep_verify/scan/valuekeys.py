@@ -0,0 +1,241 @@
+"""Detection of context.WithValue chains that set the same key twice in Go.
+
+A context answers ``Value(key)`` from the innermost ``WithValue`` holding
+the key, so storing a second value under a key already in the chain
+silently shadows the first, typically a copy-paste slip in middleware::
+
+    ctx := context.WithValue(r.Context(), userKey, user)
+    ctx = context.WithValue(ctx, userKey, tenant) // CC-153: meant tenantKey
+
+Within a function, a ``WithValue`` call (resolved through the file's
+import aliases) is reported when its parent context is the variable the
+result of an earlier call with the same key was assigned to, or is that
+earlier call itself (``WithValue(WithValue(ctx, k, a), k, b)``), and the
+earlier call's block contains it, so assignments in the branches of an
+``if``/``else`` are not reported.
+
+Keys are compared by expression, except that typed constants declared in
+the file are compared by type and value: with ``const a ctxKey = "id"``
+and ``const b ctxKey = "id"``, ``a`` and ``b`` are the same key.
+
+"""
+
+from __future__ import annotations
+
+import re
+from dataclasses import dataclass
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for WithValue calls shadowing a key set earlier in the chain
+DUPLICATE_CONTEXT_KEY_PATTERN = Pattern(
+    id=PatternId("CC-153-CODE-GO"),
+    domain=ArtifactDomain.API,
+    signals=[],
+    severity=Severity.INFO,
+    description="WithValue sets a key already set in the chain - the first value is shadowed",
+    remediation="Use a distinct key for each value, or set the key once",
+    language="go",
+    effort=PatternEffort.LOW,
+)
+
+# Confidence of CC-153 findings: the same key expression is the same key unless
+# it names a variable reassigned in between, which is rare and not tracked
+DUPLICATE_CONTEXT_KEY_CONFIDENCE = 0.8
+
+# Typed constants with a literal value: `userKey ctxKey = "user"`,
+# `userKey = ctxKey("user")`, with or without the `const` keyword
+_TYPED_CONST_RE = re.compile(
+    r"^[ \t]*(?:const[ \t]+)?([A-Za-z_]\w*)[ \t]+([A-Za-z_][\w.]*)[ \t]*=[ \t]*"
+    r"(\"(?:\\.|[^\"\\])*\"|`[^`]*`|-?\d\w*)[ \t]*$"
+)
+_CONVERTED_CONST_RE = re.compile(
+    r"^[ \t]*(?:const[ \t]+)?([A-Za-z_]\w*)[ \t]*=[ \t]*([A-Za-z_][\w.]*)\([ \t]*"
+    r"(\"(?:\\.|[^\"\\])*\"|`[^`]*`|-?\d\w*)[ \t]*\)[ \t]*$"
+)
+_CONST_BLOCK_RE = re.compile(r"^[ \t]*const[ \t]*\([ \t]*$")
+
+# Assignment target ending a line before a call: `ctx :=`, `ctx =`
+_TARGET_RE = re.compile(r"(?<![\w.])([A-Za-z_]\w*)[ \t]*:?=[ \t]*$")
+
+# String and rune literals; their punctuation is masked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+@dataclass(frozen=True, slots=True)
+class _Call:
+    """A WithValue call: its span, arguments and the variable it is assigned to."""
+
+    start: int
+    end: int
+    parent_end: int
+    parent: str
+    key: str
+    identity: str
+    target: str | None
+    block_end: int
+
+
+def _mask(literal: re.Match[str]) -> str:
+    """Mask a literal's punctuation, keeping its letters so literal keys stay distinct."""
+    text = literal.group(0)
+    return text[0] + re.sub(r"[^\w ]", "_", text[1:-1]) + text[-1]
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals masked and line comments removed."""
+    return [_LITERAL_RE.sub(_mask, line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _call_args(code: str, open_paren: int) -> tuple[list[str], int]:
+    """Return the top-level arguments of the call at open_paren and its closing position."""
+    args: list[str] = []
+    depth = 0
+    start = open_paren + 1
+    for position in range(open_paren, len(code)):
+        char = code[position]
+        if char in "([{":
+            depth += 1
+        elif char in ")]}":
+            depth -= 1
+            if depth == 0:
+                args.append(code[start:position])
+                return args, position
+        elif char == "," and depth == 1:
+            args.append(code[start:position])
+            start = position + 1
+    return [], len(code)  # unbalanced call
+
+
+def _enclosing_block_end(code: str, position: int) -> int:
+    """Return the position of the brace closing the innermost block around position."""
+    depth = 0
+    for open_brace in range(position - 1, -1, -1):
+        if code[open_brace] == "}":
+            depth += 1
+        elif code[open_brace] == "{":
+            if depth == 0:
+                break
+            depth -= 1
+    else:
+        return len(code)
+    depth = 0
+    for close in range(open_brace, len(code)):
+        if code[close] == "{":
+            depth += 1
+        elif code[close] == "}":
+            depth -= 1
+            if depth == 0:
+                return close
+    return len(code)
+
+
+def _constant_keys(source_lines: list[str]) -> dict[str, str]:
+    """Return the identity (type and value) of each typed constant with a literal value."""
+    identities: dict[str, str] = {}
+    in_block = False
+    for line in source_lines:
+        if _CONST_BLOCK_RE.match(line):
+            in_block = True
+            continue
+        if in_block and line.strip().startswith(")"):
+            in_block = False
+            continue
+        if not in_block and not line.lstrip().startswith("const"):
+            continue
+        code = re.sub(r"[ \t]*//[^\"`]*$", "", line)
+        constant = _TYPED_CONST_RE.match(code) or _CONVERTED_CONST_RE.match(code)
+        if constant is not None:
+            name, type_name, value = constant.groups()
+            identities[name] = f"{type_name}({value})"
+    return identities
+
+
+def find_duplicate_context_keys(
+    text: str, rel_path: str, config: ScanConfig
+) -> list[ScanFinding]:
+    """Report context.WithValue calls that shadow a key set earlier in the chain.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file.
+
+    Returns:
+        CC-153 findings in line order, one per shadowing call.
+
+    """
+    if not config.is_enabled(DUPLICATE_CONTEXT_KEY_PATTERN.id):
+        return []
+    aliases = [a for a, path in parse_go_imports(text).items() if path == "context"]
+    if not aliases:
+        return []
+    call_re = re.compile(
+        "|".join(
+            r"(?<![\w.])WithValue[ \t]*\(" if a == "." else re.escape(a) + r"\.WithValue[ \t]*\("
+            for a in aliases
+        )
+    )
+    source_lines = text.split("\n")
+    constants = _constant_keys(source_lines)
+    code = "\n".join(_code_lines(text))
+
+    calls: list[_Call] = []
+    for match in call_re.finditer(code):
+        args, close = _call_args(code, match.end() - 1)
+        if len(args) != 3:
+            continue
+        key = re.sub(r"\s+", "", args[1])
+        line_start = code.rfind("\n", 0, match.start()) + 1
+        target = _TARGET_RE.search(code, line_start, match.start())
+        calls.append(
+            _Call(
+                start=match.start(),
+                end=close,
+                parent_end=match.end() + len(args[0]),
+                parent=args[0].strip(),
+                key=key,
+                identity=constants.get(key, key),
+                target=target.group(1) if target else None,
+                block_end=_enclosing_block_end(code, match.start()),
+            )
+        )
+
+    findings: list[ScanFinding] = []
+    for call in calls:
+        for earlier in calls:
+            if earlier is call or earlier.identity != call.identity:
+                continue
+            wraps = call.start < earlier.start and earlier.end < call.parent_end
+            chains = (
+                earlier.end < call.start < earlier.block_end
+                and earlier.target is not None
+                and call.parent == earlier.target
+            )
+            if not (wraps or chains):
+                continue
+            index = code.count("\n", 0, call.start)
+            first_line = code.count("\n", 0, earlier.start) + 1
+            findings.append(
+                builtin_finding(
+                    DUPLICATE_CONTEXT_KEY_PATTERN,
+                    f"Context key {call.key} shadows the value set on line {first_line}",
+                    rel_path,
+                    index + 1,
+                    source_lines[index],
+                    config,
+                    DUPLICATE_CONTEXT_KEY_CONFIDENCE,
+                )
+            )
+            break
+    findings.sort(key=lambda f: f.line)
+    return findings
@@ -0,0 +1,82 @@
+"""Symbol visibility filtering for Go scans.
+
+With ``ScanOptions.exported_only``, findings are kept only when they lie in
+an exported declaration: a function, a method whose name and receiver type
+are both exported, or a type. Findings in unexported helpers, unexported
+types and package-level code outside any function or type are dropped.
+
+Declarations are found from top-level ``func`` and ``type`` lines, which
+gofmt places at column 0 and closes with a column-0 ``}`` or ``)``.
+
+Example:
+    >>> from bmad_assist.deep_verify.scan import ScanOptions
+    >>> options = ScanOptions(exported_only=True)
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.scan.types import ScanFinding
+
+# Top-level function or method: `func Name(`, `func (r *Recv[T]) Name(`
+_FUNC_RE = re.compile(r"^func\s*(?:\(\s*(?:\w+\s+)?\*?\s*(\w+)[^)]*\)\s*)?(\w+)")
+
+# Top-level single type declaration: `type Name ...`
+_TYPE_RE = re.compile(r"^type\s+(\w+)")
+
+# Bare closing line at column 0 that ends a top-level declaration
+_CLOSE_RE = re.compile(r"^[})]\s*$")
+
+
+def _is_exported(name: str) -> bool:
+    """Check whether a Go identifier is exported."""
+    return name[:1].isupper()
+
+
+def exported_lines(text: str) -> list[bool]:
+    """Map each line of a Go file to whether it lies in an exported declaration.
+
+    Args:
+        text: Go source.
+
+    Returns:
+        One flag per line (index 0 is line 1).
+
+    """
+    flags: list[bool] = []
+    exported = False
+    for line in text.split("\n"):
+        if func := _FUNC_RE.match(line):
+            receiver, name = func.groups()
+            exported = _is_exported(name) and (receiver is None or _is_exported(receiver))
+        elif decl := _TYPE_RE.match(line):
+            exported = _is_exported(decl.group(1))
+        elif line[:1] not in ("", " ", "\t", "}", ")"):
+            # Other top-level code: package, import, var, const or a comment
+            exported = False
+        flags.append(exported)
+        if _CLOSE_RE.match(line):
+            exported = False
+    return flags
+
+
+def filter_exported(findings: list[ScanFinding], text: str, language: str) -> list[ScanFinding]:
+    """Drop findings outside exported declarations of a Go file.
+
+    Findings of other languages are returned unchanged.
+
+    Args:
+        findings: Findings for the file.
+        text: File contents.
+        language: Language of the file.
+
+    Returns:
+        Findings located in exported declarations, in input order.
+
+    """
+    if language != "go":
+        return findings
+    flags = exported_lines(text)
+    return [f for f in findings if 1 <= f.line <= len(flags) and flags[f.line - 1]]
@@ -0,0 +1,180 @@
+"""Detection of unbounded WaitGroup waits during shutdown in Go scans.
+
+A server's shutdown path usually waits for its goroutines. If one of them
+never calls ``Done``, an unbounded ``Wait`` hangs the shutdown forever and
+the process has to be killed::
+
+    func (s *Server) Shutdown(ctx context.Context) error {
+        close(s.quit)
+        s.wg.Wait() // CC-129: blocks past ctx's deadline
+        return nil
+    }
+
+``Wait`` calls on ``sync.WaitGroup`` variables and fields (resolved through
+the file's imports) are reported in shutdown-like functions: those named
+``Shutdown``, ``Close`` or ``Stop`` (in any case) and those taking a
+``context.Context``. A ``Wait`` inside a function literal is taken to be the
+usual timeout wrapper and is not reported::
+
+    done := make(chan struct{})
+    go func() { s.wg.Wait(); close(done) }()
+    select {
+    case <-done:
+    case <-ctx.Done():
+    }
+
+The check is a heuristic and opt-in: enable it with ``opt_in: [CC-129]`` in
+``.deepverify.yaml``.
+
+"""
+
+from __future__ import annotations
+
+import re
+
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternEffort,
+    PatternId,
+    Severity,
+)
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.deprecations import parse_go_imports
+from bmad_assist.deep_verify.scan.types import ScanFinding, builtin_finding
+
+# Pattern reported for unbounded WaitGroup waits in shutdown-like functions
+UNBOUNDED_WAIT_PATTERN = Pattern(
+    id=PatternId("CC-129-CODE-GO"),
+    domain=ArtifactDomain.CONCURRENCY,
+    signals=[],
+    severity=Severity.INFO,
+    description="Shutdown waits on a WaitGroup with no timeout - a missing Done hangs it forever",
+    remediation="Wait in a goroutine that closes a channel, then select on it and ctx.Done()",
+    language="go",
+    opt_in=True,
+    effort=PatternEffort.MEDIUM,
+)
+
+# Confidence of CC-129 findings: callers often bound the wait with their own
+# timeout or shutdown deadline, which is invisible at the Wait call
+UNBOUNDED_WAIT_CONFIDENCE = 0.6
+
+# Function names treated as shutdown paths, compared case-insensitively
+SHUTDOWN_FUNCS = frozenset({"shutdown", "close", "stop"})
+
+# Function or method declaration with its parameters on the first line
+_FUNC_DECL_RE = re.compile(
+    r"^func[ \t]*(?:\([^)\n]*\)[ \t]*)?([A-Za-z_]\w*)(?:\[[^\]\n]*\])?[ \t]*\(([^)\n]*)",
+    re.MULTILINE,
+)
+
+_FUNC_RE = re.compile(r"\bfunc\b")
+
+# String and rune literals, blanked before matching
+_LITERAL_RE = re.compile(r'"(?:\\.|[^"\\\n])*"|`[^`\n]*`|\'(?:\\.|[^\'\\\n])+\'')
+
+
+def _code_lines(text: str) -> list[str]:
+    """Return the file's lines with literals blanked and line comments removed."""
+    return [_LITERAL_RE.sub('""', line).split("//", 1)[0] for line in text.split("\n")]
+
+
+def _waitgroup_names(code: str, sync_aliases: list[str]) -> set[str]:
+    """Return names declared as sync.WaitGroup values or pointers in the file."""
+    qualified = "|".join(
+        r"(?<![\w.])WaitGroup" if a == "." else re.escape(a) + r"\.WaitGroup" for a in sync_aliases
+    )
+    declared = re.compile(
+        r"(?<![\w.])([A-Za-z_]\w*(?:[ \t]*,[ \t]*[A-Za-z_]\w*)*)[ \t]+\*?(?:" + qualified + r")\b"
+    )
+    assigned = re.compile(
+        r"(?<![\w.])([A-Za-z_]\w*)[ \t]*:?=[ \t]*(?:&(?:" + qualified + r")\{|new\((?:"
+        + qualified
+        + r")\))"
+    )
+    names = {m.group(1) for m in assigned.finditer(code)}
+    for match in declared.finditer(code):
+        names.update(name.strip() for name in match.group(1).split(","))
+    return names
+
+
+def find_unbounded_waits(text: str, rel_path: str, config: ScanConfig) -> list[ScanFinding]:
+    """Report WaitGroup waits with no timeout in shutdown-like functions.
+
+    Args:
+        text: Go source.
+        rel_path: Path relative to the scan root.
+        config: Effective config for the file (CC-129 is opt-in).
+
+    Returns:
+        CC-129 findings in line order, one per Wait call.
+
+    """
+    if not config.is_enabled(UNBOUNDED_WAIT_PATTERN.id, opt_in=UNBOUNDED_WAIT_PATTERN.opt_in):
+        return []
+    imports = parse_go_imports(text)
+    sync_aliases = [name for name, path in imports.items() if path == "sync"]
+    if not sync_aliases:
+        return []
+    lines = _code_lines(text)
+    code = "\n".join(lines)
+    names = _waitgroup_names(code, sync_aliases)
+    if not names:
+        return []
+    wait_re = re.compile(
+        r"(?<![\w.])((?:[A-Za-z_]\w*\.)*(?:"
+        + "|".join(re.escape(n) for n in sorted(names))
+        + r"))\.Wait\(\)"
+    )
+    context_re = re.compile(
+        "|".join(
+            r"(?<![\w.])Context\b" if a == "." else re.escape(a) + r"\.Context\b"
+            for a, path in imports.items()
+            if path == "context"
+        )
+        or r"(?!)"
+    )
+
+    source_lines = text.split("\n")
+    findings: list[ScanFinding] = []
+    for func in _FUNC_DECL_RE.finditer(code):
+        name, params = func.groups()
+        if name.lower() not in SHUTDOWN_FUNCS and not context_re.search(params):
+            continue
+        start = code.count("\n", 0, func.start())
+        # Open blocks: the body first, then "func" for literals, else "block"
+        stack: list[str] = []
+        opened = False
+        for index in range(start, len(lines)):
+            line = lines[index]
+            if not opened and index > start and line.startswith("func"):
+                break  # declaration without a body
+            waits = {m.start(): m.group(1) for m in wait_re.finditer(line)}
+            segment = 0
+            for position, char in enumerate(line):
+                if position in waits and stack and "func" not in stack[1:]:
+                    findings.append(
+                        builtin_finding(
+                            UNBOUNDED_WAIT_PATTERN,
+                            f"{name} waits on {waits[position]} with no timeout",
+                            rel_path,
+                            index + 1,
+                            source_lines[index],
+                            config,
+                            UNBOUNDED_WAIT_CONFIDENCE,
+                        )
+                    )
+                if char == "{":
+                    literal = stack and _FUNC_RE.search(line[segment:position])
+                    stack.append("func" if literal else "block")
+                    opened = True
+                    segment = position + 1
+                elif char == "}":
+                    segment = position + 1
+                    if stack:
+                        stack.pop()
+            if opened and not stack:
+                break
+    findings.sort(key=lambda f: f.line)
+    return findings
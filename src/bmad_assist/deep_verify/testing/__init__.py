@@ -0,0 +1,25 @@
+"""Test helpers for Deep Verify pattern authors.
+
+Pattern authors can unit-test a detector against source snippets without
+writing files or wiring up a scanner, similar to Go's analysistest.
+
+Example:
+    >>> from bmad_assist.deep_verify.testing import WantFinding, assert_findings
+    >>> assert_findings(
+    ...     "func main() {\\n    go func() {\\n        work()\\n    }()\\n}\\n",
+    ...     [WantFinding("CC-001-CODE-GO", line=2)],
+    ... )
+
+"""
+
+from bmad_assist.deep_verify.testing.helpers import (
+    WantFinding,
+    assert_findings,
+    run_detector,
+)
+
+__all__ = [
+    "WantFinding",
+    "assert_findings",
+    "run_detector",
+]
@@ -0,0 +1,178 @@
+"""Helpers for running a single detector against a source snippet.
+
+A detector is a code pattern from the default library or a built-in scan
+check (given by ID), or a ``Pattern`` built by the caller, e.g. one loaded
+from a plugin YAML file.
+"""
+
+from __future__ import annotations
+
+from dataclasses import dataclass
+from textwrap import dedent
+
+from bmad_assist.core.exceptions import PatternNotFoundError
+from bmad_assist.deep_verify.core.types import Pattern, PatternId, Severity
+from bmad_assist.deep_verify.patterns.library import PatternLibrary, get_default_pattern_library
+from bmad_assist.deep_verify.patterns.matcher import PatternMatcher
+from bmad_assist.deep_verify.scan.config import ScanConfig
+from bmad_assist.deep_verify.scan.scanner import BUILTIN_PATTERNS, ScanOptions, Scanner
+from bmad_assist.deep_verify.scan.types import ScanFinding
+
+# Language assumed when neither the caller nor the pattern names one
+DEFAULT_LANGUAGE = "go"
+
+
+@dataclass(frozen=True, slots=True)
+class WantFinding:
+    """An expected finding.
+
+    Attributes:
+        pattern_id: Expected pattern ID.
+        line: Expected 1-based line, or None to accept any line.
+        severity: Expected severity, or None to accept any severity.
+
+    """
+
+    pattern_id: str
+    line: int | None = None
+    severity: Severity | None = None
+
+    def matches(self, finding: ScanFinding) -> bool:
+        """Check whether a finding satisfies this expectation."""
+        return (
+            finding.pattern_id == self.pattern_id
+            and (self.line is None or finding.line == self.line)
+            and (self.severity is None or finding.severity == self.severity)
+        )
+
+
+def _resolve_detector(detector: Pattern | str, library: PatternLibrary) -> Pattern:
+    """Return the Pattern for a detector given as Pattern or ID."""
+    if isinstance(detector, Pattern):
+        return detector
+    pattern_id = PatternId(detector.strip().upper())
+    pattern = library.get_pattern(pattern_id) or next(
+        (p for p in BUILTIN_PATTERNS if p.id == pattern_id), None
+    )
+    if pattern is None:
+        raise PatternNotFoundError(f"Unknown pattern ID '{detector}'", pattern_id=detector)
+    return pattern
+
+
+def run_detector(
+    detector: Pattern | str,
+    source: str,
+    language: str | None = None,
+    threshold: float = PatternMatcher.DEFAULT_THRESHOLD,
+    config: ScanConfig | None = None,
+    library: PatternLibrary | None = None,
+) -> list[ScanFinding]:
+    """Run one detector over a source snippet.
+
+    The snippet is dedented first, so it can be written as an indented
+    triple-quoted string; line numbers refer to the dedented text.
+    Opt-in detectors always run.
+
+    Args:
+        detector: Pattern, or ID of a library pattern or built-in check.
+        source: Source code to scan.
+        language: Source language (default: the pattern's language, else "go").
+        threshold: Minimum pattern confidence.
+        config: Scan config applied to the snippet (severity overrides,
+            suppression governance).
+        library: Library used to resolve pattern IDs (default library if None).
+
+    Returns:
+        Findings sorted by line and pattern ID.
+
+    Raises:
+        PatternNotFoundError: If a pattern ID is neither in the library nor
+            a built-in check.
+
+    """
+    library = library if library is not None else get_default_pattern_library()
+    pattern = _resolve_detector(detector, library)
+    if pattern.opt_in:
+        # Built-in checks look up their opt-in in the config
+        config = config or ScanConfig()
+        config = config.model_copy(update={"opt_in": [*config.opt_in, pattern.id]})
+    scanner = Scanner(ScanOptions(threshold=threshold), library=library)
+    return scanner.scan_source(
+        dedent(source),
+        language=language or pattern.language or DEFAULT_LANGUAGE,
+        patterns=[pattern],
+        config=config,
+    )
+
+
+def assert_findings(
+    source: str,
+    want: list[WantFinding],
+    detectors: list[Pattern | str] | None = None,
+    language: str | None = None,
+    threshold: float = PatternMatcher.DEFAULT_THRESHOLD,
+    config: ScanConfig | None = None,
+    library: PatternLibrary | None = None,
+) -> list[ScanFinding]:
+    """Assert that detectors report exactly the expected findings.
+
+    Every expected finding must be reported, and every reported finding
+    must be expected. Pass ``want=[]`` with explicit detectors to assert
+    that a snippet is clean.
+
+    Args:
+        source: Source code to scan (dedented before scanning).
+        want: Expected findings.
+        detectors: Detectors to run (default: the patterns named in want).
+        language: Source language (default: each pattern's language).
+        threshold: Minimum pattern confidence.
+        config: Scan config applied to the snippet.
+        library: Library used to resolve pattern IDs.
+
+    Returns:
+        The reported findings, for further assertions.
+
+    Raises:
+        AssertionError: If the findings differ from the expectation.
+        ValueError: If neither detectors nor expected findings are given.
+
+    """
+    if detectors is None:
+        detectors = list(dict.fromkeys(w.pattern_id for w in want))
+    if not detectors:
+        raise ValueError("assert_findings needs detectors when want is empty")
+
+    findings: list[ScanFinding] = []
+    for detector in detectors:
+        findings.extend(
+            run_detector(
+                detector,
+                source,
+                language=language,
+                threshold=threshold,
+                config=config,
+                library=library,
+            )
+        )
+    findings.sort(key=lambda f: (f.line, f.pattern_id))
+
+    missing = [w for w in want if not any(w.matches(f) for f in findings)]
+    unexpected = [f for f in findings if not any(w.matches(f) for w in want)]
+    if missing or unexpected:
+        lines = ["Findings differ from expectation:"]
+        lines.extend(f"  missing:    {_describe_want(w)}" for w in missing)
+        lines.extend(f"  unexpected: {f.pattern_id} at line {f.line}" for f in unexpected)
+        reported = ", ".join(f"{f.pattern_id}@{f.line}" for f in findings) or "(none)"
+        lines.append(f"  reported:   {reported}")
+        raise AssertionError("\n".join(lines))
+    return findings
+
+
+def _describe_want(want: WantFinding) -> str:
+    """Format an expected finding for failure messages."""
+    text = want.pattern_id
+    if want.line is not None:
+        text += f" at line {want.line}"
+    if want.severity is not None:
+        text += f" ({want.severity.value})"
+    return text
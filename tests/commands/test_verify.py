@@ -472,6 +472,852 @@ class TestForceOption:
         assert result.exit_code == 1
 
 
+class TestVerifyExplain:
+    """Test verify explain subcommand."""
+
+    def test_explain_full_code_pattern(self) -> None:
+        """Test explaining a code pattern with examples."""
+        result = runner.invoke(app, ["verify", "explain", "CC-004-CODE-GO"])
+        assert result.exit_code == 0
+        assert "CC-004-CODE-GO" in result.output
+        assert "Why it matters:" in result.output
+        assert "mu.RLock()" in result.output
+
+    def test_explain_base_id(self) -> None:
+        """Test that a base ID explains every language variant."""
+        result = runner.invoke(app, ["verify", "explain", "cc-004"])
+        assert result.exit_code == 0
+        assert "CC-004-CODE-GO" in result.output
+        assert "CC-004-CODE-PY" in result.output
+
+    def test_explain_unknown_id(self) -> None:
+        """Test that unknown IDs fail with a clear error."""
+        result = runner.invoke(app, ["verify", "explain", "ZZ-999"])
+        assert result.exit_code == 1
+        assert "Unknown pattern ID 'ZZ-999'" in result.output
+
+
+class TestVerifyScan:
+    """Test verify scan subcommand."""
+
+    GO_GOROUTINE = "package main\n\nfunc main() {\n    go func() {\n        doWork()\n    }()\n}\n"
+
+    def test_scan_reports_findings(self, tmp_path: Path) -> None:
+        """Test that findings are printed and fail the scan."""
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path)])
+
+        assert result.exit_code == 1
+        assert "main.go:4: CRITICAL CC-001-CODE-GO" in result.output
+        assert "1 finding(s) in 1 file(s)" in result.output
+        assert "Estimated effort: 2h (1 medium)" in result.output
+
+    def test_scan_json_output(self, tmp_path: Path) -> None:
+        """Test JSON output of a scan."""
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--output", "json"])
+
+        data = json.loads(result.output)
+        assert data["files_scanned"] == ["main.go"]
+        assert data["findings"][0]["pattern_id"] == "CC-001-CODE-GO"
+
+    def test_scan_json_compact(self, tmp_path: Path) -> None:
+        """Test that an empty --json-indent renders single-line JSON."""
+        (tmp_path / "main.go").write_text("package main\n")
+
+        result = runner.invoke(
+            app, ["verify", "scan", str(tmp_path), "--output", "json", "--json-indent", ""]
+        )
+
+        assert result.exit_code == 0
+        assert result.output.startswith('{"root":')
+        assert result.output.count("\n") == 1
+
+    def test_scan_invalid_json_indent(self, tmp_path: Path) -> None:
+        """Test that a non-whitespace --json-indent is rejected."""
+        result = runner.invoke(
+            app, ["verify", "scan", str(tmp_path), "--output", "json", "--json-indent", "ab"]
+        )
+
+        assert result.exit_code == 2
+        assert "Invalid --json-indent value" in result.output
+
+    def test_scan_gitlab_output(self, tmp_path: Path) -> None:
+        """Test GitLab Code Quality output of a scan."""
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--output", "gitlab"])
+
+        issues = json.loads(result.output)
+        assert issues[0]["check_name"] == "CC-001-CODE-GO"
+        assert issues[0]["severity"] == "critical"
+        assert issues[0]["location"] == {"path": "main.go", "lines": {"begin": 4}}
+
+    def test_scan_github_output(self, tmp_path: Path) -> None:
+        """Test GitHub Actions annotation output of a scan."""
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--output", "github"])
+
+        assert result.output.startswith("::error file=main.go,line=4,title=CC-001-CODE-GO::")
+
+    def test_scan_sarif_delta_output(self, tmp_path: Path) -> None:
+        """Test delta SARIF output against a baseline JSON report."""
+        src = tmp_path / "src"
+        src.mkdir()
+        (src / "main.go").write_text(self.GO_GOROUTINE)
+        baseline = tmp_path / "baseline.json"
+        baseline.write_text(
+            runner.invoke(app, ["verify", "scan", str(src), "--output", "json"]).output
+        )
+        (src / "worker.go").write_text(self.GO_GOROUTINE)
+
+        result = runner.invoke(
+            app,
+            [
+                "verify",
+                "scan",
+                str(src),
+                "--output",
+                "sarif",
+                "--sarif-baseline",
+                str(baseline),
+                "--sarif-delta",
+            ],
+        )
+
+        results = json.loads(result.output)["runs"][0]["results"]
+        assert [
+            (r["locations"][0]["physicalLocation"]["artifactLocation"]["uri"], r["baselineState"])
+            for r in results
+        ] == [("worker.go", "new")]
+
+    def test_scan_sarif_delta_requires_baseline(self, tmp_path: Path) -> None:
+        """Test that --sarif-delta without --sarif-baseline is rejected."""
+        result = runner.invoke(
+            app, ["verify", "scan", str(tmp_path), "--output", "sarif", "--sarif-delta"]
+        )
+
+        assert result.exit_code == 2
+        assert "--sarif-delta requires --sarif-baseline" in result.output
+
+    def test_scan_fingerprints_output(self, tmp_path: Path) -> None:
+        """Test that fingerprints output lists the JSON report's fingerprints."""
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+
+        json_result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--output", "json"])
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--output", "fingerprints"])
+
+        findings = json.loads(json_result.output)["findings"]
+        assert result.output.splitlines() == sorted({f["fingerprint"] for f in findings})
+
+    def test_scan_badge_output(self, tmp_path: Path) -> None:
+        """Test that badge output writes an SVG badge with the finding count."""
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--output", "badge"])
+
+        assert result.output.startswith("<svg ")
+        assert "finding" in result.output
+        assert result.output.rstrip().endswith("</svg>")
+
+    def test_scan_html_output(self, tmp_path: Path) -> None:
+        """Test that html output writes a page embedding the findings."""
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--output", "html"])
+
+        assert result.output.startswith("<!DOCTYPE html>")
+        assert 'id="deepverify-data"' in result.output
+        assert "CC-001-CODE-GO" in result.output
+
+    def test_scan_import_sarif(self, tmp_path: Path) -> None:
+        """Test that --import-sarif merges another tool's findings into the report."""
+        src = tmp_path / "src"
+        src.mkdir()
+        (src / "main.go").write_text(self.GO_GOROUTINE)
+        gosec = tmp_path / "gosec.sarif"
+        gosec.write_text(
+            json.dumps(
+                {
+                    "version": "2.1.0",
+                    "runs": [
+                        {
+                            "tool": {"driver": {"name": "gosec"}},
+                            "results": [
+                                {
+                                    "ruleId": "G104",
+                                    "level": "warning",
+                                    "message": {"text": "Errors unhandled"},
+                                    "locations": [
+                                        {
+                                            "physicalLocation": {
+                                                "artifactLocation": {"uri": "main.go"},
+                                                "region": {"startLine": 2},
+                                            }
+                                        }
+                                    ],
+                                }
+                            ],
+                        }
+                    ],
+                }
+            )
+        )
+
+        result = runner.invoke(
+            app,
+            ["verify", "scan", str(src), "--import-sarif", f"gosec={gosec}", "--output", "json"],
+        )
+
+        findings = json.loads(result.output)["findings"]
+        assert [(f["pattern_id"], f.get("tool")) for f in findings] == [
+            ("G104", "gosec"),
+            ("CC-001-CODE-GO", None),
+        ]
+
+    def test_scan_import_sarif_invalid_spec(self, tmp_path: Path) -> None:
+        """Test that --import-sarif without TOOL= is rejected."""
+        result = runner.invoke(
+            app, ["verify", "scan", str(tmp_path), "--import-sarif", "gosec.sarif"]
+        )
+
+        assert result.exit_code == 2
+        assert "Use TOOL=PATH" in result.output
+
+    def test_scan_sqlite_append(self, tmp_path: Path) -> None:
+        """Test that --sqlite --append adds a run per scan."""
+        import sqlite3
+
+        src = tmp_path / "src"
+        src.mkdir()
+        (src / "main.go").write_text(self.GO_GOROUTINE)
+        db = tmp_path / "deepverify.db"
+
+        for _ in range(2):
+            runner.invoke(app, ["verify", "scan", str(src), "--sqlite", str(db), "--append"])
+
+        conn = sqlite3.connect(db)
+        try:
+            assert conn.execute("SELECT COUNT(*) FROM runs").fetchone()[0] == 2
+            assert conn.execute("SELECT COUNT(*) FROM findings").fetchone()[0] == 2
+        finally:
+            conn.close()
+
+    GO_LEAKED_CANCEL = (
+        "package main\n\nfunc load(parent context.Context) error {\n"
+        "    ctx, cancel := context.WithTimeout(parent, time.Second)\n"
+        "    return fetch(ctx)\n}\n"
+    )
+
+    def test_fix_writes_resolving_fixes(self, tmp_path: Path) -> None:
+        """Test that verify fix writes fixes that resolve findings without new ones."""
+        (tmp_path / "main.go").write_text(self.GO_LEAKED_CANCEL)
+
+        result = runner.invoke(app, ["verify", "fix", str(tmp_path)])
+        rescan = runner.invoke(app, ["verify", "scan", str(tmp_path), "--fail-on", "warning"])
+
+        assert result.exit_code == 0
+        assert "Resolved: main.go:4: WARNING CC-039-CODE-GO" in result.output
+        assert "Resolved 1 finding(s) in 1 file(s)" in result.output
+        assert "    defer cancel()\n" in (tmp_path / "main.go").read_text()
+        assert rescan.exit_code == 0
+
+    def test_fix_dry_run_shows_diff(self, tmp_path: Path) -> None:
+        """Test that verify fix --dry-run prints the diff and leaves files alone."""
+        (tmp_path / "main.go").write_text(self.GO_LEAKED_CANCEL)
+
+        result = runner.invoke(app, ["verify", "fix", str(tmp_path), "--dry-run"])
+
+        assert result.exit_code == 0
+        assert "+    defer cancel()" in result.output
+        assert "Would resolve 1 finding(s) in 1 file(s)" in result.output
+        assert (tmp_path / "main.go").read_text() == self.GO_LEAKED_CANCEL
+
+    def test_fix_nothing_to_fix(self, tmp_path: Path) -> None:
+        """Test that verify fix reports when no finding has a fix."""
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+
+        result = runner.invoke(app, ["verify", "fix", str(tmp_path)])
+
+        assert result.exit_code == 0
+        assert "No fixable findings" in result.output
+        assert (tmp_path / "main.go").read_text() == self.GO_GOROUTINE
+
+    def test_scan_sqlite_then_trend(self, tmp_path: Path) -> None:
+        """Test that runs appended at each commit chart with per-commit deltas."""
+        import subprocess
+
+        repo = tmp_path / "repo"
+        repo.mkdir()
+        db = tmp_path / "deepverify.db"
+        for args in (["init"], ["config", "user.email", "t@t.com"], ["config", "user.name", "T"]):
+            subprocess.run(["git", *args], cwd=repo, capture_output=True, check=True)
+        for content in ("package main\n", self.GO_GOROUTINE):
+            (repo / "main.go").write_text(content)
+            subprocess.run(["git", "add", "-A"], cwd=repo, capture_output=True, check=True)
+            subprocess.run(["git", "commit", "-m", "c"], cwd=repo, capture_output=True, check=True)
+            runner.invoke(app, ["verify", "scan", str(repo), "--sqlite", str(db), "--append"])
+
+        result = runner.invoke(app, ["verify", "trend", str(db), "--repo", str(repo)])
+        data = runner.invoke(
+            app, ["verify", "trend", str(db), "--repo", str(repo), "--output", "json"]
+        )
+
+        assert result.exit_code == 0
+        assert "Health score over 2 commit(s):" in result.output
+        assert "-80.00" in result.output
+        commits = json.loads(data.output)["commits"]
+        assert [(c["health_score"], c["delta"]) for c in commits] == [(100.0, None), (20.0, -80.0)]
+        assert [c["effort_hours"] for c in commits] == [0.0, 2.0]
+
+    def test_scan_then_compare(self, tmp_path: Path) -> None:
+        """Test comparing JSON reports of a clean and a regressed branch."""
+        for name, content in (("main", "package main\n"), ("feature", self.GO_GOROUTINE)):
+            (tmp_path / name).mkdir()
+            (tmp_path / name / "main.go").write_text(content)
+            scan = runner.invoke(app, ["verify", "scan", str(tmp_path / name), "-o", "json"])
+            (tmp_path / f"{name}.json").write_text(scan.output)
+        paths = [str(tmp_path / "main.json"), f"feat={tmp_path / 'feature.json'}"]
+
+        result = runner.invoke(app, ["verify", "compare", *paths])
+        data = runner.invoke(app, ["verify", "compare", *paths, "--output", "json"])
+
+        assert result.exit_code == 0
+        assert "Regressed: feat" in result.output
+        matrix = json.loads(data.output)
+        assert matrix["baseline"] == "main"
+        assert [(r["label"], r["introduced"], r["regressed"]) for r in matrix["reports"]] == [
+            ("main", 0, False),
+            ("feat", 1, True),
+        ]
+
+    def test_compare_missing_report(self, tmp_path: Path) -> None:
+        """Test that an unreadable report is a usage error."""
+        result = runner.invoke(app, ["verify", "compare", str(tmp_path / "missing.json")])
+        assert result.exit_code == 2
+        assert "Failed to read scan report" in result.output
+
+    def test_scan_split_by_owner(self, tmp_path: Path) -> None:
+        """Test that --split-by-owner writes each team's findings to its own report."""
+        repo = tmp_path / "repo"
+        for team in ("payments", "search"):
+            (repo / "services" / team).mkdir(parents=True)
+            (repo / "services" / team / "main.go").write_text(self.GO_GOROUTINE)
+        (repo / ".github").mkdir()
+        (repo / ".github" / "CODEOWNERS").write_text(
+            "*                   @org/platform\n"
+            "/services/payments/ @org/payments\n"
+            "/services/search/   @org/search\n"
+        )
+        out = tmp_path / "by-owner"
+
+        result = runner.invoke(
+            app, ["verify", "scan", str(repo / "services"), "--split-by-owner", str(out)]
+        )
+
+        assert result.exit_code == 1
+        payments = json.loads((out / "org-payments.json").read_text())
+        assert [f["path"] for f in payments["findings"]] == ["payments/main.go"]
+        search = json.loads((out / "org-search.json").read_text())
+        assert [f["path"] for f in search["findings"]] == ["search/main.go"]
+        index = json.loads((out / "index.json").read_text())
+        assert sorted(index["owners"]) == ["@org/payments", "@org/search"]
+
+    def test_scan_split_by_owner_without_codeowners(self, tmp_path: Path) -> None:
+        """Test that --split-by-owner without a CODEOWNERS file is a usage error."""
+        (tmp_path / ".git").mkdir()
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+
+        result = runner.invoke(
+            app, ["verify", "scan", str(tmp_path), "--split-by-owner", str(tmp_path / "out")]
+        )
+
+        assert result.exit_code == 2
+        assert "No CODEOWNERS file found" in result.output
+
+    def test_scan_show_suppressed(self, tmp_path: Path) -> None:
+        """Test that suppressed findings appear only with --show-suppressed."""
+        (tmp_path / "main.go").write_text(
+            self.GO_GOROUTINE.replace("go func", "// deepverify:ignore reason=audited\n    go func")
+        )
+
+        hidden = runner.invoke(app, ["verify", "scan", str(tmp_path)])
+        shown = runner.invoke(app, ["verify", "scan", str(tmp_path), "--show-suppressed"])
+
+        assert "CC-001-CODE-GO" not in hidden.output
+        assert "main.go:5: CRITICAL CC-001-CODE-GO" in shown.output
+        assert "[suppressed: audited]" in shown.output
+        assert "0 finding(s) in 1 file(s), 1 suppressed" in shown.output
+
+    def test_scan_suppressed_findings_never_fail(self, tmp_path: Path) -> None:
+        """Test that suppressed findings do not affect exit codes."""
+        (tmp_path / "main.go").write_text(
+            self.GO_GOROUTINE.replace("go func", "// deepverify:ignore\n    go func")
+        )
+
+        for args in ([], ["--show-suppressed"], ["--show-suppressed", "--output", "json"]):
+            result = runner.invoke(
+                app, ["verify", "scan", str(tmp_path), "--fail-on", "info", *args]
+            )
+            assert result.exit_code == 0
+
+        data = json.loads(result.output)
+        assert data["findings"][0]["suppressed"] is True
+
+    def test_scan_include_generated(self, tmp_path: Path) -> None:
+        """Test that generated files are scanned only with --include-generated."""
+        (tmp_path / "mock.go").write_text(
+            "// Code generated by mockgen. DO NOT EDIT.\n\n" + self.GO_GOROUTINE
+        )
+
+        skipped = runner.invoke(app, ["verify", "scan", str(tmp_path)])
+        included = runner.invoke(app, ["verify", "scan", str(tmp_path), "--include-generated"])
+
+        assert skipped.exit_code == 0
+        assert "0 finding(s) in 0 file(s)" in skipped.output
+        assert included.exit_code == 1
+        assert "mock.go:6: CRITICAL CC-001-CODE-GO" in included.output
+
+    def test_scan_exported_only(self, tmp_path: Path) -> None:
+        """Test that --exported-only drops findings in unexported functions."""
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--exported-only"])
+
+        assert result.exit_code == 0
+        assert "0 finding(s) in 1 file(s)" in result.output
+
+    def test_warm_then_scan_hits_cache(self, tmp_path: Path) -> None:
+        """Test that a scan after verify warm is served from the cache."""
+        src = tmp_path / "src"
+        src.mkdir()
+        (src / "main.go").write_text(self.GO_GOROUTINE)
+        (src / "util.go").write_text("package main\n")
+        cache_file = tmp_path / "cache.json"
+
+        warmed = runner.invoke(app, ["verify", "warm", str(src), "--cache", str(cache_file)])
+        scanned = runner.invoke(app, ["verify", "scan", str(src), "--cache", str(cache_file)])
+
+        assert warmed.exit_code == 0
+        assert "with 2 file(s) (0 already cached)" in warmed.output
+        assert "CC-001-CODE-GO" not in warmed.output
+        assert scanned.exit_code == 1
+        assert "main.go:4: CRITICAL CC-001-CODE-GO" in scanned.output
+        assert "Cache: 2 hit(s), 0 miss(es)" in scanned.output
+
+    def test_scan_changed_files_from_manifest(self, tmp_path: Path) -> None:
+        """Test that unlisted files reuse cached results after their mtime changes."""
+        import os
+
+        src = tmp_path / "src"
+        (src / "api").mkdir(parents=True)
+        (src / "api" / "main.go").write_text(self.GO_GOROUTINE)
+        (src / "util.go").write_text("package main\n")
+        cache_file = tmp_path / "cache.json"
+        manifest = tmp_path / "changed.txt"
+        manifest.write_text("util.go\n")
+        runner.invoke(app, ["verify", "warm", str(src), "--cache", str(cache_file)])
+        os.utime(src / "api" / "main.go", (1, 1))
+
+        result = runner.invoke(
+            app,
+            [
+                "verify",
+                "scan",
+                str(src),
+                "--cache",
+                str(cache_file),
+                "--changed-files-from",
+                str(manifest),
+            ],
+        )
+
+        assert result.exit_code == 1
+        assert "api/main.go:4: CRITICAL CC-001-CODE-GO" in result.output
+
+    def test_scan_changed_files_manifest_missing(self, tmp_path: Path) -> None:
+        """Test that an unreadable manifest is a config error."""
+        result = runner.invoke(
+            app,
+            ["verify", "scan", str(tmp_path), "--changed-files-from", str(tmp_path / "none")],
+        )
+
+        assert result.exit_code == 2
+        assert "Failed to read changed files manifest" in result.output
+
+    def test_scan_reproducible_zeroes_duration(self, tmp_path: Path) -> None:
+        """Test that --reproducible reports a zero duration."""
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+
+        result = runner.invoke(
+            app, ["verify", "scan", str(tmp_path), "--output", "json", "--reproducible"]
+        )
+
+        assert json.loads(result.output)["duration_ms"] == 0
+
+    def test_scan_skips_large_files(self, tmp_path: Path) -> None:
+        """Test that --max-file-bytes skips oversized files."""
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--max-file-bytes", "10"])
+
+        assert result.exit_code == 0
+        assert "Skipped 1 file(s) larger than 10 bytes" in result.output
+
+    def test_scan_fail_on_none(self, tmp_path: Path) -> None:
+        """Test that --fail-on none always succeeds."""
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--fail-on", "none"])
+
+        assert result.exit_code == 0
+
+    def test_scan_respects_config_files(self, tmp_path: Path) -> None:
+        """Test that .deepverify.yaml disables patterns unless --no-config."""
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+        (tmp_path / ".deepverify.yaml").write_text("disable: [CC-001]\n")
+
+        assert runner.invoke(app, ["verify", "scan", str(tmp_path)]).exit_code == 0
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--no-config"])
+        assert result.exit_code == 1
+
+    def test_scan_invalid_fail_on(self, tmp_path: Path) -> None:
+        """Test that an invalid --fail-on value is a usage error."""
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--fail-on", "fatal"])
+        assert result.exit_code == 2
+        assert "Invalid --fail-on value" in result.output
+
+    def test_scan_ratchet(self, tmp_path: Path) -> None:
+        """Test that --ratchet defers failing on a domain until its date."""
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+
+        past = runner.invoke(
+            app, ["verify", "scan", str(tmp_path), "--ratchet", "concurrency=2000-01-01"]
+        )
+        future = runner.invoke(
+            app, ["verify", "scan", str(tmp_path), "--ratchet", "concurrency=2999-01-01"]
+        )
+
+        assert past.exit_code == 1
+        assert future.exit_code == 0
+        assert "CC-001-CODE-GO" in future.output
+        assert "Not failing yet on ratcheted domain(s): concurrency" in future.output
+
+    def test_scan_invalid_ratchet(self, tmp_path: Path) -> None:
+        """Test that a malformed --ratchet value is a usage error."""
+        result = runner.invoke(
+            app, ["verify", "scan", str(tmp_path), "--ratchet", "concurrency=soon"]
+        )
+        assert result.exit_code == 2
+        assert "Invalid --ratchet value" in result.output
+
+    def test_scan_labels(self, tmp_path: Path) -> None:
+        """Test that --label values reach the JSON report."""
+        (tmp_path / "main.go").write_text("package main\n")
+
+        result = runner.invoke(
+            app,
+            [
+                "verify",
+                "scan",
+                str(tmp_path),
+                "--output",
+                "json",
+                "--label",
+                "tenant=acme",
+                "--label",
+                "commit=abc123",
+            ],
+        )
+
+        assert result.exit_code == 0
+        assert json.loads(result.output)["labels"] == {"commit": "abc123", "tenant": "acme"}
+
+    @pytest.mark.parametrize("label", ["tenant", "tags=x"])
+    def test_scan_invalid_label(self, tmp_path: Path, label: str) -> None:
+        """Test that malformed and reserved --label values are usage errors."""
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--label", label])
+
+        assert result.exit_code == 2
+        assert "label" in result.output.lower()
+
+    def test_scan_summary_only(self, tmp_path: Path) -> None:
+        """Test that --summary-only prints counts without finding lines."""
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--summary-only"])
+
+        assert result.exit_code == 1
+        assert "CC-001-CODE-GO" not in result.output
+        assert "1 finding(s) in 1 file(s)" in result.output
+
+    def test_scan_max_findings(self, tmp_path: Path) -> None:
+        """Test that --max-findings keeps critical findings and reports the drops."""
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+        quiet = tmp_path / "quiet"
+        quiet.mkdir()
+        (quiet / ".deepverify.yaml").write_text("severity:\n  CC-001: info\n")
+        for index in range(3):
+            (quiet / f"w{index}.go").write_text(self.GO_GOROUTINE)
+
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--max-findings", "2"])
+
+        assert result.exit_code == 1
+        assert "main.go:4: CRITICAL CC-001-CODE-GO" in result.output
+        assert "2 finding(s) in 4 file(s)" in result.output
+        assert "Capped at 2 finding(s); dropped 2 info" in result.output
+
+    def test_scan_max_findings_seed(self, tmp_path: Path) -> None:
+        """Test that --seed makes the --max-findings sample reproducible."""
+        for index in range(20):
+            (tmp_path / f"w{index:02}.go").write_text(self.GO_GOROUTINE)
+        args = ["verify", "scan", str(tmp_path), "--max-findings", "3", "--output", "json"]
+
+        first = runner.invoke(app, [*args, "--seed", "7"])
+        second = runner.invoke(app, [*args, "--seed", "7"])
+
+        assert first.exit_code == 1
+        assert first.output == second.output
+        assert runner.invoke(app, args).output == runner.invoke(app, [*args, "--seed", "0"]).output
+
+    def test_scan_max_findings_after_baseline(self, tmp_path: Path) -> None:
+        """Test that a baselined warning cannot push a new one out of --max-findings."""
+        (tmp_path / ".deepverify.yaml").write_text("severity:\n  CC-001: warning\n")
+        old = tmp_path / "accepted"
+        old.mkdir()
+        (old / "main.go").write_text(self.GO_GOROUTINE)
+        baseline = tmp_path / "baseline.json"
+        runner.invoke(app, ["verify", "baseline-create", str(baseline), "--path", str(tmp_path)])
+        new = tmp_path / "regressed"
+        new.mkdir()
+        (new / "main.go").write_text(self.GO_GOROUTINE)
+
+        result = runner.invoke(
+            app,
+            [
+                "verify",
+                "scan",
+                str(tmp_path),
+                "--baseline",
+                str(baseline),
+                "--max-findings",
+                "1",
+            ],
+        )
+
+        assert result.exit_code == 0
+        assert "regressed/main.go:4: WARNING CC-001-CODE-GO" in result.output
+        assert "accepted/main.go" not in result.output
+        assert "Capped at" not in result.output
+
+    def test_minimize(self, tmp_path: Path) -> None:
+        """Test that verify minimize writes the reduced source."""
+        source = tmp_path / "main.go"
+        source.write_text(
+            "package main\n\nfunc helper() int {\n    return 1\n}\n\n" + self.GO_GOROUTINE[14:]
+        )
+        out = tmp_path / "repro.go"
+
+        result = runner.invoke(
+            app, ["verify", "minimize", str(source), "--expect", "CC-001@8", "-o", str(out)]
+        )
+
+        assert result.exit_code == 0
+        assert out.read_text() == "package main\nfunc main() {\n    go func() {\n    }()\n}\n"
+        assert "Reduced 11 line(s) to 5: CC-001-CODE-GO at line 3" in result.output
+
+    def test_minimize_unreported_finding(self, tmp_path: Path) -> None:
+        """Test that verify minimize fails when the finding is not reported."""
+        source = tmp_path / "main.go"
+        source.write_text(self.GO_GOROUTINE)
+
+        result = runner.invoke(app, ["verify", "minimize", str(source), "--expect", "CC-004@4"])
+
+        assert result.exit_code == 2
+        assert "Finding CC-004@4 is not reported on the source" in result.output
+
+    def test_scan_high_signal_preset(self, tmp_path: Path) -> None:
+        """Test that --preset high-signal skips unrated patterns."""
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--preset", "high-signal"])
+
+        assert result.exit_code == 0
+        assert "CC-001-CODE-GO" not in result.output
+
+    def test_scan_unknown_preset(self, tmp_path: Path) -> None:
+        """Test that an unknown preset is a usage error."""
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--preset", "noisy"])
+        assert result.exit_code == 2
+        assert "Unknown preset 'noisy'" in result.output
+
+    def test_scan_since_version(self, tmp_path: Path) -> None:
+        """Test that --since-version skips patterns unchanged since that release."""
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--since-version", "0.4.18"])
+        assert result.exit_code == 0
+        assert "CC-001-CODE-GO" not in result.output
+
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--since-version", "0.4.17"])
+        assert result.exit_code == 1
+        assert "CC-001-CODE-GO" in result.output
+
+    def test_scan_invalid_since_version(self, tmp_path: Path) -> None:
+        """Test that a malformed release number is a usage error."""
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--since-version", "latest"])
+        assert result.exit_code == 2
+        assert "Invalid version 'latest'" in result.output
+
+    def test_scan_invalid_concurrency(self, tmp_path: Path) -> None:
+        """Test that a worker count below 1 is a usage error."""
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--load-concurrency", "0"])
+        assert result.exit_code == 2
+        assert "load_concurrency must be at least 1" in result.output
+
+    def test_scan_missing_path(self, tmp_path: Path) -> None:
+        """Test scanning a missing path."""
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path / "missing")])
+        assert result.exit_code == 1
+        assert "Scan path not found" in result.output
+
+
+class TestVerifyBaselineCreate:
+    """Test verify baseline-create subcommand and scan --baseline."""
+
+    GO_GOROUTINE = "package main\n\nfunc main() {\n    go func() {\n        doWork()\n    }()\n}\n"
+
+    def test_baseline_then_scan_reports_only_regressions(self, tmp_path: Path) -> None:
+        """Test that a scan right after baseline-create passes and new findings fail."""
+        src = tmp_path / "src"
+        src.mkdir()
+        (src / "main.go").write_text(self.GO_GOROUTINE)
+        baseline = tmp_path / "baseline.json"
+
+        created = runner.invoke(
+            app, ["verify", "baseline-create", str(baseline), "--path", str(src)]
+        )
+        clean = runner.invoke(app, ["verify", "scan", str(src), "--baseline", str(baseline)])
+        (src / "worker.go").write_text(self.GO_GOROUTINE)
+        regressed = runner.invoke(app, ["verify", "scan", str(src), "--baseline", str(baseline)])
+
+        assert created.exit_code == 0
+        assert "Accepted 1 finding(s) in 1 file(s)" in created.output
+        assert clean.exit_code == 0
+        assert "0 finding(s) in 1 file(s)" in clean.output
+        assert regressed.exit_code == 1
+        assert "worker.go:4:" in regressed.output
+        assert "main.go" not in regressed.output
+
+    def test_baseline_create_with_analysis_options(self, tmp_path: Path) -> None:
+        """Test that baseline-create honours the analysis options of verify scan."""
+        (tmp_path / "main.go").write_text(self.GO_GOROUTINE)
+        baseline = tmp_path / "baseline.json"
+
+        create = ["verify", "baseline-create", str(baseline), "--path", str(tmp_path)]
+
+        exported = runner.invoke(app, [*create, "--exported-only"])
+        too_small = runner.invoke(app, [*create, "--max-file-bytes", "10"])
+        invalid = runner.invoke(app, [*create, "--preset", "unknown"])
+
+        assert exported.exit_code == 0
+        assert "Accepted 0 finding(s) in 1 file(s)" in exported.output
+        assert too_small.exit_code == 0
+        assert "Accepted 0 finding(s) in 0 file(s)" in too_small.output
+        assert invalid.exit_code == 2
+
+    def test_scan_rejects_invalid_baseline(self, tmp_path: Path) -> None:
+        """Test that an unreadable baseline is a config error."""
+        baseline = tmp_path / "baseline.json"
+        baseline.write_text("[]")
+
+        result = runner.invoke(app, ["verify", "scan", str(tmp_path), "--baseline", str(baseline)])
+
+        assert result.exit_code == 2
+        assert "Failed to read baseline" in result.output
+
+
+class TestVerifyValidateConfig:
+    """Test verify validate-config subcommand."""
+
+    def test_validate_config_valid(self, tmp_path: Path) -> None:
+        """Test that a valid config exits 0."""
+        config = tmp_path / ".deepverify.yaml"
+        config.write_text('disable: ["CC-140"]\n')
+
+        result = runner.invoke(app, ["verify", "validate-config", str(config)])
+
+        assert result.exit_code == 0
+        assert "is valid" in result.output
+
+    def test_validate_config_reports_issues(self, tmp_path: Path) -> None:
+        """Test that issues are printed with their lines and exit 2."""
+        config = tmp_path / ".deepverify.yaml"
+        config.write_text("enable: [CC-]\ndisable: [CC-9999]\n")
+
+        result = runner.invoke(app, ["verify", "validate-config", str(config)])
+
+        assert result.exit_code == 2
+        assert f"{config}:2: disable: unknown selector 'CC-9999'" in result.output
+        assert "1 issue(s) in" in result.output
+
+
+class TestVerifyServe:
+    """Test verify serve subcommand."""
+
+    def test_serve_missing_root(self, tmp_path: Path) -> None:
+        """Test that a missing root directory is rejected before binding."""
+        result = runner.invoke(app, ["verify", "serve", "--root", str(tmp_path / "missing")])
+
+        assert result.exit_code == 1
+        assert "Root directory not found" in result.output
+
+
+class TestVerifyBench:
+    """Test verify bench subcommand."""
+
+    def _bench(self, baseline: Path, *args: str) -> Any:
+        return runner.invoke(
+            app,
+            ["verify", "bench", "--files", "3", "--count", "1", "--baseline", str(baseline), *args],
+        )
+
+    def test_bench_within_budget(self, tmp_path: Path) -> None:
+        """Test benchstat output and success within the budget."""
+        baseline = tmp_path / "baseline.json"
+        baseline.write_text(json.dumps({"ns_per_file": 1e12}))
+
+        result = self._bench(baseline)
+
+        assert result.exit_code == 0
+        assert "BenchmarkScanFile/files=3\t3\t" in result.output
+
+    def test_bench_over_budget(self, tmp_path: Path) -> None:
+        """Test that exceeding the budget exits 1."""
+        baseline = tmp_path / "baseline.json"
+        baseline.write_text(json.dumps({"ns_per_file": 1.0, "factor": 1.0}))
+
+        result = self._bench(baseline)
+
+        assert result.exit_code == 1
+        assert "over the budget" in result.output
+
+    def test_bench_update_baseline(self, tmp_path: Path) -> None:
+        """Test that --update-baseline records the median and keeps the factor."""
+        baseline = tmp_path / "baseline.json"
+        baseline.write_text(json.dumps({"ns_per_file": 1.0, "factor": 2.0, "reference": "ci"}))
+
+        result = self._bench(baseline, "--update-baseline")
+
+        data = json.loads(baseline.read_text())
+        assert result.exit_code == 0
+        assert data["ns_per_file"] > 1.0
+        assert (data["factor"], data["reference"], data["files"]) == (2.0, "ci", 3)
+
+
 class TestLanguageDetection:
     """Test language detection from file extension."""
 
@@ -569,6 +569,28 @@ def test_retry_handler_backoff_with_jitter():
     assert 1.0 <= delay <= 1.2
 
 
+def test_retry_handler_same_seed_same_delays():
+    """Test that handlers with the same seed produce identical jittered delays."""
+    config = RetryConfig(base_delay_seconds=1.0, max_delay_seconds=30.0, jitter_factor=0.2)
+
+    first = RetryHandler(config)
+    second = RetryHandler(config)
+
+    assert [first.calculate_backoff(n) for n in range(4)] == [
+        second.calculate_backoff(n) for n in range(4)
+    ]
+
+
+def test_retry_handler_seed_changes_delays():
+    """Test that a different seed changes the jitter sequence."""
+    seeded = RetryHandler(RetryConfig(jitter_factor=0.2, seed=1))
+    default = RetryHandler(RetryConfig(jitter_factor=0.2))
+
+    assert [seeded.calculate_backoff(n) for n in range(4)] != [
+        default.calculate_backoff(n) for n in range(4)
+    ]
+
+
 # =============================================================================
 # Tests for Model Pricing
 # =============================================================================
@@ -600,6 +622,7 @@ def test_llm_config_defaults():
     assert config.max_retries == 3
     assert config.base_delay_seconds == 1.0
     assert config.max_delay_seconds == 30.0
+    assert config.seed == 0
     assert config.tokens_per_minute_limit == 100000
     assert config.cost_tracking_enabled is True
     assert config.log_all_calls is True
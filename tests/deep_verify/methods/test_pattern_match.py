@@ -572,6 +572,23 @@ func process() {
         assert "Remediation:" not in finding.description
         assert finding.description == "Test pattern without remediation"
 
+    @pytest.mark.asyncio
+    async def test_opt_in_patterns_are_skipped(self) -> None:
+        """Test that opt-in patterns never produce findings."""
+        opt_in_pattern = Pattern(
+            id=PatternId("TEST-002"),
+            domain=ArtifactDomain.CONCURRENCY,
+            severity=Severity.INFO,
+            signals=[Signal(type="exact", pattern="test signal")],
+            description="Opt-in heuristic",
+            opt_in=True,
+        )
+        method = PatternMatchMethod(patterns=[opt_in_pattern])
+
+        findings = await method.analyze("test signal here")
+
+        assert findings == []
+
     @pytest.mark.asyncio
     async def test_title_truncation_to_80_chars(self) -> None:
         """Test that long titles are truncated to 80 characters."""
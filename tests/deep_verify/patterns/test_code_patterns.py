@@ -181,6 +181,8 @@ class TestLanguageFilter:
         # Create spec patterns (no language)
         spec_file = tmp_path / "spec.yaml"
         spec_file.write_text("""
+api_version: 1
+
 patterns:
   - id: "CC-001"
     domain: "concurrency"
@@ -202,6 +204,8 @@ patterns:
         go_dir.mkdir()
         go_file = go_dir / "patterns.yaml"
         go_file.write_text("""
+api_version: 1
+
 patterns:
   - id: "CC-001-CODE-GO"
     domain: "concurrency"
@@ -218,6 +222,8 @@ patterns:
         py_dir.mkdir()
         py_file = py_dir / "patterns.yaml"
         py_file.write_text("""
+api_version: 1
+
 patterns:
   - id: "CC-001-CODE-PY"
     domain: "concurrency"
@@ -229,184 +235,1545 @@ patterns:
     signals: ["except:"]
 """)
 
-        return PatternLibrary.load([spec_file, code_dir])
+        return PatternLibrary.load([spec_file, code_dir])
+
+    def test_get_patterns_without_language_filter(self, library_with_mixed_patterns: PatternLibrary) -> None:
+        """Test that all patterns returned when no language filter."""
+        patterns = library_with_mixed_patterns.get_patterns()
+        assert len(patterns) == 6  # 2 spec + 2 Go + 2 Python
+
+    def test_get_patterns_with_go_language(self, library_with_mixed_patterns: PatternLibrary) -> None:
+        """Test filtering patterns by Go language."""
+        patterns = library_with_mixed_patterns.get_patterns(language="go")
+        # Should return spec patterns (None) + Go patterns
+        assert len(patterns) == 4  # 2 spec + 2 Go
+
+        # Verify Go patterns are included
+        ids = {p.id for p in patterns}
+        assert "CC-001-CODE-GO" in ids
+        assert "SEC-001-CODE-GO" in ids
+
+        # Verify Python patterns are excluded
+        assert "CC-001-CODE-PY" not in ids
+
+    def test_get_patterns_with_python_language(self, library_with_mixed_patterns: PatternLibrary) -> None:
+        """Test filtering patterns by Python language."""
+        patterns = library_with_mixed_patterns.get_patterns(language="python")
+        # Should return spec patterns (None) + Python patterns
+        assert len(patterns) == 4  # 2 spec + 2 Python
+
+        ids = {p.id for p in patterns}
+        assert "CC-001-CODE-PY" in ids
+        assert "CQ-001-CODE-PY" in ids
+        assert "CC-001-CODE-GO" not in ids
+
+    def test_get_patterns_with_domain_and_language(self, library_with_mixed_patterns: PatternLibrary) -> None:
+        """Test filtering by both domain and language."""
+        patterns = library_with_mixed_patterns.get_patterns(
+            domains=[ArtifactDomain.CONCURRENCY],
+            language="go"
+        )
+        # Should return spec concurrency + Go concurrency
+        assert len(patterns) == 2
+
+        ids = {p.id for p in patterns}
+        assert "CC-001" in ids
+        assert "CC-001-CODE-GO" in ids
+
+    def test_get_patterns_case_insensitive_language(self, library_with_mixed_patterns: PatternLibrary) -> None:
+        """Test that language matching is case-insensitive."""
+        # Test various case variations
+        for lang in ["go", "Go", "GO", "gO"]:
+            patterns = library_with_mixed_patterns.get_patterns(language=lang)
+            ids = {p.id for p in patterns}
+            assert "CC-001-CODE-GO" in ids, f"Failed for language={lang}"
+
+    def test_get_patterns_python_alias(self, library_with_mixed_patterns: PatternLibrary) -> None:
+        """Test that 'py' alias works for python."""
+        patterns = library_with_mixed_patterns.get_patterns(language="py")
+        ids = {p.id for p in patterns}
+        assert "CC-001-CODE-PY" in ids
+
+    def test_get_patterns_unknown_language_logs_warning(
+        self, library_with_mixed_patterns: PatternLibrary, caplog: pytest.LogCaptureFixture
+    ) -> None:
+        """Test that unknown language logs warning and returns spec patterns only."""
+        import logging
+        with caplog.at_level(logging.WARNING):
+            patterns = library_with_mixed_patterns.get_patterns(language="rust")
+
+        # Should only return spec patterns (no Rust patterns defined)
+        assert len(patterns) == 2
+        assert all(p.language is None for p in patterns)
+
+        # Verify warning was logged
+        assert "No code patterns found" in caplog.text
+
+
+# =============================================================================
+# Go Pattern Matching Tests
+# =============================================================================
+
+
+class TestGoConcurrencyPatterns:
+    """Tests for Go concurrency pattern detection."""
+
+    @pytest.fixture
+    def go_concurrency_library(self) -> PatternLibrary:
+        """Load Go concurrency patterns."""
+        code_dir = (
+            Path(__file__).parent.parent.parent.parent
+            / "src"
+            / "bmad_assist"
+            / "deep_verify"
+            / "patterns"
+            / "data"
+            / "code"
+            / "go"
+        )
+        if (code_dir / "concurrency.yaml").exists():
+            return PatternLibrary.load([code_dir / "concurrency.yaml"])
+        return PatternLibrary()
+
+    def test_detect_goroutine_without_waitgroup(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test detecting goroutine spawn without wait group."""
+        code = """
+func main() {
+    go func() {
+        doWork()
+    }()
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-001-CODE-GO") in ids
+
+    def test_detect_mutex_without_defer(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test detecting mutex without defer unlock."""
+        code = """
+import "sync"
+
+func process() {
+    var mu sync.Mutex
+    mu.Lock()
+    // critical section
+    mu.Unlock()
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-002-CODE-GO") in ids
+
+    def test_negative_read_lock_release_is_not_upgrade(
+        self, go_concurrency_library: PatternLibrary
+    ) -> None:
+        """Test that RUnlock after RLock is not read as a lock upgrade (CC-004)."""
+        code = """
+import "sync"
+
+type Cache struct {
+    mu    sync.RWMutex
+    items map[string]int
+}
+
+func (c *Cache) Get(key string) int {
+    c.mu.RLock()
+    v := c.items[key]
+    c.mu.RUnlock()
+    return v
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-004-CODE-GO") not in ids
+
+    def test_detect_concurrent_map_increment(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test detecting unsynchronized map increment inside a goroutine."""
+        code = """
+func tally(keys []string) map[string]int {
+    counts := make(map[string]int)
+    var wg sync.WaitGroup
+    for _, k := range keys {
+        wg.Add(1)
+        go func(k string) {
+            defer wg.Done()
+            counts[k]++
+        }(k)
+    }
+    wg.Wait()
+    return counts
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-099-CODE-GO") in ids
+
+    def test_negative_map_increment_under_mutex(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test that a mutex-guarded map increment is not flagged."""
+        code = """
+func tally(keys []string) map[string]int {
+    counts := make(map[string]int)
+    var mu sync.Mutex
+    var wg sync.WaitGroup
+    for _, k := range keys {
+        wg.Add(1)
+        go func(k string) {
+            defer wg.Done()
+            mu.Lock()
+            counts[k]++
+            mu.Unlock()
+        }(k)
+    }
+    wg.Wait()
+    return counts
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-099-CODE-GO") not in ids
+
+    def test_detect_waitgroup_reused_across_phases(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test detecting a WaitGroup reused for a second phase."""
+        code = """
+func migrate(tables, indexes []string) {
+    var wg sync.WaitGroup
+    for _, t := range tables {
+        wg.Add(1)
+        go func(t string) {
+            defer wg.Done()
+            copyTable(t)
+        }(t)
+    }
+    wg.Wait()
+
+    for _, idx := range indexes {
+        wg.Add(1)
+        go func(idx string) {
+            defer wg.Done()
+            buildIndex(idx)
+        }(idx)
+    }
+    wg.Wait()
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-102-CODE-GO") in ids
+
+    def test_detect_waitgroup_add_after_concurrent_wait(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test detecting Add after a goroutine started waiting."""
+        code = """
+func fanOut(jobs <-chan Job, results chan<- Result) {
+    var wg sync.WaitGroup
+    wg.Add(1)
+    go produce(&wg)
+    go func() {
+        wg.Wait()
+        close(results)
+    }()
+    for j := range jobs {
+        wg.Add(1)
+        go work(j, &wg, results)
+    }
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-102-CODE-GO") in ids
+
+    def test_negative_fresh_waitgroup_per_phase(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test that re-creating the WaitGroup per phase is not flagged."""
+        code = """
+func migrate(tables, indexes []string) {
+    var wg sync.WaitGroup
+    for _, t := range tables {
+        wg.Add(1)
+        go func(t string) {
+            defer wg.Done()
+            copyTable(t)
+        }(t)
+    }
+    wg.Wait()
+
+    wg = sync.WaitGroup{}
+    for _, idx := range indexes {
+        wg.Add(1)
+        go func(idx string) {
+            defer wg.Done()
+            buildIndex(idx)
+        }(idx)
+    }
+    wg.Wait()
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-102-CODE-GO") not in ids
+
+    def test_negative_separate_waitgroups(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test that one WaitGroup per phase is not flagged."""
+        code = """
+func migrate(tables, indexes []string) {
+    var tablesWG sync.WaitGroup
+    for _, t := range tables {
+        tablesWG.Add(1)
+        go copyTable(t, &tablesWG)
+    }
+    tablesWG.Wait()
+
+    var indexWG sync.WaitGroup
+    for _, idx := range indexes {
+        indexWG.Add(1)
+        go buildIndex(idx, &indexWG)
+    }
+    indexWG.Wait()
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-102-CODE-GO") not in ids
+
+    def test_waitgroup_reuse_confidence_stays_low(
+        self, go_concurrency_library: PatternLibrary
+    ) -> None:
+        """Test that sequential WaitGroup reuse is reported with partial confidence."""
+        code = """
+var wg sync.WaitGroup
+wg.Add(1)
+go step(&wg)
+wg.Wait()
+wg.Add(1)
+go step(&wg)
+wg.Wait()
+"""
+        matcher = PatternMatcher(go_concurrency_library.get_patterns())
+        results = {r.pattern.id: r for r in matcher.match(code)}
+
+        assert results[PatternId("CC-102-CODE-GO")].confidence == pytest.approx(2 / 3)
+
+    def test_waitgroup_reuse_full_match_is_capped(
+        self, go_concurrency_library: PatternLibrary
+    ) -> None:
+        """Test that a WaitGroup reused after a waiting goroutine stays at its cap."""
+        code = """
+var wg sync.WaitGroup
+wg.Add(1)
+go step(&wg)
+go func() { wg.Wait() }()
+wg.Add(1)
+go step(&wg)
+wg.Wait()
+"""
+        matcher = PatternMatcher(go_concurrency_library.get_patterns())
+        result = {r.pattern.id: r for r in matcher.match(code)}[PatternId("CC-102-CODE-GO")]
+
+        assert not result.unmatched_signals
+        assert result.confidence == 0.7
+
+    def test_detect_mutex_field_never_locked(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test detecting a mutex field with no Lock call sites."""
+        code = """
+type Cache struct {
+    mu      sync.RWMutex
+    entries map[string][]byte
+}
+
+func (c *Cache) Get(key string) []byte {
+    return c.entries[key]
+}
+
+func (c *Cache) Set(key string, value []byte) {
+    c.entries[key] = value
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-104-CODE-GO") in ids
+
+    def test_negative_mutex_field_locked(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test that a locked mutex field is not flagged."""
+        code = """
+type Cache struct {
+    mu      sync.RWMutex
+    entries map[string][]byte
+}
+
+func (c *Cache) Get(key string) []byte {
+    c.mu.RLock()
+    defer c.mu.RUnlock()
+    return c.entries[key]
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-104-CODE-GO") not in ids
+
+    def test_negative_mutex_locked_before_declaration(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test that Lock calls above the struct count."""
+        code = """
+func (c *Counter) Inc() {
+    c.lock.Lock()
+    c.n++
+    c.lock.Unlock()
+}
+
+type Counter struct {
+    lock sync.Mutex
+    n    int
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-104-CODE-GO") not in ids
+
+    def test_detect_fake_singleton(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test detecting a documented singleton that returns a fresh value."""
+        code = """
+// GetInstance returns the shared registry.
+func GetInstance() *Registry {
+    return &Registry{items: map[string]Item{}}
+}
+"""
+        matcher = PatternMatcher(go_concurrency_library.get_patterns())
+        results = {r.pattern.id: r for r in matcher.match(code)}
+
+        assert not results[PatternId("CC-106-CODE-GO")].unmatched_signals
+        assert results[PatternId("CC-106-CODE-GO")].confidence == 0.7
+
+    def test_detect_fake_singleton_by_name(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test detecting a Shared* constructor building a new value."""
+        code = """
+func SharedClient() *Client {
+    c := &Client{timeout: 5 * time.Second}
+    return &Client{timeout: c.timeout}
+}
+"""
+        matcher = PatternMatcher(go_concurrency_library.get_patterns())
+        results = {r.pattern.id: r for r in matcher.match(code)}
+
+        assert results[PatternId("CC-106-CODE-GO")].confidence == pytest.approx(2 / 3)
+
+    def test_negative_sync_once_singleton(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test that a sync.Once singleton is not flagged."""
+        code = """
+var (
+    registryOnce sync.Once
+    registry     *Registry
+)
+
+// GetInstance returns the shared registry.
+func GetInstance() *Registry {
+    registryOnce.Do(func() {
+        registry = &Registry{items: map[string]Item{}}
+    })
+    return registry
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-106-CODE-GO") not in ids
+
+    def test_negative_plain_constructor(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test that New* constructors are not flagged."""
+        code = """
+func NewRegistry() *Registry {
+    return &Registry{items: map[string]Item{}}
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-106-CODE-GO") not in ids
+
+    def test_detect_rlock_early_return(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test detecting an early return that skips RUnlock."""
+        code = """
+type Cache struct {
+    mu    sync.RWMutex
+    items map[string]*Item
+}
+
+func (c *Cache) Get(key string) (*Item, error) {
+    c.mu.RLock()
+    item, ok := c.items[key]
+    if !ok {
+        return nil, ErrNotFound
+    }
+    c.mu.RUnlock()
+    return item, nil
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-009-CODE-GO") in ids
+        # Read-lock-only code is not a lock upgrade
+        assert PatternId("CC-004-CODE-GO") not in ids
+        assert PatternId("CC-002-CODE-GO") not in ids
+
+    def test_negative_deferred_runlock(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test that a deferred RUnlock is not flagged."""
+        code = """
+type Cache struct {
+    mu    sync.RWMutex
+    items map[string]*Item
+}
+
+func (c *Cache) Get(key string) (*Item, error) {
+    c.mu.RLock()
+    defer c.mu.RUnlock()
+    item, ok := c.items[key]
+    if !ok {
+        return nil, ErrNotFound
+    }
+    return item, nil
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-009-CODE-GO") not in ids
+
+    def test_negative_runlock_before_return(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test that releasing before the early return is not flagged."""
+        code = """
+type Cache struct {
+    mu    sync.RWMutex
+    items map[string]*Item
+}
+
+func (c *Cache) Get(key string) (*Item, error) {
+    c.mu.RLock()
+    item, ok := c.items[key]
+    c.mu.RUnlock()
+    if !ok {
+        return nil, ErrNotFound
+    }
+    return item, nil
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-009-CODE-GO") not in ids
+
+    def test_negative_runlock_in_other_function(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test that matching does not cross function boundaries."""
+        code = """
+func (c *Cache) beginRead() *Cache {
+    c.mu.RLock()
+    return c
+}
+
+func (c *Cache) endRead() {
+    c.mu.RUnlock()
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-009-CODE-GO") not in ids
+
+    def test_cc113_read_before_lock(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-113 detects a field read before Lock and updated under it."""
+        code = """
+func (s *Store) Incr() {
+    n := s.count
+    s.mu.Lock()
+    s.count = n + 1
+    s.mu.Unlock()
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-113-CODE-GO") in ids
+
+    def test_cc113_read_before_deferred_unlock(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-113 treats a deferred Unlock as holding the lock."""
+        code = """
+func (s *Store) Add(k string) {
+    items := s.items
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    s.items[k] = len(items)
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-113-CODE-GO") in ids
+
+    def test_cc113_read_under_lock_safe(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-113 ignores reads made entirely under the lock."""
+        code = """
+func (s *Store) Incr() {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    n := s.count
+    s.count = n + 1
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-113-CODE-GO") not in ids
+
+    def test_cc113_unrelated_field_safe(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-113 ignores a pre-lock read of a field not used under the lock."""
+        code = """
+func (s *Store) Incr(delta int) {
+    name := s.name
+    s.mu.Lock()
+    s.count += delta
+    s.mu.Unlock()
+    log.Println(name)
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-113-CODE-GO") not in ids
+
+    def test_cc115_unjoined_goroutine_logging(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-115 detects t.Errorf in a goroutine the test never waits for."""
+        code = """
+package fetch
+
+func TestFetch(t *testing.T) {
+    go func() {
+        if err := fetch(); err != nil {
+            t.Errorf("fetch: %v", err)
+        }
+    }()
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-115-CODE-GO") in ids
+
+    def test_cc115_joined_goroutine_safe(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-115 does not flag a goroutine joined with a WaitGroup before return."""
+        code = """
+package fetch
+
+func TestFetch(t *testing.T) {
+    var wg sync.WaitGroup
+    wg.Add(1)
+    go func() {
+        defer wg.Done()
+        if err := fetch(); err != nil {
+            t.Errorf("fetch: %v", err)
+        }
+    }()
+    wg.Wait()
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-115-CODE-GO") not in ids
+
+    def test_cc117_misaligned_atomic_field(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-117 detects an atomically accessed int64 that is not the first struct field."""
+        code = """
+package stats
+
+type stats struct {
+    ok   bool
+    hits int64
+}
+
+func (s *stats) hit() {
+    atomic.AddInt64(&s.hits, 1)
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-117-CODE-GO") in ids
+
+    def test_cc117_first_field_atomic_safe(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-117 does not flag an atomic int64 placed first in its struct."""
+        code = """
+package stats
+
+type stats struct {
+    hits int64
+    ok   bool
+}
+
+func (s *stats) hit() {
+    atomic.AddInt64(&s.hits, 1)
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-117-CODE-GO") not in ids
+
+    def test_cc118_defer_close_of_parent_resource(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-118 detects a goroutine deferring Close of a resource its parent opened."""
+        code = """
+package main
+
+func upload(path string) error {
+    f, err := os.Open(path)
+    if err != nil {
+        return err
+    }
+    go func() {
+        defer f.Close()
+        send(f)
+    }()
+    return nil
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-118-CODE-GO") in ids
+
+    def test_cc118_goroutine_local_resource_safe(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-118 does not flag a goroutine closing a resource it opened itself."""
+        code = """
+package main
+
+func upload(path string) {
+    go func() {
+        f, err := os.Open(path)
+        if err != nil {
+            return
+        }
+        defer f.Close()
+        send(f)
+    }()
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-118-CODE-GO") not in ids
+
+    def test_cc039_leaked_cancel(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-039 detects a context cancel function that is never called."""
+        code = """
+package main
+
+func load(parent context.Context, id string) (*Item, error) {
+    ctx, cancel := context.WithTimeout(parent, time.Second)
+    return fetch(ctx, id)
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-039-CODE-GO") in ids
+
+    def test_cc039_deferred_cancel_safe(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-039 does not flag a deferred cancel."""
+        code = """
+package main
+
+func load(parent context.Context, id string) (*Item, error) {
+    ctx, cancel := context.WithTimeout(parent, time.Second)
+    defer cancel()
+    return fetch(ctx, id)
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-039-CODE-GO") not in ids
+
+    def test_cc120_exported_locking_channel_func(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-120 flags an exported function returning a channel set up under a lock."""
+        code = """
+package broker
+
+func (b *Broker) Subscribe(topic string) <-chan Message {
+    b.mu.Lock()
+    defer b.mu.Unlock()
+    ch := make(chan Message, 16)
+    b.subs[topic] = append(b.subs[topic], ch)
+    return ch
+}
+"""
+        matcher = PatternMatcher(go_concurrency_library.get_patterns())
+        results = {r.pattern.id: r for r in matcher.match(code)}
+
+        assert not results[PatternId("CC-120-CODE-GO")].unmatched_signals
+        assert results[PatternId("CC-120-CODE-GO")].confidence == 0.6
+
+    def test_cc120_internal_helper_safe(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-120 does not flag unexported helpers or channel parameters."""
+        code = """
+package broker
+
+func (b *Broker) subscribe(topic string) <-chan Message {
+    b.mu.Lock()
+    defer b.mu.Unlock()
+    ch := make(chan Message, 16)
+    b.subs[topic] = append(b.subs[topic], ch)
+    return ch
+}
+
+func Drain(ch chan int) int {
+    mu.Lock()
+    defer mu.Unlock()
+    return len(ch)
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-120-CODE-GO") not in ids
+
+    def test_cc120_is_opt_in(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-120 is low-confidence info and only runs when enabled."""
+        pattern = go_concurrency_library.get_pattern(PatternId("CC-120-CODE-GO"))
+
+        assert pattern is not None
+        assert pattern.opt_in
+        assert pattern.severity == Severity.INFO
+        assert pattern.max_confidence == 0.6
+
+    def test_cc121_done_not_deferred(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-121 detects wg.Done at the end of a goroutine that can panic first."""
+        code = """
+package main
+
+func process(items []Item) {
+    var wg sync.WaitGroup
+    for _, it := range items {
+        wg.Add(1)
+        go func(it Item) {
+            handle(it)
+            wg.Done()
+        }(it)
+    }
+    wg.Wait()
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-121-CODE-GO") in ids
+
+    def test_cc121_worker_function(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-121 detects a worker taking *sync.WaitGroup that calls Done last."""
+        code = """
+package main
+
+func worker(jobs <-chan Job, wg *sync.WaitGroup) {
+    for j := range jobs {
+        j.Run()
+    }
+    wg.Done()
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-121-CODE-GO") in ids
+
+    def test_cc121_deferred_done_safe(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-121 does not flag a deferred Done or a body that cannot panic."""
+        code = """
+package main
+
+func process(items []Item) {
+    var wg sync.WaitGroup
+    for _, it := range items {
+        wg.Add(1)
+        go func(it Item) {
+            defer wg.Done()
+            handle(it)
+        }(it)
+    }
+    wg.Add(1)
+    go func() {
+        count := 1
+        wg.Done()
+    }()
+    wg.Wait()
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-121-CODE-GO") not in ids
+
+    def test_cc122_uncancellable_worker_loop(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-122 detects a for/select worker loop without a ctx.Done arm."""
+        code = """
+package main
+
+func (w *Worker) Run(ctx context.Context) {
+    for {
+        select {
+        case job := <-w.jobs:
+            w.handle(ctx, job)
+        case <-w.tick.C:
+            w.flush()
+        }
+    }
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-122-CODE-GO") in ids
+
+    def test_cc122_ctx_done_arm_safe(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test CC-122 does not flag a loop whose select has a ctx.Done arm."""
+        code = """
+package main
+
+func (w *Worker) Run(ctx context.Context) error {
+    for {
+        select {
+        case job := <-w.jobs:
+            w.handle(ctx, job)
+        case <-ctx.Done():
+            return ctx.Err()
+        }
+    }
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-122-CODE-GO") not in ids
+
+    def test_negative_no_false_positives(self, go_concurrency_library: PatternLibrary) -> None:
+        """Test that safe code doesn't trigger false positives."""
+        code = """
+func safe() {
+    // No goroutines, no mutexes - should not match
+    println("safe")
+}
+"""
+        patterns = go_concurrency_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        # Should have no matches or very low confidence
+        assert len(results) == 0
+
+
+class TestGoQualityPatterns:
+    """Tests for Go quality pattern detection."""
+
+    @pytest.fixture
+    def go_quality_library(self) -> PatternLibrary:
+        """Load Go quality patterns."""
+        code_dir = (
+            Path(__file__).parent.parent.parent.parent
+            / "src"
+            / "bmad_assist"
+            / "deep_verify"
+            / "patterns"
+            / "data"
+            / "code"
+            / "go"
+        )
+        if (code_dir / "quality.yaml").exists():
+            return PatternLibrary.load([code_dir / "quality.yaml"])
+        return PatternLibrary()
+
+    def test_detect_ignored_error(self, go_quality_library: PatternLibrary) -> None:
+        """Test detecting ignored error return."""
+        # Use both patterns that match the signals (_ = and _ :=)
+        code = """
+func main() {
+    _ = doSomething()
+    _ := anotherThing()
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CQ-001-CODE-GO") in ids
+
+    def test_detect_defer_in_loop(self, go_quality_library: PatternLibrary) -> None:
+        """Test detecting defer inside loop."""
+        # Test code that contains both "for" and "defer" keywords
+        code = """
+func process() {
+    for i := 0; i < 10; i++ {
+        defer cleanup()
+    }
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        # CQ-002-CODE-GO looks for for+defer or defer+for patterns
+        assert PatternId("CQ-002-CODE-GO") in ids
+
+    def test_detect_read_buffer_used_at_full_length(self, go_quality_library: PatternLibrary) -> None:
+        """Test detecting full-length buffer use after Read."""
+        code = """
+func copyChunk(r io.Reader, w io.Writer) error {
+    buf := make([]byte, 4096)
+    n, err := r.Read(buf)
+    if err != nil {
+        return err
+    }
+    _, err = w.Write(buf)
+    return err
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-100-CODE-GO") in ids
+
+    def test_negative_read_buffer_sliced_to_n(self, go_quality_library: PatternLibrary) -> None:
+        """Test that slicing the buffer to n is not flagged."""
+        code = """
+func copyChunk(r io.Reader, w io.Writer) error {
+    buf := make([]byte, 4096)
+    n, err := r.Read(buf)
+    if err != nil {
+        return err
+    }
+    _, err = w.Write(buf[:n])
+    return err
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-100-CODE-GO") not in ids
+
+    def test_negative_read_full(self, go_quality_library: PatternLibrary) -> None:
+        """Test that io.ReadFull is not flagged."""
+        code = """
+func readHeader(r io.Reader) ([]byte, error) {
+    buf := make([]byte, 16)
+    if _, err := io.ReadFull(r, buf); err != nil {
+        return nil, err
+    }
+    return parse(buf), nil
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-100-CODE-GO") not in ids
+
+    def test_detect_swapped_month_day_layout(self, go_quality_library: PatternLibrary) -> None:
+        """Test detecting a 2006-02-01 layout."""
+        code = """
+func day(s string) (time.Time, error) {
+    return time.Parse("2006-02-01", s)
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-107-CODE-GO") in ids
+
+    def test_detect_non_reference_layout_number(self, go_quality_library: PatternLibrary) -> None:
+        """Test detecting 30 used for minutes."""
+        code = """
+func stamp(t time.Time) string {
+    return t.Format("15:30:05")
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-107-CODE-GO") in ids
+
+    def test_detect_java_style_layout(self, go_quality_library: PatternLibrary) -> None:
+        """Test detecting yyyy-MM-dd style layouts."""
+        code = """
+func stamp(t time.Time) string {
+    return t.Format("yyyy-MM-dd HH:mm:ss")
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-107-CODE-GO") in ids
+
+    def test_negative_reference_layout(self, go_quality_library: PatternLibrary) -> None:
+        """Test that a correct layout is not flagged."""
+        code = """
+func stamp(t time.Time) string {
+    return t.Format("2006-01-02 15:04:05")
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-107-CODE-GO") not in ids
+
+    def test_negative_custom_valid_layout(self, go_quality_library: PatternLibrary) -> None:
+        """Test that unusual but valid layouts are not flagged."""
+        code = """
+func stamp(t time.Time) string {
+    return t.Format("Mon, 02 Jan 2006 3:04PM MST -07:00 .000")
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-107-CODE-GO") not in ids
+
+    def test_negative_compact_layout(self, go_quality_library: PatternLibrary) -> None:
+        """Test that separator-free layouts are not flagged."""
+        code = """
+func stamp(t time.Time) string {
+    return t.Format("20060102T150405Z0700")
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-107-CODE-GO") not in ids
+
+    def test_detect_append_to_ranged_slice(self, go_quality_library: PatternLibrary) -> None:
+        """Test detecting append to the slice being ranged over."""
+        code = """
+func expandAll(nodes []Node) []Node {
+    for i := range nodes {
+        if nodes[i].HasChildren() {
+            nodes = append(nodes, nodes[i].Children...)
+        }
+    }
+    return nodes
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-108-CODE-GO") in ids
+
+    def test_detect_append_to_ranged_field(self, go_quality_library: PatternLibrary) -> None:
+        """Test detecting append to a ranged struct field."""
+        code = """
+func (w *Walker) grow() {
+    for _, n := range w.queue {
+        w.queue = append(w.queue, n.Next)
+    }
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-108-CODE-GO") in ids
+
+    def test_negative_append_to_different_slice(self, go_quality_library: PatternLibrary) -> None:
+        """Test that appending to another slice is not flagged."""
+        code = """
+func expandAll(nodes []Node) []Node {
+    var out []Node
+    for i := range nodes {
+        out = append(out, nodes[i].Children...)
+    }
+    return out
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-108-CODE-GO") not in ids
+
+    def test_negative_index_loop(self, go_quality_library: PatternLibrary) -> None:
+        """Test that index-based manual loops are not flagged."""
+        code = """
+func expandAll(nodes []Node) []Node {
+    for i := 0; i < len(nodes); i++ {
+        nodes = append(nodes, nodes[i].Children...)
+    }
+    return nodes
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-108-CODE-GO") not in ids
+
+    def test_negative_append_after_loop(self, go_quality_library: PatternLibrary) -> None:
+        """Test that appending after the loop is not flagged."""
+        code = """
+func expandAll(nodes []Node) []Node {
+    for i := range nodes {
+        process(nodes[i])
+    }
+    nodes = append(nodes, extra)
+    return nodes
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-108-CODE-GO") not in ids
 
-    def test_get_patterns_without_language_filter(self, library_with_mixed_patterns: PatternLibrary) -> None:
-        """Test that all patterns returned when no language filter."""
-        patterns = library_with_mixed_patterns.get_patterns()
-        assert len(patterns) == 6  # 2 spec + 2 Go + 2 Python
+    def test_detect_embedded_interface(self, go_quality_library: PatternLibrary) -> None:
+        """Test detecting a struct embedding an interface declared earlier."""
+        code = """
+type Store interface {
+    Get(key string) ([]byte, error)
+}
 
-    def test_get_patterns_with_go_language(self, library_with_mixed_patterns: PatternLibrary) -> None:
-        """Test filtering patterns by Go language."""
-        patterns = library_with_mixed_patterns.get_patterns(language="go")
-        # Should return spec patterns (None) + Go patterns
-        assert len(patterns) == 4  # 2 spec + 2 Go
+type LoggingStore struct {
+    Store
+    log *slog.Logger
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
 
-        # Verify Go patterns are included
-        ids = {p.id for p in patterns}
-        assert "CC-001-CODE-GO" in ids
-        assert "SEC-001-CODE-GO" in ids
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-109-CODE-GO") in ids
 
-        # Verify Python patterns are excluded
-        assert "CC-001-CODE-PY" not in ids
+    def test_detect_embedded_interface_declared_later(self, go_quality_library: PatternLibrary) -> None:
+        """Test detecting a struct embedding an interface declared later."""
+        code = """
+type LoggingStore struct {
+    log *slog.Logger
+    Store // promoted Get and Put
+}
 
-    def test_get_patterns_with_python_language(self, library_with_mixed_patterns: PatternLibrary) -> None:
-        """Test filtering patterns by Python language."""
-        patterns = library_with_mixed_patterns.get_patterns(language="python")
-        # Should return spec patterns (None) + Python patterns
-        assert len(patterns) == 4  # 2 spec + 2 Python
+type Store interface {
+    Get(key string) ([]byte, error)
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
 
-        ids = {p.id for p in patterns}
-        assert "CC-001-CODE-PY" in ids
-        assert "CQ-001-CODE-PY" in ids
-        assert "CC-001-CODE-GO" not in ids
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-109-CODE-GO") in ids
 
-    def test_get_patterns_with_domain_and_language(self, library_with_mixed_patterns: PatternLibrary) -> None:
-        """Test filtering by both domain and language."""
-        patterns = library_with_mixed_patterns.get_patterns(
-            domains=[ArtifactDomain.CONCURRENCY],
-            language="go"
-        )
-        # Should return spec concurrency + Go concurrency
-        assert len(patterns) == 2
+    def test_detect_embedded_stdlib_interface(self, go_quality_library: PatternLibrary) -> None:
+        """Test detecting a struct embedding a standard library interface."""
+        code = """
+type countingReader struct {
+    io.Reader
+    n int64
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
 
-        ids = {p.id for p in patterns}
-        assert "CC-001" in ids
-        assert "CC-001-CODE-GO" in ids
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-109-CODE-GO") in ids
 
-    def test_get_patterns_case_insensitive_language(self, library_with_mixed_patterns: PatternLibrary) -> None:
-        """Test that language matching is case-insensitive."""
-        # Test various case variations
-        for lang in ["go", "Go", "GO", "gO"]:
-            patterns = library_with_mixed_patterns.get_patterns(language=lang)
-            ids = {p.id for p in patterns}
-            assert "CC-001-CODE-GO" in ids, f"Failed for language={lang}"
+    def test_negative_embedded_concrete_type(self, go_quality_library: PatternLibrary) -> None:
+        """Test that embedded concrete types are not flagged."""
+        code = """
+type Base struct {
+    id string
+}
 
-    def test_get_patterns_python_alias(self, library_with_mixed_patterns: PatternLibrary) -> None:
-        """Test that 'py' alias works for python."""
-        patterns = library_with_mixed_patterns.get_patterns(language="py")
-        ids = {p.id for p in patterns}
-        assert "CC-001-CODE-PY" in ids
+type Cache struct {
+    sync.Mutex
+    Base
+    items map[string]string
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
 
-    def test_get_patterns_unknown_language_logs_warning(
-        self, library_with_mixed_patterns: PatternLibrary, caplog: pytest.LogCaptureFixture
-    ) -> None:
-        """Test that unknown language logs warning and returns spec patterns only."""
-        import logging
-        with caplog.at_level(logging.WARNING):
-            patterns = library_with_mixed_patterns.get_patterns(language="rust")
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-109-CODE-GO") not in ids
 
-        # Should only return spec patterns (no Rust patterns defined)
-        assert len(patterns) == 2
-        assert all(p.language is None for p in patterns)
+    def test_negative_named_interface_field(self, go_quality_library: PatternLibrary) -> None:
+        """Test that named interface fields are not flagged."""
+        code = """
+type Store interface {
+    Get(key string) ([]byte, error)
+}
 
-        # Verify warning was logged
-        assert "No code patterns found" in caplog.text
+type LoggingStore struct {
+    store Store
+    r     io.Reader
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
 
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-109-CODE-GO") not in ids
 
-# =============================================================================
-# Go Pattern Matching Tests
-# =============================================================================
+    def test_negative_interface_embedding_interface(self, go_quality_library: PatternLibrary) -> None:
+        """Test that interfaces embedding interfaces are not flagged."""
+        code = """
+type Reader interface {
+    Read(p []byte) (int, error)
+}
 
+type ReadCloser interface {
+    Reader
+    Close() error
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
 
-class TestGoConcurrencyPatterns:
-    """Tests for Go concurrency pattern detection."""
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-109-CODE-GO") not in ids
 
-    @pytest.fixture
-    def go_concurrency_library(self) -> PatternLibrary:
-        """Load Go concurrency patterns."""
-        code_dir = (
-            Path(__file__).parent.parent.parent.parent
-            / "src"
-            / "bmad_assist"
-            / "deep_verify"
-            / "patterns"
-            / "data"
-            / "code"
-            / "go"
-        )
-        if (code_dir / "concurrency.yaml").exists():
-            return PatternLibrary.load([code_dir / "concurrency.yaml"])
-        return PatternLibrary()
+    def test_detect_errorf_v_on_error(self, go_quality_library: PatternLibrary) -> None:
+        """Test detecting %v used to format an error."""
+        code = """
+func load(path string) (*Config, error) {
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return nil, fmt.Errorf("read config %s: %v", path, err)
+    }
+    return parse(data)
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
 
-    def test_detect_goroutine_without_waitgroup(self, go_concurrency_library: PatternLibrary) -> None:
-        """Test detecting goroutine spawn without wait group."""
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-110-CODE-GO") in ids
+
+    def test_detect_errorf_s_on_named_error(self, go_quality_library: PatternLibrary) -> None:
+        """Test detecting %s used on an error variable."""
         code = """
-func main() {
-    go func() {
-        doWork()
-    }()
+func (c *Client) Close() error {
+    if closeErr := c.conn.Close(); closeErr != nil {
+        return fmt.Errorf("close connection: %s", closeErr)
+    }
+    return nil
 }
 """
-        patterns = go_concurrency_library.get_patterns()
+        patterns = go_quality_library.get_patterns()
         matcher = PatternMatcher(patterns)
         results = matcher.match(code)
 
         ids = {r.pattern.id for r in results}
-        assert PatternId("CC-001-CODE-GO") in ids
+        assert PatternId("CC-110-CODE-GO") in ids
 
-    def test_detect_mutex_without_defer(self, go_concurrency_library: PatternLibrary) -> None:
-        """Test detecting mutex without defer unlock."""
+    def test_detect_errorf_multiline_ctx_err(self, go_quality_library: PatternLibrary) -> None:
+        """Test detecting ctx.Err() formatted on a continuation line."""
         code = """
-import "sync"
+func wait(ctx context.Context) error {
+    <-ctx.Done()
+    return fmt.Errorf("wait aborted: %v",
+        ctx.Err())
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
 
-func process() {
-    var mu sync.Mutex
-    mu.Lock()
-    // critical section
-    mu.Unlock()
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-110-CODE-GO") in ids
+
+    def test_negative_errorf_w_on_error(self, go_quality_library: PatternLibrary) -> None:
+        """Test that %w wrapping is not flagged."""
+        code = """
+func load(path string) (*Config, error) {
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return nil, fmt.Errorf("read config %s: %w", path, err)
+    }
+    return parse(data)
 }
 """
-        patterns = go_concurrency_library.get_patterns()
+        patterns = go_quality_library.get_patterns()
         matcher = PatternMatcher(patterns)
         results = matcher.match(code)
 
         ids = {r.pattern.id for r in results}
-        assert PatternId("CC-002-CODE-GO") in ids
+        assert PatternId("CC-110-CODE-GO") not in ids
 
-    def test_negative_no_false_positives(self, go_concurrency_library: PatternLibrary) -> None:
-        """Test that safe code doesn't trigger false positives."""
+    def test_negative_errorf_v_on_non_error(self, go_quality_library: PatternLibrary) -> None:
+        """Test that %v on non-error values is not flagged."""
         code = """
-func safe() {
-    // No goroutines, no mutexes - should not match
-    println("safe")
+func validate(port int, host string) error {
+    if port == 0 {
+        return fmt.Errorf("invalid port %v for host %s", port, host)
+    }
+    return nil
 }
 """
-        patterns = go_concurrency_library.get_patterns()
+        patterns = go_quality_library.get_patterns()
         matcher = PatternMatcher(patterns)
         results = matcher.match(code)
 
-        # Should have no matches or very low confidence
-        assert len(results) == 0
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-110-CODE-GO") not in ids
 
+    def test_negative_errorf_error_string(self, go_quality_library: PatternLibrary) -> None:
+        """Test that err.Error() strings are not flagged."""
+        code = """
+func report(err error) error {
+    return fmt.Errorf("upstream said: %s", err.Error())
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
 
-class TestGoQualityPatterns:
-    """Tests for Go quality pattern detection."""
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-110-CODE-GO") not in ids
 
-    @pytest.fixture
-    def go_quality_library(self) -> PatternLibrary:
-        """Load Go quality patterns."""
-        code_dir = (
-            Path(__file__).parent.parent.parent.parent
-            / "src"
-            / "bmad_assist"
-            / "deep_verify"
-            / "patterns"
-            / "data"
-            / "code"
-            / "go"
-        )
-        if (code_dir / "quality.yaml").exists():
-            return PatternLibrary.load([code_dir / "quality.yaml"])
-        return PatternLibrary()
+    def test_cc116_value_returned_with_error(self, go_quality_library: PatternLibrary) -> None:
+        """Test CC-116 detects a non-nil value returned together with the error."""
+        code = """
+package store
 
-    def test_detect_ignored_error(self, go_quality_library: PatternLibrary) -> None:
-        """Test detecting ignored error return."""
-        # Use both patterns that match the signals (_ = and _ :=)
+func Load(id string) (*User, error) {
+    u, err := db.Find(id)
+    if err != nil {
+        return u, err
+    }
+    return u, nil
+}
+"""
+        patterns = go_quality_library.get_patterns()
+        matcher = PatternMatcher(patterns)
+        results = matcher.match(code)
+
+        ids = {r.pattern.id for r in results}
+        assert PatternId("CC-116-CODE-GO") in ids
+
+    def test_cc116_nil_error_on_error_path(self, go_quality_library: PatternLibrary) -> None:
+        """Test CC-116 detects a nil error returned from an error path."""
         code = """
-func main() {
-    _ = doSomething()
-    _ := anotherThing()
+package config
+
+func Parse(data []byte) (*Config, error) {
+    cfg, err := parse(data)
+    if err != nil {
+        return nil, nil
+    }
+    return cfg, nil
 }
 """
         patterns = go_quality_library.get_patterns()
@@ -414,16 +1781,27 @@ func main() {
         results = matcher.match(code)
 
         ids = {r.pattern.id for r in results}
-        assert PatternId("CQ-001-CODE-GO") in ids
+        assert PatternId("CC-116-CODE-GO") in ids
 
-    def test_detect_defer_in_loop(self, go_quality_library: PatternLibrary) -> None:
-        """Test detecting defer inside loop."""
-        # Test code that contains both "for" and "defer" keywords
+    def test_cc116_conventional_returns_safe(self, go_quality_library: PatternLibrary) -> None:
+        """Test CC-116 does not flag (value, nil) and (zero, err) returns."""
         code = """
-func process() {
-    for i := 0; i < 10; i++ {
-        defer cleanup()
+package store
+
+func Load(id string) (*User, error) {
+    u, err := db.Find(id)
+    if err != nil {
+        return nil, err
+    }
+    return u, nil
+}
+
+func Count(q string) (int, error) {
+    n, err := db.Count(q)
+    if err != nil {
+        return 0, fmt.Errorf("count: %w", err)
     }
+    return n, nil
 }
 """
         patterns = go_quality_library.get_patterns()
@@ -431,8 +1809,7 @@ func process() {
         results = matcher.match(code)
 
         ids = {r.pattern.id for r in results}
-        # CQ-002-CODE-GO looks for for+defer or defer+for patterns
-        assert PatternId("CQ-002-CODE-GO") in ids
+        assert PatternId("CC-116-CODE-GO") not in ids
 
     def test_negative_proper_error_handling(self, go_quality_library: PatternLibrary) -> None:
         """Test that proper error handling doesn't trigger."""
@@ -818,3 +2195,25 @@ class TestPatternSerialization:
         assert restored.domain == original.domain
         assert restored.severity == original.severity
         assert restored.language == original.language
+
+    def test_roundtrip_serialization_with_fix(self) -> None:
+        """Test roundtrip serialization of Pattern with a fix."""
+        from bmad_assist.deep_verify.core.types import (
+            Pattern, PatternFix, serialize_pattern, deserialize_pattern
+        )
+
+        original = Pattern(
+            id=PatternId("CC-039-CODE-GO"),
+            domain=ArtifactDomain.CONCURRENCY,
+            signals=[],
+            severity=Severity.WARNING,
+            language="go",
+            fix=PatternFix(find=r"^([ \t]+)(\w+)$", replace=r"\g<0>\n\1defer \2()"),
+        )
+
+        data = serialize_pattern(original)
+        restored = deserialize_pattern(data)
+
+        assert data["fix"] == {"find": r"^([ \t]+)(\w+)$", "replace": r"\g<0>\n\1defer \2()"}
+        assert restored.fix == original.fix
+        assert deserialize_pattern({**data, "fix": None}).fix is None
@@ -0,0 +1,150 @@
+"""Tests for pattern explanations."""
+
+from pathlib import Path
+
+import pytest
+import yaml
+
+from bmad_assist.core.exceptions import PatternNotFoundError
+from bmad_assist.deep_verify.core.types import PatternId, Severity
+from bmad_assist.deep_verify.patterns.explain import (
+    NO_EXAMPLE,
+    NO_HELP_URL,
+    NO_RATIONALE,
+    explain_pattern,
+    format_explanation,
+)
+from bmad_assist.deep_verify.patterns.library import (
+    PATTERN_API_VERSION,
+    PatternLibrary,
+    get_default_pattern_library,
+)
+from bmad_assist.deep_verify.scan import known_patterns
+
+
+class TestExplainPattern:
+    """Tests for explain_pattern()."""
+
+    def test_explain_every_registered_pattern(self) -> None:
+        """Test that every library pattern and built-in check can be explained."""
+        library = get_default_pattern_library()
+        patterns = known_patterns(library)
+        assert {"CC-103", "CC-111-CODE-GO", "CC-153-CODE-GO"} <= {p.id for p in patterns}
+        for pattern in patterns:
+            explanations = explain_pattern(pattern.id, library)
+            assert explanations[0].pattern_id == pattern.id
+
+            text = format_explanation(explanations[0])
+            assert text.startswith(f"{pattern.id}: ")
+            assert "Why it matters:" in text
+            assert "Bad example:" in text
+            assert "Good example:" in text
+            assert "Help:" in text
+
+    def test_explain_includes_examples(self) -> None:
+        """Test that example snippets from metadata are included."""
+        [explanation] = explain_pattern("CC-004-CODE-GO")
+
+        assert explanation.title == "RWMutex read lock held while attempting to acquire write lock"
+        assert explanation.severity == Severity.CRITICAL
+        assert explanation.language == "go"
+        assert explanation.rationale is not None
+        assert explanation.bad_example is not None
+        assert "mu.RLock()" in explanation.bad_example
+        assert explanation.good_example is not None
+        assert "mu.RUnlock()" in explanation.good_example
+
+    def test_explain_base_id_resolves_code_variants(self) -> None:
+        """Test that a base ID explains the spec pattern and its code variants."""
+        explanations = explain_pattern("CC-004")
+
+        ids = [e.pattern_id for e in explanations]
+        assert ids == [
+            PatternId("CC-004"),
+            PatternId("CC-004-CODE-GO"),
+            PatternId("CC-004-CODE-PY"),
+        ]
+
+    def test_explain_is_case_insensitive(self) -> None:
+        """Test that pattern IDs are normalized."""
+        [explanation] = explain_pattern(" cc-099-code-go ")
+        assert explanation.pattern_id == PatternId("CC-099-CODE-GO")
+
+    def test_explain_unknown_id_raises(self) -> None:
+        """Test that unknown IDs raise PatternNotFoundError."""
+        with pytest.raises(PatternNotFoundError, match="Unknown pattern ID 'ZZ-999'") as exc_info:
+            explain_pattern("ZZ-999")
+        assert exc_info.value.pattern_id == "ZZ-999"
+
+    def test_explain_without_metadata_uses_placeholders(self, tmp_path: Path) -> None:
+        """Test that missing optional metadata is reported as unavailable."""
+        pattern_file = tmp_path / "patterns.yaml"
+        pattern_file.write_text(
+            yaml.dump(
+                {
+                    "api_version": PATTERN_API_VERSION,
+                    "patterns": [
+                        {
+                            "id": "CC-001",
+                            "domain": "concurrency",
+                            "severity": "error",
+                            "signals": ["race condition"],
+                        }
+                    ]
+                }
+            )
+        )
+        library = PatternLibrary.load([pattern_file])
+
+        [explanation] = explain_pattern("CC-001", library)
+        text = format_explanation(explanation)
+
+        assert explanation.title == "Pattern CC-001"
+        assert NO_RATIONALE in text
+        assert text.count(NO_EXAMPLE) == 2
+        assert NO_HELP_URL in text
+        assert "Remediation:" not in text
+
+    def test_explain_with_help_url(self, tmp_path: Path) -> None:
+        """Test that a help URL from metadata is printed."""
+        pattern_file = tmp_path / "patterns.yaml"
+        pattern_file.write_text(
+            yaml.dump(
+                {
+                    "api_version": PATTERN_API_VERSION,
+                    "patterns": [
+                        {
+                            "id": "CC-001",
+                            "domain": "concurrency",
+                            "severity": "error",
+                            "signals": ["race condition"],
+                            "description": "Race condition - shared state",
+                            "help_url": "https://example.com/cc-001",
+                        }
+                    ]
+                }
+            )
+        )
+        library = PatternLibrary.load([pattern_file])
+
+        [explanation] = explain_pattern("CC-001", library)
+
+        assert explanation.title == "Race condition"
+        assert "Help: https://example.com/cc-001" in format_explanation(explanation)
+
+    def test_explain_file_limited_pattern(self) -> None:
+        """Test that patterns limited to certain files list the globs."""
+        [explanation] = explain_pattern("CC-115-CODE-GO")
+
+        assert explanation.files == ("*_test.go",)
+        assert "Files: *_test.go" in format_explanation(explanation)
+
+    def test_explain_fixable_pattern(self) -> None:
+        """Test that patterns with an automatic fix say so."""
+        [fixable] = explain_pattern("CC-039-CODE-GO")
+        [manual] = explain_pattern("CC-115-CODE-GO")
+
+        assert fixable.fixable
+        assert "Automatic fix: yes (verify fix)" in format_explanation(fixable)
+        assert not manual.fixable
+        assert "Automatic fix" not in format_explanation(manual)
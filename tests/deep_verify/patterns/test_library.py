@@ -8,11 +8,15 @@ import yaml
 from bmad_assist.core.exceptions import PatternLibraryError, PatternNotFoundError
 from bmad_assist.deep_verify.core.types import (
     ArtifactDomain,
+    PatternEffort,
+    PatternFix,
     PatternId,
+    PatternPrecision,
     Severity,
     Signal,
 )
 from bmad_assist.deep_verify.patterns.library import (
+    PATTERN_API_VERSION,
     PatternLibrary,
     _parse_yaml_signal,
     PATTERN_ID_REGEX,
@@ -88,6 +92,7 @@ class TestPatternLibraryLoad:
         """Create a temporary YAML file with valid patterns."""
         yaml_file = tmp_path / "test_patterns.yaml"
         data = {
+            "api_version": PATTERN_API_VERSION,
             "patterns": [
                 {
                     "id": "CC-001",
@@ -118,6 +123,7 @@ class TestPatternLibraryLoad:
         # File 1
         file1 = patterns_dir / "concurrency.yaml"
         data1 = {
+            "api_version": PATTERN_API_VERSION,
             "patterns": [
                 {
                     "id": "CC-001",
@@ -132,6 +138,7 @@ class TestPatternLibraryLoad:
         # File 2
         file2 = patterns_dir / "security.yaml"
         data2 = {
+            "api_version": PATTERN_API_VERSION,
             "patterns": [
                 {
                     "id": "SEC-001",
@@ -165,7 +172,7 @@ class TestPatternLibraryLoad:
     def test_load_no_patterns_key(self, tmp_path: Path) -> None:
         """Test loading YAML without 'patterns' key."""
         yaml_file = tmp_path / "no_patterns.yaml"
-        yaml_file.write_text(yaml.dump({"other": "data"}))
+        yaml_file.write_text(yaml.dump({"api_version": PATTERN_API_VERSION, "other": "data"}))
         library = PatternLibrary.load([yaml_file])
         assert len(library) == 0
 
@@ -188,7 +195,9 @@ class TestPatternLibraryLoad:
     def test_load_patterns_not_list(self, tmp_path: Path) -> None:
         """Test loading YAML with non-list patterns raises error."""
         yaml_file = tmp_path / "bad_patterns.yaml"
-        yaml_file.write_text(yaml.dump({"patterns": "not a list"}))
+        yaml_file.write_text(
+            yaml.dump({"api_version": PATTERN_API_VERSION, "patterns": "not a list"})
+        )
         with pytest.raises(PatternLibraryError) as exc_info:
             PatternLibrary.load([yaml_file])
         assert "list" in str(exc_info.value).lower()
@@ -198,7 +207,10 @@ class TestPatternLibraryLoad:
         yaml_file = tmp_path / "no_id.yaml"
         yaml_file.write_text(
             yaml.dump(
-                {"patterns": [{"domain": "concurrency", "severity": "critical"}]}
+                {
+                    "api_version": PATTERN_API_VERSION,
+                    "patterns": [{"domain": "concurrency", "severity": "critical"}],
+                }
             )
         )
         with pytest.raises(PatternLibraryError) as exc_info:
@@ -211,6 +223,7 @@ class TestPatternLibraryLoad:
         yaml_file.write_text(
             yaml.dump(
                 {
+                    "api_version": PATTERN_API_VERSION,
                     "patterns": [
                         {
                             "id": "invalid-id",
@@ -229,7 +242,12 @@ class TestPatternLibraryLoad:
         """Test loading pattern without domain raises error."""
         yaml_file = tmp_path / "no_domain.yaml"
         yaml_file.write_text(
-            yaml.dump({"patterns": [{"id": "CC-001", "severity": "critical"}]})
+            yaml.dump(
+                {
+                    "api_version": PATTERN_API_VERSION,
+                    "patterns": [{"id": "CC-001", "severity": "critical"}],
+                }
+            )
         )
         with pytest.raises(PatternLibraryError) as exc_info:
             PatternLibrary.load([yaml_file])
@@ -241,6 +259,7 @@ class TestPatternLibraryLoad:
         yaml_file.write_text(
             yaml.dump(
                 {
+                    "api_version": PATTERN_API_VERSION,
                     "patterns": [
                         {
                             "id": "CC-001",
@@ -259,7 +278,12 @@ class TestPatternLibraryLoad:
         """Test loading pattern without severity raises error."""
         yaml_file = tmp_path / "no_severity.yaml"
         yaml_file.write_text(
-            yaml.dump({"patterns": [{"id": "CC-001", "domain": "concurrency"}]})
+            yaml.dump(
+                {
+                    "api_version": PATTERN_API_VERSION,
+                    "patterns": [{"id": "CC-001", "domain": "concurrency"}],
+                }
+            )
         )
         with pytest.raises(PatternLibraryError) as exc_info:
             PatternLibrary.load([yaml_file])
@@ -271,6 +295,7 @@ class TestPatternLibraryLoad:
         yaml_file.write_text(
             yaml.dump(
                 {
+                    "api_version": PATTERN_API_VERSION,
                     "patterns": [
                         {
                             "id": "CC-001",
@@ -291,6 +316,7 @@ class TestPatternLibraryLoad:
         yaml_file.write_text(
             yaml.dump(
                 {
+                    "api_version": PATTERN_API_VERSION,
                     "patterns": [
                         {
                             "id": "CC-001",
@@ -306,6 +332,344 @@ class TestPatternLibraryLoad:
             PatternLibrary.load([yaml_file])
         assert "regex" in str(exc_info.value).lower()
 
+    def test_load_files_limit(self, tmp_path: Path) -> None:
+        """Test loading a pattern limited to file name globs."""
+        yaml_file = tmp_path / "files.yaml"
+        yaml_file.write_text(
+            yaml.dump(
+                {
+                    "api_version": PATTERN_API_VERSION,
+                    "patterns": [
+                        {
+                            "id": "CC-001",
+                            "domain": "concurrency",
+                            "severity": "error",
+                            "signals": ["t.Log"],
+                            "files": ["*_test.go"],
+                        }
+                    ]
+                }
+            )
+        )
+        library = PatternLibrary.load([yaml_file])
+        pattern = library.get_pattern(PatternId("CC-001"))
+
+        assert pattern is not None
+        assert pattern.files == ("*_test.go",)
+        assert pattern.applies_to("pkg/fetch_test.go")
+        assert not pattern.applies_to("pkg/fetch.go")
+
+    def test_load_invalid_files(self, tmp_path: Path) -> None:
+        """Test loading pattern with non-list files raises error."""
+        yaml_file = tmp_path / "bad_files.yaml"
+        yaml_file.write_text(
+            yaml.dump(
+                {
+                    "api_version": PATTERN_API_VERSION,
+                    "patterns": [
+                        {
+                            "id": "CC-001",
+                            "domain": "concurrency",
+                            "severity": "error",
+                            "signals": ["t.Log"],
+                            "files": {"glob": "*_test.go"},
+                        }
+                    ]
+                }
+            )
+        )
+        with pytest.raises(PatternLibraryError) as exc_info:
+            PatternLibrary.load([yaml_file])
+        assert "files" in str(exc_info.value).lower()
+
+    def test_load_precision(self, tmp_path: Path) -> None:
+        """Test loading a pattern's precision rating, which defaults to unrated."""
+        yaml_file = tmp_path / "precision.yaml"
+        yaml_file.write_text(
+            yaml.dump(
+                {
+                    "api_version": PATTERN_API_VERSION,
+                    "patterns": [
+                        {
+                            "id": "CC-001",
+                            "domain": "concurrency",
+                            "severity": "error",
+                            "signals": ["go func("],
+                            "precision": "HIGH",
+                        },
+                        {
+                            "id": "CC-002",
+                            "domain": "concurrency",
+                            "severity": "error",
+                            "signals": ["Lock()"],
+                        },
+                    ]
+                }
+            )
+        )
+        library = PatternLibrary.load([yaml_file])
+
+        rated = library.get_pattern(PatternId("CC-001"))
+        unrated = library.get_pattern(PatternId("CC-002"))
+        assert rated is not None and rated.precision == PatternPrecision.HIGH
+        assert unrated is not None and unrated.precision is None
+
+    def test_load_invalid_precision(self, tmp_path: Path) -> None:
+        """Test loading pattern with an unknown precision raises error."""
+        yaml_file = tmp_path / "bad_precision.yaml"
+        yaml_file.write_text(
+            yaml.dump(
+                {
+                    "api_version": PATTERN_API_VERSION,
+                    "patterns": [
+                        {
+                            "id": "CC-001",
+                            "domain": "concurrency",
+                            "severity": "error",
+                            "signals": ["go func("],
+                            "precision": "certain",
+                        }
+                    ]
+                }
+            )
+        )
+        with pytest.raises(PatternLibraryError) as exc_info:
+            PatternLibrary.load([yaml_file])
+        assert "precision" in str(exc_info.value).lower()
+
+    def test_load_effort(self, tmp_path: Path) -> None:
+        """Test loading a pattern's effort rating, which defaults to unrated."""
+        yaml_file = tmp_path / "effort.yaml"
+        yaml_file.write_text(
+            yaml.dump(
+                {
+                    "api_version": PATTERN_API_VERSION,
+                    "patterns": [
+                        {
+                            "id": "CC-001",
+                            "domain": "concurrency",
+                            "severity": "error",
+                            "signals": ["go func("],
+                            "effort": "Medium",
+                        },
+                        {
+                            "id": "CC-002",
+                            "domain": "concurrency",
+                            "severity": "error",
+                            "signals": ["Lock()"],
+                        },
+                    ]
+                }
+            )
+        )
+        library = PatternLibrary.load([yaml_file])
+
+        rated = library.get_pattern(PatternId("CC-001"))
+        unrated = library.get_pattern(PatternId("CC-002"))
+        assert rated is not None and rated.effort == PatternEffort.MEDIUM
+        assert unrated is not None and unrated.effort is None
+
+    def test_load_invalid_effort(self, tmp_path: Path) -> None:
+        """Test loading pattern with an unknown effort raises error."""
+        yaml_file = tmp_path / "bad_effort.yaml"
+        yaml_file.write_text(
+            yaml.dump(
+                {
+                    "api_version": PATTERN_API_VERSION,
+                    "patterns": [
+                        {
+                            "id": "CC-001",
+                            "domain": "concurrency",
+                            "severity": "error",
+                            "signals": ["go func("],
+                            "effort": "trivial",
+                        }
+                    ]
+                }
+            )
+        )
+        with pytest.raises(PatternLibraryError) as exc_info:
+            PatternLibrary.load([yaml_file])
+        assert "effort" in str(exc_info.value).lower()
+
+    def test_load_max_confidence(self, tmp_path: Path) -> None:
+        """Test loading a pattern's confidence ceiling, which defaults to none."""
+        yaml_file = tmp_path / "capped.yaml"
+        yaml_file.write_text(
+            yaml.dump(
+                {
+                    "api_version": PATTERN_API_VERSION,
+                    "patterns": [
+                        {
+                            "id": "CC-001",
+                            "domain": "concurrency",
+                            "severity": "warning",
+                            "signals": ["go func("],
+                            "max_confidence": 0.7,
+                        },
+                        {
+                            "id": "CC-002",
+                            "domain": "concurrency",
+                            "severity": "error",
+                            "signals": ["Lock()"],
+                        },
+                    ]
+                }
+            )
+        )
+        library = PatternLibrary.load([yaml_file])
+
+        capped = library.get_pattern(PatternId("CC-001"))
+        uncapped = library.get_pattern(PatternId("CC-002"))
+        assert capped is not None and capped.max_confidence == 0.7
+        assert uncapped is not None and uncapped.max_confidence is None
+
+    @pytest.mark.parametrize("value", [0, 1.5, "high", True])
+    def test_load_invalid_max_confidence(self, tmp_path: Path, value: object) -> None:
+        """Test loading a pattern with a confidence ceiling outside (0, 1] raises error."""
+        yaml_file = tmp_path / "bad_cap.yaml"
+        yaml_file.write_text(
+            yaml.dump(
+                {
+                    "api_version": PATTERN_API_VERSION,
+                    "patterns": [
+                        {
+                            "id": "CC-001",
+                            "domain": "concurrency",
+                            "severity": "warning",
+                            "signals": ["go func("],
+                            "max_confidence": value,
+                        }
+                    ]
+                }
+            )
+        )
+        with pytest.raises(PatternLibraryError, match="max_confidence"):
+            PatternLibrary.load([yaml_file])
+
+    def test_load_fix(self, tmp_path: Path) -> None:
+        """Test loading a pattern with a fix."""
+        find, replace = r"^(\s+)\w+, (\w+) :=.*$", r"\g<0>\n\1defer \2()"
+        yaml_file = tmp_path / "fix.yaml"
+        yaml_file.write_text(
+            yaml.dump(
+                {
+                    "api_version": PATTERN_API_VERSION,
+                    "patterns": [
+                        {
+                            "id": "CC-001",
+                            "domain": "concurrency",
+                            "severity": "warning",
+                            "signals": ["context.WithCancel"],
+                            "fix": {"find": find, "replace": replace},
+                        }
+                    ]
+                }
+            )
+        )
+        library = PatternLibrary.load([yaml_file])
+        pattern = library.get_pattern(PatternId("CC-001"))
+
+        assert pattern is not None
+        assert pattern.fix == PatternFix(find=find, replace=replace)
+
+    @pytest.mark.parametrize(
+        "fix",
+        [
+            "defer cancel()",
+            {"find": "cancel"},
+            {"find": "(unclosed", "replace": ""},
+        ],
+    )
+    def test_load_invalid_fix(self, tmp_path: Path, fix: object) -> None:
+        """Test loading pattern with a malformed fix raises error."""
+        yaml_file = tmp_path / "bad_fix.yaml"
+        yaml_file.write_text(
+            yaml.dump(
+                {
+                    "api_version": PATTERN_API_VERSION,
+                    "patterns": [
+                        {
+                            "id": "CC-001",
+                            "domain": "concurrency",
+                            "severity": "warning",
+                            "signals": ["context.WithCancel"],
+                            "fix": fix,
+                        }
+                    ]
+                }
+            )
+        )
+        with pytest.raises(PatternLibraryError) as exc_info:
+            PatternLibrary.load([yaml_file])
+        assert "fix" in str(exc_info.value).lower()
+
+    def test_load_matching_api_version(self, tmp_path: Path) -> None:
+        """Test that a file declaring the supported api_version loads."""
+        yaml_file = tmp_path / "plugin.yaml"
+        yaml_file.write_text(
+            yaml.dump(
+                {
+                    "api_version": PATTERN_API_VERSION,
+                    "patterns": [
+                        {
+                            "id": "CC-001",
+                            "domain": "concurrency",
+                            "severity": "error",
+                            "signals": ["go func("],
+                        }
+                    ],
+                }
+            )
+        )
+        library = PatternLibrary.load([yaml_file])
+
+        assert library.get_pattern(PatternId("CC-001")) is not None
+
+    @pytest.mark.parametrize("api_version", [PATTERN_API_VERSION + 1, 0, "1"])
+    def test_load_mismatched_api_version(self, tmp_path: Path, api_version: object) -> None:
+        """Test that a file written for another api_version is rejected."""
+        yaml_file = tmp_path / "plugin.yaml"
+        yaml_file.write_text(
+            yaml.dump(
+                {
+                    "api_version": api_version,
+                    "patterns": [
+                        {
+                            "id": "CC-001",
+                            "domain": "concurrency",
+                            "severity": "error",
+                            "signals": ["go func("],
+                        }
+                    ],
+                }
+            )
+        )
+        with pytest.raises(PatternLibraryError) as exc_info:
+            PatternLibrary.load([yaml_file])
+        assert "api_version" in str(exc_info.value)
+
+    def test_load_missing_api_version(self, tmp_path: Path) -> None:
+        """Test that a file without api_version is rejected, naming the version to declare."""
+        yaml_file = tmp_path / "plugin.yaml"
+        yaml_file.write_text(
+            yaml.dump(
+                {
+                    "patterns": [
+                        {
+                            "id": "CC-001",
+                            "domain": "concurrency",
+                            "severity": "error",
+                            "signals": ["go func("],
+                        }
+                    ],
+                }
+            )
+        )
+        with pytest.raises(PatternLibraryError) as exc_info:
+            PatternLibrary.load([yaml_file])
+        assert f"api_version: {PATTERN_API_VERSION}" in str(exc_info.value)
+
 
 class TestPatternLibraryDeduplication:
     """Tests for pattern deduplication behavior."""
@@ -316,6 +680,7 @@ class TestPatternLibraryDeduplication:
         file1.write_text(
             yaml.dump(
                 {
+                    "api_version": PATTERN_API_VERSION,
                     "patterns": [
                         {
                             "id": "CC-001",
@@ -333,6 +698,7 @@ class TestPatternLibraryDeduplication:
         file2.write_text(
             yaml.dump(
                 {
+                    "api_version": PATTERN_API_VERSION,
                     "patterns": [
                         {
                             "id": "CC-001",
@@ -365,6 +731,7 @@ class TestPatternLibraryGetPattern:
         yaml_file.write_text(
             yaml.dump(
                 {
+                    "api_version": PATTERN_API_VERSION,
                     "patterns": [
                         {
                             "id": "CC-001",
@@ -414,6 +781,7 @@ class TestPatternLibraryGetPatterns:
         yaml_file.write_text(
             yaml.dump(
                 {
+                    "api_version": PATTERN_API_VERSION,
                     "patterns": [
                         {
                             "id": "CC-001",
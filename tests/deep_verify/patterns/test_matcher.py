@@ -1,6 +1,7 @@
 """Tests for PatternMatcher class."""
 
 import re
+import threading
 
 import pytest
 
@@ -48,6 +49,14 @@ class TestMatchContext:
         assert context.line_offsets[1] == 4  # "abc\n"
         assert context.line_offsets[2] == 8  # "abc\ndef\n"
 
+    def test_read_only(self) -> None:
+        """Test that lines and offsets cannot be changed by detectors."""
+        context = MatchContext.from_text("abc\ndef")
+        assert isinstance(context.lines, tuple)
+        assert isinstance(context.line_offsets, tuple)
+        with pytest.raises(AttributeError):
+            context.lines = ()  # type: ignore[misc]
+
     def test_get_line_number(self) -> None:
         """Test getting line number from position."""
         context = MatchContext.from_text("line 1\nline 2\nline 3")
@@ -208,6 +217,19 @@ class TestPatternMatcherRegexMatching:
         matched_text = results[0].matched_signals[0].matched_text
         assert "go func(" in matched_text
 
+    def test_invalid_regex_is_reported_as_skipped(self) -> None:
+        """Test that a pattern with an invalid regex is listed in skipped."""
+        pattern = Pattern(
+            id=PatternId("CC-003"),
+            domain=ArtifactDomain.CONCURRENCY,
+            signals=[Signal(type="regex", pattern=r"go func(")],
+            severity=Severity.ERROR,
+        )
+        matcher = PatternMatcher([pattern])
+
+        assert matcher.match("go func() {}") == []
+        assert matcher.skipped == {"CC-003": "invalid regex"}
+
 
 class TestPatternMatcherConfidence:
     """Tests for confidence calculation."""
@@ -283,6 +305,24 @@ class TestPatternMatcherConfidence:
         # 2 / 3 = 0.667
         assert results[0].confidence == pytest.approx(0.667, abs=0.01)
 
+    def test_confidence_capped_at_max_confidence(self) -> None:
+        """Test that a pattern's max_confidence caps even a full match."""
+        pattern = Pattern(
+            id=PatternId("TEST-005"),
+            domain=ArtifactDomain.CONCURRENCY,
+            signals=[
+                Signal(type="exact", pattern="signal1"),
+                Signal(type="exact", pattern="signal2"),
+            ],
+            severity=Severity.WARNING,
+            max_confidence=0.7,
+        )
+        matcher = PatternMatcher([pattern], threshold=0.5)
+
+        assert matcher.match("signal1 signal2")[0].confidence == 0.7
+        assert matcher.match("signal1")[0].confidence == 0.5
+        assert PatternMatcher([pattern], threshold=0.8).match("signal1 signal2") == []
+
 
 class TestPatternMatcherThreshold:
     """Tests for threshold filtering."""
@@ -359,6 +399,19 @@ class TestPatternMatcherMultiplePatterns:
         results = matcher.match("race timing index")
         assert len(results) == 3
 
+    def test_match_context_shared_between_matchers(self, patterns: list[Pattern]) -> None:
+        """Test that matchers over one context find what a single matcher finds."""
+        text = "race timing index"
+        context = MatchContext.from_text(text)
+
+        split = [
+            r.pattern.id
+            for part in (patterns[:1], patterns[1:])
+            for r in PatternMatcher(part).match_context(context)
+        ]
+
+        assert split == [r.pattern.id for r in PatternMatcher(patterns).match(text)]
+
     def test_results_sorted_by_confidence(self, patterns: list[Pattern]) -> None:
         """Test results are sorted by confidence descending."""
         # Create patterns with different signal counts
@@ -526,6 +579,22 @@ class TestPatternMatcherEdgeCases:
         results = matcher.match("some text")
         assert len(results) == 0  # 0/0 signals = 0 confidence
 
+    def test_regex_matching_off_main_thread(self) -> None:
+        """Test that regex signals match from worker threads (no SIGALRM there)."""
+        pattern = Pattern(
+            id=PatternId("TEST-012"),
+            domain=ArtifactDomain.CONCURRENCY,
+            signals=[Signal(type="regex", pattern=r"go\s+func")],
+            severity=Severity.ERROR,
+        )
+        results: list[int] = []
+        worker = threading.Thread(
+            target=lambda: results.append(len(PatternMatcher([pattern]).match("go func() {}")))
+        )
+        worker.start()
+        worker.join()
+        assert results == [1]
+
     def test_unicode_text(self) -> None:
         """Test matching in unicode text."""
         pattern = Pattern(
@@ -0,0 +1 @@
+"""Tests for Deep Verify source tree scanning."""
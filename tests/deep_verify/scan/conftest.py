@@ -0,0 +1,684 @@
+"""Shared fixtures for Deep Verify scan tests."""
+
+from pathlib import Path
+
+import pytest
+
+from bmad_assist.deep_verify.scan import ScanOptions, Scanner
+
+# Go snippet matching the unsynchronized-goroutine pattern (CC-001-CODE-GO)
+GO_GOROUTINE = """package main
+
+func main() {
+    go func() {
+        doWork()
+    }()
+}
+"""
+
+# Go snippet that matches no code pattern
+GO_CLEAN = """package main
+
+func add(a, b int) int {
+    return a + b
+}
+"""
+
+# Go snippet matching the opt-in loop-without-ctx-check pattern (CC-101-CODE-GO)
+GO_UNCANCELLABLE_LOOP = """package index
+
+import "context"
+
+func build(ctx context.Context, docs []string) int {
+    total := 0
+    for _, d := range docs {
+        total += len(d)
+    }
+    return total
+}
+"""
+
+# Go test snippet matching the test-only unjoined-goroutine-logging pattern (CC-115-CODE-GO)
+GO_TEST_UNJOINED_LOG = """package fetch
+
+func TestFetch(t *testing.T) {
+    go func() {
+        t.Log("fetched")
+    }()
+}
+"""
+
+# Go snippet matching a concurrency pattern (CC-001) and a quality pattern (CQ-008)
+GO_MIXED = """package main
+
+func main() {
+    go func() {
+        doWork()
+    }()
+    msg := fmt.Sprintf("%s", name)
+    _ = msg
+}
+"""
+
+# Go snippet matching the opt-in channel ownership pattern (CC-120-CODE-GO, info)
+GO_LOCKED_CHANNEL = """package broker
+
+func (b *Broker) Subscribe(topic string) <-chan Message {
+    b.mu.Lock()
+    defer b.mu.Unlock()
+    ch := make(chan Message, 16)
+    b.subs[topic] = append(b.subs[topic], ch)
+    return ch
+}
+"""
+
+# GO_MIXED with a generated-code header
+GO_GENERATED = "// Code generated by mockgen. DO NOT EDIT.\n\n" + GO_MIXED
+
+# Go snippet matching the misaligned 64-bit atomic pattern (CC-117-CODE-GO, warning)
+GO_MISALIGNED_ATOMIC = """package stats
+
+type stats struct {
+    ok   bool
+    hits int64
+}
+
+func (s *stats) hit() {
+    atomic.AddInt64(&s.hits, 1)
+}
+"""
+
+# Go snippet matching the leaked context cancel pattern (CC-039-CODE-GO, warning, fixable)
+GO_LEAKED_CANCEL = """package store
+
+func load(parent context.Context, id string) (*Item, error) {
+    ctx, cancel := context.WithTimeout(parent, time.Second)
+    return fetch(ctx, id)
+}
+"""
+
+# Go snippet with a promoted mutex shared with the embedded type (CC-105-CODE-GO)
+GO_PROMOTED_MUTEX_LOCK = """package pool
+
+import "sync"
+
+type Conn struct {
+    mu  sync.Mutex
+    buf []byte
+}
+
+func (c *Conn) Write(p []byte) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    c.buf = append(c.buf, p...)
+}
+
+type PooledConn struct {
+    Conn
+    idle bool
+}
+
+func (p *PooledConn) Release() {
+    p.mu.Lock()
+    defer p.mu.Unlock()
+    p.idle = true
+}
+"""
+
+# Go snippet with a call to a deprecated io/ioutil function (CC-111-CODE-GO)
+GO_DEPRECATED_CALL = """package config
+
+import (
+    "fmt"
+    "io/ioutil"
+)
+
+func Load(path string) ([]byte, error) {
+    data, err := ioutil.ReadFile(path)
+    if err != nil {
+        return nil, fmt.Errorf("load: %w", err)
+    }
+    return data, nil
+}
+"""
+
+# Go snippet with a copy of a struct holding a mutex (CC-112-CODE-GO)
+GO_MUTEX_STRUCT_COPY = """package config
+
+import "sync"
+
+type Config struct {
+    mu      sync.Mutex
+    Retries int
+}
+
+var defaults = Config{Retries: 3}
+
+func newConfig() *Config {
+    cfg := defaults
+    return &cfg
+}
+"""
+
+# Go snippet with a local channel that is only received from (CC-114-CODE-GO)
+GO_RECEIVE_ONLY_CHANNEL = """package main
+
+func wait() {
+    done := make(chan struct{})
+    go cleanup()
+    <-done
+}
+"""
+
+# Go snippet with a password field passed to a logger (CC-119-CODE-GO)
+GO_SENSITIVE_LOG = """package auth
+
+import "log"
+
+func Login(user *User) {
+    log.Printf("%s", user.Password)
+}
+"""
+
+# Go snippet with a switch missing a case of its enum (CC-123-CODE-GO)
+GO_ENUM_SWITCH_MISSING_CASE = """package order
+
+type State int
+
+const (
+    StateOpen State = iota
+    StateClosed
+    StateArchived
+)
+
+func label(s State) string {
+    switch s {
+    case StateOpen:
+        return "open"
+    case StateClosed:
+        return "closed"
+    }
+    return ""
+}
+"""
+
+# Go snippet with a session token drawn from math/rand (CC-124-CODE-GO)
+GO_WEAK_RANDOM_TOKEN = """package auth
+
+import (
+    "encoding/hex"
+    "math/rand"
+)
+
+func NewSession() Session {
+    b := make([]byte, 16)
+    rand.Read(b)
+    token := hex.EncodeToString(b)
+    return Session{Token: token}
+}
+"""
+
+# Go snippet with a value receiver mutating its copy (CC-125-CODE-GO)
+GO_VALUE_RECEIVER_MUTATION = """package counter
+
+type Counter struct {
+    n     int
+    label string
+}
+
+func (c *Counter) Inc() {
+    c.n++
+}
+
+func (c Counter) Value() int {
+    return c.n
+}
+
+func (c Counter) Reset() {
+    if c.n > 0 {
+        c.n = 0
+    }
+}
+"""
+
+# Go snippet with an unkeyed literal of an imported struct (CC-126-CODE-GO)
+GO_UNKEYED_LITERAL = """package main
+
+import "example.com/app/server"
+
+func main() {
+    cfg := server.Config{true, 30, "x"}
+    server.Run(cfg)
+}
+"""
+
+# Go snippet with a context.Context stored in a struct field (CC-127-CODE-GO)
+GO_CONTEXT_FIELD = """package client
+
+import "context"
+
+type Client struct {
+    name string
+    ctx  context.Context
+}
+"""
+
+# Go snippet with time.After in a select competing with a receive (CC-128-CODE-GO)
+GO_TIMER_SELECT = """package queue
+
+import (
+    "context"
+    "time"
+)
+
+func (mq *MessageQueue) Consume(ctx context.Context) (string, error) {
+    select {
+    case msg := <-mq.messages:
+        return msg, nil
+    case <-time.After(30 * time.Second):
+        return "", context.DeadlineExceeded
+    }
+}
+"""
+
+# Go snippet with a WaitGroup.Wait without a timeout (opt-in) (CC-129-CODE-GO)
+GO_UNBOUNDED_WAIT = """package server
+
+import (
+    "context"
+    "sync"
+)
+
+type Server struct {
+    wg   sync.WaitGroup
+    quit chan struct{}
+}
+
+func (s *Server) Shutdown(ctx context.Context) error {
+    close(s.quit)
+    s.wg.Wait()
+    return nil
+}
+"""
+
+# Go snippet with a bitwise operator in a condition (CC-130-CODE-GO)
+GO_BITWISE_CONDITION = """package main
+
+func run(a, b bool) {
+    if a & b {
+        start()
+    }
+}
+"""
+
+# Go snippet with a blocking channel send in a deferred function (CC-131-CODE-GO)
+GO_DEFERRED_SEND = """package main
+
+func (w *Worker) run() {
+    defer func() {
+        w.results <- w.summary()
+    }()
+    w.process()
+}
+"""
+
+# Go snippet with a switch routing on a recovered panic value (CC-132-CODE-GO)
+GO_PANIC_ROUTE = """package walk
+
+func Walk(root *Node) (err error) {
+    defer func() {
+        if r := recover(); r != nil {
+            switch e := r.(type) {
+            case stopWalk:
+                err = nil
+            case walkError:
+                err = e.err
+            default:
+                panic(r)
+            }
+        }
+    }()
+    visit(root)
+    return nil
+}
+"""
+
+# Go snippet with a mutated copy of a map value never stored back (CC-133-CODE-GO)
+GO_MAP_VALUE_MUTATION = """package stats
+
+type Entry struct {
+    count int
+}
+
+type Stats struct {
+    byName map[string]Entry
+}
+
+func (s *Stats) record(name string) {
+    entry := s.byName[name]
+    entry.count++
+}
+"""
+
+# Go snippet with a test helper without t.Helper() (CC-134-CODE-GO)
+GO_UNMARKED_TEST_HELPER = """package server
+
+import "testing"
+
+func assertStatus(t *testing.T, got, want int) {
+    if got != want {
+        t.Fatalf("status = %d, want %d", got, want)
+    }
+}
+
+func assertBody(t *testing.T, got, want string) {
+    t.Helper()
+    if got != want {
+        t.Errorf("body = %q, want %q", got, want)
+    }
+}
+
+func TestServe(t *testing.T) {
+    if err := serve(); err != nil {
+        t.Fatal(err)
+    }
+    assertStatus(t, 200, 200)
+    assertBody(t, "ok", "ok")
+}
+"""
+
+# Go snippet with unchecked size arithmetic in make (CC-135-CODE-GO)
+GO_SIZE_OVERFLOW = """package wire
+
+func decode(r io.Reader) ([]byte, error) {
+    n, m := readHeader(r)
+    buf := make([]byte, n*m)
+    _, err := io.ReadFull(r, buf)
+    return buf, err
+}
+"""
+
+# Go snippet with a sync.WaitGroup passed by value (CC-136-CODE-GO)
+GO_WAITGROUP_BY_VALUE = """package pool
+
+import "sync"
+
+func worker(id int, wg sync.WaitGroup) {
+    defer wg.Done()
+    process(id)
+}
+"""
+
+# Go snippet with a goroutine started under a lock it takes itself (CC-137-CODE-GO)
+GO_LOCKED_SPAWN = """package cache
+
+func (c *Cache) Refresh() {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    done := make(chan struct{})
+    go func() {
+        c.mu.Lock()
+        c.items = load()
+        c.mu.Unlock()
+        close(done)
+    }()
+    <-done
+}
+"""
+
+# Go snippet with a select clause reading another case's received value (CC-138-CODE-GO)
+GO_CROSS_CASE_RECEIVE = """package inbox
+
+func poll(inbox <-chan Message) {
+    var msg Message
+    select {
+    case msg := <-inbox:
+        handle(msg)
+    default:
+        handle(msg)
+    }
+}
+"""
+
+# Go snippet with a file path built with fmt.Sprintf (CC-139-CODE-GO)
+GO_CONCATENATED_PATH = """package store
+
+import (
+    "fmt"
+    "os"
+)
+
+func load(dir, name string) (*os.File, error) {
+    path := fmt.Sprintf("%s/%s.json", dir, name)
+    return os.Open(path)
+}
+"""
+
+# Go snippet with a built-in string used as a context key (CC-140-CODE-GO)
+GO_STRING_CONTEXT_KEY = """package auth
+
+import "context"
+
+func withUser(ctx context.Context, id string) context.Context {
+    return context.WithValue(ctx, "userID", id)
+}
+"""
+
+# Go snippet with roundabout time.Since and time.Until spellings (CC-141-CODE-GO)
+GO_ROUNDABOUT_TIME = """package jobs
+
+import "time"
+
+func (j *Job) Run() {
+    start := time.Now()
+    j.work()
+    j.elapsed = time.Now().Sub(start)
+    if j.finished == time.Unix(0, 0) {
+        j.finished = time.Now()
+    }
+}
+"""
+
+# Go snippet with a request body read without a size limit (CC-142-CODE-GO)
+GO_UNBOUNDED_BODY_READ = """package api
+
+import (
+    "encoding/json"
+    "io"
+    "net/http"
+)
+
+func upload(w http.ResponseWriter, r *http.Request) {
+    defer r.Body.Close()
+    data, err := io.ReadAll(r.Body)
+    if err != nil {
+        return
+    }
+    store(data)
+}
+"""
+
+# Go snippet with a math/rand generator shared by goroutines (CC-143-CODE-GO)
+GO_SHARED_RAND = """package jobs
+
+import "math/rand"
+
+func schedule(jobs []*Job, seed int64) {
+    rng := rand.New(rand.NewSource(seed))
+    for _, job := range jobs {
+        go func(job *Job) {
+            job.jitter = rng.Intn(100)
+        }(job)
+    }
+}
+"""
+
+# Go snippet with a lock taken in a loop while another is held (CC-144-CODE-GO)
+GO_LOOP_LOCK = """package registry
+
+func (r *Registry) Flush() {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    for _, s := range r.sessions {
+        s.mu.Lock()
+        s.flush()
+        s.mu.Unlock()
+    }
+}
+"""
+
+# Go snippet with a select that silently drops a send (CC-145-CODE-GO)
+GO_SILENT_DROP = """package bus
+
+func (b *Bus) Publish(ev Event) {
+    select {
+    case b.events <- ev:
+    default:
+    }
+}
+"""
+
+# Go snippet with a secret read from the environment without a check (CC-146-CODE-GO)
+GO_UNCHECKED_ENV_READ = """package main
+
+import "os"
+
+func newClient() *api.Client {
+    return api.New(os.Getenv("API_KEY"))
+}
+"""
+
+# Go snippet with a goroutine writing at a captured loop index (CC-147-CODE-GO)
+GO_CAPTURED_INDEX_WRITE = """package work
+
+func fill(xs []int) {
+    for i := range xs {
+        go func() {
+            xs[i] = f(i)
+        }()
+    }
+}
+"""
+
+# Go snippet with an unchecked narrowing integer conversion (CC-148-CODE-GO)
+GO_NARROWING_CONVERSION = """package quota
+
+func setQuota(n int64) int32 {
+    quota := int32(n)
+    return quota
+}
+"""
+
+# Go snippet with a lazily created map without the receiver's lock (CC-149-CODE-GO)
+GO_UNGUARDED_LAZY_MAP = """package store
+
+import "sync"
+
+type Store struct {
+    mu    sync.Mutex
+    items map[string]string
+}
+
+func (s *Store) Put(k, v string) {
+    if s.items == nil {
+        s.items = make(map[string]string)
+    }
+    s.items[k] = v
+}
+"""
+
+# Go snippet with a polling loop driven by time.Sleep (CC-150-CODE-GO)
+GO_SLEEP_POLL = """package worker
+
+import (
+    "context"
+    "time"
+)
+
+func (w *Worker) Run(ctx context.Context) {
+    for {
+        w.poll(ctx)
+        time.Sleep(w.interval)
+    }
+}
+"""
+
+# Go snippet with an errgroup goroutine ignoring the group context (CC-151-CODE-GO)
+GO_GROUP_CONTEXT_IGNORED = """package fetch
+
+import (
+    "context"
+
+    "golang.org/x/sync/errgroup"
+)
+
+func fetchAll(ctx context.Context, urls []string) error {
+    g, gctx := errgroup.WithContext(ctx)
+    for _, url := range urls {
+        g.Go(func() error {
+            return fetch(ctx, url)
+        })
+    }
+    return g.Wait()
+}
+"""
+
+# Go snippet with a method call on a map element that may be nil (CC-152-CODE-GO)
+GO_NIL_MAP_VALUE = """package server
+
+type Server struct {
+    handlers map[string]*Handler
+}
+
+func (s *Server) dispatch(name string, req *Request) error {
+    return s.handlers[name].Serve(req)
+}
+"""
+
+# Go snippet with context.WithValue chains repeating a key (CC-153-CODE-GO)
+GO_DUPLICATE_CONTEXT_KEY = """package middleware
+
+import (
+    "context"
+    "net/http"
+)
+
+type ctxKey int
+
+const (
+    userKey ctxKey = iota
+    tenantKey
+)
+
+func withIdentity(r *http.Request, user, tenant string) *http.Request {
+    ctx := context.WithValue(r.Context(), userKey, user)
+    ctx = context.WithValue(ctx, userKey, tenant)
+    return r.WithContext(ctx)
+}
+"""
+
+
+def write_file(root: Path, rel_path: str, content: str) -> Path:
+    """Write a file under root, creating parent directories."""
+    path = root / rel_path
+    path.parent.mkdir(parents=True, exist_ok=True)
+    path.write_text(content)
+    return path
+
+
+def scan_locations(
+    root: Path, pattern_id: str, options: ScanOptions | None = None
+) -> list[tuple[str, int]]:
+    """Scan a tree and return the path and line of each finding of one pattern."""
+    report = Scanner(options).scan(root)
+    return [(f.path, f.line) for f in report.findings if f.pattern_id == pattern_id]
+
+
+@pytest.fixture
+def go_tree(tmp_path: Path) -> Path:
+    """Create a small tree with one Go file per team subtree."""
+    write_file(tmp_path, "main.go", GO_GOROUTINE)
+    write_file(tmp_path, "teams/payments/worker.go", GO_GOROUTINE)
+    write_file(tmp_path, "teams/search/indexer.go", GO_GOROUTINE)
+    write_file(tmp_path, "README.md", "# Not code\n")
+    return tmp_path
@@ -0,0 +1,98 @@
+"""Tests for scan status badges."""
+
+import io
+import xml.etree.ElementTree as ET
+from dataclasses import replace
+
+import pytest
+
+from bmad_assist.deep_verify.core.types import ArtifactDomain, PatternId, Severity
+from bmad_assist.deep_verify.scan import (
+    BADGE_GREEN,
+    BADGE_RED,
+    BADGE_YELLOW,
+    ScanFinding,
+    ScanReport,
+    badge_svg,
+    write_badge,
+)
+
+SVG = "{http://www.w3.org/2000/svg}"
+
+FINDING = ScanFinding(
+    pattern_id=PatternId("CC-001-CODE-GO"),
+    severity=Severity.WARNING,
+    title="t",
+    description="d",
+    path="a.go",
+    line=3,
+    snippet="go func() {",
+    confidence=1.0,
+    domain=ArtifactDomain.CONCURRENCY,
+    language="go",
+)
+
+
+def _badge(*findings: ScanFinding, style: str = "flat") -> tuple[str, str]:
+    """Return the (message, message color) of a report's badge."""
+    root = ET.fromstring(badge_svg(ScanReport(root=".", findings=list(findings)), style))
+    texts = [t.text for t in root.iter(f"{SVG}text")]
+    rects = [r for r in root.iter(f"{SVG}rect") if r.get("x")]
+    return texts[1], rects[0].get("fill")
+
+
+class TestBadgeSvg:
+    """Tests for badge_svg."""
+
+    def test_no_findings_is_green(self) -> None:
+        """Test that a clean report gets a green badge."""
+        assert _badge() == ("0 findings", BADGE_GREEN)
+
+    def test_critical_is_red(self) -> None:
+        """Test that a critical finding turns the badge red."""
+        critical = replace(FINDING, severity=Severity.CRITICAL, line=9)
+
+        assert _badge(FINDING, critical) == ("2 findings", BADGE_RED)
+
+    def test_warnings_are_yellow(self) -> None:
+        """Test that warnings and info findings give a yellow badge."""
+        assert _badge(FINDING) == ("1 finding", BADGE_YELLOW)
+        assert _badge(replace(FINDING, severity=Severity.INFO)) == ("1 finding", BADGE_YELLOW)
+        assert _badge(replace(FINDING, severity=Severity.ERROR))[1] == BADGE_RED
+
+    def test_suppressed_findings_are_not_counted(self) -> None:
+        """Test that suppressed findings do not count or color the badge."""
+        suppressed = replace(FINDING, severity=Severity.CRITICAL, suppressed=True)
+
+        assert _badge(suppressed) == ("0 findings", BADGE_GREEN)
+
+    def test_styles(self) -> None:
+        """Test the rounded flat style and the square one, and unknown styles."""
+        report = ScanReport(root=".")
+
+        flat = badge_svg(report)
+        square = badge_svg(report, "flat-square")
+
+        assert 'rx="3"' in flat and "linearGradient" in flat
+        assert 'rx="0"' in square and "linearGradient" not in square
+        with pytest.raises(ValueError, match="Unknown badge style"):
+            badge_svg(report, "plastic")
+
+    def test_self_contained(self) -> None:
+        """Test that the badge references no external resources."""
+        svg = badge_svg(ScanReport(root=".", findings=[FINDING]))
+
+        assert "href" not in svg
+        assert "<title>deep verify: 1 finding</title>" in svg
+
+
+class TestWriteBadge:
+    """Tests for write_badge."""
+
+    def test_writes_svg_with_newline(self) -> None:
+        """Test that the SVG document is written with a trailing newline."""
+        out = io.StringIO()
+
+        write_badge(ScanReport(root="."), out, style="flat-square")
+
+        assert out.getvalue() == badge_svg(ScanReport(root="."), "flat-square") + "\n"
@@ -0,0 +1,97 @@
+"""Tests for accepted-findings baselines."""
+
+from pathlib import Path
+
+import pytest
+
+from bmad_assist.deep_verify.scan import (
+    BASELINE_REASON,
+    FindingBaseline,
+    ScanOptions,
+    Scanner,
+    apply_finding_baseline,
+    create_finding_baseline,
+    read_finding_baseline,
+    write_finding_baseline,
+)
+
+from tests.deep_verify.scan.conftest import GO_GOROUTINE, write_file
+
+# GO_GOROUTINE reformatted: moved down and indented differently
+GO_GOROUTINE_MOVED = """package main
+
+// main starts the worker.
+func main() {
+
+	go  func() {
+		doWork()
+	}()
+}
+"""
+
+
+def _new_findings(root: Path, baseline: FindingBaseline) -> list[tuple[str, int]]:
+    report = apply_finding_baseline(Scanner().scan(root), baseline)
+    return [(f.path, f.line) for f in report.unsuppressed_findings()]
+
+
+class TestFindingBaseline:
+    """Tests for creating and applying baselines."""
+
+    def test_fresh_baseline_leaves_no_new_findings(self, tmp_path: Path) -> None:
+        """Test that diffing right after creating a baseline reports nothing new."""
+        write_file(tmp_path, "main.go", GO_GOROUTINE)
+        report = Scanner().scan(tmp_path)
+        baseline = create_finding_baseline(report)
+
+        assert [e.pattern_id for e in baseline.accepted] == [f.pattern_id for f in report.findings]
+        assert _new_findings(tmp_path, baseline) == []
+
+    def test_survives_reformatting_and_reports_regressions(self, tmp_path: Path) -> None:
+        """Test that moved findings stay accepted while added ones are new."""
+        write_file(tmp_path, "main.go", GO_GOROUTINE)
+        baseline = create_finding_baseline(Scanner().scan(tmp_path))
+
+        write_file(tmp_path, "main.go", GO_GOROUTINE_MOVED)
+        assert _new_findings(tmp_path, baseline) == []
+
+        write_file(tmp_path, "worker.go", GO_GOROUTINE)
+        assert _new_findings(tmp_path, baseline) == [("worker.go", 4)]
+
+    def test_keep_accepted_marks_findings_suppressed(self, tmp_path: Path) -> None:
+        """Test that accepted findings can be kept for auditing without failing a scan."""
+        write_file(tmp_path, "main.go", GO_GOROUTINE)
+        scanner = Scanner(ScanOptions())
+        report = scanner.scan(tmp_path)
+
+        kept = apply_finding_baseline(report, create_finding_baseline(report), keep_accepted=True)
+
+        assert len(kept.findings) == len(report.findings)
+        assert all(f.suppressed and f.suppression_reason == BASELINE_REASON for f in kept.findings)
+        assert not scanner.failed(kept)
+
+
+class TestBaselineFile:
+    """Tests for reading and writing baseline files."""
+
+    def test_round_trip(self, tmp_path: Path) -> None:
+        """Test that a written baseline reads back unchanged."""
+        write_file(tmp_path, "main.go", GO_GOROUTINE)
+        baseline = create_finding_baseline(Scanner(ScanOptions(reproducible=True)).scan(tmp_path))
+        path = tmp_path / "baseline.json"
+
+        write_finding_baseline(baseline, path)
+
+        assert read_finding_baseline(path) == baseline
+
+    @pytest.mark.parametrize(
+        "content",
+        ["not json", '{"format": 99, "accepted": []}', '{"format": 1, "accepted": [{}]}'],
+    )
+    def test_rejects_invalid_files(self, tmp_path: Path, content: str) -> None:
+        """Test that invalid JSON, other formats and malformed entries raise ValueError."""
+        path = tmp_path / "baseline.json"
+        path.write_text(content)
+
+        with pytest.raises(ValueError, match="baseline"):
+            read_finding_baseline(path)
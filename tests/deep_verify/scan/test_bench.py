@@ -0,0 +1,118 @@
+"""Tests for the analysis speed benchmark and its regression guard."""
+
+from pathlib import Path
+
+import pytest
+
+from bmad_assist.deep_verify.scan.bench import (
+    DEFAULT_BASELINE_PATH,
+    BenchBaseline,
+    BenchResult,
+    format_benchstat,
+    load_baseline,
+    run_benchmark,
+    save_baseline,
+    write_corpus,
+)
+
+
+def _result(seconds: float, files: int = 100) -> BenchResult:
+    return BenchResult(f"BenchmarkScanFile/files={files}", files, seconds)
+
+
+class TestBenchResult:
+    """Tests for BenchResult."""
+
+    def test_rates(self) -> None:
+        """Test time per file and throughput."""
+        result = _result(0.5)
+
+        assert result.ns_per_file == pytest.approx(5e6)
+        assert result.files_per_sec == pytest.approx(200.0)
+
+
+class TestBenchBaseline:
+    """Tests for BenchBaseline."""
+
+    def test_within_budget(self) -> None:
+        """Test that a median under factor x baseline passes."""
+        baseline = BenchBaseline(ns_per_file=1e6, factor=3.0)
+
+        assert baseline.check([_result(0.1), _result(0.29), _result(0.5)]) is None
+
+    def test_over_budget(self) -> None:
+        """Test that a median over factor x baseline reports the regression."""
+        baseline = BenchBaseline(ns_per_file=1e6, factor=3.0, reference="ref box")
+
+        message = baseline.check([_result(0.31), _result(0.4), _result(0.1)])
+
+        assert message == (
+            "analysis takes 3.10 ms per file, over the budget of 3.00 ms "
+            "(3x the 1.00 ms baseline on ref box)"
+        )
+
+    def test_empty_results(self) -> None:
+        """Test that checking no results is an error."""
+        with pytest.raises(ValueError, match="no benchmark results"):
+            BenchBaseline(ns_per_file=1e6).check([])
+
+    def test_round_trip(self, tmp_path: Path) -> None:
+        """Test saving and loading a baseline."""
+        path = tmp_path / "baseline.json"
+        baseline = BenchBaseline(ns_per_file=2e6, factor=2.0, reference="ci", files=10)
+
+        save_baseline(baseline, path)
+
+        assert load_baseline(path) == baseline
+
+    def test_invalid_baseline(self, tmp_path: Path) -> None:
+        """Test that unknown keys are rejected."""
+        path = tmp_path / "baseline.json"
+        path.write_text('{"ns_per_file": 1, "speed": 2}')
+
+        with pytest.raises(ValueError, match="Invalid benchmark baseline"):
+            load_baseline(path)
+
+
+class TestBenchmark:
+    """Tests for the corpus, runner and output format."""
+
+    def test_corpus_is_deterministic(self, tmp_path: Path) -> None:
+        """Test that the same file count writes the same files."""
+        first = write_corpus(tmp_path / "a", 3)
+        second = write_corpus(tmp_path / "b", 3)
+
+        assert [p.read_text() for p in first] == [p.read_text() for p in second]
+        assert [p.name for p in first] == ["file0000.go", "file0001.go", "file0002.go"]
+
+    def test_format_benchstat(self) -> None:
+        """Test the Go benchmark format read by benchstat."""
+        lines = format_benchstat([_result(0.5), _result(0.25)]).splitlines()
+
+        assert lines[0].startswith("os: ")
+        assert "pkg: bmad_assist.deep_verify.scan" in lines
+        assert lines[-2:] == [
+            "BenchmarkScanFile/files=100\t100\t5000000 ns/op\t200.0 files/s",
+            "BenchmarkScanFile/files=100\t100\t2500000 ns/op\t400.0 files/s",
+        ]
+
+    def test_invalid_count(self) -> None:
+        """Test that a run count below 1 is rejected."""
+        with pytest.raises(ValueError, match="at least 1"):
+            run_benchmark(files=1, count=0)
+
+    def test_summary_only_runs_are_named(self) -> None:
+        """Test that summary-only runs get their own benchstat name."""
+        (result,) = run_benchmark(files=2, count=1, summary_only=True)
+
+        assert result.name == "BenchmarkScanFile/files=2/summary"
+        assert result.files == 2
+
+    def test_analysis_within_budget(self) -> None:
+        """Guard: analysis speed stays within the committed budget."""
+        baseline = load_baseline(DEFAULT_BASELINE_PATH)
+
+        results = run_benchmark(files=baseline.files, count=3)
+
+        assert all(r.files == baseline.files for r in results)
+        assert baseline.check(results) is None
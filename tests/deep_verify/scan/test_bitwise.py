@@ -0,0 +1,96 @@
+"""Tests for bitwise operators in conditions (CC-130)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    BITWISE_CONDITION_CONFIDENCE,
+    ScanConfig,
+    find_bitwise_conditions,
+)
+
+from tests.deep_verify.scan.conftest import GO_BITWISE_CONDITION
+
+BITMASK_TEST = """package main
+
+const FlagDebug = 1 << 2
+
+func run(flags int) {
+    if flags&FlagDebug != 0 {
+        debug()
+    }
+}
+"""
+
+
+def _conditions(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_bitwise_conditions(text, "x.go", ScanConfig())]
+
+
+class TestFindBitwiseConditions:
+    """Tests for find_bitwise_conditions."""
+
+    def test_bitwise_and_condition(self) -> None:
+        """Test reporting `if a & b` with a low confidence."""
+        (finding,) = find_bitwise_conditions(GO_BITWISE_CONDITION, "main.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-130-CODE-GO"
+        assert finding.severity == Severity.WARNING
+        assert finding.line == 4
+        assert finding.title == "if condition uses bitwise & - did you mean &&?"
+        assert finding.confidence == BITWISE_CONDITION_CONFIDENCE < 1.0
+
+    def test_bitmask_comparison_is_safe(self) -> None:
+        """Test that a compared bitmask test is not reported."""
+        assert _conditions(BITMASK_TEST) == []
+
+    def test_condition_clauses(self) -> None:
+        """Test else-if, init statements, for loops and range loops."""
+        text = """package main
+
+func run(items []int, ready, done bool) {
+    if ready {
+    } else if ready | done {
+    }
+    if v := mask & bits; v {
+    }
+    if err := load(); ready | done {
+    }
+    for !ready | done {
+    }
+    for i := 0; ready & done; i++ {
+    }
+    for i := 0; i < n; i |= 1 {
+    }
+    for _, v := range items {
+    }
+}
+"""
+        assert _conditions(text) == [
+            (5, "if condition uses bitwise | - did you mean ||?"),
+            (9, "if condition uses bitwise | - did you mean ||?"),
+            (11, "for condition uses bitwise | - did you mean ||?"),
+            (13, "for condition uses bitwise & - did you mean &&?"),
+        ]
+
+    def test_logical_and_address_operators_are_safe(self) -> None:
+        """Test &&, ||, &^, address-of, receives, strings and comments."""
+        text = """package main
+
+func run(a, b bool, ch chan int) {
+    if a && b || !a {
+    }
+    if ok(&cfg, a) {
+    }
+    if <-ready {
+    }
+    if contains("a & b") {
+    }
+    if a { // a & b
+    }
+}
+"""
+        assert _conditions(text) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-130."""
+        config = ScanConfig(disable=["CC-130"])
+        assert find_bitwise_conditions(GO_BITWISE_CONDITION, "x.go", config) == []
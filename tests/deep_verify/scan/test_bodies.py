@@ -0,0 +1,125 @@
+"""Tests for unbounded request body reads (CC-142)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import ScanConfig, find_unbounded_body_reads
+
+from tests.deep_verify.scan.conftest import GO_UNBOUNDED_BODY_READ
+
+LIMITED = """package api
+
+import (
+    "encoding/json"
+    "io"
+    "net/http"
+)
+
+func upload(w http.ResponseWriter, r *http.Request) {
+    r.Body = http.MaxBytesReader(w, r.Body, 1<<20)
+    defer r.Body.Close()
+    data, err := io.ReadAll(r.Body)
+    if err != nil {
+        return
+    }
+    store(data)
+}
+"""
+
+
+def _reads(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_unbounded_body_reads(text, "x.go", ScanConfig())]
+
+
+class TestFindUnboundedBodyReads:
+    """Tests for find_unbounded_body_reads."""
+
+    def test_unbounded_read(self) -> None:
+        """Test reporting io.ReadAll of an unwrapped request body."""
+        (finding,) = find_unbounded_body_reads(GO_UNBOUNDED_BODY_READ, "api.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-142-CODE-GO"
+        assert finding.severity == Severity.INFO
+        assert (finding.line, finding.title) == (
+            11,
+            "r.Body read by io.ReadAll without a size limit - wrap it in http.MaxBytesReader",
+        )
+        assert finding.snippet == "data, err := io.ReadAll(r.Body)"
+
+    def test_max_bytes_reader_is_safe(self) -> None:
+        """Test that a body reassigned to http.MaxBytesReader is not reported."""
+        assert _reads(LIMITED) == []
+
+    def test_readers_and_limits(self) -> None:
+        """Test decoders, Read calls, inline limits and function literals."""
+        text = """package api
+
+import (
+    "io"
+    web "net/http"
+)
+
+func decode(w web.ResponseWriter, req *web.Request) {
+    if req.Body == nil {
+        return
+    }
+    json.NewDecoder(req.Body).Decode(&v)
+    n, _ := req.Body.Read(buf)
+    limited := io.LimitReader(req.Body, 4096)
+    io.CopyN(dst, req.Body, 4096)
+}
+
+func routes(mux *web.ServeMux) {
+    mux.HandleFunc("/", func(w web.ResponseWriter, r *web.Request) {
+        r.Body = io.NopCloser(io.LimitReader(r.Body, 1024))
+        io.ReadAll(r.Body)
+    })
+    mux.HandleFunc("/raw", func(w web.ResponseWriter, r *web.Request) {
+        io.Copy(io.Discard, r.Body)
+    })
+}
+"""
+        suffix = " without a size limit - wrap it in http.MaxBytesReader"
+        assert _reads(text) == [
+            (12, "req.Body read by json.NewDecoder" + suffix),
+            (13, "req.Body read by req.Body.Read" + suffix),
+            (24, "r.Body read by io.Copy" + suffix),
+        ]
+
+    def test_other_bodies_are_ignored(self) -> None:
+        """Test that responses and files without net/http are not reported."""
+        response = """package api
+
+import (
+    "io"
+    "net/http"
+)
+
+func fetch(c *http.Client, url string) ([]byte, error) {
+    resp, err := c.Get(url)
+    if err != nil {
+        return nil, err
+    }
+    return io.ReadAll(resp.Body)
+}
+"""
+        assert _reads(response) == []
+        assert _reads(GO_UNBOUNDED_BODY_READ.replace('"net/http"', '"example.com/http"')) == []
+
+    def test_configured_limiting_calls(self) -> None:
+        """Test that detector options add project limit readers."""
+        wrap = "    r.Body = limits.LimitBody(w, r.Body)\n"
+        limited = GO_UNBOUNDED_BODY_READ.replace(
+            "    defer r.Body.Close()", wrap + "    defer r.Body.Close()"
+        )
+        config = ScanConfig(detectors={"CC-142": {"limiting_calls": ["LimitBody"]}})
+
+        assert [line for line, _ in _reads(limited)] == [10, 12]
+        assert find_unbounded_body_reads(limited, "x.go", config) == []
+
+    def test_severity_and_disable_by_config(self) -> None:
+        """Test that config can raise CC-142 to a warning or disable it."""
+        raised = ScanConfig(severity={"CC-142": "warning"})
+        (finding,) = find_unbounded_body_reads(GO_UNBOUNDED_BODY_READ, "x.go", raised)
+        assert finding.severity == Severity.WARNING
+
+        disabled = ScanConfig(disable=["CC-142"])
+        assert find_unbounded_body_reads(GO_UNBOUNDED_BODY_READ, "x.go", disabled) == []
@@ -0,0 +1,129 @@
+"""Tests for loops that never check for cancellation (CC-101)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    UNCANCELLABLE_LOOP_CONFIDENCE,
+    ScanConfig,
+    find_uncancellable_loops,
+)
+
+
+OPT_IN = ScanConfig(opt_in=["CC-101"])
+
+UNCHECKED = """package search
+
+import "context"
+
+func buildIndex(ctx context.Context, docs []Document) map[string][]int {
+    index := make(map[string][]int)
+    for i, doc := range docs {
+        for _, tok := range tokenize(doc.Body) {
+            index[tok] = append(index[tok], i)
+        }
+    }
+    return index
+}
+"""
+
+CHECKED = """package search
+
+import "context"
+
+func buildIndex(ctx context.Context, docs []Document) (map[string][]int, error) {
+    index := make(map[string][]int)
+    for i, doc := range docs {
+        if i%1000 == 0 {
+            if err := ctx.Err(); err != nil {
+                return nil, err
+            }
+        }
+        for _, tok := range tokenize(doc.Body) {
+            index[tok] = append(index[tok], i)
+        }
+    }
+    return index, nil
+}
+"""
+
+
+def _loops(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_uncancellable_loops(text, "x.go", OPT_IN)]
+
+
+class TestFindUncancellableLoops:
+    """Tests for find_uncancellable_loops."""
+
+    def test_loop_without_context_check(self) -> None:
+        """Test reporting the outermost loop of a function that never checks ctx."""
+        (finding,) = find_uncancellable_loops(UNCHECKED, "index.go", OPT_IN)
+
+        assert finding.pattern_id == "CC-101-CODE-GO"
+        assert finding.severity == Severity.INFO
+        assert finding.confidence == UNCANCELLABLE_LOOP_CONFIDENCE
+        assert (finding.line, finding.title) == (7, "Loop in buildIndex never checks ctx")
+        assert finding.snippet == "for i, doc := range docs {"
+
+    def test_periodic_check_is_safe(self) -> None:
+        """Test that a loop checking ctx.Err() is not reported."""
+        assert _loops(CHECKED) == []
+
+    def test_context_observed_by_calls_and_selects(self) -> None:
+        """Test loops passing ctx to a call or selecting on ctx.Done()."""
+        text = """package worker
+
+import "context"
+
+func processAll(ctx context.Context, items []Item) error {
+    for _, item := range items {
+        if err := process(ctx, item); err != nil {
+            return err
+        }
+    }
+    for {
+        select {
+        case <-ctx.Done():
+            return ctx.Err()
+        case job := <-jobs:
+            run(job)
+        }
+    }
+}
+"""
+        assert _loops(text) == []
+
+    def test_aliases_parameter_names_and_bounds(self) -> None:
+        """Test import aliases, other parameter names and literal-bounded loops."""
+        text = """package worker
+
+import stdctx "context"
+
+func drain(c stdctx.Context, queue <-chan Job) {
+    for i := 0; i < 3; i++ {
+        warm(i)
+    }
+    for range 8 {
+        tick()
+    }
+    for job := range queue {
+        run(job)
+    }
+}
+
+func local(items []Item) {
+    for _, item := range items {
+        touch(item)
+    }
+}
+"""
+        assert _loops(text) == [(12, "Loop in drain never checks c")]
+
+    def test_requires_context_import_and_opt_in(self) -> None:
+        """Test that the check is opt-in and needs context.Context from the imports."""
+        assert find_uncancellable_loops(UNCHECKED, "x.go", ScanConfig()) == []
+        without_import = UNCHECKED.replace('import "context"\n', "")
+        assert _loops(without_import) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-101 after opting in."""
+        config = ScanConfig(opt_in=["CC-101"], disable=["CC-101"])
+        assert find_uncancellable_loops(UNCHECKED, "x.go", config) == []
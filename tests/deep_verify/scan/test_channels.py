@@ -0,0 +1,109 @@
+"""Tests for local channels used in only one direction (CC-114)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    ONE_WAY_CHANNEL_CONFIDENCE,
+    ScanConfig,
+    find_one_way_channels,
+)
+
+from tests.deep_verify.scan.conftest import GO_RECEIVE_ONLY_CHANNEL
+
+ESCAPING = GO_RECEIVE_ONLY_CHANNEL.replace("go cleanup()", "go cleanup(done)")
+
+BALANCED = """package main
+
+func compute() int {
+    results := make(chan int)
+    go func() {
+        results <- work()
+    }()
+    return <-results
+}
+"""
+
+
+def _channels(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_one_way_channels(text, "x.go", ScanConfig())]
+
+
+class TestFindOneWayChannels:
+    """Tests for find_one_way_channels."""
+
+    def test_receive_without_sender(self) -> None:
+        """Test reporting a local channel that is received from but never sent on."""
+        (finding,) = find_one_way_channels(GO_RECEIVE_ONLY_CHANNEL, "main.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-114-CODE-GO"
+        assert finding.severity == Severity.WARNING
+        assert finding.confidence == ONE_WAY_CHANNEL_CONFIDENCE
+        assert (finding.line, finding.title) == (
+            4,
+            "Channel done is only received from and never escapes",
+        )
+        assert finding.snippet == "done := make(chan struct{})"
+
+    def test_send_without_receiver(self) -> None:
+        """Test reporting an unbuffered local channel that is sent on but never received."""
+        text = """package main
+
+func compute() {
+    results := make(chan int)
+    go func() {
+        results <- work()
+    }()
+}
+"""
+        assert _channels(text) == [(4, "Channel results is only sent on and never escapes")]
+
+    def test_balanced_and_escaping_channels_are_safe(self) -> None:
+        """Test channels with both sides, and channels passed to another function."""
+        assert _channels(BALANCED) == []
+        assert _channels(ESCAPING) == []
+
+    def test_selects_ranges_closes_and_buffers(self) -> None:
+        """Test select cases, range loops, close, buffered sends and escapes by value."""
+        text = """package worker
+
+func run(jobs []Job) {
+    var quit chan struct{} = make(chan struct{})
+    for {
+        select {
+        case <-quit:
+            return
+        default:
+            step()
+        }
+    }
+}
+
+func collect(jobs []Job) {
+    out := make(chan Result)
+    go func() {
+        defer close(out)
+        for _, j := range jobs {
+            out <- j.Do()
+        }
+    }()
+    for r := range out {
+        record(r, len(out))
+    }
+}
+
+func notify() {
+    sent := make(chan error, 1)
+    sent <- check()
+}
+
+func forward(sink chan chan int) {
+    reply := make(chan int)
+    sink <- reply
+    <-reply
+}
+"""
+        assert _channels(text) == [(4, "Channel quit is only received from and never escapes")]
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-114."""
+        config = ScanConfig(disable=["CC-114"])
+        assert find_one_way_channels(GO_RECEIVE_ONLY_CHANNEL, "x.go", config) == []
@@ -0,0 +1,82 @@
+"""Tests for roundabout time idioms (CC-141)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import ScanConfig, find_time_idioms
+
+from tests.deep_verify.scan.conftest import GO_ROUNDABOUT_TIME
+
+IDIOMATIC = """package jobs
+
+import "time"
+
+func (j *Job) Run() {
+    start := time.Now()
+    j.work()
+    j.elapsed = time.Since(start)
+    if j.finished.IsZero() {
+        j.finished = time.Now()
+    }
+}
+"""
+
+
+def _idioms(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_time_idioms(text, "x.go", ScanConfig())]
+
+
+class TestFindTimeIdioms:
+    """Tests for find_time_idioms."""
+
+    def test_now_sub_and_epoch_compare(self) -> None:
+        """Test reporting time.Now().Sub and an == comparison with the epoch."""
+        sub, epoch = find_time_idioms(GO_ROUNDABOUT_TIME, "jobs.go", ScanConfig())
+
+        assert sub.pattern_id == "CC-141-CODE-GO"
+        assert sub.severity == Severity.INFO
+        assert (sub.line, sub.title) == (
+            8,
+            "time.Now().Sub(x) computes elapsed time - use time.Since(x)",
+        )
+        assert sub.snippet == "j.elapsed = time.Now().Sub(start)"
+        assert (epoch.line, epoch.title) == (
+            9,
+            "Time compared with the Unix epoch as a zero check - use IsZero()",
+        )
+
+    def test_since_and_is_zero_are_safe(self) -> None:
+        """Test that time.Since and IsZero are not reported."""
+        assert _idioms(IDIOMATIC) == []
+
+    def test_equal_aliases_and_other_times(self) -> None:
+        """Test Equal comparisons, aliased imports and non-epoch Unix times."""
+        text = """package jobs
+
+import stdtime "time"
+
+func check(t stdtime.Time, deadline stdtime.Time) bool {
+    if stdtime.Unix(0, 0).Equal(t) || t.Equal(stdtime.Unix( 0, 0 )) {
+        return false
+    }
+    if stdtime.Unix(0, 0) != t {
+        log("since", stdtime.Now().Sub(deadline)) // time.Now().Sub(x)
+    }
+    return t.Equal(stdtime.Unix(1700000000, 0)) || deadline.Sub(stdtime.Now()) > 0
+}
+"""
+        assert [line for line, _ in _idioms(text)] == [6, 6, 9, 10]
+
+    def test_local_time_package_is_ignored(self) -> None:
+        """Test that calls on names that are not the time package are not reported."""
+        text = """package jobs
+
+func check(clock Clock, t Time) bool {
+    _ = clock.Now().Sub(t)
+    return t == time.Unix(0, 0)
+}
+"""
+        assert _idioms(text) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-141."""
+        config = ScanConfig(disable=["CC-141"])
+        assert find_time_idioms(GO_ROUNDABOUT_TIME, "x.go", config) == []
@@ -0,0 +1,100 @@
+"""Tests for report comparison matrices."""
+
+from dataclasses import replace
+
+from bmad_assist.deep_verify.core.types import ArtifactDomain, PatternId, Severity
+from bmad_assist.deep_verify.scan import (
+    COMPARE_SEVERITIES,
+    ScanFinding,
+    ScanReport,
+    compare_reports,
+    serialize_report_comparison,
+)
+
+
+def _finding(line: int, severity: Severity = Severity.ERROR, snippet: str = "") -> ScanFinding:
+    return ScanFinding(
+        pattern_id=PatternId("CC-001-CODE-GO"),
+        severity=severity,
+        title="Goroutine without join",
+        description="Goroutine without join",
+        path="main.go",
+        line=line,
+        snippet=snippet or f"go work{line}()",
+        confidence=1.0,
+        domain=ArtifactDomain.CONCURRENCY,
+        language="go",
+    )
+
+
+MAIN = ScanReport(
+    root=".",
+    findings=[_finding(3), _finding(7, Severity.WARNING), _finding(9, Severity.INFO)],
+)
+
+# Fixes the info finding and shifts the others by two lines
+RELEASE = ScanReport(
+    root=".",
+    findings=[
+        _finding(5, snippet="go work3()"),
+        _finding(9, Severity.WARNING, snippet="go work7()"),
+        replace(_finding(12, Severity.CRITICAL), suppressed=True),
+    ],
+)
+
+# Introduces an error and a critical finding
+FEATURE = ScanReport(
+    root=".",
+    findings=[*MAIN.findings, _finding(20), _finding(30, Severity.CRITICAL)],
+)
+
+
+class TestCompareReports:
+    """Tests for compare_reports."""
+
+    def test_cells_match_each_summary(self) -> None:
+        """Test that every column's counts match its report's severity counts."""
+        reports = [("main", MAIN), ("release", RELEASE), ("feature", FEATURE)]
+
+        columns = compare_reports(reports)
+
+        assert [c.label for c in columns] == ["main", "release", "feature"]
+        for column, (_, report) in zip(columns, reports, strict=True):
+            counts = report.severity_counts()
+            assert column.counts == {s: counts.get(s, 0) for s in COMPARE_SEVERITIES}
+            assert column.total == len(report.unsuppressed_findings())
+
+    def test_introduced_resolved_and_regressions(self) -> None:
+        """Test diffing each column against the baseline by fingerprint."""
+        main, release, feature = compare_reports(
+            [("main", MAIN), ("release", RELEASE), ("feature", FEATURE)]
+        )
+
+        assert (main.introduced, main.resolved, main.regressed) == (0, 0, False)
+        assert (release.introduced, release.resolved, release.regressed) == (0, 1, False)
+        assert (feature.introduced, feature.resolved) == (2, 0)
+        assert feature.regressions == (Severity.CRITICAL, Severity.ERROR)
+        assert feature.regressed
+
+    def test_no_reports(self) -> None:
+        """Test that comparing nothing yields an empty matrix."""
+        assert compare_reports([]) == []
+
+
+class TestSerializeReportComparison:
+    """Tests for serialize_report_comparison."""
+
+    def test_shape(self) -> None:
+        """Test the JSON shape of a comparison matrix."""
+        data = serialize_report_comparison(compare_reports([("main", MAIN), ("feat", FEATURE)]))
+
+        assert data["baseline"] == "main"
+        assert data["reports"][1] == {
+            "label": "feat",
+            "counts": {"critical": 1, "error": 2, "warning": 1, "info": 1},
+            "total": 5,
+            "introduced": 2,
+            "resolved": 0,
+            "regressions": ["critical", "error"],
+            "regressed": True,
+        }
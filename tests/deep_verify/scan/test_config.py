@@ -0,0 +1,269 @@
+"""Tests for per-directory scan configuration."""
+
+from pathlib import Path
+
+import pytest
+
+from bmad_assist.core.exceptions import ConfigError
+from bmad_assist.deep_verify.core.types import ArtifactDomain, Pattern, PatternId, Severity
+from bmad_assist.deep_verify.scan import (
+    UNBOUNDED_BODY_PATTERN,
+    UNCHECKED_ENV_PATTERN,
+    UnboundedBodyOptions,
+    UncheckedEnvOptions,
+)
+from bmad_assist.deep_verify.scan.config import (
+    CONFIG_FILENAME,
+    ScanConfig,
+    ScanConfigResolver,
+    detector_for,
+    load_scan_config,
+    matches_selector,
+    merge_scan_configs,
+)
+
+from tests.deep_verify.scan.conftest import write_file
+
+
+def _pattern(pattern_id: str, severity: Severity = Severity.ERROR) -> Pattern:
+    return Pattern(
+        id=PatternId(pattern_id),
+        domain=ArtifactDomain.CONCURRENCY,
+        signals=[],
+        severity=severity,
+    )
+
+
+class TestMatchesSelector:
+    """Tests for matches_selector()."""
+
+    def test_exact_id(self) -> None:
+        """Test exact ID selectors."""
+        assert matches_selector("CC-004-CODE-GO", "CC-004-CODE-GO")
+        assert not matches_selector("CC-004-CODE-PY", "CC-004-CODE-GO")
+
+    def test_base_id_covers_code_variants(self) -> None:
+        """Test that a base ID selects its code variants."""
+        assert matches_selector("CC-004", "CC-004")
+        assert matches_selector("CC-004-CODE-GO", "cc-004")
+        assert not matches_selector("CC-040-CODE-GO", "CC-004")
+
+    def test_prefix_selector(self) -> None:
+        """Test trailing-dash prefix selectors."""
+        assert matches_selector("CC-099-CODE-GO", "CC-")
+        assert not matches_selector("SEC-001-CODE-GO", "CC-")
+
+    def test_empty_selector(self) -> None:
+        """Test that empty selectors match nothing."""
+        assert not matches_selector("CC-001", " ")
+
+
+class TestScanConfig:
+    """Tests for ScanConfig."""
+
+    def test_default_enables_everything(self) -> None:
+        """Test that the default config enables all patterns."""
+        assert ScanConfig().is_enabled("CC-001-CODE-GO")
+
+    def test_enable_and_disable(self) -> None:
+        """Test that disable applies after enable."""
+        config = ScanConfig(enable=["CC-"], disable=["CC-002"])
+        assert config.is_enabled("CC-001-CODE-GO")
+        assert not config.is_enabled("CC-002-CODE-GO")
+        assert not config.is_enabled("SEC-001-CODE-GO")
+
+    def test_opt_in_patterns_need_selection(self) -> None:
+        """Test that opt-in patterns run only when selected by opt_in."""
+        assert not ScanConfig().is_enabled("CC-101-CODE-GO", opt_in=True)
+        assert ScanConfig(opt_in=["CC-101"]).is_enabled("CC-101-CODE-GO", opt_in=True)
+        # enable does not turn on opt-in patterns
+        assert not ScanConfig(enable=["CC-"]).is_enabled("CC-101-CODE-GO", opt_in=True)
+
+    def test_disable_wins_over_opt_in(self) -> None:
+        """Test that disable still applies to opted-in patterns."""
+        config = ScanConfig(opt_in=["CC-101"], disable=["CC-101"])
+        assert not config.is_enabled("CC-101-CODE-GO", opt_in=True)
+
+    def test_severity_override_prefers_exact_id(self) -> None:
+        """Test that exact-ID severity overrides beat broader selectors."""
+        config = ScanConfig(
+            severity={"CC-": Severity.INFO, "CC-001-CODE-GO": Severity.WARNING}
+        )
+        assert config.severity_for(_pattern("CC-001-CODE-GO")) == Severity.WARNING
+        assert config.severity_for(_pattern("CC-002-CODE-GO")) == Severity.INFO
+        assert config.severity_for(_pattern("SEC-001-CODE-GO")) == Severity.ERROR
+
+
+class TestMergeScanConfigs:
+    """Tests for merge_scan_configs()."""
+
+    def test_child_replaces_set_keys(self) -> None:
+        """Test that keys set in the child replace inherited values."""
+        parent = ScanConfig(enable=["CC-"], disable=["CC-001"])
+        child = ScanConfig(disable=["CC-002"])
+
+        merged = merge_scan_configs(parent, child)
+
+        assert merged.enable == ["CC-"]
+        assert merged.disable == ["CC-002"]
+
+    def test_severity_merges_key_wise(self) -> None:
+        """Test that severity overrides merge with child entries winning."""
+        parent = ScanConfig(severity={"CC-001": Severity.INFO, "CC-002": Severity.INFO})
+        child = ScanConfig(severity={"CC-002": Severity.CRITICAL})
+
+        merged = merge_scan_configs(parent, child)
+
+        assert merged.severity == {"CC-001": Severity.INFO, "CC-002": Severity.CRITICAL}
+
+    def test_detectors_merge_key_wise(self) -> None:
+        """Test that detector options merge per detector with child entries winning."""
+        parent = ScanConfig(
+            detectors={"CC-146": {"secret_words": ["seed"]}, "CC-142": {"limiting_calls": ["A"]}}
+        )
+        child = ScanConfig(detectors={"CC-142": {"limiting_calls": ["B"]}})
+
+        merged = merge_scan_configs(parent, child)
+
+        env = merged.detector_options(UNCHECKED_ENV_PATTERN.id, UncheckedEnvOptions)
+        body = merged.detector_options(UNBOUNDED_BODY_PATTERN.id, UnboundedBodyOptions)
+        assert (env.secret_words, body.limiting_calls) == (["seed"], ["B"])
+
+
+class TestLoadScanConfig:
+    """Tests for load_scan_config()."""
+
+    def test_load_valid(self, tmp_path: Path) -> None:
+        """Test loading a valid config file."""
+        path = write_file(
+            tmp_path, CONFIG_FILENAME, "enable: [CC-]\nseverity:\n  CC-001: warning\n"
+        )
+        config = load_scan_config(path)
+        assert config.enable == ["CC-"]
+        assert config.severity == {"CC-001": Severity.WARNING}
+
+    def test_load_empty(self, tmp_path: Path) -> None:
+        """Test that an empty file yields the default config."""
+        path = write_file(tmp_path, CONFIG_FILENAME, "")
+        assert load_scan_config(path) == ScanConfig()
+
+    def test_load_not_mapping(self, tmp_path: Path) -> None:
+        """Test that a non-mapping document is rejected."""
+        path = write_file(tmp_path, CONFIG_FILENAME, "- CC-001\n")
+        with pytest.raises(ConfigError, match="must be a mapping"):
+            load_scan_config(path)
+
+    def test_load_invalid_severity(self, tmp_path: Path) -> None:
+        """Test that invalid severities are rejected."""
+        path = write_file(tmp_path, CONFIG_FILENAME, "severity:\n  CC-001: fatal\n")
+        with pytest.raises(ConfigError, match="Invalid scan config"):
+            load_scan_config(path)
+
+    def test_load_invalid_suppression_field(self, tmp_path: Path) -> None:
+        """Test that unknown suppression fields are rejected."""
+        path = write_file(tmp_path, CONFIG_FILENAME, "suppression_fields: [team]\n")
+        with pytest.raises(ConfigError, match="Invalid scan config"):
+            load_scan_config(path)
+
+
+class TestDetectorOptions:
+    """Tests for the detectors key and ScanConfig.detector_options()."""
+
+    def test_detector_for(self) -> None:
+        """Test that exact and base IDs name a detector and prefixes do not."""
+        assert detector_for("CC-146") == "CC-146-CODE-GO"
+        assert detector_for("cc-146-code-go") == "CC-146-CODE-GO"
+        assert detector_for("CC-") is None
+        assert detector_for("CC-001") is None
+
+    def test_detector_receives_configured_options(self, tmp_path: Path) -> None:
+        """Test that a loaded entry reaches the detector as its typed options."""
+        path = write_file(
+            tmp_path, CONFIG_FILENAME, "detectors:\n  CC-146:\n    secret_words: [seed]\n"
+        )
+
+        config = load_scan_config(path)
+
+        options = config.detector_options(UNCHECKED_ENV_PATTERN.id, UncheckedEnvOptions)
+        assert options == UncheckedEnvOptions(secret_words=["seed"])
+
+    def test_defaults_when_not_configured(self) -> None:
+        """Test that unconfigured detectors get their options' defaults."""
+        options = ScanConfig().detector_options(UNCHECKED_ENV_PATTERN.id, UncheckedEnvOptions)
+        assert options == UncheckedEnvOptions()
+
+    @pytest.mark.parametrize(
+        ("entry", "message"),
+        [
+            ("CC-001:\n    words: [a]", "Unknown detector in detectors: 'CC-001'"),
+            ("CC-:\n    secret_words: [a]", "Unknown detector in detectors: 'CC-'"),
+            ("CC-146:\n    secret_word: [seed]", "Invalid options for CC-146"),
+            ("CC-146:\n    secret_words: seed", "Invalid options for CC-146"),
+            ("CC-146:\n    secret_words: [api_seed]", "Invalid secret word"),
+            ("CC-142:\n    limiting_calls: [limits.LimitBody]", "Invalid limiting call"),
+        ],
+    )
+    def test_invalid_options_rejected(self, tmp_path: Path, entry: str, message: str) -> None:
+        """Test that unknown detectors and invalid option values fail at load."""
+        path = write_file(tmp_path, CONFIG_FILENAME, f"detectors:\n  {entry}\n")
+        with pytest.raises(ConfigError, match=message):
+            load_scan_config(path)
+
+
+class TestScanConfigResolver:
+    """Tests for nearest-ancestor config resolution."""
+
+    def test_subtree_config_overrides_root(self, go_tree: Path) -> None:
+        """Test that subtree and root configs yield different enabled sets."""
+        write_file(go_tree, CONFIG_FILENAME, "disable: [CC-001]\n")
+        write_file(go_tree, f"teams/payments/{CONFIG_FILENAME}", "disable: [CC-002]\n")
+        resolver = ScanConfigResolver(go_tree)
+
+        root_config = resolver.resolve(go_tree / "main.go")
+        payments_config = resolver.resolve(go_tree / "teams/payments/worker.go")
+        search_config = resolver.resolve(go_tree / "teams/search/indexer.go")
+
+        assert not root_config.is_enabled("CC-001-CODE-GO")
+        assert root_config.is_enabled("CC-002-CODE-GO")
+        assert payments_config.is_enabled("CC-001-CODE-GO")
+        assert not payments_config.is_enabled("CC-002-CODE-GO")
+        # No config of its own: inherits the root
+        assert search_config == root_config
+
+    def test_nearest_ancestor_wins(self, tmp_path: Path) -> None:
+        """Test that the deepest config on the path takes precedence."""
+        write_file(tmp_path, CONFIG_FILENAME, "enable: [SEC-]\n")
+        write_file(tmp_path, f"a/{CONFIG_FILENAME}", "enable: [CC-]\n")
+        write_file(tmp_path, f"a/b/{CONFIG_FILENAME}", "enable: [CQ-]\n")
+        resolver = ScanConfigResolver(tmp_path)
+
+        config = resolver.resolve(tmp_path / "a/b/c/file.go")
+
+        assert config.enable == ["CQ-"]
+
+    def test_base_config_applies_beneath_files(self, tmp_path: Path) -> None:
+        """Test that the base config is inherited by file configs."""
+        write_file(tmp_path, f"a/{CONFIG_FILENAME}", "disable: [CC-002]\n")
+        base = ScanConfig(severity={"CC-001": Severity.INFO})
+        resolver = ScanConfigResolver(tmp_path, base=base)
+
+        config = resolver.resolve(tmp_path / "a/file.go")
+
+        assert config.disable == ["CC-002"]
+        assert config.severity == {"CC-001": Severity.INFO}
+
+    def test_configs_above_root_are_ignored(self, tmp_path: Path) -> None:
+        """Test that configs outside the scan root do not apply."""
+        write_file(tmp_path, CONFIG_FILENAME, "disable: [CC-]\n")
+        resolver = ScanConfigResolver(tmp_path / "project")
+
+        config = resolver.resolve(tmp_path / "project/main.go")
+
+        assert config.is_enabled("CC-001-CODE-GO")
+
+    def test_use_files_false(self, go_tree: Path) -> None:
+        """Test that config files can be ignored."""
+        write_file(go_tree, CONFIG_FILENAME, "disable: [CC-]\n")
+        resolver = ScanConfigResolver(go_tree, use_files=False)
+
+        assert resolver.resolve(go_tree / "main.go").is_enabled("CC-001-CODE-GO")
@@ -0,0 +1,143 @@
+"""Tests for context.Context struct field detection (CC-127)."""
+
+from pathlib import Path
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import CONFIG_FILENAME, ScanConfig, find_context_fields
+
+from tests.deep_verify.scan.conftest import GO_CONTEXT_FIELD, scan_locations, write_file
+
+PASSED = """package client
+
+import "context"
+
+type Client struct {
+    name string
+}
+
+func (c *Client) Fetch(ctx context.Context, id string) error {
+    return nil
+}
+"""
+
+
+def _fields(text: str, config: ScanConfig | None = None) -> list[tuple[int, str]]:
+    findings = find_context_fields(text, "x.go", config or ScanConfig())
+    return [(f.line, f.title) for f in findings]
+
+
+class TestFindContextFields:
+    """Tests for find_context_fields."""
+
+    def test_stored_context(self) -> None:
+        """Test reporting a struct field of type context.Context."""
+        (finding,) = find_context_fields(GO_CONTEXT_FIELD, "client.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-127-CODE-GO"
+        assert finding.severity == Severity.INFO
+        assert finding.line == 7
+        assert finding.title == "Client stores a context.Context in ctx"
+        assert finding.snippet == "ctx  context.Context"
+
+    def test_context_parameter_is_safe(self) -> None:
+        """Test that passing a context to a method is not reported."""
+        assert _fields(PASSED) == []
+
+    def test_aliased_grouped_and_embedded_fields(self) -> None:
+        """Test aliased imports, grouped names, embedding and tagged fields."""
+        text = """package worker
+
+import (
+    stdctx "context"
+)
+
+type Worker struct {
+    done chan struct{}
+    parent, child stdctx.Context
+    stdctx.Context
+    tagged stdctx.Context `json:"-"`
+}
+"""
+        assert _fields(text) == [
+            (9, "Worker stores a context.Context in parent, child"),
+            (10, "Worker stores a context.Context in Context"),
+            (11, "Worker stores a context.Context in tagged"),
+        ]
+
+    def test_type_block_and_anonymous_struct(self) -> None:
+        """Test type block specs and anonymous struct types, including field types."""
+        text = """package jobs
+
+import "context"
+
+type (
+    Job struct {
+        meta struct {
+            ctx context.Context
+        }
+    }
+    ID string
+)
+
+var pending = []struct {
+    ctx context.Context
+}{}
+"""
+        assert _fields(text) == [
+            (8, "Anonymous struct stores a context.Context in ctx"),
+            (15, "Anonymous struct stores a context.Context in ctx"),
+        ]
+
+    def test_local_context_type_ignored(self) -> None:
+        """Test that a Context type not imported from "context" is not reported."""
+        text = """package local
+
+type Context struct{}
+
+type Handler struct {
+    ctx Context
+}
+"""
+        assert _fields(text) == []
+
+    def test_comments_and_strings_ignored(self) -> None:
+        """Test that commented-out fields and code in strings are not reported."""
+        text = """package client
+
+import "context"
+
+type Client struct {
+    // ctx context.Context
+    doc string
+}
+
+const example = "type T struct { ctx context.Context }"
+"""
+        assert _fields(text) == []
+
+    def test_context_holders_allowlist(self) -> None:
+        """Test that types named in context_holders are exempt."""
+        text = GO_CONTEXT_FIELD + """
+type requestScope struct {
+    ctx context.Context
+}
+"""
+        config = ScanConfig(context_holders=["requestScope"])
+        assert _fields(text, config) == [(7, "Client stores a context.Context in ctx")]
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-127."""
+        config = ScanConfig(disable=["CC-127"])
+        assert find_context_fields(GO_CONTEXT_FIELD, "x.go", config) == []
+
+
+class TestScannerContextFields:
+    """Tests for CC-127 in tree scans."""
+
+    def test_context_holders_from_config_file(self, tmp_path: Path) -> None:
+        """Test that context_holders in a subtree config exempts its types."""
+        write_file(tmp_path, "client.go", GO_CONTEXT_FIELD)
+        write_file(tmp_path, "scope/scope.go", GO_CONTEXT_FIELD.replace("Client", "Scope"))
+        write_file(tmp_path, f"scope/{CONFIG_FILENAME}", "context_holders: [Scope]\n")
+
+        assert scan_locations(tmp_path, "CC-127-CODE-GO") == [("client.go", 7)]
@@ -0,0 +1,100 @@
+"""Tests for narrowing integer conversions (CC-148)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import ScanConfig, find_narrowing_conversions
+
+from tests.deep_verify.scan.conftest import GO_NARROWING_CONVERSION
+
+SAFE = """package quota
+
+const defaultQuota = 1 << 20
+
+func quotas(n int32, m int64) (int64, int32, int32) {
+    wide := int64(n)
+    small := int32(100)
+    limit := int32(defaultQuota)
+    return wide + m, small, limit
+}
+"""
+
+
+def _conversions(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_narrowing_conversions(text, "x.go", ScanConfig())]
+
+
+class TestFindNarrowingConversions:
+    """Tests for find_narrowing_conversions."""
+
+    def test_int64_variable_to_int32(self) -> None:
+        """Test reporting an int64 parameter converted to int32."""
+        (finding,) = find_narrowing_conversions(GO_NARROWING_CONVERSION, "quota.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-148-CODE-GO"
+        assert finding.severity == Severity.INFO
+        assert (finding.line, finding.title) == (
+            4,
+            "int32(n) narrows int64 - out-of-range values are truncated",
+        )
+        assert finding.snippet == "quota := int32(n)"
+
+    def test_constants_and_widening_are_safe(self) -> None:
+        """Test that literals, constants and widening conversions are not reported."""
+        assert _conversions(SAFE) == []
+
+    def test_type_sources(self) -> None:
+        """Test fields, locals, function results, ParseInt, word types and bounds."""
+        text = """package quota
+
+import (
+    "math"
+    "os"
+    "strconv"
+)
+
+var total uint64
+
+type Header struct {
+    Count int64
+    Kind  uint8
+}
+
+func count() int64 { return 0 }
+
+func read(h Header, fi os.FileInfo, s string, a, b int) (int, error) {
+    var big int64
+    n := int(h.Count)
+    k := uint16(h.Kind)
+    size := int(fi.Size())
+    c := int32(count())
+    t := uint32(total)
+    v, err := strconv.ParseInt(s, 10, 32)
+    w := int32(v)
+    u, _ := strconv.ParseInt(s, 10, 64)
+    x := int16(u)
+    y := int8(a)
+    z := uint(b)
+    use(n, k, size, c, t, w, x, y, z, big)
+    return int(big), err
+}
+
+func bounded(n int64) int32 {
+    if n > math.MaxInt32 {
+        return 0
+    }
+    return int32(n)
+}
+"""
+        assert _conversions(text) == [
+            (20, "int(h.Count) narrows int64 - out-of-range values are truncated"),
+            (22, "int(fi.Size()) narrows int64 - out-of-range values are truncated"),
+            (23, "int32(count()) narrows int64 - out-of-range values are truncated"),
+            (24, "uint32(total) narrows uint64 - out-of-range values are truncated"),
+            (28, "int16(u) narrows int64 - out-of-range values are truncated"),
+            (29, "int8(a) narrows int - out-of-range values are truncated"),
+            (32, "int(big) narrows int64 - out-of-range values are truncated"),
+        ]
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-148."""
+        config = ScanConfig(disable=["CC-148"])
+        assert find_narrowing_conversions(GO_NARROWING_CONVERSION, "x.go", config) == []
@@ -0,0 +1,103 @@
+"""Tests for WaitGroups passed by value (CC-136)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import ScanConfig, find_waitgroup_copies
+
+from tests.deep_verify.scan.conftest import GO_WAITGROUP_BY_VALUE
+
+BY_POINTER = """package pool
+
+import "sync"
+
+func worker(id int, wg *sync.WaitGroup) {
+    defer wg.Done()
+    process(id)
+}
+"""
+
+
+def _copies(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_waitgroup_copies(text, "x.go", ScanConfig())]
+
+
+class TestFindWaitgroupCopies:
+    """Tests for find_waitgroup_copies."""
+
+    def test_by_value(self) -> None:
+        """Test reporting a WaitGroup parameter taken by value."""
+        (finding,) = find_waitgroup_copies(GO_WAITGROUP_BY_VALUE, "pool.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-136-CODE-GO"
+        assert finding.severity == Severity.ERROR
+        assert finding.line == 5
+        assert finding.title == "worker takes WaitGroup wg by value"
+        assert finding.snippet == "func worker(id int, wg sync.WaitGroup) {"
+
+    def test_by_pointer_is_safe(self) -> None:
+        """Test that a *sync.WaitGroup parameter is not reported."""
+        assert _copies(BY_POINTER) == []
+
+    def test_literals_methods_and_grouped_params(self) -> None:
+        """Test function literals, methods and `a, b sync.WaitGroup` parameters."""
+        text = """package pool
+
+import (
+    "fmt"
+    gosync "sync"
+)
+
+func (p *Pool) Run(jobs []Job) {
+    var wg gosync.WaitGroup
+    for _, job := range jobs {
+        wg.Add(1)
+        go func(job Job, wg gosync.WaitGroup) {
+            defer wg.Done()
+            job.Do()
+        }(job, wg)
+    }
+    wg.Wait()
+}
+
+func (p Pool) drain(started, finished gosync.WaitGroup) {
+    started.Wait()
+    finished.Wait()
+}
+"""
+        assert _copies(text) == [
+            (12, "Function literal takes WaitGroup wg by value"),
+            (20, "drain takes WaitGroup started by value"),
+            (20, "drain takes WaitGroup finished by value"),
+        ]
+
+    def test_unused_copies_and_other_types(self) -> None:
+        """Test that unused copies, other packages' types and func types are skipped."""
+        text = """package pool
+
+import (
+    "sync"
+
+    "example.com/other"
+)
+
+type Starter func(wg sync.WaitGroup)
+
+func count(wg sync.WaitGroup) int {
+    return 0 // wg.Wait() in a comment
+}
+
+func run(wg other.WaitGroup) {
+    wg.Wait()
+}
+"""
+        assert _copies(text) == []
+
+    def test_dot_import(self) -> None:
+        """Test resolving WaitGroup through a dot import of sync."""
+        text = GO_WAITGROUP_BY_VALUE.replace('import "sync"', 'import . "sync"')
+        text = text.replace("sync.Wait", "Wait")
+        assert _copies(text) == [(5, "worker takes WaitGroup wg by value")]
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-136."""
+        config = ScanConfig(disable=["CC-136"])
+        assert find_waitgroup_copies(GO_WAITGROUP_BY_VALUE, "x.go", config) == []
@@ -0,0 +1,132 @@
+"""Tests for channel sends in deferred functions (CC-131)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import ScanConfig, find_deferred_sends
+
+from tests.deep_verify.scan.conftest import GO_DEFERRED_SEND
+
+DEFERRED_SEND_DEFAULT = """package main
+
+func (w *Worker) run() {
+    defer func() {
+        select {
+        case w.results <- w.summary():
+        default:
+        }
+    }()
+    w.process()
+}
+"""
+
+
+def _sends(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_deferred_sends(text, "x.go", ScanConfig())]
+
+
+class TestFindDeferredSends:
+    """Tests for find_deferred_sends."""
+
+    def test_deferred_blocking_send(self) -> None:
+        """Test reporting an unguarded send in a deferred closure."""
+        (finding,) = find_deferred_sends(GO_DEFERRED_SEND, "worker.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-131-CODE-GO"
+        assert finding.severity == Severity.WARNING
+        assert finding.line == 5
+        assert finding.title == "Deferred function sends on w.results without a guard"
+        assert finding.snippet == "w.results <- w.summary()"
+
+    def test_deferred_send_with_default_is_safe(self) -> None:
+        """Test that a select with a default case is not reported."""
+        assert _sends(DEFERRED_SEND_DEFAULT) == []
+
+    def test_select_guards(self) -> None:
+        """Test selects guarded by ctx.Done() or a timeout, and unguarded ones."""
+        text = """package main
+
+func run(ctx context.Context, out chan error) {
+    defer func() {
+        select {
+        case out <- err:
+        case <-ctx.Done():
+        }
+    }()
+    defer func() {
+        select {
+        case out <- err:
+        case <-time.After(time.Second):
+        }
+    }()
+    defer func() {
+        select {
+        case out <- err:
+        case other <- err:
+        }
+    }()
+}
+"""
+        assert _sends(text) == [
+            (18, "Deferred function sends on out without a guard"),
+            (19, "Deferred function sends on other without a guard"),
+        ]
+
+    def test_one_line_closures_and_arguments(self) -> None:
+        """Test sends in one-line closures and closures taking arguments."""
+        text = """package main
+
+func run(done chan struct{}, errs chan error) (err error) {
+    defer func() { cleanup(); done <- struct{}{} }()
+    defer func(e error) {
+        if e != nil {
+            errs <- e
+        }
+    }(err)
+    return nil
+}
+"""
+        assert _sends(text) == [
+            (4, "Deferred function sends on done without a guard"),
+            (7, "Deferred function sends on errs without a guard"),
+        ]
+
+    def test_buffered_channels_are_safe(self) -> None:
+        """Test that channels made with a buffer in the file are not reported."""
+        text = """package main
+
+func New() *Worker {
+    return &Worker{results: make(chan Result, 1)}
+}
+
+func run() {
+    done := make(chan bool, 1)
+    block := make(chan bool, 0)
+    defer func() {
+        w.results <- summary()
+        done <- true
+        block <- true
+    }()
+}
+"""
+        assert _sends(text) == [(13, "Deferred function sends on block without a guard")]
+
+    def test_safe_forms(self) -> None:
+        """Test receives, goroutines, plain defers, strings and comments."""
+        text = """package main
+
+func run(ch chan int) {
+    defer func() {
+        v := <-ch
+        <-ch
+        go func() { ch <- v }()
+        log("ch <- v") // ch <- v
+    }()
+    defer close(ch)
+    ch <- 1
+}
+"""
+        assert _sends(text) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-131."""
+        config = ScanConfig(disable=["CC-131"])
+        assert find_deferred_sends(GO_DEFERRED_SEND, "x.go", config) == []
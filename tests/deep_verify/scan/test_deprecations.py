@@ -0,0 +1,191 @@
+"""Tests for deprecated function detection (CC-111)."""
+
+from pathlib import Path
+
+from bmad_assist.deep_verify.core.types import PatternId, Severity
+from bmad_assist.deep_verify.patterns.library import get_default_pattern_library
+from bmad_assist.deep_verify.scan import (
+    DEFAULT_DEPRECATED_FUNCS,
+    ScanConfig,
+    ScanOptions,
+    Scanner,
+    find_deprecated_calls,
+    parse_go_imports,
+)
+
+from tests.deep_verify.scan.conftest import GO_DEPRECATED_CALL, write_file
+
+OS_READ = """package config
+
+import "os"
+
+func Load(path string) ([]byte, error) {
+    return os.ReadFile(path)
+}
+"""
+
+
+def _calls(text: str, deprecated: dict[str, str] | None = None) -> list[tuple[int, str]]:
+    findings = find_deprecated_calls(text, "x.go", ScanConfig(), deprecated)
+    return [(f.line, f.title) for f in findings]
+
+
+class TestParseGoImports:
+    """Tests for Go import parsing."""
+
+    def test_import_forms(self) -> None:
+        """Test single, grouped, aliased, dot and blank imports."""
+        text = """package main
+
+import "strings"
+
+import (
+    "io/ioutil"
+    mrand "math/rand"
+    . "bytes"
+    _ "embed"
+    yaml "gopkg.in/yaml.v3"
+    "github.com/acme/lib/v2"
+)
+"""
+        assert parse_go_imports(text) == {
+            "strings": "strings",
+            "ioutil": "io/ioutil",
+            "mrand": "math/rand",
+            ".": "bytes",
+            "yaml": "gopkg.in/yaml.v3",
+            "lib": "github.com/acme/lib/v2",
+        }
+
+
+class TestFindDeprecatedCalls:
+    """Tests for find_deprecated_calls."""
+
+    def test_ioutil_read_file(self) -> None:
+        """Test reporting ioutil.ReadFile with its replacement."""
+        (finding,) = find_deprecated_calls(GO_DEPRECATED_CALL, "config.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-111-CODE-GO"
+        assert finding.line == 9
+        assert finding.title == "io/ioutil.ReadFile is deprecated"
+        assert finding.remediation == "Use os.ReadFile"
+        assert finding.severity == Severity.WARNING
+
+    def test_os_read_file_is_safe(self) -> None:
+        """Test that the replacement is not reported."""
+        assert _calls(OS_READ) == []
+
+    def test_resolves_aliases_and_dot_imports(self) -> None:
+        """Test that references resolve through import aliases."""
+        text = """package main
+
+import (
+    mrand "math/rand"
+    . "strings"
+)
+
+func init() {
+    mrand.Seed(42)
+    name := Title("go")
+    _ = name
+}
+"""
+        assert _calls(text) == [
+            (9, "math/rand.Seed is deprecated"),
+            (10, "strings.Title is deprecated"),
+        ]
+
+    def test_unrelated_package_with_same_name(self) -> None:
+        """Test that a local package named like a stdlib one is not reported."""
+        text = """package main
+
+import "example.com/shop/internal/ioutil"
+
+func main() {
+    ioutil.ReadFile("x")
+}
+"""
+        assert _calls(text) == []
+
+    def test_function_value_reference(self) -> None:
+        """Test that function values are reported, not only calls."""
+        text = 'package main\n\nimport "io/ioutil"\n\nvar read = ioutil.ReadFile\n'
+        assert _calls(text) == [(5, "io/ioutil.ReadFile is deprecated")]
+
+    def test_comments_and_strings_are_ignored(self) -> None:
+        """Test that mentions in comments and string literals are not reported."""
+        text = """package main
+
+import "io/ioutil"
+
+// Previously used ioutil.ReadFile here.
+func main() {
+    log.Print("replaced ioutil.ReadFile")
+    _ = ioutil.NopCloser
+}
+"""
+        assert _calls(text) == [(8, "io/ioutil.NopCloser is deprecated")]
+
+    def test_custom_deprecations(self) -> None:
+        """Test reporting internal deprecations."""
+        text = """package main
+
+import "example.com/shop/legacy"
+
+func main() {
+    legacy.Charge(order)
+}
+"""
+        deprecated = {"example.com/shop/legacy.Charge": "billing.Charge"}
+        (finding,) = find_deprecated_calls(text, "main.go", ScanConfig(), deprecated)
+
+        assert finding.title == "example.com/shop/legacy.Charge is deprecated"
+        assert finding.remediation == "Use billing.Charge"
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-111."""
+        config = ScanConfig(disable=["CC-111"])
+        assert find_deprecated_calls(GO_DEPRECATED_CALL, "config.go", config) == []
+
+    def test_default_list_keys(self) -> None:
+        """Test that default entries name an import path and a function."""
+        for key, replacement in DEFAULT_DEPRECATED_FUNCS.items():
+            path, _, func = key.rpartition(".")
+            assert path and func[0].isupper(), key
+            assert replacement, key
+
+
+class TestScannerDeprecations:
+    """Tests for CC-111 in tree scans."""
+
+    def test_options_extend_default_list(self, tmp_path: Path) -> None:
+        """Test that ScanOptions.deprecated_funcs adds to the defaults."""
+        write_file(tmp_path, "old.go", GO_DEPRECATED_CALL)
+        write_file(tmp_path, "new.go", OS_READ)
+        options = ScanOptions(deprecated_funcs={"os.ReadFile": "fsys.ReadFile"})
+
+        report = Scanner(options).scan(tmp_path)
+
+        titles = {f.title for f in report.findings if f.pattern_id == "CC-111-CODE-GO"}
+        assert titles == {"io/ioutil.ReadFile is deprecated", "os.ReadFile is deprecated"}
+
+    def test_suppression_applies(self, tmp_path: Path) -> None:
+        """Test that deepverify:ignore silences CC-111."""
+        write_file(
+            tmp_path,
+            "old.go",
+            GO_DEPRECATED_CALL.replace(
+                "ioutil.ReadFile(path)", "ioutil.ReadFile(path) // deepverify:ignore CC-111"
+            ),
+        )
+
+        report = Scanner().scan(tmp_path)
+
+        assert all(f.pattern_id != "CC-111-CODE-GO" for f in report.findings)
+
+    def test_explicit_patterns_skip_builtin_checks(self) -> None:
+        """Test that scan_source with explicit patterns runs only those."""
+        pattern = get_default_pattern_library().get_pattern(PatternId("CC-001-CODE-GO"))
+        assert pattern is not None
+
+        assert Scanner().scan_source(GO_DEPRECATED_CALL, "go", patterns=[pattern]) == []
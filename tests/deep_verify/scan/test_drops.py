@@ -0,0 +1,113 @@
+"""Tests for select sends dropped by an empty default (CC-145)."""
+
+from pathlib import Path
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import ScanConfig, ScanOptions, Scanner, find_silent_drops
+
+from tests.deep_verify.scan.conftest import GO_SILENT_DROP, write_file
+
+COUNTED = """package bus
+
+func (b *Bus) Publish(ev Event) {
+    select {
+    case b.events <- ev:
+    default:
+        b.dropped.Add(1)
+    }
+}
+"""
+
+BLOCKING = """package bus
+
+func (b *Bus) Publish(ev Event) {
+    select {
+    case b.events <- ev:
+    case <-b.done:
+    }
+}
+"""
+
+
+def _drops(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_silent_drops(text, "x.go", ScanConfig())]
+
+
+class TestFindSilentDrops:
+    """Tests for find_silent_drops."""
+
+    def test_send_with_empty_default(self) -> None:
+        """Test reporting a send whose select default does nothing."""
+        (finding,) = find_silent_drops(GO_SILENT_DROP, "bus.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-145-CODE-GO"
+        assert finding.severity == Severity.INFO
+        assert (finding.line, finding.title) == (
+            5,
+            "Send on b.events dropped silently when full - the select default is empty",
+        )
+        assert finding.snippet == "case b.events <- ev:"
+
+    def test_counted_drop_and_blocking_send_are_safe(self) -> None:
+        """Test that a default recording the drop and a select without default pass."""
+        assert _drops(COUNTED) == []
+        assert _drops(BLOCKING) == []
+
+    def test_clause_forms(self) -> None:
+        """Test one-line selects, receive cases, literals and comment-only defaults."""
+        text = """package bus
+
+func forward(in <-chan Event, out chan<- Event, ch chan int, v int) {
+    select { case ch <- v: default: }
+    select {
+    case msg := <-in:
+        use(msg)
+    case out <- Event{ID: 1, Name: "a:b"}:
+    default:
+        // best effort
+    }
+    select {
+    case <-in:
+    default:
+    }
+    select {
+    case ch <- v:
+    default:
+        select {
+        case out <- Event{}:
+        default:
+        }
+    }
+}
+"""
+        assert _drops(text) == [
+            (4, "Send on ch dropped silently when full - the select default is empty"),
+            (8, "Send on out dropped silently when full - the select default is empty"),
+            (20, "Send on out dropped silently when full - the select default is empty"),
+        ]
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-145."""
+        config = ScanConfig(disable=["CC-145"])
+        assert find_silent_drops(GO_SILENT_DROP, "x.go", config) == []
+
+
+class TestScannerSilentDrops:
+    """Tests for CC-145 in tree scans."""
+
+    def test_suppressed_with_reason(self, tmp_path: Path) -> None:
+        """Test that intentional best-effort sends are suppressed with their reason."""
+        write_file(
+            tmp_path,
+            "silent.go",
+            GO_SILENT_DROP.replace(
+                "case b.events <- ev:",
+                'case b.events <- ev: // deepverify:ignore CC-145 reason="best-effort notify"',
+            ),
+        )
+
+        report = Scanner(ScanOptions(show_suppressed=True)).scan(tmp_path)
+
+        (finding,) = [f for f in report.findings if f.pattern_id == "CC-145-CODE-GO"]
+        assert finding.suppressed
+        assert finding.suppression_reason == "best-effort notify"
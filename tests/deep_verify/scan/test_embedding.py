@@ -0,0 +1,120 @@
+"""Tests for mutexes shared through struct embedding (CC-105)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    PROMOTED_MUTEX_CONFIDENCE,
+    ScanConfig,
+    find_promoted_mutex_locks,
+)
+
+from tests.deep_verify.scan.conftest import GO_PROMOTED_MUTEX_LOCK
+
+SEPARATE = GO_PROMOTED_MUTEX_LOCK.replace(
+    "    Conn\n    idle bool", "    Conn\n    poolMu sync.Mutex\n    idle   bool"
+).replace("p.mu.", "p.poolMu.")
+
+
+def _locks(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_promoted_mutex_locks(text, "x.go", ScanConfig())]
+
+
+class TestFindPromotedMutexLocks:
+    """Tests for find_promoted_mutex_locks."""
+
+    def test_outer_method_locks_promoted_mutex(self) -> None:
+        """Test reporting an outer method locking the embedded type's mutex."""
+        (finding,) = find_promoted_mutex_locks(GO_PROMOTED_MUTEX_LOCK, "pool.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-105-CODE-GO"
+        assert finding.severity == Severity.WARNING
+        assert finding.confidence == PROMOTED_MUTEX_CONFIDENCE
+        assert (finding.line, finding.title) == (
+            22,
+            "PooledConn.Release locks mu promoted from Conn, which Conn methods also lock",
+        )
+        assert finding.snippet == "p.mu.Lock()"
+
+    def test_separate_locks_are_safe(self) -> None:
+        """Test that an outer type with its own mutex is not reported."""
+        assert _locks(SEPARATE) == []
+
+    def test_pointer_embedding_and_read_locks(self) -> None:
+        """Test a pointer-embedded RWMutex holder locked under RLock and Lock."""
+        text = """package store
+
+import stdsync "sync"
+
+type Store struct {
+    mu   stdsync.RWMutex `json:"-"`
+    data map[string]string
+}
+
+func (s *Store) Get(k string) string {
+    s.mu.RLock()
+    defer s.mu.RUnlock()
+    return s.data[k]
+}
+
+type CachedStore struct {
+    *Store
+    hits int
+}
+
+func (c *CachedStore) Lookup(k string) string {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    c.hits++
+    return c.Get(k)
+}
+"""
+        assert _locks(text) == [
+            (22, "CachedStore.Lookup locks mu promoted from Store, which Store methods also lock"),
+        ]
+
+    def test_unshared_and_shadowed_mutexes(self) -> None:
+        """Test inner mutexes its methods never lock, and outer fields shadowing them."""
+        text = """package pool
+
+import "sync"
+
+type Base struct {
+    mu sync.Mutex
+}
+
+func (b *Base) Name() string { return "base" }
+
+type Conn struct {
+    mu  sync.Mutex
+    buf []byte
+}
+
+func (c *Conn) Write(p []byte) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+}
+
+type Worker struct {
+    Base
+}
+
+func (w *Worker) Run() {
+    w.mu.Lock()
+    defer w.mu.Unlock()
+}
+
+type Shadow struct {
+    Conn
+    mu sync.Mutex
+}
+
+func (s *Shadow) Close() {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+}
+"""
+        assert _locks(text) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-105."""
+        config = ScanConfig(disable=["CC-105"])
+        assert find_promoted_mutex_locks(GO_PROMOTED_MUTEX_LOCK, "x.go", config) == []
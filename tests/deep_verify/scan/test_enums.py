@@ -0,0 +1,153 @@
+"""Tests for non-exhaustive enum switch detection (CC-123)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    ScanConfig,
+    find_enum_switches,
+    parse_go_enums,
+)
+
+from tests.deep_verify.scan.conftest import GO_ENUM_SWITCH_MISSING_CASE
+
+ENUM = """package order
+
+type State int
+
+const (
+    StateOpen State = iota
+    StateClosed
+    StateArchived
+)
+"""
+
+WITH_DEFAULT = (
+    ENUM
+    + """
+func label(s State) string {
+    switch s {
+    case StateOpen:
+        return "open"
+    default:
+        return "other"
+    }
+}
+"""
+)
+
+FULLY_COVERED = (
+    ENUM
+    + """
+func label(s State) string {
+    switch s {
+    case StateOpen:
+        return "open"
+    case StateClosed, StateArchived:
+        return "done"
+    }
+    return ""
+}
+"""
+)
+
+
+def _switches(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_enum_switches(text, "x.go", ScanConfig())]
+
+
+class TestParseGoEnums:
+    """Tests for parse_go_enums."""
+
+    def test_iota_block(self) -> None:
+        """Test that implicit repetition inherits the type."""
+        assert parse_go_enums(ENUM) == {"State": ("StateOpen", "StateClosed", "StateArchived")}
+
+    def test_string_enum_and_untyped_constants(self) -> None:
+        """Test typed string constants, skipped blanks and untyped constants."""
+        text = """package shape
+
+type Kind string
+
+const (
+    _          = iota
+    KindCircle Kind = "circle" // round
+    KindSquare Kind = "square"
+    maxSides        = 4
+    minSides
+)
+"""
+        assert parse_go_enums(text) == {"Kind": ("KindCircle", "KindSquare")}
+
+    def test_types_from_other_files_ignored(self) -> None:
+        """Test that constants of types not declared in the file are ignored."""
+        text = "package order\n\nconst (\n    A time.Duration = 1\n    B\n)\n"
+        assert parse_go_enums(text) == {}
+
+
+class TestFindEnumSwitches:
+    """Tests for find_enum_switches."""
+
+    def test_missing_case(self) -> None:
+        """Test reporting a switch that misses a member without a default."""
+        (finding,) = find_enum_switches(GO_ENUM_SWITCH_MISSING_CASE, "order.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-123-CODE-GO"
+        assert finding.line == 12
+        assert finding.title == "Switch over State has no default and misses StateArchived"
+        assert finding.severity == Severity.WARNING
+
+    def test_default_is_safe(self) -> None:
+        """Test that a default case handles future members."""
+        assert _switches(WITH_DEFAULT) == []
+
+    def test_fully_covered_is_safe(self) -> None:
+        """Test that a switch listing every member is not reported."""
+        assert _switches(FULLY_COVERED) == []
+
+    def test_nested_switch(self) -> None:
+        """Test that case clauses of a nested switch are not attributed to the outer one."""
+        text = (
+            ENUM
+            + """
+func label(s State, verbose bool) string {
+    switch s {
+    case StateOpen:
+        switch {
+        case verbose:
+            return "open for orders"
+        default:
+            return "open"
+        }
+    }
+    return ""
+}
+"""
+        )
+        assert _switches(text) == [
+            (12, "Switch over State has no default and misses StateClosed, StateArchived")
+        ]
+
+    def test_other_switches_ignored(self) -> None:
+        """Test that type switches and switches on other values are not checked."""
+        text = (
+            ENUM
+            + """
+func describe(v any, s State, n int) {
+    switch x := v.(type) {
+    case int:
+        _ = x
+    }
+    switch n {
+    case 1:
+    }
+    switch s {
+    case StateOpen, State(7):
+    }
+}
+"""
+        )
+        assert _switches(text) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-123."""
+        config = ScanConfig(disable=["CC-123"])
+        assert find_enum_switches(GO_ENUM_SWITCH_MISSING_CASE, "x.go", config) == []
@@ -0,0 +1,132 @@
+"""Tests for secrets read with os.Getenv and used unchecked (CC-146)."""
+
+from pathlib import Path
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    ScanConfig,
+    ScanOptions,
+    Scanner,
+    find_unchecked_env_reads,
+)
+
+from tests.deep_verify.scan.conftest import GO_UNCHECKED_ENV_READ, write_file
+
+LOOKED_UP = """package main
+
+import (
+    "errors"
+    "os"
+)
+
+func newClient() (*api.Client, error) {
+    key, ok := os.LookupEnv("API_KEY")
+    if !ok {
+        return nil, errors.New("API_KEY is not set")
+    }
+    return api.New(key), nil
+}
+"""
+
+
+def _reads(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_unchecked_env_reads(text, "x.go", ScanConfig())]
+
+
+def _title(name: str) -> str:
+    return f"{name} read with os.Getenv is used without checking that it is set"
+
+
+class TestFindUncheckedEnvReads:
+    """Tests for find_unchecked_env_reads."""
+
+    def test_getenv_passed_to_call(self) -> None:
+        """Test reporting a secret passed straight to a function."""
+        (finding,) = find_unchecked_env_reads(GO_UNCHECKED_ENV_READ, "main.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-146-CODE-GO"
+        assert finding.severity == Severity.INFO
+        assert finding.confidence == 0.7
+        assert (finding.line, finding.title) == (6, _title("API_KEY"))
+        assert finding.snippet == 'return api.New(os.Getenv("API_KEY"))'
+
+    def test_lookup_env_is_safe(self) -> None:
+        """Test that os.LookupEnv with an ok check is not reported."""
+        assert _reads(LOOKED_UP) == []
+
+    def test_assignments_and_comparisons(self) -> None:
+        """Test variables, fields, comparisons and empty checks."""
+        text = """package main
+
+import env "os"
+
+type Config struct{ DSN string }
+
+func load() Config {
+    token := env.Getenv("WEBHOOK_TOKEN")
+    secret := env.Getenv("SIGNING_SECRET")
+    if secret == "" {
+        panic("SIGNING_SECRET is not set")
+    }
+    if r.Header.Get("X-Token") == env.Getenv("ADMIN_TOKEN") {
+        allow()
+    }
+    if env.Getenv("DB_PASSWORD") != "" {
+        connect()
+    }
+    debug := env.Getenv("DEBUG")
+    use(token, secret, debug)
+    return Config{DSN: env.Getenv("DATABASE_DSN")}
+}
+
+func (c Config) Validate() error {
+    if len(c.DSN) == 0 {
+        return errMissing
+    }
+    return nil
+}
+
+func base() string {
+    return env.Getenv("BASE_URL") // callers check
+}
+"""
+        assert _reads(text) == [(8, _title("WEBHOOK_TOKEN")), (13, _title("ADMIN_TOKEN"))]
+
+    def test_ignores_comments_and_other_packages(self) -> None:
+        """Test that comments and Getenv of other packages are ignored."""
+        text = """package main
+
+import "os"
+
+// os.Getenv("API_KEY") is read in newClient.
+func newClient() *api.Client {
+    return api.New(config.Getenv("API_KEY"))
+}
+"""
+        assert _reads(text) == []
+        assert _reads(GO_UNCHECKED_ENV_READ.replace('import "os"', 'import "fmt"')) == []
+
+    def test_configured_secret_words(self) -> None:
+        """Test that detector options add secret words, in any case."""
+        text = GO_UNCHECKED_ENV_READ.replace("API_KEY", "SIGNING_SEED")
+        config = ScanConfig(detectors={"CC-146": {"secret_words": ["Seed"]}})
+
+        assert _reads(text) == []
+        assert [f.line for f in find_unchecked_env_reads(text, "x.go", config)] == [6]
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-146."""
+        config = ScanConfig(disable=["CC-146"])
+        assert find_unchecked_env_reads(GO_UNCHECKED_ENV_READ, "x.go", config) == []
+
+
+class TestScannerUncheckedEnvReads:
+    """Tests for CC-146 in tree scans."""
+
+    def test_filtered_by_threshold(self, tmp_path: Path) -> None:
+        """Test that a threshold above the check's confidence drops its findings."""
+        write_file(tmp_path, "unchecked.go", GO_UNCHECKED_ENV_READ)
+
+        report = Scanner(ScanOptions(threshold=0.8)).scan(tmp_path)
+
+        assert not [f for f in report.findings if f.pattern_id == "CC-146-CODE-GO"]
@@ -0,0 +1,97 @@
+"""Tests for errgroup goroutines that ignore the group's context (CC-151)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    ScanConfig,
+    find_group_context_leaks,
+)
+
+from tests.deep_verify.scan.conftest import GO_GROUP_CONTEXT_IGNORED
+
+HONORED = """package fetch
+
+import (
+    "context"
+
+    "golang.org/x/sync/errgroup"
+)
+
+func fetchAll(ctx context.Context, urls []string) error {
+    g, gctx := errgroup.WithContext(ctx)
+    for _, url := range urls {
+        g.Go(func() error {
+            return fetch(gctx, url)
+        })
+    }
+    return g.Wait()
+}
+"""
+
+
+def _leaks(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_group_context_leaks(text, "x.go", ScanConfig())]
+
+
+class TestFindGroupContextLeaks:
+    """Tests for find_group_context_leaks."""
+
+    def test_goroutine_ignoring_group_context(self) -> None:
+        """Test reporting a g.Go literal that uses the parent context."""
+        (finding,) = find_group_context_leaks(GO_GROUP_CONTEXT_IGNORED, "fetch.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-151-CODE-GO"
+        assert finding.severity == Severity.WARNING
+        assert (finding.line, finding.title) == (13, "g.Go function uses ctx instead of gctx")
+        assert finding.snippet == "return fetch(ctx, url)"
+
+    def test_goroutine_using_group_context_is_safe(self) -> None:
+        """Test that a g.Go literal using the group's context is not reported."""
+        assert _leaks(HONORED) == []
+
+    def test_fresh_contexts_and_shadowing(self) -> None:
+        """Test Background and TODO contexts, shadowed and discarded group contexts."""
+        text = """package fetch
+
+import (
+    stdctx "context"
+
+    eg "golang.org/x/sync/errgroup"
+)
+
+func shadowed(ctx stdctx.Context) error {
+    g, ctx := eg.WithContext(ctx)
+    g.Go(func() error { return ping(ctx) })
+    g.Go(func() error { return ping(stdctx.Background()) })
+    return g.Wait()
+}
+
+func discarded(ctx stdctx.Context) error {
+    group, _ := eg.WithContext(ctx)
+    group.Go(func() error {
+        log("start")
+        return ping(stdctx.TODO())
+    })
+    group.Go(func() error { return nil })
+    return group.Wait()
+}
+"""
+        assert _leaks(text) == [
+            (12, "g.Go function uses stdctx.Background() instead of ctx"),
+            (20, "group.Go function uses stdctx.TODO() instead of the discarded group context"),
+        ]
+
+    def test_ignores_other_groups(self) -> None:
+        """Test that groups without WithContext and other packages are not reported."""
+        plain = GO_GROUP_CONTEXT_IGNORED.replace(
+            "g, gctx := errgroup.WithContext(ctx)", "var g errgroup.Group"
+        )
+        assert _leaks(plain) == []
+        other = GO_GROUP_CONTEXT_IGNORED.replace(
+            "golang.org/x/sync/errgroup", "example.com/errgroup"
+        )
+        assert _leaks(other) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-151."""
+        config = ScanConfig(disable=["CC-151"])
+        assert find_group_context_leaks(GO_GROUP_CONTEXT_IGNORED, "x.go", config) == []
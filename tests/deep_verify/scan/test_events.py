@@ -0,0 +1,76 @@
+"""Tests for structured analysis events."""
+
+from datetime import UTC, datetime
+from pathlib import Path
+
+from bmad_assist.deep_verify.scan import (
+    AnalysisEvent,
+    AnalysisEventKind,
+    ScanCache,
+    ScanOptions,
+    Scanner,
+)
+
+from tests.deep_verify.scan.conftest import GO_CLEAN, GO_GENERATED, GO_GOROUTINE, write_file
+
+FIXED = datetime(2026, 1, 2, 3, 4, 5, tzinfo=UTC)
+
+
+def _recording_scanner(events: list[AnalysisEvent], cache: ScanCache | None = None) -> Scanner:
+    options = ScanOptions(
+        clock=lambda: FIXED,
+        load_concurrency=1,
+        analyze_concurrency=1,
+        event_sink=events.append,
+    )
+    return Scanner(options, cache=cache)
+
+
+class TestAnalysisEvents:
+    """Tests for ScanOptions.event_sink."""
+
+    def test_two_files_with_one_cache_hit(self, tmp_path: Path) -> None:
+        """Test the event sequence when one file is cached and the other changed."""
+        write_file(tmp_path, "a.go", GO_GOROUTINE)
+        write_file(tmp_path, "b.go", GO_CLEAN)
+        cache = ScanCache()
+        first = Scanner(ScanOptions(clock=lambda: FIXED), cache=cache).scan(tmp_path)
+        write_file(tmp_path, "b.go", GO_GOROUTINE + "\n// changed\n")
+        events: list[AnalysisEvent] = []
+
+        report = _recording_scanner(events, cache).scan(tmp_path)
+
+        cached = [f for f in first.findings if f.path == "a.go"]
+        fired = [(f.pattern_id, f.line) for f in report.findings if f.path == "b.go"]
+        assert cached and fired
+        assert [(e.kind, e.path) for e in events] == [
+            (AnalysisEventKind.CACHE_HIT, "a.go"),
+            (AnalysisEventKind.FILE_STARTED, "b.go"),
+            *[(AnalysisEventKind.DETECTOR_FIRED, "b.go")] * len(fired),
+            (AnalysisEventKind.FILE_FINISHED, "b.go"),
+        ]
+        assert events[0].findings == len(cached)
+        assert [(e.pattern_id, e.line) for e in events[2:-1]] == fired
+        assert events[-1].findings == len(fired)
+        assert all(e.timestamp == FIXED for e in events)
+
+    def test_skipped_files(self, tmp_path: Path) -> None:
+        """Test that skipped files report why they were not analyzed."""
+        write_file(tmp_path, "big.go", GO_GOROUTINE * 20)
+        write_file(tmp_path, "mock.go", GO_GENERATED)
+        events: list[AnalysisEvent] = []
+        options = ScanOptions(max_file_bytes=len(GO_GOROUTINE) * 10, event_sink=events.append)
+
+        Scanner(options).scan(tmp_path)
+
+        skipped = {e.path: e.reason for e in events if e.kind == AnalysisEventKind.FILE_SKIPPED}
+        assert skipped == {
+            "big.go": f"larger than {len(GO_GOROUTINE) * 10} bytes",
+            "mock.go": "generated",
+        }
+
+    def test_no_sink_by_default(self, tmp_path: Path) -> None:
+        """Test that scans without a sink behave as before."""
+        write_file(tmp_path, "a.go", GO_GOROUTINE)
+
+        assert Scanner().scan(tmp_path).findings
@@ -0,0 +1,158 @@
+"""Tests for automatic fixes of scan findings."""
+
+from dataclasses import replace
+from pathlib import Path
+
+import yaml
+
+from bmad_assist.deep_verify.core.types import ArtifactDomain, PatternFix, PatternId, Severity
+from bmad_assist.deep_verify.patterns.library import PATTERN_API_VERSION, PatternLibrary
+from bmad_assist.deep_verify.scan import (
+    ScanFinding,
+    Scanner,
+    apply_fixes,
+    compare_findings,
+    write_fixes,
+)
+
+from tests.deep_verify.scan.conftest import GO_CLEAN, GO_LEAKED_CANCEL, write_file
+
+# Appends a marker comment to a `call()` line
+_MARK_FIX = PatternFix(find=r"^([ \t]*)call\(\)$", replace=r"\g<0> // fixed")
+
+
+def _finding(line: int, pattern_id: str = "CC-900-CODE-GO", snippet: str = "call()") -> ScanFinding:
+    return ScanFinding(
+        pattern_id=PatternId(pattern_id),
+        severity=Severity.WARNING,
+        title="Call",
+        description="Call",
+        path="main.go",
+        line=line,
+        snippet=snippet,
+        confidence=1.0,
+        domain=ArtifactDomain.CONCURRENCY,
+        language="go",
+    )
+
+
+def _fix_for(pattern_id: str) -> PatternFix | None:
+    return _MARK_FIX if pattern_id == "CC-900-CODE-GO" else None
+
+
+class TestApplyFixes:
+    """Tests for apply_fixes()."""
+
+    TEXT = "func main() {\n    call()\n    other()\n    call()\n}\n"
+
+    def test_applies_each_fix_at_its_line(self) -> None:
+        fixed, applied = apply_fixes(self.TEXT, [_finding(4), _finding(2)], _fix_for)
+
+        assert fixed == "func main() {\n    call() // fixed\n    other()\n    call() // fixed\n}\n"
+        assert [f.line for f in applied] == [2, 4]
+
+    def test_skips_findings_without_matching_fix(self) -> None:
+        findings = [_finding(3), _finding(2, pattern_id="CC-901-CODE-GO"), _finding(99)]
+
+        assert apply_fixes(self.TEXT, findings, _fix_for) == (self.TEXT, [])
+
+    def test_skips_suppressed_findings(self) -> None:
+        finding = replace(_finding(2), suppressed=True)
+
+        assert apply_fixes(self.TEXT, [finding], _fix_for) == (self.TEXT, [])
+
+    def test_overlapping_fix_applied_once(self) -> None:
+        fixed, applied = apply_fixes(self.TEXT, [_finding(2), _finding(2)], _fix_for)
+
+        assert fixed.count("// fixed") == 1
+        assert len(applied) == 1
+
+
+class TestCompareFindings:
+    """Tests for compare_findings()."""
+
+    def test_resolved_and_introduced(self) -> None:
+        kept, gone, new = _finding(2), _finding(3, snippet="a()"), _finding(5, snippet="b()")
+
+        resolved, introduced = compare_findings([kept, gone], [replace(kept, line=3), new])
+
+        assert resolved == [gone]
+        assert introduced == [new]
+
+    def test_counts_duplicate_fingerprints(self) -> None:
+        resolved, introduced = compare_findings([_finding(2), _finding(4)], [_finding(2)])
+
+        assert [f.line for f in resolved] == [4]
+        assert introduced == []
+
+
+class TestScannerFix:
+    """Tests for Scanner.fix() and write_fixes()."""
+
+    def test_fix_resolves_leaked_cancel(self, tmp_path: Path) -> None:
+        path = write_file(tmp_path, "store/load.go", GO_LEAKED_CANCEL)
+        write_file(tmp_path, "main.go", GO_CLEAN)
+
+        [fix] = Scanner().fix(tmp_path)
+
+        assert fix.path == "store/load.go"
+        assert [f.pattern_id for f in fix.resolved] == ["CC-039-CODE-GO"]
+        assert fix.introduced == ()
+        assert "    defer cancel()\n    return fetch(ctx, id)" in fix.fixed
+        assert "+    defer cancel()" in fix.diff()
+        # Nothing is written until write_fixes
+        assert path.read_text() == GO_LEAKED_CANCEL
+
+        assert write_fixes([fix], tmp_path) == [path]
+        assert Scanner().scan(tmp_path).findings == []
+
+    def test_reports_findings_introduced_by_fixes(self, tmp_path: Path) -> None:
+        patterns = tmp_path / "patterns" / "code" / "go" / "fixes.yaml"
+        patterns.parent.mkdir(parents=True)
+        patterns.write_text(
+            yaml.dump(
+                {
+                    "api_version": PATTERN_API_VERSION,
+                    "patterns": [
+                        {
+                            "id": "CC-900-CODE-GO",
+                            "domain": "concurrency",
+                            "severity": "warning",
+                            "signals": [r"regex:(?m)^[ \t]*legacy\(\)"],
+                            "fix": {"find": r"^([ \t]*)legacy\(\)$", "replace": r"\1go legacy()"},
+                        },
+                        {
+                            "id": "CC-901-CODE-GO",
+                            "domain": "concurrency",
+                            "severity": "error",
+                            "signals": ["go legacy()"],
+                        },
+                    ]
+                }
+            )
+        )
+        src = tmp_path / "src"
+        write_file(src, "main.go", "package main\n\nfunc main() {\n    legacy()\n}\n")
+        scanner = Scanner(library=PatternLibrary.load([patterns]))
+
+        [fix] = scanner.fix(src)
+
+        assert [f.pattern_id for f in fix.resolved] == ["CC-900-CODE-GO"]
+        assert [(f.pattern_id, f.line) for f in fix.introduced] == [("CC-901-CODE-GO", 4)]
+
+    def test_preserves_crlf_line_endings(self, tmp_path: Path) -> None:
+        path = tmp_path / "load.go"
+        path.write_bytes(GO_LEAKED_CANCEL.replace("\n", "\r\n").encode())
+
+        write_fixes(Scanner().fix(tmp_path), tmp_path)
+
+        data = path.read_bytes()
+        assert b"    defer cancel()\r\n" in data
+        assert b"\n" not in data.replace(b"\r\n", b"")
+
+    def test_single_file_root(self, tmp_path: Path) -> None:
+        path = write_file(tmp_path, "load.go", GO_LEAKED_CANCEL)
+
+        [fix] = Scanner().fix(path)
+
+        assert fix.path == "load.go"
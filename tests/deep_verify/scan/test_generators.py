@@ -0,0 +1,116 @@
+"""Tests for random generators shared across goroutines (CC-143)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import ScanConfig, find_shared_rands
+
+from tests.deep_verify.scan.conftest import GO_SHARED_RAND
+
+PER_GOROUTINE = """package jobs
+
+import "math/rand"
+
+func schedule(jobs []*Job, seed int64) {
+    for i, job := range jobs {
+        go func(job *Job, seed int64) {
+            rng := rand.New(rand.NewSource(seed))
+            job.jitter = rng.Intn(100)
+        }(job, seed+int64(i))
+    }
+}
+"""
+
+TOP_LEVEL = """package jobs
+
+import "math/rand"
+
+func schedule(jobs []*Job) {
+    for _, job := range jobs {
+        go func(job *Job) {
+            job.jitter = rand.Intn(100)
+        }(job)
+    }
+}
+"""
+
+
+def _shared(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_shared_rands(text, "x.go", ScanConfig())]
+
+
+class TestFindSharedRands:
+    """Tests for find_shared_rands."""
+
+    def test_shared_across_goroutines(self) -> None:
+        """Test reporting a Rand called from goroutines started in a loop."""
+        (finding,) = find_shared_rands(GO_SHARED_RAND, "jobs.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-143-CODE-GO"
+        assert finding.severity == Severity.WARNING
+        assert (finding.line, finding.title) == (
+            9,
+            "*rand.Rand rng used from several goroutines without a lock",
+        )
+        assert finding.snippet == "job.jitter = rng.Intn(100)"
+
+    def test_per_goroutine_and_top_level_are_safe(self) -> None:
+        """Test that goroutine-owned Rands and top-level rand functions are not reported."""
+        assert _shared(PER_GOROUTINE) == []
+        assert _shared(TOP_LEVEL) == []
+
+    def test_fields_and_uses_outside_the_goroutine(self) -> None:
+        """Test fields shared with other methods, locks and single goroutines."""
+        text = """package jobs
+
+import (
+    "sync"
+
+    mrand "math/rand/v2"
+)
+
+type Sampler struct {
+    mu  sync.Mutex
+    rng *mrand.Rand
+}
+
+func (s *Sampler) Start() {
+    go func() {
+        s.record(s.rng.Float64())
+    }()
+}
+
+func (s *Sampler) Next() float64 {
+    return s.rng.Float64()
+}
+
+func (s *Sampler) Locked() {
+    go func() {
+        s.mu.Lock()
+        defer s.mu.Unlock()
+        s.record(s.rng.Float64())
+    }()
+}
+
+func once(seed uint64) {
+    local := mrand.New(mrand.NewPCG(seed, seed))
+    go func() {
+        use(local.IntN(10))
+    }()
+}
+
+func both(seed uint64) {
+    local := mrand.New(mrand.NewPCG(seed, seed))
+    go func() {
+        use(local.IntN(10))
+    }()
+    use(local.IntN(10))
+}
+"""
+        assert _shared(text) == [
+            (16, "*rand.Rand s.rng used from several goroutines without a lock"),
+            (42, "*rand.Rand local used from several goroutines without a lock"),
+        ]
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-143."""
+        config = ScanConfig(disable=["CC-143"])
+        assert find_shared_rands(GO_SHARED_RAND, "x.go", config) == []
@@ -0,0 +1,89 @@
+"""Tests for GitHub Actions annotation export."""
+
+import io
+from dataclasses import replace
+
+from bmad_assist.deep_verify.core.types import ArtifactDomain, PatternId, Severity
+from bmad_assist.deep_verify.scan import (
+    GITHUB_COMMAND,
+    ScanFinding,
+    ScanReport,
+    github_annotation,
+    write_github_annotations,
+)
+
+
+def _finding(severity: Severity = Severity.ERROR, line: int = 3) -> ScanFinding:
+    return ScanFinding(
+        pattern_id=PatternId("CC-002-CODE-GO"),
+        severity=severity,
+        title="Mutex locked without deferred unlock",
+        description="Mutex locked without deferred unlock",
+        path="pkg/cache.go",
+        line=line,
+        snippet="mu.Lock()",
+        confidence=1.0,
+        domain=ArtifactDomain.CONCURRENCY,
+        language="go",
+    )
+
+
+class TestGithubAnnotation:
+    """Tests for github_annotation."""
+
+    def test_command_syntax(self) -> None:
+        """Test the exact workflow command for a finding."""
+        assert github_annotation(_finding()) == (
+            "::error file=pkg/cache.go,line=3,title=CC-002-CODE-GO::"
+            "Mutex locked without deferred unlock"
+        )
+
+    def test_severity_commands(self) -> None:
+        """Test that every severity maps to a GitHub annotation level."""
+        assert set(GITHUB_COMMAND) == set(Severity)
+        assert github_annotation(_finding(Severity.CRITICAL)).startswith("::error ")
+        assert github_annotation(_finding(Severity.WARNING)).startswith("::warning ")
+        assert github_annotation(_finding(Severity.INFO)).startswith("::notice ")
+
+    def test_message_escaping(self) -> None:
+        """Test that %, carriage returns and newlines are escaped in messages."""
+        finding = replace(
+            _finding(),
+            title="100% of locks, unlocked: never\r\nsee below",
+            remediation="Defer the unlock",
+        )
+
+        assert github_annotation(finding) == (
+            "::error file=pkg/cache.go,line=3,title=CC-002-CODE-GO::"
+            "100%25 of locks, unlocked: never%0D%0Asee below%0ADefer the unlock"
+        )
+
+    def test_property_escaping(self) -> None:
+        """Test that commas, colons, % and newlines are escaped in properties."""
+        finding = replace(_finding(), path="dir,with:odd%name\n/a.go")
+
+        assert github_annotation(finding).startswith(
+            "::error file=dir%2Cwith%3Aodd%25name%0A/a.go,line=3,"
+        )
+
+
+class TestWriteGithubAnnotations:
+    """Tests for write_github_annotations."""
+
+    def test_one_line_per_unsuppressed_finding(self) -> None:
+        """Test that suppressed findings are skipped and lines end with newlines."""
+        report = ScanReport(
+            root=".",
+            findings=[
+                _finding(line=3),
+                replace(_finding(line=5), suppressed=True),
+                _finding(Severity.WARNING, line=9),
+            ],
+        )
+        out = io.StringIO()
+
+        write_github_annotations(report, out)
+
+        lines = out.getvalue().split("\n")
+        assert lines[-1] == ""
+        assert [line.split(",")[1] for line in lines[:-1]] == ["line=3", "line=9"]
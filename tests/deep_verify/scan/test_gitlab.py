@@ -0,0 +1,120 @@
+"""Tests for GitLab Code Quality export."""
+
+import io
+import json
+from dataclasses import replace
+from pathlib import Path
+
+from bmad_assist.deep_verify.core.types import ArtifactDomain, PatternId, Severity
+from bmad_assist.deep_verify.scan import (
+    GITLAB_SEVERITY,
+    ScanFinding,
+    ScanReport,
+    Scanner,
+    finding_fingerprint,
+    gitlab_code_quality_report,
+    write_gitlab_code_quality,
+)
+
+# Severities accepted by GitLab's Code Quality schema
+GITLAB_SEVERITIES = {"info", "minor", "major", "critical", "blocker"}
+
+
+def _finding(severity: Severity = Severity.ERROR, line: int = 3) -> ScanFinding:
+    return ScanFinding(
+        pattern_id=PatternId("CC-002-CODE-GO"),
+        severity=severity,
+        title="Mutex locked without deferred unlock",
+        description="Mutex locked without deferred unlock",
+        path="pkg/cache.go",
+        line=line,
+        snippet="mu.Lock()",
+        confidence=1.0,
+        domain=ArtifactDomain.CONCURRENCY,
+        language="go",
+    )
+
+
+def _assert_code_quality_issue(issue: dict) -> None:
+    """Assert an issue follows GitLab's documented Code Quality format."""
+    assert set(issue) == {"description", "check_name", "fingerprint", "severity", "location"}
+    assert isinstance(issue["description"], str) and issue["description"]
+    assert isinstance(issue["check_name"], str) and issue["check_name"]
+    assert isinstance(issue["fingerprint"], str) and issue["fingerprint"]
+    assert issue["severity"] in GITLAB_SEVERITIES
+    assert set(issue["location"]) == {"path", "lines"}
+    assert isinstance(issue["location"]["path"], str)
+    assert not issue["location"]["path"].startswith("/")
+    assert set(issue["location"]["lines"]) == {"begin"}
+    assert isinstance(issue["location"]["lines"]["begin"], int)
+    assert issue["location"]["lines"]["begin"] >= 1
+
+
+class TestGitLabCodeQuality:
+    """Tests for the GitLab Code Quality exporter."""
+
+    def test_issue_schema(self) -> None:
+        """Test that issues follow GitLab's Code Quality schema."""
+        report = ScanReport(root=".", findings=[_finding()], files_scanned=["pkg/cache.go"])
+
+        (issue,) = gitlab_code_quality_report(report)
+
+        _assert_code_quality_issue(issue)
+        assert issue["check_name"] == "CC-002-CODE-GO"
+        assert issue["severity"] == "major"
+        assert issue["location"] == {"path": "pkg/cache.go", "lines": {"begin": 3}}
+        assert issue["fingerprint"] == finding_fingerprint(_finding())
+
+    def test_severity_mapping_covers_all_severities(self) -> None:
+        """Test that every severity maps to a GitLab severity."""
+        assert set(GITLAB_SEVERITY) == set(Severity)
+        assert set(GITLAB_SEVERITY.values()) <= GITLAB_SEVERITIES
+
+    def test_write_scanned_tree(self, go_tree: Path) -> None:
+        """Test writing a real scan as a JSON array of valid issues."""
+        report = Scanner().scan(go_tree)
+        out = io.StringIO()
+
+        write_gitlab_code_quality(report, out)
+
+        issues = json.loads(out.getvalue())
+        assert isinstance(issues, list)
+        assert len(issues) == len(report.findings)
+        for issue in issues:
+            _assert_code_quality_issue(issue)
+
+    def test_skips_suppressed_findings(self) -> None:
+        """Test that suppressed findings are not reported as issues."""
+        suppressed = replace(_finding(line=4), suppressed=True, suppression_reason="audit")
+        report = ScanReport(root=".", findings=[_finding(), suppressed])
+
+        issues = gitlab_code_quality_report(report)
+
+        assert [i["location"]["lines"]["begin"] for i in issues] == [3]
+
+    def test_empty_report(self) -> None:
+        """Test that an empty report is an empty array."""
+        out = io.StringIO()
+
+        write_gitlab_code_quality(ScanReport(root="."), out)
+
+        assert json.loads(out.getvalue()) == []
+
+
+class TestFindingFingerprint:
+    """Tests for finding_fingerprint()."""
+
+    def test_stable_across_line_shifts(self) -> None:
+        """Test that moving a finding does not change its fingerprint."""
+        assert finding_fingerprint(_finding(line=3)) == finding_fingerprint(_finding(line=40))
+
+    def test_ignores_severity_overrides(self) -> None:
+        """Test that config severity changes keep the fingerprint."""
+        assert finding_fingerprint(_finding(Severity.ERROR)) == finding_fingerprint(
+            _finding(Severity.INFO)
+        )
+
+    def test_distinguishes_paths(self) -> None:
+        """Test that the same match in different files differs."""
+        other = replace(_finding(), path="pkg/store.go")
+        assert finding_fingerprint(other) != finding_fingerprint(_finding())
@@ -0,0 +1,83 @@
+"""Tests for test helpers without t.Helper() (CC-134)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import ScanConfig, find_unmarked_test_helpers
+
+from tests.deep_verify.scan.conftest import GO_UNMARKED_TEST_HELPER
+
+
+def _helpers(text: str, rel_path: str = "x_test.go") -> list[tuple[int, str]]:
+    return [
+        (f.line, f.title) for f in find_unmarked_test_helpers(text, rel_path, ScanConfig())
+    ]
+
+
+class TestFindUnmarkedTestHelpers:
+    """Tests for find_unmarked_test_helpers."""
+
+    def test_helper_without_t_helper(self) -> None:
+        """Test reporting only the helper that fails without t.Helper()."""
+        (finding,) = find_unmarked_test_helpers(
+            GO_UNMARKED_TEST_HELPER, "server_test.go", ScanConfig()
+        )
+
+        assert finding.pattern_id == "CC-134-CODE-GO"
+        assert finding.severity == Severity.INFO
+        assert finding.line == 5
+        assert finding.title == "Test helper assertStatus fails the test without calling t.Helper()"
+        assert finding.snippet == "func assertStatus(t *testing.T, got, want int) {"
+
+    def test_only_test_files(self) -> None:
+        """Test that files not ending in _test.go are skipped."""
+        assert _helpers(GO_UNMARKED_TEST_HELPER, "server.go") == []
+
+    def test_parameter_types_and_aliases(self) -> None:
+        """Test B, F and TB parameters, import aliases, methods and one-line helpers."""
+        text = """package bench
+
+import (
+    tt "testing"
+)
+
+func mustLoad(b *tt.B, path string) { b.FailNow() }
+
+func (s *suite) check(tb tt.TB, ok bool) {
+    if !ok {
+        tb.Error("not ok")
+    }
+}
+
+func seed(f *tt.F) {
+    f.Add(1)
+}
+
+func BenchmarkLoad(b *tt.B) {
+    b.Fatal("slow")
+}
+
+func Testable(t *tt.T) {
+    t.Fail()
+}
+"""
+        assert _helpers(text) == [
+            (7, "Test helper mustLoad fails the test without calling b.Helper()"),
+            (9, "Test helper check fails the test without calling tb.Helper()"),
+            (23, "Test helper Testable fails the test without calling t.Helper()"),
+        ]
+
+    def test_without_testing_import(self) -> None:
+        """Test that files without the testing import are skipped."""
+        text = """package main
+
+type T struct{}
+
+func check(t *T) {
+    t.Fatal()
+}
+"""
+        assert _helpers(text) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-134."""
+        config = ScanConfig(disable=["CC-134"])
+        assert find_unmarked_test_helpers(GO_UNMARKED_TEST_HELPER, "x_test.go", config) == []
@@ -0,0 +1,124 @@
+"""Tests for the pattern release history."""
+
+from pathlib import Path
+
+import pytest
+import yaml
+
+from bmad_assist.deep_verify.patterns.library import (
+    PATTERN_API_VERSION,
+    PatternLibrary,
+    get_default_pattern_library,
+)
+from bmad_assist.deep_verify.scan import (
+    LIBRARY_RELEASE,
+    PATTERN_HISTORY,
+    ScanConfig,
+    ScanOptions,
+    Scanner,
+    apply_since_version,
+    changed_since,
+    parse_version,
+)
+from bmad_assist.deep_verify.scan.scanner import BUILTIN_PATTERNS
+
+from tests.deep_verify.scan.conftest import GO_GOROUTINE, write_file
+
+# Releases of three library patterns; CC-004 is not listed
+HISTORY = {
+    "CC-001-CODE-GO": ("0.4.18",),
+    "CC-002-CODE-GO": ("0.4.18", "0.5.2"),
+    "CC-003-CODE-GO": ("0.5.0",),
+}
+
+
+def _library(tmp_path: Path) -> PatternLibrary:
+    patterns = [
+        {
+            "id": f"CC-00{index}-CODE-GO",
+            "domain": "concurrency",
+            "severity": "warning",
+            "signals": ["go func("],
+        }
+        for index in range(1, 5)
+    ]
+    yaml_file = tmp_path / "patterns.yaml"
+    yaml_file.write_text(yaml.dump({"api_version": PATTERN_API_VERSION, "patterns": patterns}))
+    return PatternLibrary.load([yaml_file])
+
+
+def _changed(tmp_path: Path, version: str) -> list[str]:
+    """Return the changed library patterns, without built-in checks."""
+    builtin_ids = {p.id for p in BUILTIN_PATTERNS}
+    ids = changed_since(version, _library(tmp_path), {**HISTORY, **dict.fromkeys(builtin_ids, ())})
+    return [pattern_id for pattern_id in ids if pattern_id not in builtin_ids]
+
+
+class TestChangedSince:
+    """Tests for changed_since."""
+
+    def test_enables_exactly_the_patterns_changed_after_a_release(self, tmp_path: Path) -> None:
+        """Test selections for releases before, between and after the changes."""
+        assert _changed(tmp_path, "0.4.17") == [
+            "CC-001-CODE-GO",
+            "CC-002-CODE-GO",
+            "CC-003-CODE-GO",
+            "CC-004-CODE-GO",
+        ]
+        assert _changed(tmp_path, "0.4.18") == ["CC-002-CODE-GO", "CC-003-CODE-GO"]
+        assert _changed(tmp_path, "0.5.1") == ["CC-002-CODE-GO"]
+        assert _changed(tmp_path, "0.5.2") == []
+
+    def test_default_history(self) -> None:
+        """Test that the recorded history covers every built-in check."""
+        ids = changed_since(LIBRARY_RELEASE, get_default_pattern_library())
+
+        assert {p.id for p in BUILTIN_PATTERNS} <= set(ids)
+        assert "CC-140-CODE-GO" in ids
+        assert "CC-001-CODE-GO" not in ids
+        assert set(ids) == {
+            pattern_id
+            for pattern_id, releases in PATTERN_HISTORY.items()
+            if any(parse_version(r) > parse_version(LIBRARY_RELEASE) for r in releases)
+        }
+
+    def test_parse_version(self) -> None:
+        """Test release number parsing and rejection of other strings."""
+        assert parse_version("0.4.27") == (0, 4, 27)
+        assert parse_version("v1.10.0") == (1, 10, 0)
+        assert parse_version("0.10.0") > parse_version("0.9.9")
+        for invalid in ("0.4", "latest", "0.4.x"):
+            with pytest.raises(ValueError, match="Invalid version"):
+                parse_version(invalid)
+
+
+class TestApplySinceVersion:
+    """Tests for apply_since_version."""
+
+    def test_sets_the_base_enable_list(self, tmp_path: Path) -> None:
+        """Test that the changed patterns are enabled, within an existing enable list."""
+        library = _library(tmp_path)
+
+        options = apply_since_version(ScanOptions(), "0.5.0", library, HISTORY)
+        assert options.config is not None
+        assert "CC-002-CODE-GO" in options.config.enable
+        assert "CC-003-CODE-GO" not in options.config.enable
+
+        narrowed = ScanOptions(config=ScanConfig(enable=["CC-003"]))
+        options = apply_since_version(narrowed, "0.4.18", library, HISTORY)
+        assert options.config is not None
+        assert options.config.enable == ["CC-003-CODE-GO"]
+
+    def test_scan_skips_unchanged_patterns(self, tmp_path: Path) -> None:
+        """Test that a scan since the library release skips the original patterns."""
+        write_file(tmp_path, "main.go", GO_GOROUTINE)
+
+        since_library = apply_since_version(ScanOptions(), LIBRARY_RELEASE)
+        before_library = apply_since_version(ScanOptions(), "0.4.17")
+
+        assert "CC-001-CODE-GO" not in {
+            f.pattern_id for f in Scanner(since_library).scan(tmp_path).findings
+        }
+        assert "CC-001-CODE-GO" in {
+            f.pattern_id for f in Scanner(before_library).scan(tmp_path).findings
+        }
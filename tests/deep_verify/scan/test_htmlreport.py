@@ -0,0 +1,92 @@
+"""Tests for interactive HTML scan reports."""
+
+import io
+import json
+import re
+from dataclasses import replace
+
+from bmad_assist.deep_verify.core.types import ArtifactDomain, PatternId, Severity
+from bmad_assist.deep_verify.scan import (
+    HTML_DATA_ID,
+    ScanFinding,
+    ScanReport,
+    report_html,
+    serialize_scan_report,
+    write_html,
+)
+
+FINDING = ScanFinding(
+    pattern_id=PatternId("CC-001-CODE-GO"),
+    severity=Severity.WARNING,
+    title="t",
+    description="d",
+    path="a.go",
+    line=3,
+    snippet="go func() {",
+    confidence=1.0,
+    domain=ArtifactDomain.CONCURRENCY,
+    language="go",
+)
+
+
+def _embedded(html: str) -> dict:
+    """Return the report data embedded in an HTML page."""
+    match = re.search(
+        rf'<script type="application/json" id="{HTML_DATA_ID}">(.*?)</script>', html
+    )
+    assert match is not None
+    return json.loads(match.group(1))
+
+
+class TestReportHtml:
+    """Tests for report_html."""
+
+    def test_embeds_findings_data(self) -> None:
+        """Test that the page embeds the serialized report."""
+        report = ScanReport(root="src", findings=[FINDING])
+
+        assert _embedded(report_html(report)) == serialize_scan_report(report)
+
+    def test_filter_and_sort_controls(self) -> None:
+        """Test that the page has the code, severity and file filters and sortable columns."""
+        html = report_html(ScanReport(root="."))
+
+        assert '<input id="filter-code"' in html
+        assert '<input id="filter-file"' in html
+        assert '<select id="filter-severity">' in html
+        for severity in Severity:
+            assert f'<option value="{severity.value}">' in html
+        for key in ("severity", "pattern_id", "path", "line"):
+            assert f'<th data-key="{key}">' in html
+
+    def test_self_contained(self) -> None:
+        """Test that the page loads no external resources."""
+        html = report_html(ScanReport(root=".", findings=[FINDING]))
+
+        assert "http" not in html
+        assert " src=" not in html
+        assert "<link" not in html
+
+    def test_markup_in_findings_is_escaped(self) -> None:
+        """Test that finding text cannot close the data block or inject markup."""
+        hostile = replace(FINDING, title="</script><script>alert(1)</script>", path="<b>.go")
+        report = ScanReport(root="<root>", findings=[hostile])
+
+        html = report_html(report)
+
+        assert "<script>alert" not in html
+        assert "<title>Deep Verify scan of &lt;root&gt;</title>" in html
+        assert _embedded(html)["findings"][0]["title"] == hostile.title
+
+
+class TestWriteHtml:
+    """Tests for write_html."""
+
+    def test_writes_page_with_newline(self) -> None:
+        """Test that the page is written with a trailing newline."""
+        out = io.StringIO()
+
+        write_html(ScanReport(root="."), out)
+
+        assert out.getvalue().startswith("<!DOCTYPE html>")
+        assert out.getvalue().endswith("</html>\n")
@@ -0,0 +1,99 @@
+"""Tests for goroutine element writes through a captured loop index (CC-147)."""
+
+from pathlib import Path
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    ScanConfig,
+    ScanOptions,
+    Scanner,
+    find_captured_index_writes,
+)
+
+from tests.deep_verify.scan.conftest import GO_CAPTURED_INDEX_WRITE, write_file
+
+PASSED = """package work
+
+func fill(xs []int) {
+    for i := range xs {
+        go func(i int) {
+            xs[i] = f(i)
+        }(i)
+    }
+}
+"""
+
+
+def _writes(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_captured_index_writes(text, "x.go", ScanConfig())]
+
+
+class TestFindCapturedIndexWrites:
+    """Tests for find_captured_index_writes."""
+
+    def test_write_through_captured_index(self) -> None:
+        """Test reporting a goroutine writing xs[i] with the loop's i."""
+        (finding,) = find_captured_index_writes(GO_CAPTURED_INDEX_WRITE, "work.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-147-CODE-GO"
+        assert finding.severity == Severity.WARNING
+        assert finding.confidence == 0.7
+        assert (finding.line, finding.title) == (
+            6,
+            "Goroutine writes xs[i] through captured loop variable i",
+        )
+        assert finding.snippet == "xs[i] = f(i)"
+
+    def test_index_passed_as_parameter_is_safe(self) -> None:
+        """Test that an index passed to the goroutine is not reported."""
+        assert _writes(PASSED) == []
+
+    def test_loop_and_write_forms(self) -> None:
+        """Test three-clause loops, fields, compound writes, copies and locals."""
+        text = """package work
+
+func run(s *State, n int) {
+    for i := 0; i < n; i++ {
+        go func() {
+            s.totals[i] += 1
+            s.items[i].done = true
+        }()
+    }
+    for k, v := range s.byName {
+        k := k
+        go func() {
+            s.seen[k] = v
+        }()
+    }
+    for _, j := range s.order {
+        go func() {
+            out := make([]int, n)
+            out[j] = 1
+            s.hits[j]++
+            send(s.buf[j])
+        }()
+    }
+}
+"""
+        assert _writes(text) == [
+            (6, "Goroutine writes s.totals[i] through captured loop variable i"),
+            (7, "Goroutine writes s.items[i] through captured loop variable i"),
+            (20, "Goroutine writes s.hits[j] through captured loop variable j"),
+        ]
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-147."""
+        config = ScanConfig(disable=["CC-147"])
+        assert find_captured_index_writes(GO_CAPTURED_INDEX_WRITE, "x.go", config) == []
+
+
+class TestScannerCapturedIndexWrites:
+    """Tests for CC-147 in tree scans."""
+
+    def test_filtered_by_threshold(self, tmp_path: Path) -> None:
+        """Test that a threshold above the check's confidence drops its findings."""
+        write_file(tmp_path, "captured.go", GO_CAPTURED_INDEX_WRITE)
+
+        report = Scanner(ScanOptions(threshold=0.8)).scan(tmp_path)
+
+        assert not [f for f in report.findings if f.pattern_id == "CC-147-CODE-GO"]
@@ -0,0 +1,97 @@
+"""Tests for string context keys (CC-140)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import ScanConfig, find_string_context_keys
+
+from tests.deep_verify.scan.conftest import GO_STRING_CONTEXT_KEY
+
+TYPED_KEY = """package auth
+
+import "context"
+
+type ctxKey struct{}
+
+func withUser(ctx context.Context, id string) context.Context {
+    return context.WithValue(ctx, ctxKey{}, id)
+}
+"""
+
+
+def _keys(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_string_context_keys(text, "x.go", ScanConfig())]
+
+
+class TestFindStringContextKeys:
+    """Tests for find_string_context_keys."""
+
+    def test_string_literal_key(self) -> None:
+        """Test reporting a string literal key."""
+        (finding,) = find_string_context_keys(GO_STRING_CONTEXT_KEY, "auth.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-140-CODE-GO"
+        assert finding.severity == Severity.WARNING
+        assert finding.line == 6
+        assert finding.title == "Context value keyed by a string literal - use a custom key type"
+        assert finding.snippet == 'return context.WithValue(ctx, "userID", id)'
+
+    def test_custom_key_type_is_safe(self) -> None:
+        """Test that keys of a custom type are not reported."""
+        assert _keys(TYPED_KEY) == []
+
+    def test_string_typed_names(self) -> None:
+        """Test constants, variables, parameters and conversions typed string."""
+        text = """package auth
+
+import (
+    stdctx "context"
+)
+
+type ctxKey string
+
+const (
+    userKey   ctxKey = "user"
+    tenantKey        // ctxKey, repeated
+    traceKey         = "trace"
+)
+
+func annotate(ctx stdctx.Context, name, value string) stdctx.Context {
+    ctx = stdctx.WithValue(ctx, userKey, value)
+    ctx = stdctx.WithValue(ctx, tenantKey, value)
+    ctx = stdctx.WithValue(ctx, traceKey, value)
+    ctx = stdctx.WithValue(ctx, name, value)
+    ctx = stdctx.WithValue(ctx, ctxKey(name), value)
+    ctx = stdctx.WithValue(ctx, string(userKey), value)
+    local := "request"
+    return stdctx.WithValue(ctx, local, requestKey)
+}
+
+var requestKey = ctxKey("request")
+"""
+        assert _keys(text) == [
+            (18, "Context value keyed by traceKey, a string - use a custom key type"),
+            (19, "Context value keyed by name, a string - use a custom key type"),
+            (21, "Context value keyed by a string conversion - use a custom key type"),
+            (23, "Context value keyed by local, a string - use a custom key type"),
+        ]
+
+    def test_locals_do_not_leak_between_functions(self) -> None:
+        """Test that a string local of one function does not type a name in another."""
+        text = """package auth
+
+import "context"
+
+func first() {
+    key := "x"
+    _ = key
+}
+
+func second(ctx context.Context, key ctxKey) context.Context {
+    return context.WithValue(ctx, key, 1)
+}
+"""
+        assert _keys(text) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-140."""
+        config = ScanConfig(disable=["CC-140"])
+        assert find_string_context_keys(GO_STRING_CONTEXT_KEY, "x.go", config) == []
@@ -0,0 +1,178 @@
+"""Tests for unguarded lazy map initialization (CC-149)."""
+
+from pathlib import Path
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    ScanConfig,
+    ScanOptions,
+    Scanner,
+    find_unguarded_lazy_maps,
+)
+
+from tests.deep_verify.scan.conftest import GO_UNGUARDED_LAZY_MAP, write_file
+
+GUARDED = """package store
+
+import "sync"
+
+type Store struct {
+    mu    sync.Mutex
+    items map[string]string
+}
+
+func (s *Store) Put(k, v string) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    if s.items == nil {
+        s.items = make(map[string]string)
+    }
+    s.items[k] = v
+}
+"""
+
+CONSTRUCTOR = """package store
+
+import "sync"
+
+type Store struct {
+    mu    sync.Mutex
+    items map[string]string
+}
+
+func NewStore() *Store {
+    s := &Store{}
+    if s.items == nil {
+        s.items = make(map[string]string)
+    }
+    return s
+}
+
+func (s *Store) Put(k, v string) {
+    s.mu.Lock()
+    s.items[k] = v
+    s.mu.Unlock()
+}
+"""
+
+
+def _lazy(text: str) -> list[tuple[int, str, float]]:
+    return [
+        (f.line, f.title, f.confidence)
+        for f in find_unguarded_lazy_maps(text, "x.go", ScanConfig())
+    ]
+
+
+class TestFindUnguardedLazyMaps:
+    """Tests for find_unguarded_lazy_maps."""
+
+    def test_unguarded_lazy_map(self) -> None:
+        """Test reporting a nil check, make and write without a lock."""
+        (finding,) = find_unguarded_lazy_maps(GO_UNGUARDED_LAZY_MAP, "store.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-149-CODE-GO"
+        assert finding.severity == Severity.WARNING
+        assert finding.confidence == 1.0
+        assert (finding.line, finding.title) == (
+            11,
+            "Map s.items created on first use and written without a lock",
+        )
+        assert finding.snippet == "if s.items == nil {"
+
+    def test_guarded_lazy_map_is_safe(self) -> None:
+        """Test that locking before the nil check is not reported."""
+        assert _lazy(GUARDED) == []
+
+    def test_constructor_init_is_safe(self) -> None:
+        """Test that creating the map in a constructor is not reported."""
+        assert _lazy(CONSTRUCTOR) == []
+
+    def test_sharing_inferred_from_goroutines(self) -> None:
+        """Test receivers without a mutex, which need a go statement in the file."""
+        text = """package cache
+
+type Cache struct {
+    hits map[string]int
+    seen map[string]bool
+}
+
+func (c *Cache) Hit(k string) {
+    if c.hits == nil {
+        c.hits = map[string]int{}
+    }
+    c.hits[k]++
+}
+
+func (c *Cache) Warm(keys []string) {
+    for _, k := range keys {
+        go c.Hit(k)
+    }
+}
+"""
+        assert _lazy(text) == [
+            (9, "Map c.hits created on first use and written without a lock", 0.6)
+        ]
+        assert _lazy(text.replace("go c.Hit(k)", "c.Hit(k)")) == []
+
+    def test_skipped_forms(self) -> None:
+        """Test sync.Once, Locked methods, reads only and non-map initialization."""
+        text = """package store
+
+import "sync"
+
+type Store struct {
+    mu    sync.Mutex
+    once  sync.Once
+    items map[string]string
+    list  []string
+}
+
+func (s *Store) init() {
+    s.once.Do(func() {
+        if s.items == nil {
+            s.items = make(map[string]string)
+        }
+        s.items[""] = ""
+    })
+}
+
+func (s *Store) putLocked(k, v string) {
+    if s.items == nil {
+        s.items = make(map[string]string)
+    }
+    s.items[k] = v
+}
+
+func (s *Store) Get(k string) string {
+    if s.items == nil {
+        s.items = make(map[string]string)
+    }
+    return s.items[k]
+}
+
+func (s *Store) Add(v string) {
+    if s.list == nil {
+        s.list = []string{}
+    }
+    s.list[0] = v
+}
+"""
+        assert _lazy(text) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-149."""
+        config = ScanConfig(disable=["CC-149"])
+        assert find_unguarded_lazy_maps(GO_UNGUARDED_LAZY_MAP, "x.go", config) == []
+
+
+class TestScannerUnguardedLazyMaps:
+    """Tests for CC-149 in tree scans."""
+
+    def test_filtered_by_threshold(self, tmp_path: Path) -> None:
+        """Test that a threshold above 0.6 drops findings with inferred sharing."""
+        text = GO_UNGUARDED_LAZY_MAP.replace("    mu    sync.Mutex\n", "")
+        write_file(tmp_path, "store.go", text + '\nfunc run(s *Store) { go s.Put("", "") }\n')
+
+        report = Scanner(ScanOptions(threshold=0.7)).scan(tmp_path)
+
+        assert not [f for f in report.findings if f.pattern_id == "CC-149-CODE-GO"]
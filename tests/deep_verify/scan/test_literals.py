@@ -0,0 +1,130 @@
+"""Tests for unkeyed struct literal detection (CC-126)."""
+
+from pathlib import Path
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    ScanConfig,
+    ScanOptions,
+    Scanner,
+    find_unkeyed_literals,
+)
+
+from tests.deep_verify.scan.conftest import GO_UNKEYED_LITERAL, write_file
+
+KEYED_EXTERNAL = """package main
+
+import "example.com/app/server"
+
+func main() {
+    cfg := server.Config{TLS: true, Timeout: 30, Name: "x"}
+    server.Run(cfg)
+}
+"""
+
+UNKEYED_LOCAL = """package main
+
+type point struct {
+    x, y int
+}
+
+func origin() point {
+    return point{0, 0}
+}
+"""
+
+
+def _literals(text: str, include_local: bool = False) -> list[tuple[int, str]]:
+    findings = find_unkeyed_literals(text, "x.go", ScanConfig(), include_local)
+    return [(f.line, f.title) for f in findings]
+
+
+class TestFindUnkeyedLiterals:
+    """Tests for find_unkeyed_literals."""
+
+    def test_unkeyed_external_struct(self) -> None:
+        """Test reporting a positional literal of an imported struct."""
+        (finding,) = find_unkeyed_literals(GO_UNKEYED_LITERAL, "main.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-126-CODE-GO"
+        assert finding.line == 6
+        assert finding.title == "server.Config literal sets fields by position"
+        assert finding.severity == Severity.WARNING
+
+    def test_keyed_external_struct_is_safe(self) -> None:
+        """Test that keyed literals are not reported."""
+        assert _literals(KEYED_EXTERNAL) == []
+
+    def test_local_struct_is_opt_in(self) -> None:
+        """Test that local struct literals are reported only when included."""
+        assert _literals(UNKEYED_LOCAL) == []
+        assert _literals(UNKEYED_LOCAL, include_local=True) == [
+            (8, "point literal sets fields by position")
+        ]
+
+    def test_nested_and_multiline_literals(self) -> None:
+        """Test nested literals, multi-line elements and keyed values with colons."""
+        text = """package main
+
+import (
+    "net/http"
+    "time"
+
+    srv "example.com/app/server"
+)
+
+var routes = []srv.Route{
+    {"/", index},
+}
+
+var limits = map[string]srv.Limit{
+    "api": {Rate: 10},
+}
+
+var opts = srv.Options{
+    Timeout: 5 * time.Second,
+    Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+    Window:  srv.Window{
+        start[1:2],
+        end,
+    },
+    Tags: []string{"a:b"},
+}
+"""
+        assert _literals(text) == [(21, "srv.Window literal sets fields by position")]
+
+    def test_blocks_comments_and_strings_ignored(self) -> None:
+        """Test that blocks after qualified names, comments and strings are skipped."""
+        text = """package main
+
+import "example.com/app/server"
+
+func check(v server.Mode) string {
+    if v == server.Off {
+        return "off"
+    }
+    // server.Config{true, 30}
+    return "server.Config{true, 30}"
+}
+
+var zero = server.Config{}
+"""
+        assert _literals(text) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-126."""
+        config = ScanConfig(disable=["CC-126"])
+        assert find_unkeyed_literals(GO_UNKEYED_LITERAL, "x.go", config) == []
+
+
+class TestScannerUnkeyedLiterals:
+    """Tests for CC-126 in tree scans."""
+
+    def test_options_include_local_structs(self, tmp_path: Path) -> None:
+        """Test that ScanOptions.unkeyed_local_structs reports local struct literals."""
+        write_file(tmp_path, "local.go", UNKEYED_LOCAL)
+
+        report = Scanner(ScanOptions(unkeyed_local_structs=True)).scan(tmp_path)
+
+        cc126 = [f for f in report.findings if f.pattern_id == "CC-126-CODE-GO"]
+        assert [(f.path, f.line) for f in cc126] == [("local.go", 8)]
@@ -0,0 +1,130 @@
+"""Tests for mutex-holding structs copied by assignment (CC-112)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    ScanConfig,
+    find_mutex_struct_copies,
+)
+
+from tests.deep_verify.scan.conftest import GO_MUTEX_STRUCT_COPY
+
+POINTER_COPY = GO_MUTEX_STRUCT_COPY.replace("= Config{", "= &Config{").replace(
+    "    return &cfg", "    return cfg"
+)
+
+FRESH_LITERAL = """package config
+
+import "sync"
+
+type Config struct {
+    mu      sync.Mutex
+    Retries int
+}
+
+func newConfig() *Config {
+    cfg := Config{Retries: 3}
+    return &cfg
+}
+"""
+
+
+def _copies(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_mutex_struct_copies(text, "x.go", ScanConfig())]
+
+
+class TestFindMutexStructCopies:
+    """Tests for find_mutex_struct_copies."""
+
+    def test_template_value_copy(self) -> None:
+        """Test reporting a mutex-holding template copied into a local."""
+        (finding,) = find_mutex_struct_copies(GO_MUTEX_STRUCT_COPY, "config.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-112-CODE-GO"
+        assert finding.severity == Severity.ERROR
+        assert (finding.line, finding.title) == (
+            13,
+            "Assignment copies defaults, a Config holding a mutex",
+        )
+        assert finding.snippet == "cfg := defaults"
+
+    def test_pointer_assignment_and_fresh_literal_are_safe(self) -> None:
+        """Test that copying a pointer or building a fresh literal is not reported."""
+        assert _copies(POINTER_COPY) == []
+        assert _copies(FRESH_LITERAL) == []
+
+    def test_literals_dereferences_and_nested_holders(self) -> None:
+        """Test literals reusing a mutex, dereferenced copies and holders of holders."""
+        text = """package config
+
+import stdsync "sync"
+
+type Config struct {
+    stdsync.RWMutex
+    mu      stdsync.Mutex `json:"-"`
+    Retries int
+}
+
+type Service struct {
+    cfg  Config
+    name string
+}
+
+var (
+    shared = &Config{Retries: 1}
+    base   Service
+)
+
+func derive(src *Config) Config {
+    return Config{mu: src.mu, Retries: src.Retries}
+}
+
+func clone() {
+    var cfg Config = *shared
+    svc := base
+    other := &Config{
+        Retries: 2,
+        RWMutex: cfg.RWMutex,
+    }
+    use(cfg, svc, other)
+}
+"""
+        assert _copies(text) == [
+            (22, "Config literal copies mutex mu from src.mu"),
+            (26, "Assignment copies *shared, a Config holding a mutex"),
+            (27, "Assignment copies base, a Service holding a mutex"),
+            (30, "Config literal copies mutex RWMutex from cfg.RWMutex"),
+        ]
+
+    def test_scopes_and_pointer_fields(self) -> None:
+        """Test names reused across functions and structs holding a mutex pointer."""
+        text = """package config
+
+import "sync"
+
+type Config struct {
+    mu *sync.Mutex
+}
+
+type Registry struct {
+    mu    sync.Mutex
+    items map[string]int
+}
+
+func first() {
+    r := &Registry{}
+    c := Config{}
+    other := c
+    use(r, other)
+}
+
+func second(r *Registry) {
+    alias := r
+    use(alias)
+}
+"""
+        assert _copies(text) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-112."""
+        config = ScanConfig(disable=["CC-112"])
+        assert find_mutex_struct_copies(GO_MUTEX_STRUCT_COPY, "x.go", config) == []
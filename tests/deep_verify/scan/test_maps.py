@@ -0,0 +1,107 @@
+"""Tests for lost updates to struct values in maps (CC-133)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import ScanConfig, find_map_value_mutations
+
+from tests.deep_verify.scan.conftest import GO_MAP_VALUE_MUTATION
+
+STORED_BACK = """package stats
+
+type Entry struct {
+    count int
+}
+
+type Stats struct {
+    byName map[string]Entry
+}
+
+func (s *Stats) record(name string) {
+    entry := s.byName[name]
+    entry.count++
+    s.byName[name] = entry
+}
+"""
+
+
+def _mutations(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_map_value_mutations(text, "x.go", ScanConfig())]
+
+
+class TestFindMapValueMutations:
+    """Tests for find_map_value_mutations."""
+
+    def test_copy_never_stored_back(self) -> None:
+        """Test reporting a modified copy of a map element that is dropped."""
+        (finding,) = find_map_value_mutations(GO_MAP_VALUE_MUTATION, "stats.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-133-CODE-GO"
+        assert finding.severity == Severity.WARNING
+        assert finding.line == 13
+        assert finding.title == "Copy of s.byName[name] is modified but never stored back"
+        assert finding.snippet == "entry.count++"
+
+    def test_store_back_is_safe(self) -> None:
+        """Test that a copy stored back into the map is not reported."""
+        assert _mutations(STORED_BACK) == []
+
+    def test_in_place_field_assignment(self) -> None:
+        """Test reporting field assignments on a map element in place."""
+        text = """package main
+
+func run(users map[int]User) {
+    users[1].name = "ann"
+    users[2].visits += 1
+    if users[3].name == "bob" {
+    }
+}
+"""
+        assert _mutations(text) == [
+            (4, "Field of map element users[1] assigned in place"),
+            (5, "Field of map element users[2] assigned in place"),
+        ]
+
+    def test_copies_used_later_are_safe(self) -> None:
+        """Test copies that are returned, passed on, or replaced before use."""
+        text = """package main
+
+func rename(users map[int]User, id int) User {
+    u, ok := users[id]
+    u.name = "ann"
+    return u
+}
+
+func notify(users map[int]User, id int) {
+    u := users[id]
+    u.seen = true
+    send(u)
+}
+
+func reset(users map[int]User, id int) {
+    u := users[id]
+    u = User{}
+    u.name = "x"
+}
+"""
+        assert _mutations(text) == []
+
+    def test_pointer_maps_are_safe(self) -> None:
+        """Test that maps of pointers, including named pointer types, are skipped."""
+        text = """package main
+
+type UserRef = *User
+
+var byID = make(map[int]*User)
+var refs map[int]UserRef
+
+func run() {
+    byID[1].name = "ann"
+    u := refs[2]
+    u.name = "bob"
+}
+"""
+        assert _mutations(text) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-133."""
+        config = ScanConfig(disable=["CC-133"])
+        assert find_map_value_mutations(GO_MAP_VALUE_MUTATION, "x.go", config) == []
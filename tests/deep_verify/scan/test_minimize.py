@@ -0,0 +1,103 @@
+"""Tests for minimizing a source file to a reproducer of one finding."""
+
+import pytest
+
+from bmad_assist.deep_verify.scan import (
+    FindingTarget,
+    ScanFinding,
+    Scanner,
+    minimize_source,
+    parse_finding_target,
+)
+
+WORKER = """package worker
+
+import (
+    "fmt"
+    "strings"
+)
+
+// Config holds settings.
+type Config struct {
+    Name  string
+    Limit int
+}
+
+func describe(c Config) string {
+    parts := []string{c.Name}
+    if c.Limit > 0 {
+        parts = append(parts, fmt.Sprint(c.Limit))
+    } else {
+        parts = append(parts, "unlimited")
+    }
+    return strings.Join(parts, ",")
+}
+
+func start(c Config) {
+    fmt.Println(describe(c))
+    go func() {
+        process(c)
+    }()
+    fmt.Println("started")
+}
+
+func stop() {
+    fmt.Println("stopped")
+}
+"""
+
+
+def _scan(text: str) -> list[ScanFinding]:
+    return Scanner().scan_source(text, "go", "worker.go")
+
+
+class TestParseFindingTarget:
+    """Tests for parse_finding_target."""
+
+    def test_parses_pattern_and_line(self) -> None:
+        """Test base and full pattern IDs with a line."""
+        assert parse_finding_target("CC-004@42") == FindingTarget("CC-004", 42)
+        assert parse_finding_target(" cc-004-code-go@7 ") == FindingTarget("CC-004-CODE-GO", 7)
+        assert str(FindingTarget("CC-004", 42)) == "CC-004@42"
+
+    @pytest.mark.parametrize("spec", ["CC-004", "CC-004@", "@42", "CC-004@0", "CC-004@x"])
+    def test_rejects_invalid_targets(self, spec: str) -> None:
+        """Test that targets without a pattern or a positive line are rejected."""
+        with pytest.raises(ValueError, match="Invalid finding target"):
+            parse_finding_target(spec)
+
+
+class TestMinimizeSource:
+    """Tests for minimize_source."""
+
+    def test_reduces_to_the_triggering_function(self) -> None:
+        """Test that a multi-function file reduces to the function with the finding."""
+        result = minimize_source(WORKER, parse_finding_target("CC-001@26"), _scan)
+
+        assert result.text == (
+            "package worker\nfunc start(c Config) {\n    go func() {\n    }()\n}\n"
+        )
+        assert result.lines == (1, 24, 26, 28, 30)
+        assert result.finding.pattern_id == "CC-001-CODE-GO"
+        assert result.finding.line == 3
+
+    def test_every_candidate_keeps_brackets_balanced(self) -> None:
+        """Test that only balanced candidates are scanned."""
+        scanned: list[str] = []
+
+        def scan(text: str) -> list[ScanFinding]:
+            scanned.append(text)
+            return _scan(text)
+
+        result = minimize_source(WORKER, parse_finding_target("CC-001@26"), scan)
+
+        assert len(scanned) == result.checks
+        for text in scanned:
+            assert text.count("{") == text.count("}")
+            assert text.count("(") == text.count(")")
+
+    def test_finding_must_be_reported(self) -> None:
+        """Test that a target absent from the source is rejected."""
+        for spec in ("CC-001@25", "CC-004@26", "CC-001@999"):
+            with pytest.raises(ValueError, match="is not reported on the source"):
+                minimize_source(WORKER, parse_finding_target(spec), _scan)
@@ -0,0 +1,78 @@
+"""Tests for locks taken in a loop under an outer lock (CC-144)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    LOOP_LOCK_CONFIDENCE,
+    ScanConfig,
+    find_loop_locks,
+)
+
+from tests.deep_verify.scan.conftest import GO_LOOP_LOCK
+
+PER_ITERATION = """package registry
+
+func (r *Registry) Flush() {
+    r.mu.Lock()
+    sessions := append([]*Session(nil), r.sessions...)
+    r.mu.Unlock()
+    for _, s := range sessions {
+        s.mu.Lock()
+        s.flush()
+        s.mu.Unlock()
+    }
+}
+"""
+
+
+def _locks(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_loop_locks(text, "x.go", ScanConfig())]
+
+
+class TestFindLoopLocks:
+    """Tests for find_loop_locks."""
+
+    def test_inner_lock_under_outer_lock(self) -> None:
+        """Test reporting a per-element lock in a loop while the collection lock is held."""
+        (finding,) = find_loop_locks(GO_LOOP_LOCK, "registry.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-144-CODE-GO"
+        assert finding.severity == Severity.INFO
+        assert finding.confidence == LOOP_LOCK_CONFIDENCE
+        assert (finding.line, finding.title) == (7, "s.mu locked in a loop while holding r.mu")
+        assert finding.snippet == "s.mu.Lock()"
+
+    def test_lock_per_iteration_without_outer_lock(self) -> None:
+        """Test that locks per iteration after the outer unlock are not reported."""
+        assert _locks(PER_ITERATION) == []
+
+    def test_loop_scopes(self) -> None:
+        """Test locks taken inside the loop, nested loops and code after the loop."""
+        text = """package registry
+
+func (r *Registry) Sweep() {
+    for _, shard := range r.shards {
+        shard.mu.Lock()
+        for _, s := range shard.sessions {
+            s.mu.RLock()
+            s.mu.RUnlock()
+        }
+        shard.mu.Unlock()
+    }
+    r.mu.Lock()
+    r.swept++
+    r.mu.Unlock()
+}
+
+func (r *Registry) Retry() {
+    r.mu.Lock()
+    for attempt := 0; attempt < 3; attempt++ {
+        r.mu.Lock()
+    }
+}
+"""
+        assert _locks(text) == [(7, "s.mu locked in a loop while holding shard.mu")]
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-144."""
+        config = ScanConfig(disable=["CC-144"])
+        assert find_loop_locks(GO_LOOP_LOCK, "x.go", config) == []
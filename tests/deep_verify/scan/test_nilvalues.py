@@ -0,0 +1,95 @@
+"""Tests for dereferences of possibly-nil map values (CC-152)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import ScanConfig, find_nil_map_values
+
+from tests.deep_verify.scan.conftest import GO_NIL_MAP_VALUE
+
+COMMA_OK = """package server
+
+type Server struct {
+    handlers map[string]*Handler
+}
+
+func (s *Server) dispatch(name string, req *Request) error {
+    h, ok := s.handlers[name]
+    if !ok {
+        return ErrUnknown
+    }
+    return h.Serve(req)
+}
+"""
+
+
+def _uses(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_nil_map_values(text, "x.go", ScanConfig())]
+
+
+class TestFindNilMapValues:
+    """Tests for find_nil_map_values."""
+
+    def test_method_on_absent_map_value(self) -> None:
+        """Test reporting a method call on an element of a pointer-valued map."""
+        (finding,) = find_nil_map_values(GO_NIL_MAP_VALUE, "server.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-152-CODE-GO"
+        assert finding.severity == Severity.WARNING
+        assert (finding.line, finding.title) == (
+            8,
+            "Method Serve called on s.handlers[name] without a presence check",
+        )
+        assert finding.snippet == "return s.handlers[name].Serve(req)"
+
+    def test_comma_ok_access_is_safe(self) -> None:
+        """Test that a comma-ok read of the element is not reported."""
+        assert _uses(COMMA_OK) == []
+
+    def test_copies_and_interface_values(self) -> None:
+        """Test unchecked copies, interface-valued maps and field reads."""
+        text = """package server
+
+type Codec interface {
+    Encode(v any) ([]byte, error)
+}
+
+var codecs = map[string]Codec{}
+
+func encode(name string, v any) ([]byte, error) {
+    c := codecs[name]
+    return c.Encode(v)
+}
+
+func size(sizes map[string]*Limit, name string) int {
+    return sizes[name].max
+}
+"""
+        assert _uses(text) == [
+            (11, "Method Encode called on c from codecs[name] without a presence check"),
+            (15, "Field max of sizes[name] used without a presence check"),
+        ]
+
+    def test_checked_and_value_maps(self) -> None:
+        """Test nil checks, range keys and maps whose values are not nilable."""
+        text = """package server
+
+func lookup(users map[int]*User, counts map[int]Count, id int) string {
+    if users[id] != nil {
+        return users[id].Name
+    }
+    u := users[id]
+    if u == nil {
+        return ""
+    }
+    log(u.Name, counts[id].n)
+    for k := range users {
+        users[k].Touch()
+    }
+    return u.Name
+}
+"""
+        assert _uses(text) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-152."""
+        config = ScanConfig(disable=["CC-152"])
+        assert find_nil_map_values(GO_NIL_MAP_VALUE, "x.go", config) == []
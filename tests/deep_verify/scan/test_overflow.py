@@ -0,0 +1,101 @@
+"""Tests for unchecked size arithmetic in allocations (CC-135)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    SIZE_OVERFLOW_CONFIDENCE,
+    ScanConfig,
+    find_size_overflows,
+)
+
+from tests.deep_verify.scan.conftest import GO_SIZE_OVERFLOW
+
+CHECKED = """package wire
+
+const maxRecords = 1 << 16
+
+func decode(r io.Reader) ([]byte, error) {
+    n, m := readHeader(r)
+    if n > maxRecords || m > maxRecords {
+        return nil, errTooLarge
+    }
+    buf := make([]byte, n*m)
+    _, err := io.ReadFull(r, buf)
+    return buf, err
+}
+"""
+
+
+def _overflows(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_size_overflows(text, "x.go", ScanConfig())]
+
+
+class TestFindSizeOverflows:
+    """Tests for find_size_overflows."""
+
+    def test_unchecked_multiplication(self) -> None:
+        """Test reporting a make size multiplying unchecked values."""
+        (finding,) = find_size_overflows(GO_SIZE_OVERFLOW, "wire.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-135-CODE-GO"
+        assert finding.severity == Severity.INFO
+        assert finding.line == 5
+        assert finding.title == "make size n*m may overflow without a bounds check"
+        assert finding.confidence == SIZE_OVERFLOW_CONFIDENCE < 1.0
+
+    def test_bounds_checked_allocation_is_safe(self) -> None:
+        """Test that a size whose operands are compared first is not reported."""
+        assert _overflows(CHECKED) == []
+
+    def test_operands(self) -> None:
+        """Test fields, capacities, additions, lengths, constants and packages."""
+        text = """package wire
+
+import "math"
+
+const (
+    headerSize = 16
+    trailer    = 4
+)
+
+func alloc(hdr Header, a, b []byte) {
+    _ = make([]Record, 0, hdr.Count*recordSize(hdr))
+    _ = make([]byte, hdr.Length+headerSize)
+    _ = make([]byte, len(a)+len(b))
+    _ = make([]byte, headerSize*trailer+1)
+    _ = make([]byte, math.MaxInt16*2)
+    _ = make([]byte, hdr.Length)
+    _ = make([]*Record, 4)
+}
+"""
+        assert _overflows(text) == [
+            (11, "make size hdr.Count*recordSize(hdr) may overflow without a bounds check"),
+            (12, "make size hdr.Length+headerSize may overflow without a bounds check"),
+        ]
+
+    def test_min_clamp_and_other_functions(self) -> None:
+        """Test that min clamps count as checks, scoped to the enclosing function."""
+        text = """package wire
+
+func clamped(n int) []byte {
+    n = min(n, 4096)
+    return make([]byte, n*8)
+}
+
+func guarded(n int) {
+    if n < 0 || n > 4096 {
+        return
+    }
+}
+
+func unguarded(n int) []byte {
+    return make([]byte, n*8)
+}
+"""
+        assert _overflows(text) == [
+            (15, "make size n*8 may overflow without a bounds check"),
+        ]
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-135."""
+        config = ScanConfig(disable=["CC-135"])
+        assert find_size_overflows(GO_SIZE_OVERFLOW, "x.go", config) == []
@@ -0,0 +1,295 @@
+"""Tests for CODEOWNERS routing of scan findings."""
+
+import json
+from pathlib import Path
+
+import pytest
+
+from bmad_assist.deep_verify.core.types import ArtifactDomain, PatternId, Severity
+from bmad_assist.deep_verify.scan import (
+    UNOWNED,
+    CodeOwners,
+    OwnerRule,
+    ScanFinding,
+    ScanReport,
+    Scanner,
+    find_codeowners,
+    owner_report_filename,
+    parse_codeowners,
+    split_by_owner,
+    write_owner_reports,
+)
+
+from tests.deep_verify.scan.conftest import GO_CLEAN, GO_GOROUTINE, write_file
+
+# Two teams sharing a repository, with a catch-all owner
+CODEOWNERS = """\
+# Default owners
+*                     @org/platform
+
+/services/payments/   @org/payments
+/services/search/     @org/search   # search infra
+/services/search/vendor/
+"""
+
+
+def _finding(path: str, line: int = 4) -> ScanFinding:
+    return ScanFinding(
+        pattern_id=PatternId("CC-001-CODE-GO"),
+        severity=Severity.CRITICAL,
+        title="Goroutine spawned without proper lifecycle management",
+        description="Goroutine spawned without proper lifecycle management",
+        path=path,
+        line=line,
+        snippet="go func() {",
+        confidence=1.0,
+        domain=ArtifactDomain.CONCURRENCY,
+        language="go",
+    )
+
+
+def _owners(text: str) -> CodeOwners:
+    return CodeOwners(parse_codeowners(text))
+
+
+class TestParseCodeowners:
+    """Tests for parse_codeowners()."""
+
+    def test_rules_in_file_order(self) -> None:
+        rules = parse_codeowners(CODEOWNERS)
+
+        assert [r.pattern for r in rules] == [
+            "*",
+            "/services/payments/",
+            "/services/search/",
+            "/services/search/vendor/",
+        ]
+        assert rules[1] == OwnerRule("/services/payments/", ("@org/payments",), line=4)
+
+    def test_inline_comment_ends_owners(self) -> None:
+        assert parse_codeowners(CODEOWNERS)[2].owners == ("@org/search",)
+
+    def test_rule_without_owners(self) -> None:
+        assert parse_codeowners(CODEOWNERS)[3].owners == ()
+
+    def test_multiple_owners(self) -> None:
+        rules = parse_codeowners("*.go @org/go dev@example.com\n")
+        assert rules[0].owners == ("@org/go", "dev@example.com")
+
+    def test_escaped_space(self) -> None:
+        rules = parse_codeowners("docs/user\\ guide/ @org/docs\n")
+        assert rules[0].pattern == "docs/user guide/"
+
+    def test_gitlab_sections(self) -> None:
+        rules = parse_codeowners(
+            "[Backend] @org/backend\n"
+            "internal/\n"
+            "internal/api/ @org/api\n"
+            "^[Docs][2] @org/writers\n"
+            "*.md\n"
+        )
+
+        assert [(r.section, r.owners) for r in rules] == [
+            ("Backend", ("@org/backend",)),
+            ("Backend", ("@org/api",)),
+            ("Docs", ("@org/writers",)),
+        ]
+
+
+class TestOwnersFor:
+    """Tests for CodeOwners.owners_for()."""
+
+    @pytest.mark.parametrize(
+        ("pattern", "path", "matches"),
+        [
+            ("*", "main.go", True),
+            ("*", "a/b/main.go", True),
+            ("*.go", "a/b/main.go", True),
+            ("*.go", "main.py", False),
+            ("/docs/", "docs/a/b.md", True),
+            ("/docs/", "src/docs/b.md", False),
+            ("docs/*", "docs/a.md", True),
+            ("docs/*", "docs/a/b.md", False),
+            ("apps/", "src/apps/main.go", True),
+            ("/build/logs", "build/logs/x.log", True),
+            ("/build/logs", "build/logs.txt", False),
+            ("**/vendor", "a/b/vendor/x.go", True),
+            ("/src/**/test_*.py", "src/a/b/test_x.py", True),
+            ("/src/**/test_*.py", "src/test_x.py", True),
+            ("cache?.go", "pkg/cache1.go", True),
+            ("cache?.go", "pkg/cache12.go", False),
+        ],
+    )
+    def test_wildcards(self, pattern: str, path: str, matches: bool) -> None:
+        owners = _owners(f"{pattern} @org/team\n")
+        assert owners.owners_for(path) == (("@org/team",) if matches else ())
+
+    def test_later_rule_wins(self) -> None:
+        owners = _owners(CODEOWNERS)
+
+        assert owners.owners_for("README.md") == ("@org/platform",)
+        assert owners.owners_for("services/payments/main.go") == ("@org/payments",)
+        assert owners.owners_for("services/search/index.go") == ("@org/search",)
+
+    def test_later_rule_without_owners_unowns(self) -> None:
+        assert _owners(CODEOWNERS).owners_for("services/search/vendor/lib.go") == ()
+
+    def test_sections_combine_owners(self) -> None:
+        owners = _owners(
+            "* @org/platform\n"
+            "[Security]\n"
+            "**/auth/ @org/security\n"
+            "[Payments] @org/payments\n"
+            "/services/payments/\n"
+        )
+
+        assert owners.owners_for("services/payments/auth/login.go") == (
+            "@org/platform",
+            "@org/security",
+            "@org/payments",
+        )
+        assert owners.owners_for("services/search/main.go") == ("@org/platform",)
+
+
+class TestFindCodeowners:
+    """Tests for find_codeowners() and CodeOwners.load()."""
+
+    def test_finds_github_location_in_ancestor(self, tmp_path: Path) -> None:
+        (tmp_path / ".git").mkdir()
+        write_file(tmp_path, ".github/CODEOWNERS", CODEOWNERS)
+        (tmp_path / "services" / "payments").mkdir(parents=True)
+
+        found = find_codeowners(tmp_path / "services" / "payments")
+
+        assert found == (tmp_path / ".github" / "CODEOWNERS").resolve()
+
+    def test_stops_at_repository_root(self, tmp_path: Path) -> None:
+        write_file(tmp_path, "CODEOWNERS", CODEOWNERS)
+        (tmp_path / "repo" / ".git").mkdir(parents=True)
+
+        assert find_codeowners(tmp_path / "repo") is None
+
+    def test_load_sets_repository_root(self, tmp_path: Path) -> None:
+        path = write_file(tmp_path, ".gitlab/CODEOWNERS", CODEOWNERS)
+
+        owners = CodeOwners.load(path)
+
+        assert owners.root == tmp_path
+        assert owners.prefix_for(tmp_path / "services") == "services"
+        assert owners.prefix_for(tmp_path) == ""
+
+
+class TestSplitByOwner:
+    """Tests for split_by_owner()."""
+
+    def test_splits_files_and_findings(self) -> None:
+        report = ScanReport(
+            root=".",
+            findings=[_finding("services/payments/main.go"), _finding("services/search/main.go")],
+            files_scanned=["main.go", "services/payments/main.go", "services/search/main.go"],
+        )
+
+        reports = split_by_owner(report, _owners(CODEOWNERS))
+
+        assert list(reports) == ["@org/payments", "@org/platform", "@org/search"]
+        assert reports["@org/payments"].files_scanned == ["services/payments/main.go"]
+        assert [f.path for f in reports["@org/payments"].findings] == [
+            "services/payments/main.go"
+        ]
+        assert reports["@org/platform"].findings == []
+
+    def test_unowned_last(self) -> None:
+        report = ScanReport(
+            root=".",
+            findings=[_finding("services/search/vendor/lib.go")],
+            files_scanned=["services/search/vendor/lib.go", "services/search/main.go"],
+        )
+
+        reports = split_by_owner(report, _owners(CODEOWNERS))
+
+        assert list(reports) == ["@org/search", UNOWNED]
+        assert len(reports[UNOWNED].findings) == 1
+
+    def test_prefix_maps_subdirectory_scans(self) -> None:
+        report = ScanReport(
+            root="services",
+            findings=[_finding("payments/main.go")],
+            files_scanned=["payments/main.go"],
+        )
+
+        reports = split_by_owner(report, _owners(CODEOWNERS), prefix="services")
+
+        assert list(reports) == ["@org/payments"]
+        assert reports["@org/payments"].findings[0].path == "payments/main.go"
+
+    def test_shared_file_in_each_owner_report(self) -> None:
+        report = ScanReport(root=".", findings=[_finding("api.go")], files_scanned=["api.go"])
+
+        reports = split_by_owner(report, _owners("*.go @org/a @org/b\n"))
+
+        assert [len(r.findings) for r in reports.values()] == [1, 1]
+
+
+class TestWriteOwnerReports:
+    """Tests for write_owner_reports()."""
+
+    def test_per_team_report_contents(self, tmp_path: Path) -> None:
+        repo = tmp_path / "repo"
+        write_file(repo, "services/payments/main.go", GO_GOROUTINE)
+        write_file(repo, "services/payments/util.go", GO_CLEAN)
+        write_file(repo, "services/search/main.go", GO_GOROUTINE)
+        write_file(repo, "tools/gen.go", GO_CLEAN)
+        owners = CodeOwners.load(write_file(repo, "CODEOWNERS", CODEOWNERS))
+        report = Scanner().scan(repo)
+        out = tmp_path / "by-owner"
+
+        written = write_owner_reports(report, owners, out)
+
+        assert written == {
+            "@org/payments": out / "org-payments.json",
+            "@org/platform": out / "org-platform.json",
+            "@org/search": out / "org-search.json",
+        }
+        payments = json.loads(written["@org/payments"].read_text())
+        assert payments["files_scanned"] == [
+            "services/payments/main.go",
+            "services/payments/util.go",
+        ]
+        assert [(f["path"], f["pattern_id"]) for f in payments["findings"]] == [
+            ("services/payments/main.go", "CC-001-CODE-GO")
+        ]
+        search = json.loads(written["@org/search"].read_text())
+        assert [f["path"] for f in search["findings"]] == ["services/search/main.go"]
+        platform = json.loads(written["@org/platform"].read_text())
+        assert platform["files_scanned"] == ["tools/gen.go"]
+        assert platform["findings"] == []
+
+        index = json.loads((out / "index.json").read_text())
+        assert index["owners"]["@org/payments"] == {
+            "report": "org-payments.json",
+            "files": 2,
+            "findings": 1,
+        }
+
+    def test_colliding_file_names_are_numbered(self, tmp_path: Path) -> None:
+        report = ScanReport(root=".", files_scanned=["a.go"])
+
+        written = write_owner_reports(report, _owners("*.go @org/a org-a\n"), tmp_path)
+
+        assert sorted(p.name for p in written.values()) == ["org-a-2.json", "org-a.json"]
+
+
+class TestOwnerReportFilename:
+    """Tests for owner_report_filename()."""
+
+    @pytest.mark.parametrize(
+        ("owner", "filename"),
+        [
+            ("@org/payments", "org-payments.json"),
+            ("@alice", "alice.json"),
+            ("dev@example.com", "dev-example.com.json"),
+            (UNOWNED, "unowned.json"),
+        ],
+    )
+    def test_filenames(self, owner: str, filename: str) -> None:
+        assert owner_report_filename(owner) == filename
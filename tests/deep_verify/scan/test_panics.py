@@ -0,0 +1,120 @@
+"""Tests for panic used as control flow (CC-132)."""
+
+from pathlib import Path
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    PANIC_ROUTE_CONFIDENCE,
+    ScanConfig,
+    ScanOptions,
+    Scanner,
+    find_panic_routes,
+)
+
+from tests.deep_verify.scan.conftest import GO_PANIC_ROUTE, write_file
+
+RECOVER_AND_LOG = """package worker
+
+func (w *Worker) run() {
+    defer func() {
+        if r := recover(); r != nil {
+            switch r.(type) {
+            case error:
+                log.Printf("worker crashed: %v", r)
+            default:
+                w.logger.Error("worker crashed", "panic", r)
+                panic(r)
+            }
+        }
+    }()
+    w.loop()
+}
+"""
+
+
+def _routes(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_panic_routes(text, "x.go", ScanConfig())]
+
+
+class TestFindPanicRoutes:
+    """Tests for find_panic_routes."""
+
+    def test_recover_and_route(self) -> None:
+        """Test reporting a type switch that routes on the recovered value."""
+        (finding,) = find_panic_routes(GO_PANIC_ROUTE, "walk.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-132-CODE-GO"
+        assert finding.severity == Severity.INFO
+        assert finding.line == 6
+        assert finding.title == "Switch on recovered value r uses panic as control flow"
+        assert finding.snippet == "switch e := r.(type) {"
+        assert finding.confidence == PANIC_ROUTE_CONFIDENCE < 1.0
+
+    def test_recover_and_log_is_safe(self) -> None:
+        """Test that a switch that only logs and re-panics is not reported."""
+        assert _routes(RECOVER_AND_LOG) == []
+
+    def test_expression_switch_and_assignment(self) -> None:
+        """Test expression switches and values assigned from recover()."""
+        text = """package parse
+
+func (p *parser) parse() (ok bool) {
+    defer func() {
+        v := recover()
+        switch v {
+        case errBacktrack:
+            ok = false
+        }
+    }()
+    return p.expr()
+}
+"""
+        assert _routes(text) == [(6, "Switch on recovered value v uses panic as control flow")]
+
+    def test_switch_on_other_values_is_safe(self) -> None:
+        """Test that switches outside the recover block or on other values are skipped."""
+        text = """package main
+
+func run(kind int) {
+    defer func() {
+        if r := recover(); r != nil {
+            log.Println(r)
+        }
+    }()
+    switch kind {
+    case 1:
+        start()
+    }
+}
+
+func other(r any) {
+    switch r.(type) {
+    case error:
+        handle(r)
+    }
+}
+"""
+        assert _routes(text) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-132."""
+        config = ScanConfig(disable=["CC-132"])
+        assert find_panic_routes(GO_PANIC_ROUTE, "x.go", config) == []
+
+
+class TestScannerPanicRoutes:
+    """Tests for CC-132 in tree scans."""
+
+    def test_documented_suppression(self, tmp_path: Path) -> None:
+        """Test that a deliberate parser-style use can be suppressed with a reason."""
+        text = GO_PANIC_ROUTE.replace(
+            "switch e := r.(type) {",
+            'switch e := r.(type) { // deepverify:ignore CC-132 reason="walk aborts via panic"',
+        )
+        write_file(tmp_path, "walk.go", text)
+
+        report = Scanner(ScanOptions(show_suppressed=True)).scan(tmp_path)
+
+        (finding,) = [f for f in report.findings if f.pattern_id == "CC-132-CODE-GO"]
+        assert finding.suppressed
+        assert finding.suppression_reason == "walk aborts via panic"
@@ -0,0 +1,94 @@
+"""Tests for file paths built with Sprintf or concatenation (CC-139)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import ScanConfig, find_concatenated_paths
+
+from tests.deep_verify.scan.conftest import GO_CONCATENATED_PATH
+
+JOINED_PATH = """package store
+
+import (
+    "os"
+    "path/filepath"
+)
+
+func load(dir, name string) (*os.File, error) {
+    path := filepath.Join(dir, name+".json")
+    return os.Open(path)
+}
+"""
+
+
+def _paths(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_concatenated_paths(text, "x.go", ScanConfig())]
+
+
+class TestFindConcatenatedPaths:
+    """Tests for find_concatenated_paths."""
+
+    def test_sprintf_path_into_open(self) -> None:
+        """Test reporting a Sprintf-built path passed to os.Open through a variable."""
+        (finding,) = find_concatenated_paths(GO_CONCATENATED_PATH, "store.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-139-CODE-GO"
+        assert finding.severity == Severity.INFO
+        assert finding.line == 10
+        assert finding.title == "os.Open path built with fmt.Sprintf, not filepath.Join"
+        assert finding.snippet == "return os.Open(path)"
+
+    def test_filepath_join_is_safe(self) -> None:
+        """Test that paths built with filepath.Join are not reported."""
+        assert _paths(JOINED_PATH) == []
+
+    def test_inline_concatenation_and_aliases(self) -> None:
+        """Test inline concatenation, aliased imports and both Rename arguments."""
+        text = """package store
+
+import (
+    f "fmt"
+    "io/ioutil"
+    "os"
+)
+
+func save(dir, name string, data []byte) error {
+    if err := ioutil.WriteFile(dir + "/" + name, data, 0o644); err != nil {
+        return err
+    }
+    return os.Rename(f.Sprintf("%s/tmp", dir), dir+"/"+name)
+}
+"""
+        assert _paths(text) == [
+            (10, "ioutil.WriteFile path built with concatenation, not filepath.Join"),
+            (13, "os.Rename path built with fmt.Sprintf, not filepath.Join"),
+            (13, "os.Rename path built with concatenation, not filepath.Join"),
+        ]
+
+    def test_reassignment_and_other_functions(self) -> None:
+        """Test that reassigned variables, non-path formats and other functions are not reported."""
+        text = """package store
+
+import (
+    "fmt"
+    "os"
+    "path/filepath"
+)
+
+func first(dir, name string) {
+    path := fmt.Sprintf("%s/%s", dir, name)
+    path = filepath.Clean(name)
+    os.Remove(path)
+    label := fmt.Sprintf("%s-%d", name, 1)
+    os.Remove(label)
+}
+
+func second(path string) {
+    os.Remove(path)
+    fmt.Println("a/" + path) // os.Open("a/" + path)
+}
+"""
+        assert _paths(text) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-139."""
+        config = ScanConfig(disable=["CC-139"])
+        assert find_concatenated_paths(GO_CONCATENATED_PATH, "x.go", config) == []
@@ -0,0 +1,98 @@
+"""Tests for the browser playground entry point."""
+
+import json
+import os
+import subprocess
+import sys
+
+import pytest
+
+from bmad_assist.deep_verify.scan.playground import PLAYGROUND_PATH, analyze
+
+from tests.deep_verify.scan.conftest import GO_CLEAN, GO_GOROUTINE
+
+# Imports the entry point with OS-bound modules blocked, as under Pyodide,
+# and prints the exported function name. os and pathlib work against Pyodide's
+# in-memory filesystem and importlib.metadata ships with it, so they load first.
+_IMPORT_SCRIPT = """
+import importlib.metadata
+import sys
+
+BLOCKED = {
+    "asyncio",
+    "ctypes",
+    "http",
+    "multiprocessing",
+    "socket",
+    "sqlite3",
+    "ssl",
+    "subprocess",
+    "urllib",
+    "xml",
+    "bmad_assist.providers",
+}
+
+class Blocker:
+    def find_spec(self, name, path=None, target=None):
+        if name in BLOCKED or name.split(".")[0] in BLOCKED:
+            raise ImportError(f"{name} is not available in the browser")
+        return None
+
+before = set(sys.modules)
+sys.meta_path.insert(0, Blocker())
+from bmad_assist.deep_verify.scan import playground
+
+loaded = sorted(m for m in set(sys.modules) - before if m in BLOCKED)
+assert not loaded, loaded
+assert callable(playground.analyze)
+print(playground.analyze.__name__)
+"""
+
+
+class TestAnalyze:
+    """Tests for analyze()."""
+
+    def test_returns_json_report(self) -> None:
+        report = json.loads(analyze(GO_GOROUTINE))
+
+        assert report["root"] == PLAYGROUND_PATH
+        assert report["files_scanned"] == [PLAYGROUND_PATH]
+        assert [f["pattern_id"] for f in report["findings"]] == ["CC-001-CODE-GO"]
+        assert report["findings"][0]["path"] == PLAYGROUND_PATH
+        assert report["findings"][0]["line"] == 4
+
+    def test_clean_source(self) -> None:
+        report = json.loads(analyze(GO_CLEAN))
+        assert report["findings"] == []
+
+    def test_custom_path(self) -> None:
+        report = json.loads(analyze(GO_GOROUTINE, path="worker.go"))
+        assert report["findings"][0]["path"] == "worker.go"
+
+    def test_language_is_case_insensitive(self) -> None:
+        assert analyze(GO_GOROUTINE, "Go") == analyze(GO_GOROUTINE, "go")
+
+    def test_unknown_language_has_no_findings(self) -> None:
+        report = json.loads(analyze(GO_GOROUTINE, "cobol"))
+        assert report["findings"] == []
+
+    def test_rejects_non_string_source(self) -> None:
+        with pytest.raises(TypeError, match="source must be a string"):
+            analyze(b"package main")  # type: ignore[arg-type]
+
+
+class TestBrowserImport:
+    """The entry point must not depend on OS-bound modules."""
+
+    def test_imports_without_os_modules(self) -> None:
+        env = {**os.environ, "PYTHONPATH": os.pathsep.join(p for p in sys.path if p)}
+        result = subprocess.run(
+            [sys.executable, "-c", _IMPORT_SCRIPT],
+            capture_output=True,
+            text=True,
+            env=env,
+            timeout=60,
+        )
+
+        assert result.returncode == 0, result.stderr
+        assert result.stdout.strip() == "analyze"
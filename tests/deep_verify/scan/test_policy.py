@@ -0,0 +1,139 @@
+"""Tests for path-based severity rules."""
+
+from pathlib import Path
+
+import pytest
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    CONFIG_FILENAME,
+    PathRule,
+    ScanOptions,
+    Scanner,
+    apply_path_rules,
+    target_arch_rules,
+)
+
+from tests.deep_verify.scan.conftest import GO_GOROUTINE, GO_MISALIGNED_ATOMIC, write_file
+
+
+def _severities(root: Path, *rules: PathRule) -> dict[str, Severity]:
+    report = Scanner(ScanOptions(path_severity_rules=rules)).scan(root)
+    return {f.path: f.severity for f in report.findings}
+
+
+@pytest.fixture
+def policy_tree(tmp_path: Path) -> Path:
+    """Create a tree with the same goroutine finding (CC-001, critical) in three places."""
+    write_file(tmp_path, "examples/demo/main.go", GO_GOROUTINE)
+    write_file(tmp_path, "pkg/api/server.go", GO_GOROUTINE)
+    write_file(tmp_path, "internal/experimental/x.go", GO_GOROUTINE)
+    return tmp_path
+
+
+class TestPathRule:
+    """Tests for PathRule validation."""
+
+    def test_set_requires_severity(self) -> None:
+        """Test that 'set' rules need a target severity."""
+        with pytest.raises(ValueError, match="requires a severity"):
+            PathRule("examples/*", action="set")
+
+    def test_negative_steps(self) -> None:
+        """Test that negative steps are rejected."""
+        with pytest.raises(ValueError, match="non-negative"):
+            PathRule("examples/*", action="lower", steps=-1)
+
+
+class TestApplyPathRules:
+    """Tests for path rules applied during scans."""
+
+    def test_examples_downgraded_pkg_upgraded(self, policy_tree: Path) -> None:
+        """Test a downgrade in examples and an upgrade in pkg."""
+        write_file(policy_tree, CONFIG_FILENAME, "severity:\n  CC-001: error\n")
+
+        severities = _severities(
+            policy_tree,
+            PathRule("examples/*", action="set", severity=Severity.INFO),
+            PathRule("pkg/*", action="raise", patterns=("CC-",)),
+        )
+
+        assert severities["examples/demo/main.go"] == Severity.INFO
+        assert severities["pkg/api/server.go"] == Severity.CRITICAL
+        assert severities["internal/experimental/x.go"] == Severity.ERROR
+
+    def test_drop(self, policy_tree: Path) -> None:
+        """Test that drop rules remove findings."""
+        severities = _severities(
+            policy_tree, PathRule("internal/experimental/*", action="drop")
+        )
+
+        assert "internal/experimental/x.go" not in severities
+        assert len(severities) == 2
+
+    def test_rules_apply_in_order_and_clamp(self, policy_tree: Path) -> None:
+        """Test that matching rules stack and clamp at the ladder ends."""
+        severities = _severities(
+            policy_tree,
+            PathRule("*", action="raise"),
+            PathRule("examples/*", action="lower", steps=10),
+        )
+
+        assert severities["pkg/api/server.go"] == Severity.CRITICAL
+        assert severities["examples/demo/main.go"] == Severity.INFO
+
+    def test_pattern_selectors_limit_rules(self, policy_tree: Path) -> None:
+        """Test that rules only touch selected patterns."""
+        severities = _severities(policy_tree, PathRule("*", action="drop", patterns=("SEC-",)))
+
+        assert set(severities.values()) == {Severity.CRITICAL}
+
+    def test_no_rules_is_identity(self) -> None:
+        """Test that no rules leaves findings untouched."""
+        assert apply_path_rules([], ()) == []
+
+
+class TestTargetArchRules:
+    """Tests for build target severity rules."""
+
+    @pytest.mark.parametrize(
+        ("goarch", "severity"),
+        [
+            (None, Severity.WARNING),
+            ("amd64", Severity.WARNING),
+            ("arm64", Severity.WARNING),
+            ("386", Severity.CRITICAL),
+            ("arm", Severity.CRITICAL),
+            ("MIPSLE", Severity.CRITICAL),
+        ],
+    )
+    def test_32bit_targets_raise_atomic_alignment(
+        self, tmp_path: Path, goarch: str | None, severity: Severity
+    ) -> None:
+        """Test that misaligned 64-bit atomics are critical on 32-bit targets only."""
+        write_file(tmp_path, "stats.go", GO_MISALIGNED_ATOMIC)
+
+        report = Scanner(ScanOptions(target_arch=goarch)).scan(tmp_path)
+
+        assert [(f.pattern_id, f.severity) for f in report.findings] == [
+            ("CC-117-CODE-GO", severity)
+        ]
+
+    def test_path_rules_apply_after_target(self, tmp_path: Path) -> None:
+        """Test that path rules adjust the target-raised severity."""
+        write_file(tmp_path, "examples/stats.go", GO_MISALIGNED_ATOMIC)
+        options = ScanOptions(
+            target_arch="arm",
+            path_severity_rules=(PathRule("examples/*", action="lower"),),
+        )
+
+        [finding] = Scanner(options).scan(tmp_path).findings
+
+        assert finding.severity == Severity.ERROR
+
+    def test_rules_select_alignment_pattern(self) -> None:
+        """Test that the target rules select only the alignment pattern."""
+        [rule] = target_arch_rules("386")
+
+        assert rule.patterns == ("CC-117-CODE-GO",)
+        assert target_arch_rules("amd64") == ()
@@ -0,0 +1,116 @@
+"""Tests for sleep-based poll loops in context-aware functions (CC-150)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    ScanConfig,
+    find_sleep_polls,
+)
+
+from tests.deep_verify.scan.conftest import GO_SLEEP_POLL
+
+TICKER = """package worker
+
+import (
+    "context"
+    "time"
+)
+
+func (w *Worker) Run(ctx context.Context) {
+    ticker := time.NewTicker(w.interval)
+    defer ticker.Stop()
+    for {
+        w.poll(ctx)
+        select {
+        case <-ctx.Done():
+            return
+        case <-ticker.C:
+        }
+    }
+}
+"""
+
+
+def _polls(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_sleep_polls(text, "x.go", ScanConfig())]
+
+
+class TestFindSleepPolls:
+    """Tests for find_sleep_polls."""
+
+    def test_sleep_poll_loop(self) -> None:
+        """Test reporting an infinite loop ending in time.Sleep."""
+        (finding,) = find_sleep_polls(GO_SLEEP_POLL, "worker.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-150-CODE-GO"
+        assert finding.severity == Severity.INFO
+        assert (finding.line, finding.title) == (
+            11,
+            "Run polls with time.Sleep, which ignores context cancellation",
+        )
+        assert finding.snippet == "time.Sleep(w.interval)"
+
+    def test_ticker_with_context_is_safe(self) -> None:
+        """Test that a ticker selected with ctx.Done() is not reported."""
+        assert _polls(TICKER) == []
+
+    def test_skipped_forms(self) -> None:
+        """Test functions without a context, nested sleeps and conditional loops."""
+        text = """package worker
+
+import (
+    "context"
+    "time"
+)
+
+func poll(w *Worker) {
+    for {
+        w.poll()
+        time.Sleep(time.Second)
+    }
+}
+
+func retry(ctx context.Context, w *Worker) {
+    for {
+        if err := w.poll(ctx); err != nil {
+            time.Sleep(time.Second)
+            continue
+        }
+        return
+    }
+}
+
+func drain(ctx context.Context, w *Worker) {
+    for w.pending() {
+        w.next()
+        time.Sleep(time.Millisecond)
+    }
+}
+"""
+        assert _polls(text) == []
+
+    def test_import_aliases_and_literals(self) -> None:
+        """Test aliased imports and loops inside goroutine literals."""
+        text = """package worker
+
+import (
+    stdctx "context"
+    t "time"
+)
+
+func Start(ctx stdctx.Context, w *Worker) {
+    go func() {
+        for {
+            w.poll()
+            t.Sleep(w.interval)
+        }
+    }()
+}
+"""
+        assert _polls(text) == [
+            (12, "Start polls with time.Sleep, which ignores context cancellation")
+        ]
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-150."""
+        config = ScanConfig(disable=["CC-150"])
+        assert find_sleep_polls(GO_SLEEP_POLL, "x.go", config) == []
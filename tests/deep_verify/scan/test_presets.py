@@ -0,0 +1,113 @@
+"""Tests for named scan presets."""
+
+from pathlib import Path
+
+import pytest
+import yaml
+
+from bmad_assist.deep_verify.core.types import PatternPrecision, Severity
+from bmad_assist.deep_verify.patterns.library import (
+    PATTERN_API_VERSION,
+    PatternLibrary,
+    get_default_pattern_library,
+)
+from bmad_assist.deep_verify.scan import (
+    HIGH_SIGNAL_PRESET,
+    HIGH_SIGNAL_THRESHOLD,
+    ScanConfig,
+    ScanOptions,
+    Scanner,
+    apply_preset,
+    high_precision_ids,
+)
+from bmad_assist.deep_verify.scan.scanner import BUILTIN_PATTERNS
+
+from tests.deep_verify.scan.conftest import GO_GOROUTINE, write_file
+
+# A string context key (CC-140, high precision) next to an unjoined goroutine
+# (CC-001, unrated)
+GO_MIXED = """package main
+
+import "context"
+
+func main() {
+    ctx := context.WithValue(context.Background(), "user", 1)
+    go func() {
+        use(ctx)
+    }()
+}
+"""
+
+
+def _library(tmp_path: Path, *precisions: str | None) -> PatternLibrary:
+    patterns = [
+        {
+            "id": f"CC-{index:03d}-CODE-GO",
+            "domain": "concurrency",
+            "severity": "warning",
+            "signals": ["go func("],
+            **({"precision": precision} if precision else {}),
+        }
+        for index, precision in enumerate(precisions, start=1)
+    ]
+    yaml_file = tmp_path / "patterns.yaml"
+    yaml_file.write_text(yaml.dump({"api_version": PATTERN_API_VERSION, "patterns": patterns}))
+    return PatternLibrary.load([yaml_file])
+
+
+class TestHighSignalPreset:
+    """Tests for the high-signal preset."""
+
+    def test_enables_exactly_the_high_precision_set(self, tmp_path: Path) -> None:
+        """Test that the preset enables high-precision library and built-in patterns only."""
+        library = _library(tmp_path, "high", "medium", None)
+        builtin_ids = [p.id for p in BUILTIN_PATTERNS if p.precision == PatternPrecision.HIGH]
+
+        options = apply_preset(ScanOptions(), HIGH_SIGNAL_PRESET, library)
+
+        assert options.config is not None
+        assert options.config.enable == sorted(["CC-001-CODE-GO", *builtin_ids])
+        assert high_precision_ids(library) == options.config.enable
+
+    def test_default_library_selection(self) -> None:
+        """Test which patterns of the default library qualify."""
+        ids = high_precision_ids(get_default_pattern_library())
+
+        assert {"CC-039-CODE-GO", "SEC-007-CODE-GO", "CC-140-CODE-GO"} <= set(ids)
+        assert "CC-001-CODE-GO" not in ids
+
+    def test_applies_the_confidence_floor(self) -> None:
+        """Test that the threshold is raised to the floor but never lowered."""
+        assert apply_preset(ScanOptions(threshold=0.5), HIGH_SIGNAL_PRESET).threshold == (
+            HIGH_SIGNAL_THRESHOLD
+        )
+        assert apply_preset(ScanOptions(threshold=0.95), HIGH_SIGNAL_PRESET).threshold == 0.95
+
+    def test_keeps_the_base_config(self) -> None:
+        """Test that other config keys are kept and an existing enable narrows the set."""
+        base = ScanConfig(enable=["SEC-"], disable=["CC-110"], severity={"SEC-": Severity.INFO})
+
+        options = apply_preset(ScanOptions(config=base), HIGH_SIGNAL_PRESET)
+
+        assert options.config is not None
+        assert options.config.enable == ["SEC-007-CODE-GO"]
+        assert options.config.disable == ["CC-110"]
+        assert options.config.severity == {"SEC-": Severity.INFO}
+
+    def test_unknown_preset(self) -> None:
+        """Test that unknown preset names are rejected."""
+        with pytest.raises(ValueError, match="high-signal"):
+            apply_preset(ScanOptions(), "everything")
+
+    def test_scan_reports_only_high_precision_findings(self, tmp_path: Path) -> None:
+        """Test that a preset scan drops findings of unrated patterns."""
+        write_file(tmp_path, "main.go", GO_MIXED)
+        write_file(tmp_path, "worker.go", GO_GOROUTINE)
+
+        full = Scanner().scan(tmp_path)
+        report = Scanner(apply_preset(ScanOptions(), HIGH_SIGNAL_PRESET)).scan(tmp_path)
+
+        assert {f.pattern_id for f in full.findings} >= {"CC-001-CODE-GO", "CC-140-CODE-GO"}
+        assert [(f.path, f.pattern_id) for f in report.findings] == [
+            ("main.go", "CC-140-CODE-GO")
+        ]
@@ -0,0 +1,89 @@
+"""Tests for pushing scan metrics to a Prometheus pushgateway."""
+
+import threading
+from collections.abc import Iterator
+from http.server import BaseHTTPRequestHandler, HTTPServer
+from pathlib import Path
+
+import pytest
+
+pytest.importorskip("prometheus_client")
+
+from bmad_assist.deep_verify.scan import ScanReport, Scanner
+from bmad_assist.deep_verify.scan.pushgateway import MetricsPushError, push_report_metrics
+
+from tests.deep_verify.scan.conftest import GO_GOROUTINE, write_file
+
+
+class _Gateway(HTTPServer):
+    """Mock pushgateway recording the pushes it receives."""
+
+    status = 200
+    pushes: list[tuple[str, str, str, str]]
+
+
+class _GatewayHandler(BaseHTTPRequestHandler):
+    server: _Gateway
+
+    def do_PUT(self) -> None:
+        body = self.rfile.read(int(self.headers["Content-Length"])).decode()
+        self.server.pushes.append((self.command, self.path, self.headers["Content-Type"], body))
+        self.send_response(self.server.status)
+        self.end_headers()
+
+    def log_message(self, *args: object) -> None:
+        pass
+
+
+@pytest.fixture
+def gateway() -> Iterator[_Gateway]:
+    server = _Gateway(("127.0.0.1", 0), _GatewayHandler)
+    server.pushes = []
+    thread = threading.Thread(target=server.serve_forever, daemon=True)
+    thread.start()
+    yield server
+    server.shutdown()
+    server.server_close()
+
+
+@pytest.fixture
+def report(tmp_path: Path) -> ScanReport:
+    write_file(tmp_path, "main.go", GO_GOROUTINE)
+    return Scanner().scan(tmp_path)
+
+
+def _url(gateway: _Gateway) -> str:
+    return f"http://127.0.0.1:{gateway.server_port}"
+
+
+class TestPushReportMetrics:
+    """Tests for push_report_metrics."""
+
+    def test_pushes_exposition_format(self, gateway: _Gateway, report: ScanReport) -> None:
+        """Test the pushed path, content type and metric values."""
+        push_report_metrics(report, _url(gateway), "deepverify", repo="app", branch="main")
+
+        ((method, path, content_type, body),) = gateway.pushes
+        assert method == "PUT"
+        assert path == "/metrics/job/deepverify/branch/main/repo/app"
+        assert content_type.startswith("text/plain")
+        lines = body.splitlines()
+        assert "# TYPE deepverify_findings gauge" in lines
+        assert "deepverify_findings 1.0" in lines
+        assert 'deepverify_findings_by_severity{severity="critical"} 1.0' in lines
+        assert 'deepverify_findings_by_severity{severity="info"} 0.0' in lines
+        assert 'deepverify_findings_by_domain{domain="concurrency"} 1.0' in lines
+        assert 'deepverify_findings_by_domain{domain="security"} 0.0' in lines
+
+    def test_grouping_key_without_labels(self, gateway: _Gateway, report: ScanReport) -> None:
+        """Test that repo and branch are left out of the grouping key when not given."""
+        push_report_metrics(report, _url(gateway), "deepverify")
+
+        assert [push[1] for push in gateway.pushes] == ["/metrics/job/deepverify"]
+
+    def test_rejected_push_raises(self, gateway: _Gateway, report: ScanReport) -> None:
+        """Test that a pushgateway error is raised as MetricsPushError."""
+        gateway.status = 500
+
+        with pytest.raises(MetricsPushError, match="Cannot push metrics"):
+            push_report_metrics(report, _url(gateway), "deepverify")
@@ -0,0 +1,109 @@
+"""Tests for math/rand secret detection (CC-124)."""
+
+from pathlib import Path
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    ScanConfig,
+    ScanOptions,
+    Scanner,
+    find_weak_random_secrets,
+)
+
+from tests.deep_verify.scan.conftest import GO_WEAK_RANDOM_TOKEN, write_file
+
+MATH_RAND_JITTER = """package retry
+
+import (
+    "math/rand"
+    "time"
+)
+
+func backoff(attempt int) time.Duration {
+    jitter := time.Duration(rand.Int63n(100)) * time.Millisecond
+    return time.Duration(attempt)*time.Second + jitter
+}
+"""
+
+CRYPTO_RAND_TOKEN = GO_WEAK_RANDOM_TOKEN.replace('"math/rand"', '"crypto/rand"')
+
+
+def _secrets(text: str, names: tuple[str, ...] | None = None) -> list[tuple[int, str]]:
+    if names is None:
+        findings = find_weak_random_secrets(text, "x.go", ScanConfig())
+    else:
+        findings = find_weak_random_secrets(text, "x.go", ScanConfig(), names)
+    return [(f.line, f.title) for f in findings]
+
+
+class TestFindWeakRandomSecrets:
+    """Tests for find_weak_random_secrets."""
+
+    def test_token_from_math_rand(self) -> None:
+        """Test reporting a token built from a buffer filled by math/rand."""
+        findings = find_weak_random_secrets(GO_WEAK_RANDOM_TOKEN, "auth.go", ScanConfig())
+
+        assert [(f.line, f.title) for f in findings] == [(11, "math/rand value used for token")]
+        assert findings[0].pattern_id == "CC-124-CODE-GO"
+        assert findings[0].severity == Severity.ERROR
+
+    def test_jitter_is_safe(self) -> None:
+        """Test that math/rand for non-secret values is not reported."""
+        assert _secrets(MATH_RAND_JITTER) == []
+
+    def test_crypto_rand_is_safe(self) -> None:
+        """Test that crypto/rand values are not reported."""
+        assert _secrets(CRYPTO_RAND_TOKEN) == []
+
+    def test_helper_function_and_alias(self) -> None:
+        """Test taint through a helper defined later and an aliased v2 import."""
+        text = """package auth
+
+import mrand "math/rand/v2"
+
+func (s *Store) Reset(u *User) {
+    u.reset_token = randomString(32)
+    count := randomString(4)
+    _ = count
+}
+
+func newSessionID() string {
+    return randomString(24)
+}
+
+func randomString(n int) string {
+    b := make([]byte, n)
+    for i := range b {
+        b[i] = letters[mrand.IntN(len(letters))]
+    }
+    return string(b)
+}
+"""
+        assert _secrets(text) == [
+            (6, "math/rand value used for reset_token"),
+            (12, "math/rand value used for newSessionID"),
+        ]
+
+    def test_custom_names(self) -> None:
+        """Test that the name list can be replaced."""
+        text = MATH_RAND_JITTER.replace("jitter", "inviteCode")
+
+        assert _secrets(text, ("invitecode",)) == [(9, "math/rand value used for inviteCode")]
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-124."""
+        config = ScanConfig(disable=["CC-124"])
+        assert find_weak_random_secrets(GO_WEAK_RANDOM_TOKEN, "auth.go", config) == []
+
+
+class TestScannerWeakRandom:
+    """Tests for CC-124 in tree scans."""
+
+    def test_options_extend_default_names(self, tmp_path: Path) -> None:
+        """Test that ScanOptions.random_secret_names adds to the defaults."""
+        write_file(tmp_path, "retry.go", MATH_RAND_JITTER)
+        options = ScanOptions(random_secret_names=("jitter",))
+
+        report = Scanner(options).scan(tmp_path)
+
+        assert any(f.pattern_id == "CC-124-CODE-GO" for f in report.findings)
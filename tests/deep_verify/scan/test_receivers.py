@@ -0,0 +1,110 @@
+"""Tests for value receivers that mutate a copy (CC-125)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    ScanConfig,
+    find_value_receiver_mutations,
+    parse_go_receivers,
+)
+
+from tests.deep_verify.scan.conftest import GO_VALUE_RECEIVER_MUTATION
+
+POINTER_RECEIVERS = GO_VALUE_RECEIVER_MUTATION.replace(
+    "func (c Counter) Reset", "func (c *Counter) Reset"
+)
+
+
+def _mutations(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_value_receiver_mutations(text, "x.go", ScanConfig())]
+
+
+class TestParseGoReceivers:
+    """Tests for parse_go_receivers."""
+
+    def test_receivers_by_type(self) -> None:
+        """Test that methods are grouped by type with their receiver kind."""
+        assert parse_go_receivers(GO_VALUE_RECEIVER_MUTATION) == {
+            "Counter": {"Inc": True, "Value": False, "Reset": False}
+        }
+
+    def test_generic_and_unnamed_receivers(self) -> None:
+        """Test generic receiver types and receivers without a name."""
+        text = """package stack
+
+func (s *Stack[T]) Push(v T) { s.items = append(s.items, v) }
+
+func (Stack[T]) Kind() string { return "stack" }
+
+func helper() {}
+"""
+        assert parse_go_receivers(text) == {"Stack": {"Push": True, "Kind": False}}
+
+
+class TestFindValueReceiverMutations:
+    """Tests for find_value_receiver_mutations."""
+
+    def test_value_receiver_assignment(self) -> None:
+        """Test reporting a value-receiver field assignment on a pointer-method type."""
+        (finding,) = find_value_receiver_mutations(
+            GO_VALUE_RECEIVER_MUTATION, "counter.go", ScanConfig()
+        )
+
+        assert finding.pattern_id == "CC-125-CODE-GO"
+        assert finding.line == 18
+        assert finding.title == "Counter.Reset has a value receiver and assigns to c.n"
+        assert finding.snippet == "c.n = 0"
+        assert finding.severity == Severity.ERROR
+
+    def test_consistent_pointer_receivers_are_safe(self) -> None:
+        """Test that types using pointer receivers for mutations are not reported."""
+        assert _mutations(POINTER_RECEIVERS) == []
+
+    def test_value_only_types_are_safe(self) -> None:
+        """Test that types without pointer-receiver methods are not checked."""
+        text = """package point
+
+type Point struct{ X, Y int }
+
+func (p Point) Moved(dx int) Point {
+    p.X += dx
+    return p
+}
+"""
+        assert _mutations(text) == []
+
+    def test_shared_data_and_one_line_methods(self) -> None:
+        """Test map and nested writes, comparisons and one-line methods."""
+        text = """package cache
+
+type Cache struct {
+    items map[string]int
+    cfg   *Config
+    hits  int
+}
+
+func (c *Cache) Get(k string) int { c.hits++; return c.items[k] }
+
+func (c Cache) Put(k string, v int) { c.items[k] = v }
+
+func (c Cache) Tune() {
+    c.cfg.limit = 1
+    if c.hits == 0 {
+        return
+    }
+}
+
+func (c Cache) Count(n int) { c.hits += n }
+
+func (c Cache) Swap() {
+    c.hits, c.cfg = 0, nil
+}
+"""
+        assert _mutations(text) == [
+            (20, "Cache.Count has a value receiver and assigns to c.hits"),
+            (23, "Cache.Swap has a value receiver and assigns to c.hits"),
+        ]
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-125."""
+        config = ScanConfig(disable=["CC-125"])
+        assert find_value_receiver_mutations(GO_VALUE_RECEIVER_MUTATION, "x.go", config) == []
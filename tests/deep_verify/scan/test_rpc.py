@@ -0,0 +1,153 @@
+"""Tests for the gRPC Analyzer service."""
+
+from collections.abc import Iterator
+from pathlib import Path
+from typing import Any
+
+import pytest
+
+from bmad_assist.deep_verify.core.types import ArtifactDomain, PatternId, Severity
+from bmad_assist.deep_verify.scan import ScanFinding, finding_fingerprint
+from bmad_assist.deep_verify.scan.rpc import (
+    AnalyzeRequest,
+    decode_analyze_request,
+    decode_finding,
+    encode_analyze_request,
+    encode_finding,
+)
+
+GO_GOROUTINE = "package main\n\nfunc main() {\n    go func() {\n        doWork()\n    }()\n}\n"
+
+
+def _finding(**overrides: Any) -> ScanFinding:
+    fields: dict[str, Any] = {
+        "pattern_id": PatternId("CC-002-CODE-GO"),
+        "severity": Severity.ERROR,
+        "title": "Mutex locked without deferred unlock",
+        "description": "Mutex locked without deferred unlock",
+        "path": "pkg/cache.go",
+        "line": 3,
+        "snippet": "mu.Lock() // zażółć",
+        "confidence": 0.67,
+        "domain": ArtifactDomain.CONCURRENCY,
+        "language": "go",
+        "remediation": "Use defer",
+    }
+    fields.update(overrides)
+    return ScanFinding(**fields)
+
+
+class TestMessages:
+    """Tests for the protobuf message codec."""
+
+    def test_finding_round_trip(self) -> None:
+        """Test that a finding survives encoding and decoding."""
+        finding = _finding()
+        assert decode_finding(encode_finding(finding)) == finding
+
+    def test_finding_defaults_round_trip(self) -> None:
+        """Test that proto3 default values are omitted and restored."""
+        finding = _finding(snippet="", confidence=0.0, remediation=None)
+        assert decode_finding(encode_finding(finding)) == finding
+
+    def test_suppressed_finding_round_trip(self) -> None:
+        """Test that suppression fields survive encoding and decoding."""
+        finding = _finding(suppressed=True, suppression_reason="fire and forget")
+        assert decode_finding(encode_finding(finding)) == finding
+
+    def test_negative_line_round_trip(self) -> None:
+        """Test int32 encoding of negative values."""
+        finding = _finding(line=-1)
+        assert decode_finding(encode_finding(finding)).line == -1
+
+    def test_finding_wire_format(self) -> None:
+        """Test the encoding of known fields against hand-computed bytes."""
+        data = encode_finding(_finding(line=300))
+        # Field 1 (pattern_id), length-delimited
+        assert data.startswith(b"\x0a\x0eCC-002-CODE-GO")
+        # Field 6 (line), varint 300 = 0xAC 0x02
+        assert b"\x30\xac\x02" in data
+        # Field 12 (fingerprint), length-delimited 64-character hex digest
+        assert data.endswith(b"\x62\x40" + finding_fingerprint(_finding(line=300)).encode())
+
+    def test_request_round_trip(self) -> None:
+        """Test that a request survives encoding and decoding."""
+        request = AnalyzeRequest(path="main.go", source=GO_GOROUTINE, language="go")
+        assert decode_analyze_request(encode_analyze_request(request)) == request
+
+    def test_unknown_fields_are_skipped(self) -> None:
+        """Test forward compatibility with fields added by newer clients."""
+        data = encode_analyze_request(AnalyzeRequest(path="main.go"))
+        # Field 15 varint, field 16 fixed32, field 17 fixed64
+        data += b"\x78\x01" + b"\x85\x01\x00\x00\x00\x00" + b"\x89\x01" + bytes(8)
+        assert decode_analyze_request(data) == AnalyzeRequest(path="main.go")
+
+    def test_truncated_message(self) -> None:
+        """Test that truncated messages are rejected."""
+        data = encode_analyze_request(AnalyzeRequest(path="main.go"))
+        with pytest.raises(ValueError, match="Truncated"):
+            decode_analyze_request(data[:-2])
+
+    def test_proto_file_is_packaged(self) -> None:
+        """Test that the service definition ships beside the codec."""
+        import bmad_assist.deep_verify.scan.rpc as rpc
+
+        proto = Path(rpc.__file__).with_name("deepverify.proto").read_text()
+        assert "service Analyzer" in proto
+        assert "rpc Analyze(stream AnalyzeRequest) returns (stream Finding);" in proto
+
+
+@pytest.fixture
+def grpc_server() -> Iterator[Any]:
+    """Start an in-process Analyzer server."""
+    pytest.importorskip("grpc")
+    from bmad_assist.deep_verify.scan.rpc.server import AnalyzerServer
+
+    server = AnalyzerServer(port=0)
+    server.start()
+    yield server
+    server.shutdown(grace=None)
+
+
+class TestAnalyzerServer:
+    """Round-trip tests over a gRPC connection."""
+
+    def test_analyze_stream(self, grpc_server: Any) -> None:
+        """Test streaming files and receiving their findings."""
+        import grpc
+
+        from bmad_assist.deep_verify.scan.rpc.server import analyze
+
+        requests = [
+            AnalyzeRequest(path="cmd/main.go", source=GO_GOROUTINE),
+            AnalyzeRequest(path="README.md", source="# Hello\n"),
+            AnalyzeRequest(path="snippet", source=GO_GOROUTINE, language="go"),
+        ]
+        with grpc.insecure_channel(grpc_server.address) as channel:
+            findings = list(analyze(channel, requests))
+
+        located = {(f.path, f.pattern_id, f.line) for f in findings}
+        assert ("cmd/main.go", "CC-001-CODE-GO", 4) in located
+        assert ("snippet", "CC-001-CODE-GO", 4) in located
+        assert all(f.path != "README.md" for f in findings)
+        assert all(f.language == "go" for f in findings)
+
+    def test_empty_stream(self, grpc_server: Any) -> None:
+        """Test that an empty request stream completes without findings."""
+        import grpc
+
+        from bmad_assist.deep_verify.scan.rpc.server import analyze
+
+        with grpc.insecure_channel(grpc_server.address) as channel:
+            assert list(analyze(channel, [])) == []
+
+    def test_malformed_request(self, grpc_server: Any) -> None:
+        """Test that undecodable requests fail the call instead of the server."""
+        import grpc
+
+        from bmad_assist.deep_verify.scan.rpc.server import ANALYZE_METHOD
+
+        with grpc.insecure_channel(grpc_server.address) as channel:
+            call = channel.stream_stream(ANALYZE_METHOD)
+            with pytest.raises(grpc.RpcError):
+                list(call(iter([b"\x0a\xff"])))
@@ -0,0 +1,277 @@
+"""Tests for SARIF export."""
+
+import io
+import json
+from dataclasses import replace
+
+import pytest
+
+from bmad_assist.deep_verify.core.types import ArtifactDomain, PatternId, Severity
+from bmad_assist.deep_verify.scan import (
+    ScanFinding,
+    ScanReport,
+    finding_fingerprint,
+    import_sarif,
+    sarif_log,
+    sarif_result,
+    write_sarif,
+)
+
+
+def _finding(
+    pattern_id: str = "CC-002-CODE-GO",
+    line: int = 3,
+    snippet: str = "mu.Lock()",
+    severity: Severity = Severity.ERROR,
+) -> ScanFinding:
+    return ScanFinding(
+        pattern_id=PatternId(pattern_id),
+        severity=severity,
+        title=f"{pattern_id} finding",
+        description=f"{pattern_id} description",
+        path="pkg/cache.go",
+        line=line,
+        snippet=snippet,
+        confidence=1.0,
+        domain=ArtifactDomain.CONCURRENCY,
+        language="go",
+    )
+
+
+# Old/new pair: the lock finding moves down a line (unchanged), the goroutine
+# is fixed (absent) and a sensitive log is introduced (new)
+LOCK = _finding()
+GOROUTINE = _finding("CC-001-CODE-GO", line=9, snippet="go func() {")
+LOG = _finding("CC-119-CODE-GO", line=12, snippet='log.Printf("%s", u.Password)')
+OLD = ScanReport(root=".", findings=[LOCK, GOROUTINE])
+NEW = ScanReport(root=".", findings=[replace(LOCK, line=4), LOG])
+
+
+def _states(log: dict) -> list[tuple[str, str | None]]:
+    return [(r["ruleId"], r.get("baselineState")) for r in log["runs"][0]["results"]]
+
+
+class TestSarifResult:
+    """Tests for sarif_result."""
+
+    def test_result_fields(self) -> None:
+        """Test the rule, level, message, location and fingerprint of a result."""
+        result = sarif_result(_finding(severity=Severity.WARNING))
+
+        assert result == {
+            "ruleId": "CC-002-CODE-GO",
+            "level": "warning",
+            "message": {"text": "CC-002-CODE-GO finding"},
+            "locations": [
+                {
+                    "physicalLocation": {
+                        "artifactLocation": {"uri": "pkg/cache.go"},
+                        "region": {"startLine": 3},
+                    }
+                }
+            ],
+            "partialFingerprints": {"deepVerify/v1": finding_fingerprint(_finding())},
+        }
+
+    def test_baseline_state(self) -> None:
+        """Test that a baseline state is added when given."""
+        assert sarif_result(_finding(), "new")["baselineState"] == "new"
+
+
+class TestSarifLog:
+    """Tests for sarif_log."""
+
+    def test_without_baseline(self) -> None:
+        """Test a plain log: no baseline states, rules sorted by ID."""
+        log = sarif_log(NEW)
+
+        assert log["version"] == "2.1.0"
+        assert _states(log) == [("CC-002-CODE-GO", None), ("CC-119-CODE-GO", None)]
+        rules = log["runs"][0]["tool"]["driver"]["rules"]
+        assert [r["id"] for r in rules] == ["CC-002-CODE-GO", "CC-119-CODE-GO"]
+
+    def test_baseline_states(self) -> None:
+        """Test new, unchanged and absent states against a known old/new pair."""
+        log = sarif_log(NEW, baseline=OLD)
+
+        assert _states(log) == [
+            ("CC-002-CODE-GO", "unchanged"),
+            ("CC-119-CODE-GO", "new"),
+            ("CC-001-CODE-GO", "absent"),
+        ]
+        assert log["runs"][0]["results"][0]["locations"][0]["physicalLocation"]["region"] == {
+            "startLine": 4
+        }
+
+    def test_delta_only(self) -> None:
+        """Test that delta mode drops unchanged results and their rules."""
+        log = sarif_log(NEW, baseline=OLD, delta_only=True)
+
+        assert _states(log) == [("CC-119-CODE-GO", "new"), ("CC-001-CODE-GO", "absent")]
+        rules = log["runs"][0]["tool"]["driver"]["rules"]
+        assert [r["id"] for r in rules] == ["CC-001-CODE-GO", "CC-119-CODE-GO"]
+
+    def test_duplicate_findings_counted(self) -> None:
+        """Test that a second copy of a baseline finding is new."""
+        log = sarif_log(ScanReport(root=".", findings=[LOCK, replace(LOCK)]), baseline=OLD)
+
+        assert _states(log) == [
+            ("CC-002-CODE-GO", "unchanged"),
+            ("CC-002-CODE-GO", "new"),
+            ("CC-001-CODE-GO", "absent"),
+        ]
+
+    def test_suppressed_findings_skipped(self) -> None:
+        """Test that suppressed findings are neither reported nor compared."""
+        current = ScanReport(root=".", findings=[replace(LOCK, suppressed=True)])
+
+        assert _states(sarif_log(current, baseline=OLD, delta_only=True)) == [
+            ("CC-002-CODE-GO", "absent"),
+            ("CC-001-CODE-GO", "absent"),
+        ]
+
+    def test_labels_in_run_properties(self) -> None:
+        """Test that report labels appear as the run's properties."""
+        labeled = replace(NEW, labels={"tenant": "acme", "commit": "abc123"})
+
+        assert sarif_log(labeled)["runs"][0]["properties"] == {
+            "commit": "abc123",
+            "tenant": "acme",
+        }
+        assert "properties" not in sarif_log(NEW)["runs"][0]
+
+    def test_delta_requires_baseline(self) -> None:
+        """Test that delta mode without a baseline is rejected."""
+        with pytest.raises(ValueError, match="baseline"):
+            sarif_log(NEW, delta_only=True)
+
+
+def test_write_sarif() -> None:
+    """Test that write_sarif writes the log as JSON."""
+    out = io.StringIO()
+
+    write_sarif(NEW, out, baseline=OLD, delta_only=True)
+
+    assert json.loads(out.getvalue()) == sarif_log(NEW, baseline=OLD, delta_only=True)
+    assert out.getvalue().endswith("\n")
+
+
+def _location(uri: str, line: int) -> list[dict]:
+    return [
+        {
+            "physicalLocation": {
+                "artifactLocation": {"uri": uri},
+                "region": {"startLine": line, "snippet": {"text": "  f.Close()\n"}},
+            }
+        }
+    ]
+
+
+# A gosec-style log: a rule with a default level, results by ruleIndex and
+# ruleId, a suppressed result and one without a location
+GOSEC_LOG = {
+    "version": "2.1.0",
+    "runs": [
+        {
+            "tool": {
+                "driver": {
+                    "name": "gosec",
+                    "rules": [
+                        {
+                            "id": "G104",
+                            "shortDescription": {"text": "Audit errors not checked"},
+                            "help": {"text": "Check the returned error"},
+                            "defaultConfiguration": {"level": "error"},
+                        },
+                        {"id": "G304", "shortDescription": {"text": "File path from input"}},
+                    ],
+                }
+            },
+            "results": [
+                {
+                    "ruleIndex": 0,
+                    "message": {"text": "Errors unhandled"},
+                    "locations": _location("pkg/cache.go", 7),
+                },
+                {
+                    "ruleId": "G304",
+                    "level": "note",
+                    "message": {"text": "Potential file inclusion"},
+                    "locations": _location("file:///repo/pkg/io.go", 2),
+                    "suppressions": [{"kind": "inSource", "justification": "trusted path"}],
+                },
+                {"ruleId": "G101", "level": "warning", "message": {"text": "No location"}},
+            ],
+        }
+    ],
+}
+
+
+class TestImportSarif:
+    """Tests for import_sarif."""
+
+    def test_findings_tagged_with_tool(self) -> None:
+        """Test that imported results become findings tagged with the source tool."""
+        report = import_sarif(
+            ScanReport(root="/repo", findings=[LOCK]), io.StringIO(json.dumps(GOSEC_LOG)), "gosec"
+        )
+
+        assert [(f.path, f.line, f.pattern_id, f.tool) for f in report.findings] == [
+            ("pkg/cache.go", 3, "CC-002-CODE-GO", None),
+            ("pkg/cache.go", 7, "G104", "gosec"),
+            ("pkg/io.go", 2, "G304", "gosec"),
+        ]
+        g104, g304 = report.findings[1:]
+        assert g104.severity == Severity.ERROR
+        assert g104.title == "Errors unhandled"
+        assert g104.description == "Audit errors not checked"
+        assert g104.remediation == "Check the returned error"
+        assert g104.snippet == "f.Close()"
+        assert g104.language == "go"
+        assert g304.severity == Severity.INFO
+        assert g304.suppressed
+        assert g304.suppression_reason == "trusted path"
+
+    def test_levels_map_to_severities(self) -> None:
+        """Test the SARIF level to severity mapping, defaulting to warning."""
+        levels = ["error", "warning", "note", "none", None]
+        results = [
+            {"ruleId": f"R{i}", "message": {"text": "m"}, "locations": _location("a.go", 1)}
+            for i in range(len(levels))
+        ]
+        for result, level in zip(results, levels, strict=True):
+            if level is not None:
+                result["level"] = level
+        log = {"version": "2.1.0", "runs": [{"tool": {"driver": {}}, "results": results}]}
+
+        report = import_sarif(ScanReport(root="."), io.StringIO(json.dumps(log)), "lint")
+
+        assert [f.severity for f in report.findings] == [
+            Severity.ERROR,
+            Severity.WARNING,
+            Severity.INFO,
+            Severity.INFO,
+            Severity.WARNING,
+        ]
+
+    def test_round_trip_keeps_tool(self) -> None:
+        """Test that exported SARIF results carry the tool of imported findings."""
+        report = import_sarif(ScanReport(root="/repo"), io.StringIO(json.dumps(GOSEC_LOG)), "gosec")
+
+        (result,) = sarif_log(report)["runs"][0]["results"]
+        assert result["ruleId"] == "G104"
+        assert result["properties"] == {"tool": "gosec"}
+
+    @pytest.mark.parametrize(
+        "document",
+        [
+            "not json",
+            "[]",
+            '{"version": "2.1.0"}',
+            json.dumps({"runs": [{"results": [{"level": "x", "locations": _location("a", 1)}]}]}),
+        ],
+    )
+    def test_invalid_log_rejected(self, document: str) -> None:
+        """Test that non-SARIF documents and malformed results raise ValueError."""
+        with pytest.raises(ValueError):
+            import_sarif(ScanReport(root="."), io.StringIO(document), "lint")
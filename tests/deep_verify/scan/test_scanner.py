@@ -0,0 +1,1475 @@
+"""Tests for the Deep Verify scanner."""
+
+import os
+import time
+from dataclasses import replace
+from datetime import UTC, date, datetime, timedelta
+from fnmatch import fnmatch
+from pathlib import Path
+
+import pytest
+
+from bmad_assist.core.exceptions import ConfigError
+from bmad_assist.deep_verify.core.types import ArtifactDomain, PatternEffort, PatternId, Severity
+from bmad_assist.deep_verify.patterns.library import get_default_pattern_library
+from bmad_assist.deep_verify.patterns import matcher
+from bmad_assist.deep_verify.patterns.matcher import PatternMatcher
+from bmad_assist.deep_verify.scan import (
+    BITWISE_CONDITION_CONFIDENCE,
+    CAPTURED_INDEX_CONFIDENCE,
+    CAP_SAMPLE_WEIGHTS,
+    CONFIG_FILENAME,
+    CROSS_CASE_RECEIVE_CONFIDENCE,
+    DEFAULT_SAMPLE_SEED,
+    DEFERRED_SEND_CONFIDENCE,
+    DUPLICATE_CONTEXT_KEY_CONFIDENCE,
+    EFFORT_HOURS,
+    LOOP_LOCK_CONFIDENCE,
+    NARROWING_CONVERSION_CONFIDENCE,
+    NIL_MAP_VALUE_CONFIDENCE,
+    NOTIFICATION_MAX_CHARS,
+    ONE_WAY_CHANNEL_CONFIDENCE,
+    PANIC_ROUTE_CONFIDENCE,
+    PROMOTED_MUTEX_CONFIDENCE,
+    SIZE_OVERFLOW_CONFIDENCE,
+    SUPPRESSION_PATTERN,
+    TIMER_SELECT_CONFIDENCE,
+    UNBOUNDED_WAIT_CONFIDENCE,
+    UNCANCELLABLE_LOOP_CONFIDENCE,
+    UNCHECKED_ENV_CONFIDENCE,
+    WEAK_RANDOM_CONFIDENCE,
+    IssueGrouping,
+    ScanCache,
+    ScanConfig,
+    ScanFinding,
+    ScanOptions,
+    ScanReport,
+    Scanner,
+    apply_finding_baseline,
+    cap_findings,
+    cap_report,
+    create_finding_baseline,
+    deserialize_scan_report,
+    finding_fingerprint,
+    is_generated_source,
+    read_change_manifest,
+    scan_report_json,
+    serialize_scan_report,
+    validate_labels,
+)
+from bmad_assist.deep_verify.scan.scanner import BUILTIN_PATTERNS
+
+from tests.deep_verify.scan.conftest import (
+    GO_BITWISE_CONDITION,
+    GO_CAPTURED_INDEX_WRITE,
+    GO_CLEAN,
+    GO_CONCATENATED_PATH,
+    GO_CONTEXT_FIELD,
+    GO_CROSS_CASE_RECEIVE,
+    GO_DEFERRED_SEND,
+    GO_DEPRECATED_CALL,
+    GO_DUPLICATE_CONTEXT_KEY,
+    GO_ENUM_SWITCH_MISSING_CASE,
+    GO_GENERATED,
+    GO_GOROUTINE,
+    GO_GROUP_CONTEXT_IGNORED,
+    GO_LOCKED_CHANNEL,
+    GO_LOCKED_SPAWN,
+    GO_LOOP_LOCK,
+    GO_MAP_VALUE_MUTATION,
+    GO_MIXED,
+    GO_MUTEX_STRUCT_COPY,
+    GO_NARROWING_CONVERSION,
+    GO_NIL_MAP_VALUE,
+    GO_PANIC_ROUTE,
+    GO_PROMOTED_MUTEX_LOCK,
+    GO_RECEIVE_ONLY_CHANNEL,
+    GO_ROUNDABOUT_TIME,
+    GO_SENSITIVE_LOG,
+    GO_SHARED_RAND,
+    GO_SILENT_DROP,
+    GO_SIZE_OVERFLOW,
+    GO_SLEEP_POLL,
+    GO_STRING_CONTEXT_KEY,
+    GO_TEST_UNJOINED_LOG,
+    GO_TIMER_SELECT,
+    GO_UNBOUNDED_BODY_READ,
+    GO_UNBOUNDED_WAIT,
+    GO_UNCANCELLABLE_LOOP,
+    GO_UNCHECKED_ENV_READ,
+    GO_UNGUARDED_LAZY_MAP,
+    GO_UNKEYED_LITERAL,
+    GO_UNMARKED_TEST_HELPER,
+    GO_VALUE_RECEIVER_MUTATION,
+    GO_WAITGROUP_BY_VALUE,
+    GO_WEAK_RANDOM_TOKEN,
+    write_file,
+)
+
+
+def _ids_by_path(report) -> dict[str, set[str]]:
+    result: dict[str, set[str]] = {}
+    for finding in report.findings:
+        result.setdefault(finding.path, set()).add(finding.pattern_id)
+    return result
+
+
+# Built-in check round trips: file, Go source, finding lines and confidence by pattern
+BUILTIN_CASES: dict[str, tuple[str, str, list[int], float]] = {
+    "CC-101-CODE-GO": ("index.go", GO_UNCANCELLABLE_LOOP, [7], UNCANCELLABLE_LOOP_CONFIDENCE),
+    "CC-105-CODE-GO": ("pool.go", GO_PROMOTED_MUTEX_LOCK, [22], PROMOTED_MUTEX_CONFIDENCE),
+    "CC-111-CODE-GO": ("old.go", GO_DEPRECATED_CALL, [9], 1.0),
+    "CC-112-CODE-GO": ("config.go", GO_MUTEX_STRUCT_COPY, [13], 1.0),
+    "CC-114-CODE-GO": ("main.go", GO_RECEIVE_ONLY_CHANNEL, [4], ONE_WAY_CHANNEL_CONFIDENCE),
+    "CC-119-CODE-GO": ("leak.go", GO_SENSITIVE_LOG, [6], 1.0),
+    "CC-123-CODE-GO": ("missing.go", GO_ENUM_SWITCH_MISSING_CASE, [12], 1.0),
+    "CC-124-CODE-GO": ("session.go", GO_WEAK_RANDOM_TOKEN, [11], WEAK_RANDOM_CONFIDENCE),
+    "CC-125-CODE-GO": ("mixed.go", GO_VALUE_RECEIVER_MUTATION, [18], 1.0),
+    "CC-126-CODE-GO": ("external.go", GO_UNKEYED_LITERAL, [6], 1.0),
+    "CC-127-CODE-GO": ("client.go", GO_CONTEXT_FIELD, [7], 1.0),
+    "CC-128-CODE-GO": ("after.go", GO_TIMER_SELECT, [12], TIMER_SELECT_CONFIDENCE),
+    "CC-129-CODE-GO": ("bare.go", GO_UNBOUNDED_WAIT, [15], UNBOUNDED_WAIT_CONFIDENCE),
+    "CC-130-CODE-GO": ("bitwise.go", GO_BITWISE_CONDITION, [4], BITWISE_CONDITION_CONFIDENCE),
+    "CC-131-CODE-GO": ("blocking.go", GO_DEFERRED_SEND, [5], DEFERRED_SEND_CONFIDENCE),
+    "CC-132-CODE-GO": ("walk.go", GO_PANIC_ROUTE, [6], PANIC_ROUTE_CONFIDENCE),
+    "CC-133-CODE-GO": ("forgot.go", GO_MAP_VALUE_MUTATION, [13], 1.0),
+    "CC-134-CODE-GO": ("server_test.go", GO_UNMARKED_TEST_HELPER, [5], 1.0),
+    "CC-135-CODE-GO": ("unchecked.go", GO_SIZE_OVERFLOW, [5], SIZE_OVERFLOW_CONFIDENCE),
+    "CC-136-CODE-GO": ("by_value.go", GO_WAITGROUP_BY_VALUE, [5], 1.0),
+    "CC-137-CODE-GO": ("same.go", GO_LOCKED_SPAWN, [7], 1.0),
+    "CC-138-CODE-GO": ("cross.go", GO_CROSS_CASE_RECEIVE, [9], CROSS_CASE_RECEIVE_CONFIDENCE),
+    "CC-139-CODE-GO": ("sprintf.go", GO_CONCATENATED_PATH, [10], 1.0),
+    "CC-140-CODE-GO": ("string_key.go", GO_STRING_CONTEXT_KEY, [6], 1.0),
+    "CC-141-CODE-GO": ("roundabout.go", GO_ROUNDABOUT_TIME, [8, 9], 1.0),
+    "CC-142-CODE-GO": ("unbounded.go", GO_UNBOUNDED_BODY_READ, [11], 1.0),
+    "CC-143-CODE-GO": ("shared.go", GO_SHARED_RAND, [9], 1.0),
+    "CC-144-CODE-GO": ("nested.go", GO_LOOP_LOCK, [7], LOOP_LOCK_CONFIDENCE),
+    "CC-145-CODE-GO": ("silent.go", GO_SILENT_DROP, [5], 1.0),
+    "CC-146-CODE-GO": ("unchecked.go", GO_UNCHECKED_ENV_READ, [6], UNCHECKED_ENV_CONFIDENCE),
+    "CC-147-CODE-GO": ("captured.go", GO_CAPTURED_INDEX_WRITE, [6], CAPTURED_INDEX_CONFIDENCE),
+    "CC-148-CODE-GO": (
+        "narrowing.go", GO_NARROWING_CONVERSION, [4], NARROWING_CONVERSION_CONFIDENCE
+    ),
+    "CC-149-CODE-GO": ("store.go", GO_UNGUARDED_LAZY_MAP, [11], 1.0),
+    "CC-150-CODE-GO": ("worker.go", GO_SLEEP_POLL, [11], 1.0),
+    "CC-151-CODE-GO": ("fetch.go", GO_GROUP_CONTEXT_IGNORED, [13], 1.0),
+    "CC-152-CODE-GO": ("server.go", GO_NIL_MAP_VALUE, [8], NIL_MAP_VALUE_CONFIDENCE),
+    "CC-153-CODE-GO": (
+        "identity.go", GO_DUPLICATE_CONTEXT_KEY, [17], DUPLICATE_CONTEXT_KEY_CONFIDENCE
+    ),
+}
+
+
+def _locations(
+    root: Path, pattern_id: str, options: ScanOptions | None = None
+) -> list[tuple[int, float]]:
+    report = Scanner(options).scan(root)
+    return [(f.line, f.confidence) for f in report.findings if f.pattern_id == pattern_id]
+
+
+class TestScanner:
+    """Tests for Scanner.scan()."""
+
+    def test_scan_directory(self, go_tree: Path) -> None:
+        """Test scanning a directory reports located findings."""
+        report = Scanner().scan(go_tree)
+
+        assert report.files_scanned == [
+            "main.go",
+            "teams/payments/worker.go",
+            "teams/search/indexer.go",
+        ]
+        finding = next(f for f in report.findings if f.path == "main.go")
+        assert finding.pattern_id == PatternId("CC-001-CODE-GO")
+        assert finding.line == 4
+        assert finding.snippet == "go func() {"
+        assert finding.language == "go"
+
+    def test_scan_single_file(self, tmp_path: Path) -> None:
+        """Test scanning a single file."""
+        path = write_file(tmp_path, "main.go", GO_GOROUTINE)
+
+        report = Scanner().scan(path)
+
+        assert report.files_scanned == ["main.go"]
+        assert PatternId("CC-001-CODE-GO") in {f.pattern_id for f in report.findings}
+
+    def test_clean_file_has_no_findings(self, tmp_path: Path) -> None:
+        """Test that clean code yields no findings."""
+        write_file(tmp_path, "add.go", GO_CLEAN)
+
+        report = Scanner().scan(tmp_path)
+
+        assert report.files_scanned == ["add.go"]
+        assert report.findings == []
+
+    def test_scan_source(self) -> None:
+        """Test scanning source text outside a tree."""
+        findings = Scanner().scan_source(GO_GOROUTINE, "go", rel_path="snippet.go")
+
+        assert [(f.path, f.line, f.pattern_id) for f in findings] == [
+            ("snippet.go", 4, PatternId("CC-001-CODE-GO"))
+        ]
+
+    def test_excluded_dirs_are_skipped(self, tmp_path: Path) -> None:
+        """Test that vendor and VCS directories are not scanned."""
+        write_file(tmp_path, "vendor/lib/lib.go", GO_GOROUTINE)
+        write_file(tmp_path, ".git/hooks/hook.go", GO_GOROUTINE)
+
+        report = Scanner().scan(tmp_path)
+
+        assert report.files_scanned == []
+
+    def test_vendor_allowlist(self, tmp_path: Path) -> None:
+        """Test that allowlisted vendored packages are scanned and the rest of vendor is not."""
+        write_file(tmp_path, "main.go", GO_GOROUTINE)
+        write_file(tmp_path, "vendor/github.com/acme/fork/fork.go", GO_GOROUTINE)
+        write_file(tmp_path, "vendor/github.com/acme/fork/sub/sub.go", GO_GOROUTINE)
+        write_file(tmp_path, "vendor/github.com/other/lib/lib.go", GO_GOROUTINE)
+        write_file(tmp_path, "vendor/modules.txt", "# github.com/acme/fork v1.0.0\n")
+        options = ScanOptions(vendor_allowlist=("github.com/acme/*",))
+
+        report = Scanner(options).scan(tmp_path)
+
+        assert report.files_scanned == [
+            "main.go",
+            "vendor/github.com/acme/fork/fork.go",
+            "vendor/github.com/acme/fork/sub/sub.go",
+        ]
+        assert {f.path for f in report.findings} == set(report.files_scanned)
+
+    def test_missing_path_raises(self, tmp_path: Path) -> None:
+        """Test that a missing scan path raises FileNotFoundError."""
+        with pytest.raises(FileNotFoundError):
+            Scanner().scan(tmp_path / "missing")
+
+    def test_invalid_threshold(self) -> None:
+        """Test that an out-of-range threshold is rejected."""
+        with pytest.raises(ValueError, match="threshold"):
+            Scanner(ScanOptions(threshold=1.5))
+
+    def test_large_files_are_skipped(self, tmp_path: Path) -> None:
+        """Test that oversized files are skipped and recorded."""
+        write_file(tmp_path, "gen/generated.go", GO_GOROUTINE + "// padding\n" * 200)
+        write_file(tmp_path, "main.go", GO_GOROUTINE)
+
+        report = Scanner(ScanOptions(max_file_bytes=1024)).scan(tmp_path)
+
+        assert report.skipped_large_files == ["gen/generated.go"]
+        assert report.files_scanned == ["main.go"]
+        assert {f.path for f in report.findings} == {"main.go"}
+
+    def test_no_file_size_limit(self, tmp_path: Path) -> None:
+        """Test that max_file_bytes=None analyzes every file."""
+        write_file(tmp_path, "gen/generated.go", GO_GOROUTINE + "// padding\n" * 200)
+
+        report = Scanner(ScanOptions(max_file_bytes=None)).scan(tmp_path)
+
+        assert report.skipped_large_files == []
+        assert report.files_scanned == ["gen/generated.go"]
+
+    def test_negative_max_file_bytes(self) -> None:
+        """Test that a negative size limit is rejected."""
+        with pytest.raises(ValueError, match="max_file_bytes"):
+            Scanner(ScanOptions(max_file_bytes=-1))
+
+    def test_subtree_configs_scope_rule_sets(self, go_tree: Path) -> None:
+        """Test that per-directory configs produce different enabled sets."""
+        write_file(go_tree, CONFIG_FILENAME, "disable: [CC-001]\n")
+        write_file(go_tree, f"teams/payments/{CONFIG_FILENAME}", "enable: [CC-001]\ndisable: []\n")
+
+        by_path = _ids_by_path(Scanner().scan(go_tree))
+
+        assert "main.go" not in by_path
+        assert "teams/search/indexer.go" not in by_path
+        assert by_path["teams/payments/worker.go"] == {"CC-001-CODE-GO"}
+
+    def test_severity_override(self, go_tree: Path) -> None:
+        """Test that config severity overrides apply to findings."""
+        write_file(go_tree, f"teams/search/{CONFIG_FILENAME}", "severity:\n  CC-001: info\n")
+
+        report = Scanner().scan(go_tree)
+
+        severities = {f.path: f.severity for f in report.findings}
+        assert severities["main.go"] == Severity.CRITICAL
+        assert severities["teams/search/indexer.go"] == Severity.INFO
+
+    def test_opt_in_pattern_requires_config(self, tmp_path: Path) -> None:
+        """Test that opt-in patterns only run when a config opts in."""
+        write_file(tmp_path, "index.go", GO_UNCANCELLABLE_LOOP)
+
+        default_ids = {f.pattern_id for f in Scanner().scan(tmp_path).findings}
+        write_file(tmp_path, CONFIG_FILENAME, "opt_in: [CC-101]\n")
+        opted_ids = {f.pattern_id for f in Scanner().scan(tmp_path).findings}
+
+        assert PatternId("CC-101-CODE-GO") not in default_ids
+        assert PatternId("CC-101-CODE-GO") in opted_ids
+
+    def test_opt_in_builtin_not_dispatched_by_default(self, tmp_path: Path) -> None:
+        """Test that the scanner only calls opt-in built-in checks once a config opts in."""
+        write_file(tmp_path, "index.go", GO_UNCANCELLABLE_LOOP)
+        scanner = Scanner()
+        calls: list[str] = []
+        scanner._builtin_detectors[PatternId("CC-101-CODE-GO")] = (
+            lambda text, rel_path, config: calls.append(rel_path) or []
+        )
+
+        scanner.scan(tmp_path)
+        assert calls == []
+        write_file(tmp_path, CONFIG_FILENAME, "opt_in: [CC-101]\n")
+        scanner.scan(tmp_path)
+        assert calls == ["index.go"]
+
+    def test_channel_ownership_suppressed_with_reason(self, tmp_path: Path) -> None:
+        """Test that opted-in CC-120 findings are silenced by a documented suppression."""
+        write_file(tmp_path, CONFIG_FILENAME, "opt_in: [CC-120]\nsuppression_fields: [reason]\n")
+        write_file(tmp_path, "broker.go", GO_LOCKED_CHANNEL)
+        write_file(
+            tmp_path,
+            "documented.go",
+            GO_LOCKED_CHANNEL.replace(
+                "func", '// deepverify:ignore CC-120 reason="closed by Close"\nfunc'
+            ),
+        )
+
+        report = Scanner().scan(tmp_path)
+
+        ids = {PatternId("CC-120-CODE-GO"), PatternId("CC-103")}
+        found = [f for f in report.findings if f.pattern_id in ids]
+        assert [(f.path, f.pattern_id, f.line) for f in found] == [
+            ("broker.go", PatternId("CC-120-CODE-GO"), 3)
+        ]
+        assert found[0].confidence == 0.6
+
+    def test_file_limited_pattern_runs_on_matching_files(self, tmp_path: Path) -> None:
+        """Test that patterns limited to *_test.go skip other Go files."""
+        write_file(tmp_path, "fetch_test.go", GO_TEST_UNJOINED_LOG)
+        write_file(tmp_path, "fetch.go", GO_TEST_UNJOINED_LOG)
+
+        report = Scanner().scan(tmp_path)
+
+        paths = {f.path for f in report.findings if f.pattern_id == "CC-115-CODE-GO"}
+        assert paths == {"fetch_test.go"}
+
+    def test_base_config_from_options(self, go_tree: Path) -> None:
+        """Test that ScanOptions.config applies without config files."""
+        options = ScanOptions(config=ScanConfig(disable=["CC-"]), use_config_files=False)
+
+        report = Scanner(options).scan(go_tree)
+
+        assert report.findings == []
+        assert len(report.files_scanned) == 3
+
+    def test_invalid_config_file_raises(self, go_tree: Path) -> None:
+        """Test that an invalid config file surfaces as ConfigError."""
+        write_file(go_tree, CONFIG_FILENAME, "- not a mapping\n")
+
+        with pytest.raises(ConfigError):
+            Scanner().scan(go_tree)
+
+
+class TestGeneratedFiles:
+    """Tests for generated file handling."""
+
+    @pytest.fixture
+    def mixed_tree(self, tmp_path: Path) -> Path:
+        """Create a tree with a generated and a hand-written file."""
+        write_file(tmp_path, "mock_store.go", GO_GENERATED)
+        write_file(tmp_path, "store.go", GO_MIXED)
+        return tmp_path
+
+    def test_generated_files_skipped_by_default(self, mixed_tree: Path) -> None:
+        """Test that generated files are not scanned unless included."""
+        report = Scanner().scan(mixed_tree)
+
+        assert report.files_scanned == ["store.go"]
+
+    def test_generated_file_gets_reduced_detector_set(self, mixed_tree: Path) -> None:
+        """Test that generated files run concurrency detectors only."""
+        report = Scanner(ScanOptions(include_generated=True)).scan(mixed_tree)
+
+        assert report.files_scanned == ["mock_store.go", "store.go"]
+        assert _ids_by_path(report) == {
+            "mock_store.go": {"CC-001-CODE-GO"},
+            "store.go": {"CC-001-CODE-GO", "CQ-008-CODE-GO"},
+        }
+
+    def test_generated_detectors_select_patterns(self, mixed_tree: Path) -> None:
+        """Test that generated_detectors replaces the default set."""
+        options = ScanOptions(include_generated=True, generated_detectors=("CQ-",))
+
+        report = Scanner(options).scan(mixed_tree)
+
+        assert _ids_by_path(report)["mock_store.go"] == {"CQ-008-CODE-GO"}
+
+    def test_generated_file_without_detectors_is_scanned(self, mixed_tree: Path) -> None:
+        """Test that an empty detector set still counts the file as scanned."""
+        options = ScanOptions(include_generated=True, generated_detectors=())
+
+        report = Scanner(options).scan(mixed_tree)
+
+        assert "mock_store.go" in report.files_scanned
+        assert "mock_store.go" not in _ids_by_path(report)
+
+    @pytest.mark.parametrize(
+        ("text", "generated"),
+        [
+            ("// Code generated by protoc-gen-go. DO NOT EDIT.\npackage pb\n", True),
+            ("// Copyright 2024\n\n// Code generated by stringer; DO NOT EDIT.\n", True),
+            ("# Code generated by tool. DO NOT EDIT.\nx = 1\n", True),
+            ("package main\n\n// Code generated by hand. DO NOT EDIT.\n", False),
+            ("// Code generated by tool.\npackage main\n", False),
+            ("// code generated by tool. do not edit.\npackage main\n", False),
+        ],
+    )
+    def test_is_generated_source(self, text: str, generated: bool) -> None:
+        """Test detection of the generated-code header."""
+        assert is_generated_source(text) is generated
+
+
+class TestBuiltinChecks:
+    """Tests for built-in checks in tree scans."""
+
+    def test_every_builtin_has_a_case(self) -> None:
+        """Test that the round-trip table covers every built-in pattern."""
+        assert set(BUILTIN_CASES) == {p.id for p in BUILTIN_PATTERNS}
+
+    @pytest.mark.parametrize("pattern_id", sorted(BUILTIN_CASES))
+    def test_scan_reports_builtin(self, tmp_path: Path, pattern_id: str) -> None:
+        """Test that scans report each built-in check at its line and confidence."""
+        path, source, lines, confidence = BUILTIN_CASES[pattern_id]
+        pattern = next(p for p in BUILTIN_PATTERNS if p.id == pattern_id)
+        write_file(tmp_path, path, source)
+        if pattern.opt_in:
+            assert _locations(tmp_path, pattern_id) == []
+            write_file(tmp_path, CONFIG_FILENAME, f"opt_in: [{pattern_id}]\n")
+
+        assert _locations(tmp_path, pattern_id) == [(line, confidence) for line in lines]
+        if confidence < 1.0:
+            options = ScanOptions(threshold=confidence + 0.1)
+            assert _locations(tmp_path, pattern_id, options) == []
+
+
+class TestScanMetadata:
+    """Tests for scan timing metadata."""
+
+    FIXED = datetime(2026, 1, 2, 3, 4, 5, tzinfo=UTC)
+
+    def test_fixed_clock_yields_identical_metadata(self, go_tree: Path) -> None:
+        """Test that a fixed clock makes repeated scans identical."""
+        options = ScanOptions(clock=lambda: self.FIXED)
+
+        first = serialize_scan_report(Scanner(options).scan(go_tree))
+        second = serialize_scan_report(Scanner(options).scan(go_tree))
+
+        assert first == second
+        assert first["started_at"] == "2026-01-02T03:04:05+00:00"
+        assert first["duration_ms"] == 0
+
+    def test_duration_uses_clock(self, go_tree: Path) -> None:
+        """Test that the duration is measured with the injected clock."""
+        ticks = iter([self.FIXED, self.FIXED + timedelta(milliseconds=250)])
+
+        report = Scanner(ScanOptions(clock=lambda: next(ticks))).scan(go_tree)
+
+        assert report.started_at == self.FIXED
+        assert report.duration_ms == 250
+
+    def test_reproducible_zeroes_duration(self, go_tree: Path) -> None:
+        """Test that reproducible mode zeroes durations."""
+        ticks = iter([self.FIXED, self.FIXED + timedelta(seconds=3)])
+        options = ScanOptions(clock=lambda: next(ticks), reproducible=True)
+
+        report = Scanner(options).scan(go_tree)
+
+        assert report.duration_ms == 0
+
+    def test_default_clock_is_utc(self, go_tree: Path) -> None:
+        """Test that the default clock records an aware UTC start time."""
+        report = Scanner().scan(go_tree)
+
+        assert report.started_at is not None
+        assert report.started_at.tzinfo is not None
+
+
+class TestScanConcurrency:
+    """Tests for the load and analyze worker pools."""
+
+    # Simulated per-file read latency for the IO-heavy benchmark (seconds)
+    READ_LATENCY = 0.05
+
+    def _tree(self, root: Path, count: int) -> Path:
+        for i in range(count):
+            write_file(root, f"pkg{i % 4}/file{i}.go", GO_GOROUTINE if i % 2 else GO_CLEAN)
+        return root
+
+    @pytest.mark.parametrize(("load", "analyze"), [(1, 1), (1, 4), (8, 1), (8, 4)])
+    def test_results_independent_of_concurrency(
+        self, tmp_path: Path, load: int, analyze: int
+    ) -> None:
+        """Test that worker counts do not change the report."""
+        root = self._tree(tmp_path, 12)
+        fixed = datetime(2026, 1, 2, tzinfo=UTC)
+
+        def scan(load_workers: int, analyze_workers: int) -> dict:
+            options = ScanOptions(
+                clock=lambda: fixed,
+                load_concurrency=load_workers,
+                analyze_concurrency=analyze_workers,
+            )
+            return serialize_scan_report(Scanner(options).scan(root))
+
+        assert scan(load, analyze) == scan(1, 1)
+
+    def test_cache_with_concurrency(self, tmp_path: Path) -> None:
+        """Test that concurrent phases populate and reuse the cache."""
+        root = self._tree(tmp_path, 8)
+        cache = ScanCache()
+        scanner = Scanner(ScanOptions(load_concurrency=4, analyze_concurrency=4), cache=cache)
+
+        first = scanner.scan(root)
+        second = scanner.scan(root)
+
+        assert second.findings == first.findings
+        assert cache.hits == 8
+
+    @pytest.mark.parametrize(
+        "name", ["load_concurrency", "analyze_concurrency", "fanout_min_lines"]
+    )
+    def test_invalid_concurrency(self, name: str) -> None:
+        """Test that worker counts below 1 are rejected."""
+        with pytest.raises(ValueError, match=name):
+            Scanner(ScanOptions(**{name: 0}))
+
+    def test_split_concurrency_beats_single_knob_on_io_heavy_tree(
+        self, tmp_path: Path, monkeypatch: pytest.MonkeyPatch
+    ) -> None:
+        """Benchmark: many readers plus few analyzers beat one shared pool size."""
+        root = self._tree(tmp_path, 32)
+        read_text = Path.read_text
+
+        def slow_read_text(self: Path, *args: object, **kwargs: object) -> str:
+            time.sleep(TestScanConcurrency.READ_LATENCY)
+            return read_text(self, *args, **kwargs)  # type: ignore[arg-type]
+
+        monkeypatch.setattr(Path, "read_text", slow_read_text)
+
+        def timed(load: int, analyze: int) -> float:
+            options = ScanOptions(
+                use_config_files=False, load_concurrency=load, analyze_concurrency=analyze
+            )
+            start = time.perf_counter()
+            report = Scanner(options).scan(root)
+            elapsed = time.perf_counter() - start
+            assert len(report.files_scanned) == 32
+            return elapsed
+
+        single_knob = timed(2, 2)
+        split = timed(16, 2)
+
+        assert split < single_knob / 2, f"split={split:.3f}s single={single_knob:.3f}s"
+
+
+class TestScanFanout:
+    """Tests for running one large file's detectors concurrently."""
+
+    # Simulated per-detector cost for the fan-out benchmark (seconds)
+    DETECTOR_LATENCY = 0.1
+
+    # Lines in the large file, about the size of a generated protobuf
+    LINES = 20_000
+
+    def _large_source(self) -> str:
+        body = GO_MIXED.split("\n", 1)[1]
+        repeats = -(-self.LINES // body.count("\n"))
+        funcs = (body.replace("func main()", f"func f{i}()") for i in range(repeats))
+        return "package main\n" + "".join(funcs)
+
+    @pytest.mark.parametrize("analyze", [2, 4, 16])
+    def test_results_independent_of_fanout(self, analyze: int) -> None:
+        """Test that splitting detectors merges to the serial result."""
+        text = GO_MIXED * 20
+
+        def scan(workers: int) -> list:
+            options = ScanOptions(analyze_concurrency=workers, fanout_min_lines=1)
+            return Scanner(options).scan_source(text, "go", rel_path="big.go")
+
+        fanned = scan(analyze)
+
+        assert fanned == scan(1)
+        assert {f.pattern_id for f in fanned} >= {"CC-001-CODE-GO", "CQ-008-CODE-GO"}
+
+    def test_small_files_are_not_fanned_out(self, monkeypatch: pytest.MonkeyPatch) -> None:
+        """Test that files below fanout_min_lines use a single matcher."""
+        created: list[int] = []
+        init = PatternMatcher.__init__
+
+        def counting_init(self: PatternMatcher, patterns: list, **kwargs: object) -> None:
+            created.append(len(patterns))
+            init(self, patterns, **kwargs)  # type: ignore[arg-type]
+
+        monkeypatch.setattr(PatternMatcher, "__init__", counting_init)
+        options = ScanOptions(analyze_concurrency=4, fanout_min_lines=100)
+
+        Scanner(options).scan_source(GO_MIXED, "go")
+
+        assert len(created) == 1
+
+    def test_fanout_speeds_up_single_large_file(self, monkeypatch: pytest.MonkeyPatch) -> None:
+        """Benchmark: a 20k-line file analyzes faster with parallel detectors."""
+        text = self._large_source()
+        assert text.count("\n") >= self.LINES
+        patterns = [
+            p
+            for p in get_default_pattern_library().get_all_patterns()
+            if p.language == "go" and not p.opt_in
+        ][:8]
+        match_single = PatternMatcher._match_single
+
+        def slow_match_single(self: PatternMatcher, *args: object) -> object:
+            time.sleep(TestScanFanout.DETECTOR_LATENCY)
+            return match_single(self, *args)  # type: ignore[arg-type]
+
+        monkeypatch.setattr(PatternMatcher, "_match_single", slow_match_single)
+
+        def timed(fanout_min_lines: int | None) -> tuple[float, list]:
+            options = ScanOptions(analyze_concurrency=8, fanout_min_lines=fanout_min_lines)
+            start = time.perf_counter()
+            findings = Scanner(options).scan_source(text, "go", "big.go", patterns=patterns)
+            return time.perf_counter() - start, findings
+
+        serial, serial_findings = timed(None)
+        fanned, fanned_findings = timed(self.LINES)
+
+        assert fanned_findings == serial_findings
+        assert fanned < serial / 2, f"fanned={fanned:.3f}s serial={serial:.3f}s"
+
+
+class TestScanPackages:
+    """Tests for per-package attribution."""
+
+    def test_findings_attributed_to_go_packages(self, tmp_path: Path) -> None:
+        """Test grouping a two-package module by import path."""
+        write_file(tmp_path, "go.mod", "module example.com/shop\n\ngo 1.22\n")
+        write_file(tmp_path, "api/handler.go", GO_GOROUTINE)
+        write_file(tmp_path, "api/routes.go", GO_CLEAN)
+        write_file(tmp_path, "store/db.go", GO_GOROUTINE)
+        write_file(tmp_path, "store/cache.go", GO_GOROUTINE)
+
+        packages = Scanner().scan(tmp_path).by_package()
+
+        assert list(packages) == ["example.com/shop/api", "example.com/shop/store"]
+        api = packages["example.com/shop/api"]
+        assert api.files == ["api/handler.go", "api/routes.go"]
+        assert {f.path for f in api.findings} == {"api/handler.go"}
+        store = packages["example.com/shop/store"]
+        assert {f.path for f in store.findings} == {"store/cache.go", "store/db.go"}
+        assert store.severity_counts() == {Severity.CRITICAL: 2}
+
+    def test_module_root_and_nested_modules(self, tmp_path: Path) -> None:
+        """Test the module root package and a nested module."""
+        write_file(tmp_path, "go.mod", 'module "example.com/shop"\n')
+        write_file(tmp_path, "main.go", GO_GOROUTINE)
+        write_file(tmp_path, "tools/go.mod", "module example.com/shop/tools\n")
+        write_file(tmp_path, "tools/gen/gen.go", GO_GOROUTINE)
+
+        report = Scanner().scan(tmp_path)
+
+        assert report.file_packages == {
+            "main.go": "example.com/shop",
+            "tools/gen/gen.go": "example.com/shop/tools/gen",
+        }
+
+    def test_files_outside_modules_use_directories(self, go_tree: Path) -> None:
+        """Test that files without a go.mod are grouped by directory."""
+        packages = Scanner().scan(go_tree).by_package()
+
+        assert list(packages) == [".", "teams/payments", "teams/search"]
+        assert packages["teams/payments"].files == ["teams/payments/worker.go"]
+
+    def test_by_package_without_recorded_packages(self) -> None:
+        """Test that reports without package data fall back to directories."""
+        report = ScanReport(root=".", files_scanned=["a/x.go", "b/y.go"])
+
+        assert list(report.by_package()) == ["a", "b"]
+
+
+class TestScanReportSerialization:
+    """Tests for scan report serialization."""
+
+    def test_roundtrip(self, go_tree: Path) -> None:
+        """Test that a report survives serialization."""
+        report = Scanner().scan(go_tree)
+
+        restored = deserialize_scan_report(serialize_scan_report(report))
+
+        assert restored == report
+
+    def test_indented_json_is_byte_identical(self, go_tree: Path) -> None:
+        """Test that two scans with a fixed clock render identical JSON."""
+        options = ScanOptions(clock=lambda: TestScanMetadata.FIXED)
+
+        first = scan_report_json(Scanner(options).scan(go_tree))
+        second = scan_report_json(Scanner(options).scan(go_tree))
+
+        assert first == second
+        assert first.startswith('{\n  "root": ')
+
+    def test_json_field_order(self) -> None:
+        """Test the documented field order and sorted map keys."""
+        report = ScanReport(
+            root=".",
+            files_scanned=["b/y.go", "a/x.go"],
+            file_packages={"b/y.go": "example.com/b", "a/x.go": "example.com/a"},
+        )
+
+        text = scan_report_json(report, indent="")
+
+        assert text == (
+            '{"root":".","started_at":null,"duration_ms":0,'
+            '"files_scanned":["b/y.go","a/x.go"],"skipped_large_files":[],'
+            '"file_packages":{"a/x.go":"example.com/a","b/y.go":"example.com/b"},'
+            '"detector_warnings":[],"findings":[]}'
+        )
+
+    def test_json_indent(self) -> None:
+        """Test that the indent string is used per nesting level."""
+        text = scan_report_json(ScanReport(root=".", files_scanned=["a.go"]), indent="\t")
+
+        assert '\n\t"files_scanned": [\n\t\t"a.go"\n\t],' in text
+
+    def test_fingerprints_match_json(self, go_tree: Path) -> None:
+        """Test that fingerprints are stable and match the JSON report's."""
+        first = Scanner().scan(go_tree)
+        second = Scanner().scan(go_tree)
+
+        embedded = {f["fingerprint"] for f in serialize_scan_report(first)["findings"]}
+        assert first.fingerprints() == second.fingerprints() == sorted(embedded)
+        assert first.fingerprints()
+
+    def test_fingerprints_distinct(self) -> None:
+        """Test that duplicate findings contribute one fingerprint."""
+        finding = ScanFinding(
+            pattern_id=PatternId("CC-001-CODE-GO"),
+            severity=Severity.CRITICAL,
+            title="t",
+            description="d",
+            path="a.go",
+            line=3,
+            snippet="go func() {",
+            confidence=1.0,
+            domain=ArtifactDomain.CONCURRENCY,
+            language="go",
+        )
+        report = ScanReport(root=".", findings=[finding, replace(finding, line=9)])
+
+        assert len(report.fingerprints()) == 1
+
+
+class TestRunLabels:
+    """Tests for ScanOptions.labels."""
+
+    LABELS = {"tenant": "acme", "repo": "acme/api", "commit": "abc123"}
+
+    def test_labels_round_trip_through_json(self, go_tree: Path) -> None:
+        """Test that labels are attached to the report and survive JSON."""
+        report = Scanner(ScanOptions(labels=self.LABELS)).scan(go_tree)
+
+        data = serialize_scan_report(report)
+        restored = deserialize_scan_report(data)
+
+        assert report.labels == self.LABELS
+        assert list(data["labels"]) == ["commit", "repo", "tenant"]
+        assert restored.labels == self.LABELS
+        assert restored == report
+
+    def test_no_labels_key_without_labels(self, go_tree: Path) -> None:
+        """Test that reports without labels keep their JSON unchanged."""
+        assert "labels" not in serialize_scan_report(Scanner().scan(go_tree))
+
+    @pytest.mark.parametrize(
+        ("labels", "message"),
+        [
+            ({"": "x"}, "Invalid label key"),
+            ({"1st": "x"}, "Invalid label key"),
+            ({"team name": "x"}, "Invalid label key"),
+            ({"tags": "x"}, "reserved"),
+            ({"deepverify.version": "x"}, "reserved"),
+            ({"tenant": 7}, "must be a string"),
+        ],
+    )
+    def test_invalid_labels_rejected(self, labels: dict, message: str) -> None:
+        """Test that malformed, reserved and non-string labels are rejected."""
+        with pytest.raises(ValueError, match=message):
+            validate_labels(labels)
+        with pytest.raises(ValueError, match=message):
+            Scanner(ScanOptions(labels=labels))
+
+
+class TestFindingFilter:
+    """Tests for ScanOptions.finding_filter."""
+
+    @staticmethod
+    def _drop_generated_goroutines(finding: ScanFinding, report: ScanReport) -> bool:
+        """Drop CC-001 in *_gen.go files unless the file also has a CQ-008 finding."""
+        if finding.pattern_id != "CC-001-CODE-GO" or not fnmatch(finding.path, "*_gen.go"):
+            return True
+        return any(
+            f.path == finding.path and f.pattern_id == "CQ-008-CODE-GO" for f in report.findings
+        )
+
+    def test_drops_code_in_path(self, tmp_path: Path) -> None:
+        """Test that the filter drops one code in matching paths and keeps the rest."""
+        write_file(tmp_path, "store.go", GO_MIXED)
+        write_file(tmp_path, "store_gen.go", GO_GOROUTINE)
+        write_file(tmp_path, "mixed_gen.go", GO_MIXED)
+        options = ScanOptions(finding_filter=self._drop_generated_goroutines)
+
+        report = Scanner(options).scan(tmp_path)
+
+        assert report.files_scanned == ["mixed_gen.go", "store.go", "store_gen.go"]
+        assert _ids_by_path(report) == {
+            "mixed_gen.go": {"CC-001-CODE-GO", "CQ-008-CODE-GO"},
+            "store.go": {"CC-001-CODE-GO", "CQ-008-CODE-GO"},
+        }
+
+    def test_runs_after_suppressions(self) -> None:
+        """Test that suppressed findings never reach the filter."""
+        seen: list[str] = []
+
+        def keep(finding: ScanFinding, report: ScanReport) -> bool:
+            seen.append(finding.pattern_id)
+            return finding.pattern_id != "CQ-008-CODE-GO"
+
+        text = GO_MIXED.replace("go func() {", "go func() { // deepverify:ignore CC-001")
+        findings = Scanner(ScanOptions(finding_filter=keep)).scan_source(text, "go", "main.go")
+
+        assert seen == ["CQ-008-CODE-GO"]
+        assert findings == []
+
+
+class TestDetectorWarnings:
+    """Tests for detectors that could not run on a file."""
+
+    @staticmethod
+    def _time_out_goroutine_regex(monkeypatch: pytest.MonkeyPatch) -> None:
+        """Make the `go func(` regex signal of CC-001 time out."""
+        original = matcher.match_with_timeout
+
+        def timing_out(pattern, text, timeout_seconds):
+            if r"\bgo\s+func\(" in pattern.pattern:
+                raise matcher.TimeoutError("Regex pattern matching timed out")
+            return original(pattern, text, timeout_seconds)
+
+        monkeypatch.setattr(matcher, "match_with_timeout", timing_out)
+
+    def test_clean_scan_has_no_warnings(self, tmp_path: Path) -> None:
+        """Test that a normal scan reports no detector warnings."""
+        write_file(tmp_path, "main.go", GO_GOROUTINE)
+
+        assert Scanner().scan(tmp_path).detector_warnings == []
+
+    def test_regex_timeout_is_reported(
+        self, tmp_path: Path, monkeypatch: pytest.MonkeyPatch
+    ) -> None:
+        """Test that a timed-out detector is named instead of silently passing."""
+        self._time_out_goroutine_regex(monkeypatch)
+        write_file(tmp_path, "main.go", GO_GOROUTINE)
+        write_file(tmp_path, "worker.go", GO_GOROUTINE)
+
+        report = Scanner().scan(tmp_path)
+
+        assert report.detector_warnings == [
+            "CC-001-CODE-GO skipped for main.go: regex timed out after 5.0s",
+            "CC-001-CODE-GO skipped for worker.go: regex timed out after 5.0s",
+        ]
+        assert deserialize_scan_report(serialize_scan_report(report)) == report
+
+    def test_incomplete_results_are_not_cached(
+        self, tmp_path: Path, monkeypatch: pytest.MonkeyPatch
+    ) -> None:
+        """Test that files with detector warnings are re-analyzed on the next scan."""
+        write_file(tmp_path, "main.go", GO_GOROUTINE)
+        cache = ScanCache()
+        self._time_out_goroutine_regex(monkeypatch)
+        Scanner(cache=cache).scan(tmp_path)
+        monkeypatch.undo()
+
+        report = Scanner(cache=cache).scan(tmp_path)
+
+        assert report.detector_warnings == []
+        assert "CC-001-CODE-GO" in _ids_by_path(report)["main.go"]
+
+
+class TestChangedFiles:
+    """Tests for ScanOptions.changed_files."""
+
+    @staticmethod
+    def _record_analyzed(monkeypatch: pytest.MonkeyPatch) -> list[str]:
+        """Record the files the scanner analyzes."""
+        analyzed: list[str] = []
+        original = Scanner._analyze_loaded
+
+        def recording(self, item):
+            analyzed.append(item.rel_path)
+            return original(self, item)
+
+        monkeypatch.setattr(Scanner, "_analyze_loaded", recording)
+        return analyzed
+
+    @staticmethod
+    def _touch_all(root: Path) -> None:
+        """Give every file a new modification time, as a fresh checkout does."""
+        later = time.time() + 3600
+        for path in root.rglob("*.go"):
+            os.utime(path, (later, later))
+
+    def test_reanalyzes_listed_files_and_package_siblings(
+        self, tmp_path: Path, monkeypatch: pytest.MonkeyPatch
+    ) -> None:
+        """Test that only listed files and their directories are re-analyzed."""
+        write_file(tmp_path, "pkg/a.go", GO_GOROUTINE)
+        write_file(tmp_path, "pkg/b.go", GO_MIXED)
+        write_file(tmp_path, "other/c.go", GO_LOCKED_CHANNEL)
+        cache = ScanCache()
+        expected = Scanner(cache=cache).scan(tmp_path)
+        self._touch_all(tmp_path)
+        analyzed = self._record_analyzed(monkeypatch)
+
+        options = ScanOptions(changed_files=frozenset({"pkg/a.go"}))
+        report = Scanner(options, cache=cache).scan(tmp_path)
+
+        assert sorted(analyzed) == ["pkg/a.go", "pkg/b.go"]
+        assert report.findings == expected.findings
+
+    def test_uncached_and_deleted_files(
+        self, tmp_path: Path, monkeypatch: pytest.MonkeyPatch
+    ) -> None:
+        """Test that unlisted files without results are analyzed and deleted files mark dirs."""
+        write_file(tmp_path, "pkg/a.go", GO_GOROUTINE)
+        write_file(tmp_path, "other/c.go", GO_LOCKED_CHANNEL)
+        cache = ScanCache()
+        Scanner(cache=cache).scan(tmp_path)
+        write_file(tmp_path, "new/d.go", GO_GOROUTINE)
+        self._touch_all(tmp_path)
+        analyzed = self._record_analyzed(monkeypatch)
+
+        options = ScanOptions(changed_files=frozenset({"pkg/removed.go"}))
+        Scanner(options, cache=cache).scan(tmp_path)
+
+        assert sorted(analyzed) == ["new/d.go", "pkg/a.go"]
+
+    def test_without_changed_files_new_mtimes_miss(
+        self, tmp_path: Path, monkeypatch: pytest.MonkeyPatch
+    ) -> None:
+        """Test that without a manifest, new modification times invalidate the cache."""
+        write_file(tmp_path, "pkg/a.go", GO_GOROUTINE)
+        cache = ScanCache()
+        Scanner(cache=cache).scan(tmp_path)
+        self._touch_all(tmp_path)
+        analyzed = self._record_analyzed(monkeypatch)
+
+        Scanner(cache=cache).scan(tmp_path)
+
+        assert analyzed == ["pkg/a.go"]
+
+    def test_read_change_manifest(self, tmp_path: Path) -> None:
+        """Test manifest parsing: comments, blanks and path normalization."""
+        manifest = write_file(
+            tmp_path, "changed.txt", "# changed in this PR\npkg/a.go\n\n./pkg/b.go\nwin\\c.go\n"
+        )
+
+        assert read_change_manifest(manifest) == {"pkg/a.go", "pkg/b.go", "win/c.go"}
+
+
+class TestRatchetDates:
+    """Tests for ScanOptions.ratchet_dates."""
+
+    @staticmethod
+    def _scan(tmp_path: Path, since: date) -> ScanReport:
+        write_file(tmp_path, "main.go", GO_GOROUTINE)
+        options = ScanOptions(
+            clock=lambda: TestScanMetadata.FIXED,
+            ratchet_dates={ArtifactDomain.CONCURRENCY: since},
+        )
+        return Scanner(options).scan(tmp_path)
+
+    def test_past_date_is_fatal(self, tmp_path: Path) -> None:
+        """Test that a domain fails the scan once its ratchet date has arrived."""
+        report = self._scan(tmp_path, date(2026, 1, 2))
+
+        assert report.ratcheted_domains == []
+        assert report.fatal_findings() == report.findings
+        assert any(f.domain == ArtifactDomain.CONCURRENCY for f in report.findings)
+
+    def test_future_date_is_not_fatal(self, tmp_path: Path) -> None:
+        """Test that a domain's findings are reported but not fatal before its date."""
+        report = self._scan(tmp_path, date(2026, 1, 3))
+
+        assert report.ratcheted_domains == [ArtifactDomain.CONCURRENCY]
+        assert any(f.domain == ArtifactDomain.CONCURRENCY for f in report.findings)
+        assert all(f.domain != ArtifactDomain.CONCURRENCY for f in report.fatal_findings())
+
+    def test_ratcheted_domains_serialized_when_set(self, tmp_path: Path) -> None:
+        """Test that ratcheted domains round-trip and are omitted when empty."""
+        report = self._scan(tmp_path, date(2026, 1, 3))
+
+        data = serialize_scan_report(report)
+
+        assert data["ratcheted_domains"] == ["concurrency"]
+        assert deserialize_scan_report(data) == report
+        assert "ratcheted_domains" not in serialize_scan_report(ScanReport(root="."))
+
+
+class TestGatePolicy:
+    """Tests for Scanner.failed and ScanOptions.gate_policy."""
+
+    @staticmethod
+    def _report(warnings: int, errors: int = 0) -> ScanReport:
+        finding = ScanFinding(
+            pattern_id=PatternId("CC-001-CODE-GO"),
+            severity=Severity.WARNING,
+            title="t",
+            description="d",
+            path="a.go",
+            line=1,
+            snippet="go func() {",
+            confidence=1.0,
+            domain=ArtifactDomain.CONCURRENCY,
+            language="go",
+        )
+        findings = [replace(finding, line=n) for n in range(1, warnings + 1)]
+        findings += [replace(finding, line=100 + n, severity=Severity.ERROR) for n in range(errors)]
+        return ScanReport(root=".", findings=findings)
+
+    @staticmethod
+    def _five_warnings(report: ScanReport) -> bool:
+        warnings = report.severity_counts().get(Severity.WARNING, 0)
+        return warnings >= 5 or Severity.ERROR in report.severity_counts()
+
+    def test_default_fails_on_severity(self) -> None:
+        """Test that without a policy the fail-on severity decides."""
+        scanner = Scanner()
+
+        assert not scanner.failed(self._report(warnings=5))
+        assert scanner.failed(self._report(warnings=5), Severity.WARNING)
+        assert scanner.failed(self._report(warnings=0, errors=1))
+        assert not scanner.failed(self._report(warnings=0, errors=1), None)
+
+    def test_policy_fails_on_five_warnings_without_errors(self) -> None:
+        """Test a count-based policy failing on five warnings and no errors."""
+        scanner = Scanner(ScanOptions(gate_policy=self._five_warnings))
+
+        assert scanner.failed(self._report(warnings=5))
+        assert not scanner.failed(self._report(warnings=4))
+
+    def test_policy_overrides_fail_on(self) -> None:
+        """Test that a policy fully controls the outcome, whatever fail_on says."""
+        scanner = Scanner(ScanOptions(gate_policy=lambda report: False))
+
+        assert not scanner.failed(self._report(warnings=0, errors=3), Severity.INFO)
+        assert Scanner(ScanOptions(gate_policy=lambda report: True)).failed(
+            self._report(warnings=0), None
+        )
+
+    def test_policy_sees_the_scanned_report(self, tmp_path: Path) -> None:
+        """Test that the policy is called with the report of a real scan."""
+        write_file(tmp_path, "main.go", GO_GOROUTINE)
+        seen: list[ScanReport] = []
+        scanner = Scanner(ScanOptions(gate_policy=lambda report: seen.append(report) is None))
+
+        report = scanner.scan(tmp_path)
+
+        assert scanner.failed(report)
+        assert seen == [report]
+
+
+class TestSummaryOnly:
+    """Tests for ScanOptions.summary_only."""
+
+    GO_WAITGROUP_COPY = (
+        'package pool\n\nimport "sync"\n\n'
+        "func worker(wg sync.WaitGroup) {\n    defer wg.Done()\n}\n"
+    )
+
+    def test_findings_lack_details_but_counts_match(self, tmp_path: Path) -> None:
+        """Test that summary findings keep location and severity but drop text."""
+        write_file(tmp_path, "main.go", GO_MIXED)
+        write_file(tmp_path, "pool.go", self.GO_WAITGROUP_COPY)
+
+        full = Scanner().scan(tmp_path)
+        summary = Scanner(ScanOptions(summary_only=True)).scan(tmp_path)
+
+        assert {"CC-001-CODE-GO", "CC-136-CODE-GO"} <= {f.pattern_id for f in full.findings}
+        assert summary.severity_counts() == full.severity_counts()
+        assert [(f.pattern_id, f.severity, f.path, f.line) for f in summary.findings] == [
+            (f.pattern_id, f.severity, f.path, f.line) for f in full.findings
+        ]
+        assert all(f.snippet for f in full.findings)
+        for finding in summary.findings:
+            assert (finding.title, finding.description, finding.snippet) == ("", "", "")
+            assert finding.remediation is None
+
+    def test_cached_results_are_not_shared(self, tmp_path: Path) -> None:
+        """Test that full and summary scans do not reuse each other's cache entries."""
+        write_file(tmp_path, "main.go", GO_MIXED)
+        cache = ScanCache()
+
+        Scanner(ScanOptions(summary_only=True), cache=cache).scan(tmp_path)
+        full = Scanner(cache=cache).scan(tmp_path)
+
+        assert all(f.snippet for f in full.findings)
+
+
+class TestIssueGrouping:
+    """Tests for ScanOptions.issue_grouping and ScanReport.issues."""
+
+    @staticmethod
+    def _finding(
+        pattern: str, path: str, line: int, snippet: str, severity: Severity = Severity.WARNING
+    ) -> ScanFinding:
+        return ScanFinding(
+            pattern_id=PatternId(pattern),
+            severity=severity,
+            title="t",
+            description="d",
+            path=path,
+            line=line,
+            snippet=snippet,
+            confidence=1.0,
+            domain=ArtifactDomain.CONCURRENCY,
+            language="go",
+        )
+
+    def _report(self, grouping: IssueGrouping) -> ScanReport:
+        findings = [
+            self._finding("CC-001-CODE-GO", "a.go", 3, "go f()"),
+            self._finding("CC-002-CODE-GO", "a.go", 5, "mu.Lock()", Severity.ERROR),
+            self._finding("CC-001-CODE-GO", "a.go", 9, "go g()"),
+            self._finding("CC-001-CODE-GO", "a.go", 12, "go f()"),
+            self._finding("CC-001-CODE-GO", "b.go", 2, "go f()"),
+            replace(self._finding("CC-003-CODE-GO", "b.go", 4, "x"), suppressed=True),
+        ]
+        return ScanReport(root=".", findings=findings, issue_grouping=grouping)
+
+    def _members(self, grouping: IssueGrouping) -> list[tuple[str | None, str | None, list[int]]]:
+        return [
+            (issue.pattern_id, issue.path, [f.line for f in issue.findings])
+            for issue in self._report(grouping).issues()
+        ]
+
+    def test_per_finding(self) -> None:
+        """Test that findings sharing a fingerprint form one issue."""
+        assert self._members(IssueGrouping.PER_FINDING) == [
+            ("CC-001-CODE-GO", "a.go", [3, 12]),
+            ("CC-002-CODE-GO", "a.go", [5]),
+            ("CC-001-CODE-GO", "a.go", [9]),
+            ("CC-001-CODE-GO", "b.go", [2]),
+        ]
+        issues = self._report(IssueGrouping.PER_FINDING).issues()
+        assert issues[0].fingerprint == finding_fingerprint(issues[0].findings[0])
+
+    def test_per_file(self) -> None:
+        """Test one issue per file across patterns, at its highest severity."""
+        assert self._members(IssueGrouping.PER_FILE) == [
+            (None, "a.go", [3, 5, 9, 12]),
+            (None, "b.go", [2]),
+        ]
+        assert [i.severity for i in self._report(IssueGrouping.PER_FILE).issues()] == [
+            Severity.ERROR,
+            Severity.WARNING,
+        ]
+
+    def test_per_rule(self) -> None:
+        """Test one issue per pattern across files."""
+        assert self._members(IssueGrouping.PER_RULE) == [
+            ("CC-001-CODE-GO", None, [3, 9, 12, 2]),
+            ("CC-002-CODE-GO", None, [5]),
+        ]
+
+    def test_per_rule_per_file(self) -> None:
+        """Test one issue per pattern and file."""
+        assert self._members(IssueGrouping.PER_RULE_PER_FILE) == [
+            ("CC-001-CODE-GO", "a.go", [3, 9, 12]),
+            ("CC-002-CODE-GO", "a.go", [5]),
+            ("CC-001-CODE-GO", "b.go", [2]),
+        ]
+
+    def test_fingerprints_are_stable_and_distinct(self) -> None:
+        """Test that issue fingerprints survive line shifts and differ across groupings."""
+        for grouping in IssueGrouping:
+            report = self._report(grouping)
+            shifted = replace(
+                report, findings=[replace(f, line=f.line + 10) for f in report.findings]
+            )
+            fingerprints = [i.fingerprint for i in report.issues()]
+            assert fingerprints == [i.fingerprint for i in shifted.issues()]
+            assert len(set(fingerprints)) == len(fingerprints)
+        per_rule = self._report(IssueGrouping.PER_RULE).issues()[0].fingerprint
+        per_pair = self._report(IssueGrouping.PER_RULE_PER_FILE).issues()[0].fingerprint
+        assert per_rule != per_pair
+
+    def test_scan_option_and_serialization(self, tmp_path: Path) -> None:
+        """Test that scans record the grouping and reports round-trip it."""
+        write_file(tmp_path, "main.go", GO_GOROUTINE)
+        write_file(tmp_path, "other.go", GO_GOROUTINE)
+
+        report = Scanner(ScanOptions(issue_grouping=IssueGrouping.PER_RULE)).scan(tmp_path)
+
+        assert report.issue_grouping == IssueGrouping.PER_RULE
+        (issue,) = [i for i in report.issues() if i.pattern_id == "CC-001-CODE-GO"]
+        assert {f.path for f in issue.findings} == {"main.go", "other.go"}
+        data = serialize_scan_report(report)
+        assert data["issue_grouping"] == "per-rule"
+        assert deserialize_scan_report(data) == report
+        assert "issue_grouping" not in serialize_scan_report(ScanReport(root="."))
+
+
+class TestRemediationEffort:
+    """Tests for effort ratings on patterns, findings and reports."""
+
+    def test_every_detector_declares_an_effort(self) -> None:
+        """Test that code patterns and built-in checks all carry an effort rating."""
+        library = get_default_pattern_library()
+        patterns = [p for p in library.get_all_patterns() if p.language is not None]
+
+        assert patterns
+        unrated = [
+            p.id
+            for p in (*patterns, *BUILTIN_PATTERNS, SUPPRESSION_PATTERN)
+            if p.effort is None
+        ]
+        assert unrated == []
+
+    def test_findings_carry_their_pattern_effort(self, tmp_path: Path) -> None:
+        """Test that scans set each finding's effort and reports round-trip it."""
+        write_file(tmp_path, "main.go", GO_GOROUTINE)
+
+        report = Scanner().scan(tmp_path)
+
+        (finding,) = [f for f in report.findings if f.pattern_id == "CC-001-CODE-GO"]
+        assert finding.effort == PatternEffort.MEDIUM
+        data = serialize_scan_report(report)
+        assert data["findings"][0]["effort"] == "medium"
+        assert deserialize_scan_report(data) == report
+
+    def test_report_aggregates_effort(self) -> None:
+        """Test effort counts and the estimate over unsuppressed, rated findings."""
+        finding = ScanFinding(
+            pattern_id=PatternId("CC-001-CODE-GO"),
+            severity=Severity.WARNING,
+            title="t",
+            description="d",
+            path="a.go",
+            line=1,
+            snippet="go f()",
+            confidence=1.0,
+            domain=ArtifactDomain.CONCURRENCY,
+            language="go",
+        )
+        report = ScanReport(
+            root=".",
+            findings=[
+                replace(finding, effort=PatternEffort.LOW),
+                replace(finding, line=2, effort=PatternEffort.LOW),
+                replace(finding, line=3, effort=PatternEffort.HIGH),
+                replace(finding, line=4),
+                replace(finding, line=5, effort=PatternEffort.HIGH, suppressed=True),
+            ],
+        )
+
+        assert report.effort_counts() == {PatternEffort.LOW: 2, PatternEffort.HIGH: 1}
+        assert report.estimated_effort() == (
+            2 * EFFORT_HOURS[PatternEffort.LOW] + EFFORT_HOURS[PatternEffort.HIGH]
+        )
+        assert ScanReport(root=".").estimated_effort() == 0
+
+
+class TestNotification:
+    """Tests for the chat notification summary of a report."""
+
+    FINDING = ScanFinding(
+        pattern_id=PatternId("CC-001-CODE-GO"),
+        severity=Severity.WARNING,
+        title="t",
+        description="d",
+        path="a.go",
+        line=1,
+        snippet="go f()",
+        confidence=1.0,
+        domain=ArtifactDomain.CONCURRENCY,
+        language="go",
+    )
+
+    def _report(self, findings: list[ScanFinding], files: int = 450) -> ScanReport:
+        return ScanReport(
+            root=".",
+            findings=findings,
+            files_scanned=[f"f{i}.go" for i in range(files)],
+        )
+
+    def test_headline_counts_and_top_files(self) -> None:
+        """Test severity counts, worst-first file ranking and suppressed findings."""
+        finding = self.FINDING
+        report = self._report(
+            [
+                replace(finding, path="b.go", line=1),
+                replace(finding, path="b.go", line=2),
+                replace(finding, path="c.go", severity=Severity.CRITICAL),
+                replace(finding, path="a.go", severity=Severity.ERROR),
+                replace(finding, path="d.go", suppressed=True),
+            ]
+        )
+
+        assert report.notification() == (
+            "🚨 1 critical, 1 error, 2 warnings in 450 files\n"
+            "• c.go: 1 finding\n"
+            "• a.go: 1 finding\n"
+            "• b.go: 2 findings"
+        )
+
+    def test_markdown_style(self) -> None:
+        """Test that markdown bolds the headline and quotes paths."""
+        report = self._report([self.FINDING], files=1)
+
+        assert report.notification("markdown") == (
+            "*⚠️ 1 warning in 1 file*\n• `a.go`: 1 finding"
+        )
+
+    def test_clean_report(self) -> None:
+        """Test the message of a report without unsuppressed findings."""
+        report = self._report([replace(self.FINDING, suppressed=True)], files=3)
+
+        assert report.notification() == "✅ No findings in 3 files"
+        assert report.notification("markdown") == "*✅ No findings in 3 files*"
+
+    def test_file_list_is_limited(self) -> None:
+        """Test that files beyond max_files are counted rather than listed."""
+        findings = [replace(self.FINDING, path=f"pkg/{i}.go") for i in range(8)]
+        report = self._report(findings)
+
+        lines = report.notification(max_files=3).split("\n")
+
+        assert lines[1:] == [
+            "• pkg/0.go: 1 finding",
+            "• pkg/1.go: 1 finding",
+            "• pkg/2.go: 1 finding",
+            "… and 5 more files",
+        ]
+        assert report.notification(max_files=7).endswith("… and 1 more file")
+
+    def test_truncates_to_length_limit(self) -> None:
+        """Test that file lines are dropped to keep long paths under the limit."""
+        findings = [replace(self.FINDING, path=f"{'d' * 900}/{i}.go") for i in range(5)]
+        report = self._report(findings)
+
+        message = report.notification()
+
+        assert len(message) <= NOTIFICATION_MAX_CHARS
+        lines = message.split("\n")
+        assert lines[0] == "⚠️ 5 warnings in 450 files"
+        assert len(lines) == 5
+        assert lines[-1] == "… and 2 more files"
+
+    def test_unknown_style(self) -> None:
+        """Test that unknown styles are rejected."""
+        with pytest.raises(ValueError, match="Unknown notification style: 'html'"):
+            self._report([]).notification("html")
+
+
+class TestMaxFindings:
+    """Tests for capping findings with severity-weighted sampling."""
+
+    FINDING = TestNotification.FINDING
+
+    def _findings(self, counts: dict[Severity, int]) -> list[ScanFinding]:
+        findings: list[ScanFinding] = []
+        for severity, count in counts.items():
+            findings.extend(
+                replace(self.FINDING, severity=severity, line=len(findings) + i + 1)
+                for i in range(count)
+            )
+        return findings
+
+    def test_keeps_critical_and_samples_the_rest(self) -> None:
+        """Test that critical and error findings survive and infos drop the most."""
+        findings = self._findings(
+            {Severity.CRITICAL: 2, Severity.ERROR: 1, Severity.WARNING: 12, Severity.INFO: 12}
+        )
+
+        kept, dropped = cap_findings(findings, 11)
+
+        assert len(kept) == 11
+        assert [f for f in findings if f.severity in (Severity.CRITICAL, Severity.ERROR)] == [
+            f for f in kept if f.severity in (Severity.CRITICAL, Severity.ERROR)
+        ]
+        assert CAP_SAMPLE_WEIGHTS[Severity.WARNING] > CAP_SAMPLE_WEIGHTS[Severity.INFO]
+        assert dropped == {Severity.WARNING: 7, Severity.INFO: 9}
+        assert kept == [f for f in findings if f in kept]
+
+    def test_samples_spread_through_the_report(self) -> None:
+        """Test that a sample is evenly spaced rather than the first findings."""
+        findings = [replace(self.FINDING, line=i + 1) for i in range(10)]
+
+        kept, dropped = cap_findings(findings, 5)
+
+        lines = [f.line for f in kept]
+        assert [b - a for a, b in zip(lines, lines[1:], strict=False)] == [2, 2, 2, 2]
+        assert dropped == {Severity.WARNING: 5}
+
+    def test_same_seed_keeps_the_same_sample(self) -> None:
+        """Test that sampling is reproducible per seed and defaults to a fixed seed."""
+        findings = [replace(self.FINDING, line=i + 1) for i in range(100)]
+        samples = {seed: cap_findings(findings, 7, seed) for seed in range(5)}
+
+        assert cap_findings(findings, 7, 3) == samples[3]
+        assert cap_findings(findings, 7) == samples[DEFAULT_SAMPLE_SEED]
+        assert len({tuple(f.line for f in kept) for kept, _ in samples.values()}) > 1
+
+    def test_critical_findings_exceed_the_cap(self) -> None:
+        """Test that critical findings are kept even when they alone exceed the cap."""
+        findings = self._findings({Severity.CRITICAL: 3, Severity.INFO: 2})
+
+        kept, dropped = cap_findings(findings, 2)
+
+        assert [f.severity for f in kept] == [Severity.CRITICAL] * 3
+        assert dropped == {Severity.INFO: 2}
+        assert cap_findings(findings, 5) == (findings, {})
+
+    def test_cap_report_records_dropped_findings(self, tmp_path: Path) -> None:
+        """Test that capped reports record and round-trip the drops."""
+        for name in ("a", "b"):
+            write_file(tmp_path, f"{name}/main.go", GO_GOROUTINE)
+        write_file(tmp_path, f"quiet/{CONFIG_FILENAME}", "severity:\n  CC-001: info\n")
+        for index in range(6):
+            write_file(tmp_path, f"quiet/w{index}.go", GO_GOROUTINE)
+        full = Scanner().scan(tmp_path)
+
+        report = cap_report(full, 3)
+
+        assert [f.severity for f in report.findings].count(Severity.CRITICAL) == 2
+        assert len(report.findings) == 3
+        assert report.dropped_findings == {Severity.INFO: 5}
+        data = serialize_scan_report(report)
+        assert data["dropped_findings"] == {"info": 5}
+        assert deserialize_scan_report(data) == report
+        assert "dropped_findings" not in serialize_scan_report(full)
+        assert cap_report(full, 8) is full
+
+    def test_baselined_findings_do_not_use_the_cap(self) -> None:
+        """Test that a baselined warning cannot push a new warning out of the cap."""
+        old = replace(self.FINDING, path="accepted.go")
+        new = replace(self.FINDING, path="regressed.go")
+        baseline = create_finding_baseline(ScanReport(root=".", findings=[old]))
+        report = ScanReport(root=".", findings=[old, new])
+
+        for keep_accepted in (False, True):
+            capped = cap_report(apply_finding_baseline(report, baseline, keep_accepted), 1)
+
+            assert [f.path for f in capped.unsuppressed_findings()] == ["regressed.go"]
+            assert capped.dropped_findings == {}
+
+    def test_negative_cap_rejected(self) -> None:
+        """Test that a negative limit is rejected."""
+        with pytest.raises(ValueError, match="max_findings must be non-negative"):
+            cap_report(ScanReport(root="."), -1)
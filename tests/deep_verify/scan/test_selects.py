@@ -0,0 +1,90 @@
+"""Tests for select receive variables read in another branch (CC-138)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import ScanConfig, find_cross_case_receives
+
+from tests.deep_verify.scan.conftest import GO_CROSS_CASE_RECEIVE
+
+PER_BRANCH = """package inbox
+
+func poll(inbox <-chan Message, urgent <-chan Message) {
+    var msg Message
+    select {
+    case msg := <-inbox:
+        handle(msg)
+    case msg := <-urgent:
+        escalate(msg)
+    default:
+        msg = Message{}
+        handle(msg)
+    }
+}
+"""
+
+
+def _receives(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_cross_case_receives(text, "x.go", ScanConfig())]
+
+
+class TestFindCrossCaseReceives:
+    """Tests for find_cross_case_receives."""
+
+    def test_read_in_default(self) -> None:
+        """Test reporting a received variable read in the default branch."""
+        (finding,) = find_cross_case_receives(GO_CROSS_CASE_RECEIVE, "inbox.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-138-CODE-GO"
+        assert finding.severity == Severity.WARNING
+        assert finding.line == 9
+        assert finding.title == "msg received in the case at line 6 is read in the default branch"
+        assert finding.snippet == "handle(msg)"
+
+    def test_per_branch_use_is_safe(self) -> None:
+        """Test that cases binding their own variable and assigned reads are not reported."""
+        assert _receives(PER_BRANCH) == []
+
+    def test_other_case_and_assignment_receive(self) -> None:
+        """Test reads in another case, `v, ok = <-ch` receives and same-line bodies."""
+        text = """package inbox
+
+func drain(in <-chan int, done <-chan struct{}) (last int) {
+    var v int
+    var ok bool
+    for {
+        select {
+        case v, ok = <-in:
+            if !ok {
+                return v
+            }
+        case <-done: return v
+        }
+    }
+}
+"""
+        assert _receives(text) == [
+            (12, "v received in the case at line 8 is read in another case"),
+        ]
+
+    def test_nested_selects_and_fields(self) -> None:
+        """Test that clauses of nested selects and field names are kept apart."""
+        text = """package inbox
+
+func relay(in, out chan Event, state *State) {
+    select {
+    case ev := <-in:
+        select {
+        case out <- ev:
+        default:
+            drop(ev)
+        }
+    default:
+        log(state.ev, Event{ev: 1})
+    }
+}
+"""
+        assert _receives(text) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-138."""
+        config = ScanConfig(disable=["CC-138"])
+        assert find_cross_case_receives(GO_CROSS_CASE_RECEIVE, "x.go", config) == []
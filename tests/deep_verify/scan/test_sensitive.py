@@ -0,0 +1,159 @@
+"""Tests for sensitive field logging detection (CC-119)."""
+
+from pathlib import Path
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    ScanConfig,
+    ScanOptions,
+    Scanner,
+    find_sensitive_logs,
+)
+
+from tests.deep_verify.scan.conftest import GO_SENSITIVE_LOG, write_file
+
+NAME_LOG = """package auth
+
+import "log"
+
+func Login(user *User) {
+    log.Printf("%s", user.Name)
+}
+"""
+
+
+def _logs(text: str, names: tuple[str, ...] | None = None) -> list[tuple[int, str]]:
+    if names is None:
+        findings = find_sensitive_logs(text, "x.go", ScanConfig())
+    else:
+        findings = find_sensitive_logs(text, "x.go", ScanConfig(), names)
+    return [(f.line, f.title) for f in findings]
+
+
+class TestFindSensitiveLogs:
+    """Tests for find_sensitive_logs."""
+
+    def test_password_field(self) -> None:
+        """Test reporting a logged password field."""
+        (finding,) = find_sensitive_logs(GO_SENSITIVE_LOG, "auth.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-119-CODE-GO"
+        assert finding.line == 6
+        assert finding.title == "Logging call prints sensitive field Password"
+        assert finding.severity == Severity.ERROR
+
+    def test_non_sensitive_field_is_safe(self) -> None:
+        """Test that ordinary fields are not reported."""
+        assert _logs(NAME_LOG) == []
+
+    def test_suffix_matching(self) -> None:
+        """Test that names match as suffixes, ignoring case and underscores."""
+        text = """package main
+
+import (
+    "fmt"
+    "log/slog"
+)
+
+func run(cfg Config, req Request, stats Stats) {
+    fmt.Println(cfg.APIKey)
+    slog.Info("request", "token", req.Access_Token)
+    fmt.Println(stats.TokenCount)
+    fmt.Printf("%s\\n", req.Session().Secret())
+}
+"""
+        assert _logs(text) == [
+            (9, "Logging call prints sensitive field APIKey"),
+            (10, "Logging call prints sensitive field Access_Token"),
+            (12, "Logging call prints sensitive field Secret"),
+        ]
+
+    def test_multiline_call(self) -> None:
+        """Test that arguments on following lines are checked."""
+        text = """package main
+
+import "log"
+
+func run(cfg Config) {
+    log.Printf(
+        "connecting with %s",
+        cfg.DB.Password,
+    )
+}
+"""
+        assert _logs(text) == [(6, "Logging call prints sensitive field Password")]
+
+    def test_resolves_aliases(self) -> None:
+        """Test that calls resolve through import aliases."""
+        text = """package main
+
+import (
+    stdlog "log"
+    "example.com/shop/log"
+)
+
+func run(u User) {
+    stdlog.Println(u.Password)
+    log.Println(u.Password)
+}
+"""
+        assert _logs(text) == [(9, "Logging call prints sensitive field Password")]
+
+    def test_strings_and_comments_are_ignored(self) -> None:
+        """Test that names in string literals and comments are not reported."""
+        text = """package main
+
+import "log"
+
+func run(u User) {
+    // log.Println(u.Password)
+    log.Println("u.Password was rotated", u.Name)
+}
+"""
+        assert _logs(text) == []
+
+    def test_custom_names(self) -> None:
+        """Test that the name list can be replaced."""
+        text = """package main
+
+import "fmt"
+
+func run(c Customer) {
+    fmt.Println(c.SSN, c.Password)
+}
+"""
+        assert _logs(text, ("ssn",)) == [(6, "Logging call prints sensitive field SSN")]
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-119."""
+        config = ScanConfig(disable=["CC-119"])
+        assert find_sensitive_logs(GO_SENSITIVE_LOG, "auth.go", config) == []
+
+
+class TestScannerSensitiveLogs:
+    """Tests for CC-119 in tree scans."""
+
+    def test_options_extend_default_names(self, tmp_path: Path) -> None:
+        """Test that ScanOptions.sensitive_names adds to the defaults."""
+        write_file(tmp_path, "leak.go", GO_SENSITIVE_LOG)
+        write_file(tmp_path, "card.go", NAME_LOG.replace("user.Name", "user.Card_Number"))
+        options = ScanOptions(sensitive_names=("CardNumber",))
+
+        report = Scanner(options).scan(tmp_path)
+
+        paths = {f.path for f in report.findings if f.pattern_id == "CC-119-CODE-GO"}
+        assert paths == {"leak.go", "card.go"}
+
+    def test_suppression_applies(self, tmp_path: Path) -> None:
+        """Test that deepverify:ignore silences CC-119."""
+        write_file(
+            tmp_path,
+            "leak.go",
+            GO_SENSITIVE_LOG.replace(
+                "user.Password)", "user.Password) // deepverify:ignore CC-119"
+            ),
+        )
+
+        report = Scanner().scan(tmp_path)
+
+        assert all(f.pattern_id != "CC-119-CODE-GO" for f in report.findings)
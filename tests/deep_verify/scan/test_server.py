@@ -0,0 +1,237 @@
+"""Tests for the Deep Verify scan server."""
+
+import json
+import os
+import urllib.error
+import urllib.request
+from collections.abc import Iterator
+from pathlib import Path
+from typing import Any
+
+import pytest
+
+from bmad_assist import __version__
+from bmad_assist.deep_verify.scan import ScanCache, ScanOptions, ScanServer, Scanner
+
+from tests.deep_verify.scan.conftest import GO_CLEAN, GO_GOROUTINE, write_file
+
+
+def _request(url: str, body: Any = None, raw: bytes | None = None) -> tuple[int, Any]:
+    """Send a GET (no body) or POST request and decode the JSON response."""
+    data = raw
+    if data is None and body is not None:
+        data = json.dumps(body).encode()
+    request = urllib.request.Request(url, data=data, method="GET" if data is None else "POST")
+    try:
+        with urllib.request.urlopen(request, timeout=10) as response:
+            return response.status, json.loads(response.read())
+    except urllib.error.HTTPError as e:
+        return e.code, json.loads(e.read())
+
+
+@pytest.fixture
+def server(go_tree: Path) -> Iterator[ScanServer]:
+    """Start a scan server on an ephemeral port."""
+    server = ScanServer(go_tree, port=0)
+    server.start()
+    try:
+        yield server
+    finally:
+        server.shutdown()
+
+
+class TestScanServer:
+    """Tests for the HTTP API."""
+
+    def test_health(self, server: ScanServer) -> None:
+        """Test the health endpoint."""
+        status, body = _request(f"{server.url}/health")
+
+        assert status == 200
+        assert body["status"] == "ok"
+        assert body["patterns"] > 0
+
+    def test_analyze_path(self, server: ScanServer) -> None:
+        """Test round-tripping a path analyze request."""
+        status, report = _request(f"{server.url}/analyze", {"path": "teams/payments"})
+
+        assert status == 200
+        assert report["files_scanned"] == ["worker.go"]
+        assert report["findings"][0]["pattern_id"] == "CC-001-CODE-GO"
+
+    def test_analyze_source(self, server: ScanServer) -> None:
+        """Test analyzing source text from the request body."""
+        status, report = _request(
+            f"{server.url}/analyze",
+            {"source": GO_GOROUTINE, "language": "go", "path": "snippet.go"},
+        )
+
+        assert status == 200
+        (finding,) = report["findings"]
+        assert finding["path"] == "snippet.go"
+        assert finding["line"] == 4
+
+    def test_analyze_snippet(self, server: ScanServer) -> None:
+        """Test that line_offset reports fragment findings at file lines."""
+        fragment = "go func() {\n    doWork()\n}()\n"
+        status, report = _request(
+            f"{server.url}/analyze",
+            {"source": fragment, "language": "go", "path": "main.go", "line_offset": 20},
+        )
+
+        assert status == 200
+        assert [(f["path"], f["line"]) for f in report["findings"]] == [("main.go", 21)]
+
+    @pytest.mark.parametrize(("line_offset", "status"), [("3", 400), (True, 400), (-1, 422)])
+    def test_analyze_snippet_invalid_offset(
+        self, server: ScanServer, line_offset: object, status: int
+    ) -> None:
+        """Test that bad line offsets are rejected."""
+        response_status, body = _request(
+            f"{server.url}/analyze",
+            {"source": "go run()\n", "language": "go", "line_offset": line_offset},
+        )
+
+        assert response_status == status
+        assert "line_offset" in body["error"]
+
+    def test_cache_stays_warm_across_requests(self, server: ScanServer) -> None:
+        """Test that repeated requests reuse cached results."""
+        _request(f"{server.url}/analyze", {"path": "."})
+        _request(f"{server.url}/analyze", {"path": "."})
+
+        cache = _request(f"{server.url}/health")[1]["cache"]
+        assert cache["misses"] == 4
+        assert cache["hits"] == 4
+
+    def test_path_outside_root_is_forbidden(self, server: ScanServer) -> None:
+        """Test that requests cannot escape the server root."""
+        status, body = _request(f"{server.url}/analyze", {"path": "../"})
+
+        assert status == 403
+        assert "outside the server root" in body["error"]
+
+    def test_missing_path(self, server: ScanServer) -> None:
+        """Test that unknown paths return 404."""
+        status, _ = _request(f"{server.url}/analyze", {"path": "missing"})
+
+        assert status == 404
+
+    @pytest.mark.parametrize(
+        ("body", "message"),
+        [
+            (b"not json", "not valid JSON"),
+            (b"[]", "JSON object"),
+            (b"{}", "'path' or 'source'"),
+            (b'{"source": "x"}', "'language'"),
+        ],
+    )
+    def test_bad_requests(self, server: ScanServer, body: bytes, message: str) -> None:
+        """Test that malformed requests return 400 with a message."""
+        status, response = _request(f"{server.url}/analyze", raw=body)
+
+        assert status == 400
+        assert message in response["error"]
+
+    def test_unknown_endpoint(self, server: ScanServer) -> None:
+        """Test that unknown endpoints return 404."""
+        assert _request(f"{server.url}/nope")[0] == 404
+
+    def test_shutdown_closes_socket(self, go_tree: Path) -> None:
+        """Test that shutdown stops accepting connections."""
+        server = ScanServer(go_tree, port=0)
+        server.start()
+        url = server.url
+        server.shutdown()
+
+        with pytest.raises(urllib.error.URLError):
+            _request(f"{url}/health")
+
+
+class TestScanCache:
+    """Tests for cached re-analysis."""
+
+    def test_unchanged_files_are_not_reanalyzed(self, go_tree: Path) -> None:
+        """Test that a second scan is served from the cache."""
+        cache = ScanCache()
+        scanner = Scanner(cache=cache)
+
+        first = scanner.scan(go_tree)
+        second = scanner.scan(go_tree)
+
+        assert second.findings == first.findings
+        assert cache.hits == 4
+        assert cache.misses == 4
+
+    def test_changed_file_is_reanalyzed(self, go_tree: Path) -> None:
+        """Test that editing a file invalidates its entry."""
+        scanner = Scanner(cache=ScanCache())
+        scanner.scan(go_tree)
+
+        path = write_file(go_tree, "main.go", GO_CLEAN)
+        os.utime(path, ns=(1, 1))
+        report = scanner.scan(go_tree)
+
+        assert "main.go" not in {f.path for f in report.findings}
+
+    def test_lru_eviction(self) -> None:
+        """Test that the oldest entry is evicted when full."""
+        cache = ScanCache(max_entries=2)
+        cache.put("a", [])
+        cache.put("b", [])
+        cache.get("a")
+        cache.put("c", None)
+
+        assert cache.get("b") == (False, None)
+        assert cache.get("a") == (True, [])
+        assert cache.get("c") == (True, None)
+
+    def test_invalid_max_entries(self) -> None:
+        """Test that an empty cache size is rejected."""
+        with pytest.raises(ValueError, match="max_entries"):
+            ScanCache(max_entries=0)
+
+    def test_saved_cache_warms_new_scanner(self, tmp_path: Path) -> None:
+        """Test that a loaded cache file serves every file of a new scan."""
+        root = tmp_path / "repo"
+        write_file(root, "main.go", GO_GOROUTINE)
+        write_file(root, "util.go", GO_CLEAN)
+        cache_file = tmp_path / "cache.json"
+        warm = ScanCache()
+        expected = Scanner(cache=warm).scan(root)
+        warm.save(cache_file)
+
+        cache = ScanCache.load(cache_file)
+        report = Scanner(cache=cache).scan(root)
+
+        assert cache.hits == 2
+        assert cache.misses == 0
+        assert report.findings == expected.findings
+
+    def test_cache_keys_include_options(self, go_tree: Path) -> None:
+        """Test that scanners with different options do not share entries."""
+        cache = ScanCache()
+        Scanner(cache=cache).scan(go_tree)
+
+        Scanner(ScanOptions(threshold=0.9), cache=cache).scan(go_tree)
+
+        assert cache.hits == 0
+
+    def test_load_missing_file(self, tmp_path: Path) -> None:
+        """Test that a missing cache file yields an empty cache."""
+        assert len(ScanCache.load(tmp_path / "missing.json")) == 0
+
+    @pytest.mark.parametrize(
+        "content",
+        [
+            "not json",
+            json.dumps({"format": 99, "version": __version__, "entries": []}),
+            json.dumps({"format": 1, "version": "0.0.1", "entries": []}),
+            json.dumps({"format": 1, "version": __version__, "entries": [{"key": ["a"]}]}),
+        ],
+    )
+    def test_load_ignores_unusable_file(self, tmp_path: Path, content: str) -> None:
+        """Test that corrupt or foreign cache files are ignored."""
+        cache_file = write_file(tmp_path, "cache.json", content)
+
+        assert len(ScanCache.load(cache_file)) == 0
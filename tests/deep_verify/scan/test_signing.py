@@ -0,0 +1,131 @@
+"""Tests for Ed25519 signing of scan reports."""
+
+import json
+from dataclasses import replace
+from pathlib import Path
+
+import pytest
+
+pytest.importorskip("cryptography")
+
+from cryptography.hazmat.primitives.asymmetric.ed25519 import Ed25519PrivateKey
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import ScanReport, Scanner, serialize_scan_report
+from bmad_assist.deep_verify.scan.signing import (
+    ReportSignatureError,
+    SignedReport,
+    canonical_report_bytes,
+    deserialize_signed_report,
+    public_key_id,
+    serialize_signed_report,
+    sign_report,
+    verify_signed_report,
+)
+
+from tests.deep_verify.scan.conftest import GO_CLEAN, GO_GOROUTINE, write_file
+
+
+@pytest.fixture
+def report(tmp_path: Path) -> ScanReport:
+    """Report with findings in two files."""
+    write_file(tmp_path, "a/main.go", GO_GOROUTINE)
+    write_file(tmp_path, "b/main.go", GO_GOROUTINE)
+    write_file(tmp_path, "b/util.go", GO_CLEAN)
+    return Scanner().scan(tmp_path)
+
+
+@pytest.fixture
+def key() -> Ed25519PrivateKey:
+    """Fresh signing key."""
+    return Ed25519PrivateKey.generate()
+
+
+class TestCanonicalReportBytes:
+    """Tests for canonical_report_bytes()."""
+
+    def test_independent_of_order(self, report: ScanReport) -> None:
+        shuffled = replace(
+            report,
+            findings=list(reversed(report.findings)),
+            files_scanned=list(reversed(report.files_scanned)),
+        )
+        assert canonical_report_bytes(shuffled) == canonical_report_bytes(report)
+
+    def test_compact_sorted_json(self, report: ScanReport) -> None:
+        data = canonical_report_bytes(report)
+
+        assert data == json.dumps(
+            json.loads(data), sort_keys=True, separators=(",", ":"), ensure_ascii=False
+        ).encode("utf-8")
+        assert [f["path"] for f in json.loads(data)["findings"]] == ["a/main.go", "b/main.go"]
+
+
+class TestSignAndVerify:
+    """Tests for sign_report() and verify_signed_report()."""
+
+    def test_round_trip(self, report: ScanReport, key: Ed25519PrivateKey) -> None:
+        signed = sign_report(report, key)
+
+        verified = verify_signed_report(key.public_key(), signed)
+
+        assert signed.key_id == public_key_id(key.public_key())
+        assert len(signed.signature) == 64
+        assert serialize_scan_report(verified) == json.loads(canonical_report_bytes(report))
+
+    def test_json_round_trip(self, report: ScanReport, key: Ed25519PrivateKey) -> None:
+        text = json.dumps(serialize_signed_report(sign_report(report, key)), indent=2)
+
+        signed = deserialize_signed_report(json.loads(text))
+
+        assert len(verify_signed_report(key.public_key(), signed).findings) == 2
+
+    def test_tampered_finding_rejected(self, report: ScanReport, key: Ed25519PrivateKey) -> None:
+        data = serialize_signed_report(sign_report(report, key))
+        data["report"]["findings"][0]["severity"] = Severity.INFO.value
+
+        with pytest.raises(ReportSignatureError, match="not valid"):
+            verify_signed_report(key.public_key(), deserialize_signed_report(data))
+
+    def test_removed_finding_rejected(self, report: ScanReport, key: Ed25519PrivateKey) -> None:
+        data = serialize_signed_report(sign_report(report, key))
+        del data["report"]["findings"][1]
+
+        with pytest.raises(ReportSignatureError):
+            verify_signed_report(key.public_key(), deserialize_signed_report(data))
+
+    def test_tampered_payload_rejected(self, report: ScanReport, key: Ed25519PrivateKey) -> None:
+        signed = sign_report(report, key)
+        forged = SignedReport(
+            payload=signed.payload.replace(b"a/main.go", b"c/main.go"),
+            signature=signed.signature,
+            key_id=signed.key_id,
+        )
+
+        with pytest.raises(ReportSignatureError):
+            verify_signed_report(key.public_key(), forged)
+
+    def test_wrong_key_rejected(self, report: ScanReport, key: Ed25519PrivateKey) -> None:
+        signed = sign_report(report, key)
+        other = Ed25519PrivateKey.generate().public_key()
+
+        with pytest.raises(ReportSignatureError, match=public_key_id(other)):
+            verify_signed_report(other, signed)
+
+
+class TestDeserializeSignedReport:
+    """Tests for deserialize_signed_report()."""
+
+    def test_unsupported_algorithm(self, report: ScanReport, key: Ed25519PrivateKey) -> None:
+        data = serialize_signed_report(sign_report(report, key))
+        data["algorithm"] = "rsa"
+
+        with pytest.raises(ReportSignatureError, match="Unsupported signature algorithm"):
+            deserialize_signed_report(data)
+
+    def test_invalid_base64(self, report: ScanReport, key: Ed25519PrivateKey) -> None:
+        data = serialize_signed_report(sign_report(report, key))
+        data["signature"] = "not base64!"
+
+        with pytest.raises(ReportSignatureError, match="base64"):
+            deserialize_signed_report(data)
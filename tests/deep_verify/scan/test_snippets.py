@@ -0,0 +1,99 @@
+"""Tests for snippet scans with a line offset."""
+
+import pytest
+
+from bmad_assist.deep_verify.scan import Scanner, wrap_go_snippet
+
+from tests.deep_verify.scan.conftest import GO_GOROUTINE
+
+# Statements selected from a function body starting at line 41 of handler.go
+GOROUTINE_STATEMENTS = """    go func() {
+        doWork()
+    }()
+"""
+
+GOROUTINE_FUNC = """func main() {
+    go func() {
+        doWork()
+    }()
+}
+"""
+
+
+class TestWrapGoSnippet:
+    """Tests for wrap_go_snippet."""
+
+    def test_complete_file_unchanged(self) -> None:
+        """Test that a fragment with a package clause is not wrapped."""
+        assert wrap_go_snippet(GO_GOROUTINE) == (GO_GOROUTINE, 0)
+
+    def test_declarations_get_package(self) -> None:
+        """Test that top-level declarations only get a package clause."""
+        wrapped, prefix_lines = wrap_go_snippet(GOROUTINE_FUNC)
+
+        assert prefix_lines == 1
+        assert wrapped == "package snippet\n" + GOROUTINE_FUNC
+
+    def test_statements_wrapped_in_function(self) -> None:
+        """Test that statements are wrapped in a function."""
+        wrapped, prefix_lines = wrap_go_snippet(GOROUTINE_STATEMENTS)
+
+        assert prefix_lines == 3
+        assert wrapped.split("\n")[:prefix_lines] == ["package snippet", "", "func snippet() {"]
+        assert wrapped.endswith("\n}\n")
+
+    @pytest.mark.parametrize(
+        ("fragment", "message"),
+        [
+            ("    }\n    return nil\n}\n", "unmatched '}' on line 1"),
+            ("if ok {\n    go run()\n", "1 unclosed '{'"),
+        ],
+    )
+    def test_unbalanced_fragment_rejected(self, fragment: str, message: str) -> None:
+        """Test that fragments cut inside a block are rejected with a clear error."""
+        with pytest.raises(ValueError, match=message):
+            wrap_go_snippet(fragment)
+
+    def test_braces_in_literals_and_comments_ignored(self) -> None:
+        """Test that braces in strings and comments do not count."""
+        fragment = 'fmt.Println("}") // {\nx := \'{\'\n'
+
+        assert wrap_go_snippet(fragment)[1] == 3
+
+
+class TestScannerScanSnippet:
+    """Tests for Scanner.scan_snippet."""
+
+    def test_statement_lines_offset(self) -> None:
+        """Test that findings in wrapped statements map back with the offset."""
+        findings = Scanner().scan_snippet(
+            GOROUTINE_STATEMENTS, "go", rel_path="handler.go", line_offset=40
+        )
+
+        assert [(f.path, f.line, f.pattern_id) for f in findings] == [
+            ("handler.go", 41, "CC-001-CODE-GO")
+        ]
+        assert findings[0].snippet == "go func() {"
+
+    def test_declaration_lines_offset(self) -> None:
+        """Test offsets for declarations and for complete files."""
+        scanner = Scanner()
+
+        declarations = scanner.scan_snippet(GOROUTINE_FUNC, "go", line_offset=10)
+        complete = scanner.scan_snippet(GO_GOROUTINE, "go", line_offset=10)
+
+        assert [f.line for f in declarations] == [12]
+        assert [f.line for f in complete] == [14]
+
+    def test_zero_offset_matches_scan_source(self) -> None:
+        """Test that a complete file at offset 0 scans like scan_source."""
+        scanner = Scanner()
+
+        assert scanner.scan_snippet(GO_GOROUTINE, "go") == scanner.scan_source(GO_GOROUTINE, "go")
+
+    def test_invalid_snippets_rejected(self) -> None:
+        """Test negative offsets and unbalanced Go fragments."""
+        with pytest.raises(ValueError, match="line_offset"):
+            Scanner().scan_snippet(GO_GOROUTINE, "go", line_offset=-1)
+        with pytest.raises(ValueError, match="unclosed"):
+            Scanner().scan_snippet("go func() {\n", "go")
@@ -0,0 +1,134 @@
+"""Tests for goroutines started while holding a mutex (CC-137)."""
+
+from pathlib import Path
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import (
+    LOCKED_SPAWN_CONFIDENCE,
+    ScanConfig,
+    ScanOptions,
+    Scanner,
+    find_locked_spawns,
+)
+
+from tests.deep_verify.scan.conftest import GO_LOCKED_SPAWN, write_file
+
+UNRELATED = """package cache
+
+func (c *Cache) Evict(key string) {
+    c.mu.Lock()
+    delete(c.items, key)
+    go metrics.Inc("evictions")
+    c.mu.Unlock()
+}
+"""
+
+OUTSIDE = """package cache
+
+func (c *Cache) Evict(key string) {
+    c.mu.Lock()
+    delete(c.items, key)
+    c.mu.Unlock()
+    go func() {
+        c.mu.Lock()
+        c.evictions++
+        c.mu.Unlock()
+    }()
+}
+"""
+
+
+def _spawns(text: str) -> list[tuple[int, str, float]]:
+    return [
+        (f.line, f.title, f.confidence)
+        for f in find_locked_spawns(text, "x.go", ScanConfig())
+    ]
+
+
+class TestFindLockedSpawns:
+    """Tests for find_locked_spawns."""
+
+    def test_goroutine_locking_held_mutex(self) -> None:
+        """Test reporting a goroutine that locks the mutex its spawner holds."""
+        (finding,) = find_locked_spawns(GO_LOCKED_SPAWN, "cache.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-137-CODE-GO"
+        assert finding.severity == Severity.WARNING
+        assert finding.line == 7
+        assert finding.title == "Goroutine started while holding c.mu locks it again"
+        assert finding.confidence == 1.0
+
+    def test_goroutine_touching_unrelated_state(self) -> None:
+        """Test that other goroutines under a lock are reported at lower confidence."""
+        assert _spawns(UNRELATED) == [
+            (6, "Goroutine started while holding c.mu", LOCKED_SPAWN_CONFIDENCE),
+        ]
+        assert LOCKED_SPAWN_CONFIDENCE < 1.0
+
+    def test_goroutine_outside_lock_is_safe(self) -> None:
+        """Test that a goroutine started after the unlock is not reported."""
+        assert _spawns(OUTSIDE) == []
+
+    def test_named_function_locking_same_field(self) -> None:
+        """Test resolving go calls to functions of the file by mutex field name."""
+        text = """package cache
+
+func (c *Cache) Set(k, v string) {
+    c.mu.RLock()
+    defer c.mu.RUnlock()
+    go c.flush()
+    go notify(k)
+}
+
+func (c *Cache) flush() {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    c.dirty = false
+}
+
+func notify(k string) {
+    log.Println(k)
+}
+"""
+        assert _spawns(text) == [
+            (6, "Goroutine started while holding c.mu locks it again", 1.0),
+            (7, "Goroutine started while holding c.mu", LOCKED_SPAWN_CONFIDENCE),
+        ]
+
+    def test_locks_reset_per_function(self) -> None:
+        """Test that a deferred unlock holds the lock only to the end of its function."""
+        text = """package cache
+
+func (c *Cache) Get(k string) string {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    return c.items[k]
+}
+
+func (c *Cache) Start() {
+    go c.loop()
+}
+"""
+        assert _spawns(text) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-137."""
+        config = ScanConfig(disable=["CC-137"])
+        assert find_locked_spawns(GO_LOCKED_SPAWN, "x.go", config) == []
+
+
+class TestScannerLockedSpawns:
+    """Tests for CC-137 in tree scans."""
+
+    def test_scan_filters_low_confidence_by_threshold(self, tmp_path: Path) -> None:
+        """Test that stricter thresholds keep only same-lock goroutines."""
+        write_file(tmp_path, "same.go", GO_LOCKED_SPAWN)
+        write_file(tmp_path, "unrelated.go", UNRELATED)
+        write_file(tmp_path, "outside.go", OUTSIDE)
+
+        default = Scanner().scan(tmp_path)
+        strict = Scanner(ScanOptions(threshold=0.8)).scan(tmp_path)
+
+        cc137 = [(f.path, f.line) for f in default.findings if f.pattern_id == "CC-137-CODE-GO"]
+        assert cc137 == [("same.go", 7), ("unrelated.go", 6)]
+        assert [f.path for f in strict.findings if f.pattern_id == "CC-137-CODE-GO"] == ["same.go"]
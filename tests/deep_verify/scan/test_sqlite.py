@@ -0,0 +1,229 @@
+"""Tests for SQLite export."""
+
+import sqlite3
+from dataclasses import replace
+from datetime import UTC, datetime
+from pathlib import Path
+
+import pytest
+
+from bmad_assist.deep_verify.core.types import ArtifactDomain, PatternEffort, PatternId, Severity
+from bmad_assist.deep_verify.scan import (
+    SQLITE_SCHEMA_VERSION,
+    ScanFinding,
+    ScanReport,
+    Scanner,
+    finding_fingerprint,
+    health_score,
+    write_sqlite,
+)
+
+
+def _finding(line: int = 3) -> ScanFinding:
+    return ScanFinding(
+        pattern_id=PatternId("CC-002-CODE-GO"),
+        severity=Severity.ERROR,
+        title="Mutex locked without deferred unlock",
+        description="Mutex locked without deferred unlock",
+        path="pkg/cache.go",
+        line=line,
+        snippet="mu.Lock()",
+        confidence=1.0,
+        domain=ArtifactDomain.CONCURRENCY,
+        language="go",
+    )
+
+
+def _report(findings: int = 2) -> ScanReport:
+    return ScanReport(
+        root=".",
+        findings=[_finding(line=3 + i) for i in range(findings)],
+        files_scanned=["pkg/cache.go"],
+        started_at=datetime(2026, 1, 2, 3, 4, 5, tzinfo=UTC),
+        duration_ms=42,
+    )
+
+
+def _count(db: Path, table: str) -> int:
+    conn = sqlite3.connect(db)
+    try:
+        return conn.execute(f"SELECT COUNT(*) FROM {table}").fetchone()[0]
+    finally:
+        conn.close()
+
+
+class TestWriteSqlite:
+    """Tests for write_sqlite."""
+
+    def test_writes_run_and_findings(self, tmp_path: Path) -> None:
+        """Test writing a report and reading the rows back."""
+        db = tmp_path / "deepverify.db"
+
+        run_id = write_sqlite(_report(), db)
+
+        assert _count(db, "runs") == 1
+        assert _count(db, "findings") == 2
+        conn = sqlite3.connect(db)
+        try:
+            run = conn.execute(
+                "SELECT root, started_at, duration_ms, files_scanned, findings FROM runs"
+            ).fetchone()
+            finding = conn.execute(
+                "SELECT run_id, fingerprint, pattern_id, severity, path, line "
+                "FROM findings ORDER BY line"
+            ).fetchone()
+        finally:
+            conn.close()
+        assert run == (".", "2026-01-02T03:04:05+00:00", 42, 1, 2)
+        assert finding == (
+            run_id,
+            finding_fingerprint(_finding()),
+            "CC-002-CODE-GO",
+            "error",
+            "pkg/cache.go",
+            3,
+        )
+
+    def test_append_adds_run(self, tmp_path: Path) -> None:
+        """Test that append mode keeps earlier runs."""
+        db = tmp_path / "deepverify.db"
+
+        first = write_sqlite(_report(findings=2), db, append=True)
+        second = write_sqlite(_report(findings=1), db, append=True)
+
+        assert second != first
+        assert _count(db, "runs") == 2
+        assert _count(db, "findings") == 3
+
+    def test_without_append_replaces_data(self, tmp_path: Path) -> None:
+        """Test that the default mode replaces earlier runs."""
+        db = tmp_path / "deepverify.db"
+
+        write_sqlite(_report(findings=2), db)
+        write_sqlite(_report(findings=1), db)
+
+        assert _count(db, "runs") == 1
+        assert _count(db, "findings") == 1
+
+    def test_empty_report(self, tmp_path: Path) -> None:
+        """Test that a report without findings still records the run."""
+        db = tmp_path / "deepverify.db"
+
+        write_sqlite(ScanReport(root="."), db)
+
+        assert _count(db, "runs") == 1
+        assert _count(db, "findings") == 0
+
+    def test_skips_suppressed_findings(self, tmp_path: Path) -> None:
+        """Test that suppressed findings are neither stored nor counted."""
+        db = tmp_path / "deepverify.db"
+        report = _report()
+        report.findings[1] = replace(report.findings[1], suppressed=True)
+
+        write_sqlite(report, db)
+
+        assert _count(db, "findings") == 1
+        conn = sqlite3.connect(db)
+        try:
+            assert conn.execute("SELECT findings FROM runs").fetchone()[0] == 1
+        finally:
+            conn.close()
+
+    def test_records_commit_and_health_score(self, tmp_path: Path) -> None:
+        """Test that the run row records the scanned commit and health score."""
+        db = tmp_path / "deepverify.db"
+
+        write_sqlite(_report(findings=1), db, commit_sha="a" * 40)
+
+        conn = sqlite3.connect(db)
+        try:
+            run = conn.execute("SELECT commit_sha, health_score FROM runs").fetchone()
+        finally:
+            conn.close()
+        assert run == ("a" * 40, health_score(_report(findings=1)))
+
+    def test_records_effort(self, tmp_path: Path) -> None:
+        """Test that findings record their effort and the run the estimated total."""
+        db = tmp_path / "deepverify.db"
+        report = _report()
+        report = replace(
+            report,
+            findings=[replace(report.findings[0], effort=PatternEffort.MEDIUM), report.findings[1]],
+        )
+
+        write_sqlite(report, db)
+
+        conn = sqlite3.connect(db)
+        try:
+            efforts = conn.execute("SELECT effort FROM findings ORDER BY line").fetchall()
+            hours = conn.execute("SELECT effort_hours FROM runs").fetchone()[0]
+        finally:
+            conn.close()
+        assert efforts == [("medium",), (None,)]
+        assert hours == report.estimated_effort() == 2.0
+
+    def test_append_upgrades_schema_version_2(self, tmp_path: Path) -> None:
+        """Test that appending to a version 2 database adds the effort columns."""
+        db = tmp_path / "deepverify.db"
+        write_sqlite(_report(), db)
+        conn = sqlite3.connect(db)
+        conn.execute("ALTER TABLE runs DROP COLUMN effort_hours")
+        conn.execute("ALTER TABLE findings DROP COLUMN effort")
+        conn.execute("PRAGMA user_version = 2")
+        conn.commit()
+        conn.close()
+
+        write_sqlite(_report(), db, append=True)
+
+        conn = sqlite3.connect(db)
+        try:
+            hours = conn.execute("SELECT effort_hours FROM runs ORDER BY id").fetchall()
+            version = conn.execute("PRAGMA user_version").fetchone()[0]
+        finally:
+            conn.close()
+        assert hours == [(None,), (0.0,)]
+        assert version == SQLITE_SCHEMA_VERSION
+
+    def test_append_upgrades_schema_version_1(self, tmp_path: Path) -> None:
+        """Test that appending to a version 1 database adds the trend columns."""
+        db = tmp_path / "deepverify.db"
+        conn = sqlite3.connect(db)
+        conn.execute(
+            "CREATE TABLE runs (id INTEGER PRIMARY KEY AUTOINCREMENT, root TEXT NOT NULL, "
+            "started_at TEXT, duration_ms INTEGER NOT NULL, files_scanned INTEGER NOT NULL, "
+            "skipped_large_files INTEGER NOT NULL, findings INTEGER NOT NULL)"
+        )
+        conn.execute("INSERT INTO runs VALUES (1, '.', NULL, 0, 0, 0, 0)")
+        conn.execute("PRAGMA user_version = 1")
+        conn.commit()
+        conn.close()
+
+        write_sqlite(_report(), db, append=True, commit_sha="b" * 40)
+
+        conn = sqlite3.connect(db)
+        try:
+            rows = conn.execute("SELECT id, commit_sha FROM runs ORDER BY id").fetchall()
+            version = conn.execute("PRAGMA user_version").fetchone()[0]
+        finally:
+            conn.close()
+        assert rows == [(1, None), (2, "b" * 40)]
+        assert version == SQLITE_SCHEMA_VERSION
+
+    def test_rejects_other_schema_version(self, tmp_path: Path) -> None:
+        """Test that a database with an unknown schema version is not modified."""
+        db = tmp_path / "deepverify.db"
+        conn = sqlite3.connect(db)
+        conn.execute(f"PRAGMA user_version = {SQLITE_SCHEMA_VERSION + 1}")
+        conn.close()
+
+        with pytest.raises(ValueError, match="schema version"):
+            write_sqlite(_report(), db, append=True)
+
+    def test_scanned_tree(self, go_tree: Path, tmp_path: Path) -> None:
+        """Test exporting a real scan."""
+        report = Scanner().scan(go_tree)
+        db = tmp_path / "deepverify.db"
+
+        write_sqlite(report, db)
+
+        assert _count(db, "findings") == len(report.findings)
@@ -0,0 +1,225 @@
+"""Tests for deepverify:ignore suppressions and their governance."""
+
+from datetime import UTC, date, datetime
+from pathlib import Path
+
+from bmad_assist.deep_verify.core.types import PatternId, Severity
+from bmad_assist.deep_verify.scan import (
+    CONFIG_FILENAME,
+    ScanOptions,
+    Scanner,
+    ScanReport,
+    deserialize_scan_report,
+    parse_suppressions,
+    serialize_scan_report,
+)
+from bmad_assist.deep_verify.scan.suppressions import Suppression, suppression_problems
+
+from tests.deep_verify.scan.conftest import write_file
+
+TODAY = datetime(2026, 3, 1, tzinfo=UTC)
+
+
+def _goroutine(comment: str) -> str:
+    return (
+        f"package main\n\nfunc main() {{\n    {comment}\n"
+        "    go func() {\n        doWork()\n    }()\n}\n"
+    )
+
+
+def _scan(root: Path) -> list[tuple[str, int]]:
+    report = Scanner(ScanOptions(clock=lambda: TODAY)).scan(root)
+    return [(f.pattern_id, f.line) for f in report.findings]
+
+
+class TestParseSuppressions:
+    """Tests for parse_suppressions()."""
+
+    def test_standalone_comment_targets_next_line(self) -> None:
+        """Test that a comment on its own line covers the next line."""
+        (s,) = parse_suppressions("x := 1\n// deepverify:ignore CC-001\ngo f()\n")
+        assert s.line == 2
+        assert s.target_line == 3
+        assert s.selectors == ("CC-001",)
+
+    def test_trailing_comment_targets_same_line(self) -> None:
+        """Test that a trailing comment covers its own line."""
+        (s,) = parse_suppressions("mu.Lock() // deepverify:ignore CC-002,CC-003\n")
+        assert s.target_line == 1
+        assert s.selectors == ("CC-002", "CC-003")
+
+    def test_fields_and_quoted_values(self) -> None:
+        """Test parsing key=value fields, including quoted values."""
+        (s,) = parse_suppressions(
+            '// deepverify:ignore reason="closed by owner" owner=payments expires=2026-06-30\n'
+        )
+        assert s.selectors == ()
+        assert s.fields == {
+            "reason": "closed by owner",
+            "owner": "payments",
+            "expires": "2026-06-30",
+        }
+
+    def test_hash_comments(self) -> None:
+        """Test that # comments are recognized."""
+        (s,) = parse_suppressions("except:  # deepverify:ignore reason=legacy\n")
+        assert s.fields == {"reason": "legacy"}
+
+
+class TestSuppressionProblems:
+    """Tests for suppression_problems()."""
+
+    def test_missing_required_fields(self) -> None:
+        """Test that each missing required field is reported."""
+        problems, expired = suppression_problems(
+            Suppression(line=1, target_line=2), ["reason", "owner"], date(2026, 3, 1)
+        )
+        assert problems == ["missing reason=", "missing owner="]
+        assert not expired
+
+    def test_invalid_expires(self) -> None:
+        """Test that unparseable dates are reported."""
+        problems, expired = suppression_problems(
+            Suppression(line=1, target_line=2, fields={"expires": "soon"}), [], date(2026, 3, 1)
+        )
+        assert problems == ["invalid expires=soon (use YYYY-MM-DD)"]
+        assert not expired
+
+
+class TestScanSuppressions:
+    """Tests for suppressions applied by the scanner."""
+
+    def test_suppression_silences_finding(self, tmp_path: Path) -> None:
+        """Test that a matching suppression drops the finding."""
+        write_file(tmp_path, "main.go", _goroutine("// deepverify:ignore CC-001"))
+
+        assert _scan(tmp_path) == []
+
+    def test_other_pattern_not_silenced(self, tmp_path: Path) -> None:
+        """Test that a suppression for another pattern keeps the finding."""
+        write_file(tmp_path, "main.go", _goroutine("// deepverify:ignore CC-002"))
+
+        assert _scan(tmp_path) == [(PatternId("CC-001-CODE-GO"), 5)]
+
+    def test_no_governance_by_default(self, tmp_path: Path) -> None:
+        """Test that suppressions need no fields unless configured."""
+        write_file(tmp_path, "main.go", _goroutine("// deepverify:ignore expires=2020-01-01"))
+
+        assert _scan(tmp_path) == []
+
+    def test_missing_reason_is_meta_finding(self, tmp_path: Path) -> None:
+        """Test that a suppression without reason= yields CC-103."""
+        write_file(tmp_path, CONFIG_FILENAME, "suppression_fields: [reason]\n")
+        write_file(tmp_path, "main.go", _goroutine("// deepverify:ignore CC-001"))
+
+        report = Scanner(ScanOptions(clock=lambda: TODAY)).scan(tmp_path)
+
+        (finding,) = report.findings
+        assert finding.pattern_id == PatternId("CC-103")
+        assert finding.line == 4
+        assert finding.severity == Severity.ERROR
+        assert "missing reason=" in finding.title
+
+    def test_fully_annotated_suppression_is_safe(self, tmp_path: Path) -> None:
+        """Test that a compliant suppression produces no findings."""
+        write_file(tmp_path, CONFIG_FILENAME, "suppression_fields: [reason, owner]\n")
+        write_file(
+            tmp_path,
+            "main.go",
+            _goroutine(
+                "// deepverify:ignore CC-001 reason=fire-and-forget owner=payments "
+                "expires=2026-12-31"
+            ),
+        )
+
+        assert _scan(tmp_path) == []
+
+    def test_expired_suppression_stops_suppressing(self, tmp_path: Path) -> None:
+        """Test that an expired suppression yields CC-103 and the finding."""
+        write_file(tmp_path, CONFIG_FILENAME, "suppression_fields: [reason]\n")
+        write_file(
+            tmp_path,
+            "main.go",
+            _goroutine("// deepverify:ignore CC-001 reason=legacy expires=2026-02-28"),
+        )
+
+        assert _scan(tmp_path) == [
+            (PatternId("CC-103"), 4),
+            (PatternId("CC-001-CODE-GO"), 5),
+        ]
+
+    def test_meta_finding_can_be_disabled(self, tmp_path: Path) -> None:
+        """Test that CC-103 obeys disable like any pattern."""
+        write_file(
+            tmp_path, CONFIG_FILENAME, "suppression_fields: [reason]\ndisable: [CC-103]\n"
+        )
+        write_file(tmp_path, "main.go", _goroutine("// deepverify:ignore CC-001"))
+
+        assert _scan(tmp_path) == []
+
+
+class TestShowSuppressed:
+    """Tests for ScanOptions.show_suppressed."""
+
+    def _report(self, root: Path, show_suppressed: bool) -> ScanReport:
+        options = ScanOptions(clock=lambda: TODAY, show_suppressed=show_suppressed)
+        return Scanner(options).scan(root)
+
+    def test_suppressed_findings_dropped_by_default(self, tmp_path: Path) -> None:
+        """Test that suppressed findings are absent without the option."""
+        write_file(tmp_path, "main.go", _goroutine("// deepverify:ignore CC-001 reason=audit"))
+
+        assert self._report(tmp_path, show_suppressed=False).findings == []
+
+    def test_suppressed_findings_marked_with_reason(self, tmp_path: Path) -> None:
+        """Test that the option keeps suppressed findings, marked with the reason."""
+        write_file(
+            tmp_path, "main.go", _goroutine('// deepverify:ignore CC-001 reason="fire and forget"')
+        )
+
+        report = self._report(tmp_path, show_suppressed=True)
+
+        (finding,) = report.findings
+        assert finding.pattern_id == PatternId("CC-001-CODE-GO")
+        assert finding.line == 5
+        assert finding.suppressed
+        assert finding.suppression_reason == "fire and forget"
+        assert report.unsuppressed_findings() == []
+
+    def test_suppression_without_reason(self, tmp_path: Path) -> None:
+        """Test that a suppression without reason= marks the finding without one."""
+        write_file(tmp_path, "main.go", _goroutine("// deepverify:ignore"))
+
+        (finding,) = self._report(tmp_path, show_suppressed=True).findings
+        assert finding.suppressed
+        assert finding.suppression_reason is None
+
+    def test_expired_suppression_not_marked(self, tmp_path: Path) -> None:
+        """Test that findings of expired suppressions stay unsuppressed."""
+        write_file(tmp_path, CONFIG_FILENAME, "suppression_fields: [reason]\n")
+        write_file(
+            tmp_path,
+            "main.go",
+            _goroutine("// deepverify:ignore CC-001 reason=legacy expires=2026-02-28"),
+        )
+
+        report = self._report(tmp_path, show_suppressed=True)
+
+        assert [(f.pattern_id, f.suppressed) for f in report.findings] == [
+            (PatternId("CC-103"), False),
+            (PatternId("CC-001-CODE-GO"), False),
+        ]
+
+    def test_serialization_round_trip(self, tmp_path: Path) -> None:
+        """Test that suppression fields serialize only for suppressed findings."""
+        write_file(tmp_path, "main.go", _goroutine("// deepverify:ignore CC-001 reason=audit"))
+        write_file(tmp_path, "other.go", _goroutine(""))
+
+        report = self._report(tmp_path, show_suppressed=True)
+        data = serialize_scan_report(report)
+
+        suppressed, active = data["findings"]
+        assert suppressed["suppressed"] is True
+        assert suppressed["suppression_reason"] == "audit"
+        assert "suppressed" not in active
+        assert deserialize_scan_report(data).findings == report.findings
@@ -0,0 +1,131 @@
+"""Tests for per-call time.After detection in selects (CC-128)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import ScanConfig, find_timer_selects
+
+from tests.deep_verify.scan.conftest import GO_TIMER_SELECT
+
+CONSUME_TIMER = """package queue
+
+import (
+    "context"
+    "time"
+)
+
+func (mq *MessageQueue) Consume(ctx context.Context) (string, error) {
+    mq.timer.Reset(30 * time.Second)
+    select {
+    case msg := <-mq.messages:
+        if !mq.timer.Stop() {
+            <-mq.timer.C
+        }
+        return msg, nil
+    case <-mq.timer.C:
+        return "", context.DeadlineExceeded
+    }
+}
+"""
+
+
+def _selects(text: str) -> list[tuple[int, str]]:
+    findings = find_timer_selects(text, "x.go", ScanConfig())
+    return [(f.line, f.title) for f in findings]
+
+
+class TestFindTimerSelects:
+    """Tests for find_timer_selects."""
+
+    def test_consume_with_time_after(self) -> None:
+        """Test reporting time.After racing a receive in a consumer."""
+        (finding,) = find_timer_selects(GO_TIMER_SELECT, "queue.go", ScanConfig())
+
+        assert finding.pattern_id == "CC-128-CODE-GO"
+        assert finding.severity == Severity.WARNING
+        assert finding.line == 12
+        assert finding.title == "time.After allocates a timer on every call to Consume"
+        assert finding.snippet == "case <-time.After(30 * time.Second):"
+
+    def test_reused_timer_is_safe(self) -> None:
+        """Test that a reused time.Timer is not reported."""
+        assert _selects(CONSUME_TIMER) == []
+
+    def test_select_in_loop(self) -> None:
+        """Test the per-iteration title for selects in loops, including goroutines."""
+        text = """package worker
+
+import tm "time"
+
+func run(jobs <-chan Job, done <-chan struct{}) {
+    go func() {
+        for {
+            select {
+            case job, ok := <-jobs:
+                handle(job, ok)
+            case <-tm.After(tm.Second):
+                idle()
+            }
+        }
+    }()
+}
+"""
+        assert _selects(text) == [
+            (11, "time.After in a select inside a loop allocates a timer per iteration")
+        ]
+
+    def test_only_done_and_send_cases_are_safe(self) -> None:
+        """Test that cancellation receives and sends do not count as receives."""
+        text = """package worker
+
+import "time"
+
+func wait(ctx context.Context, out chan<- int) {
+    select {
+    case <-ctx.Done():
+    case out <- 1:
+    case <-time.After(time.Second):
+    }
+}
+"""
+        assert _selects(text) == []
+
+    def test_select_with_default_is_safe(self) -> None:
+        """Test that a select with a default case is not reported."""
+        text = """package queue
+
+import "time"
+
+func (mq *MessageQueue) TryConsume() (string, bool) {
+    select {
+    case msg := <-mq.messages:
+        return msg, true
+    case <-time.After(time.Millisecond):
+        return "", false
+    default:
+        return "", false
+    }
+}
+"""
+        assert _selects(text) == []
+
+    def test_comments_and_local_after_ignored(self) -> None:
+        """Test commented-out cases and an After function not from "time"."""
+        text = """package queue
+
+import "time"
+
+func (mq *MessageQueue) Next() string {
+    select {
+    case msg := <-mq.messages:
+        return msg
+    // case <-time.After(time.Second):
+    case <-clock.After(time.Second):
+        return ""
+    }
+}
+"""
+        assert _selects(text) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-128."""
+        config = ScanConfig(disable=["CC-128"])
+        assert find_timer_selects(GO_TIMER_SELECT, "x.go", config) == []
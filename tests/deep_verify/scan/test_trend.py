@@ -0,0 +1,167 @@
+"""Tests for the code-health trend over commits."""
+
+import sqlite3
+import subprocess
+from dataclasses import replace
+from pathlib import Path
+
+import pytest
+
+from bmad_assist.deep_verify.core.types import ArtifactDomain, PatternId, Severity
+from bmad_assist.deep_verify.scan import (
+    ScanFinding,
+    ScanReport,
+    Scanner,
+    TrendPoint,
+    current_commit,
+    health_score,
+    load_trend,
+    write_sqlite,
+)
+
+from tests.deep_verify.scan.conftest import GO_CLEAN, GO_GOROUTINE, write_file
+
+
+def _finding(severity: Severity, confidence: float = 1.0) -> ScanFinding:
+    return ScanFinding(
+        pattern_id=PatternId("CC-001-CODE-GO"),
+        severity=severity,
+        title="Goroutine spawned without proper lifecycle management",
+        description="Goroutine spawned without proper lifecycle management",
+        path="main.go",
+        line=4,
+        snippet="go func() {",
+        confidence=confidence,
+        domain=ArtifactDomain.CONCURRENCY,
+        language="go",
+    )
+
+
+def _git(repo: Path, *args: str) -> None:
+    subprocess.run(["git", *args], cwd=repo, capture_output=True, check=True)
+
+
+def _commit(repo: Path, message: str) -> str:
+    """Commit everything in the repository and return the new SHA."""
+    _git(repo, "add", "-A")
+    _git(repo, "commit", "--allow-empty", "-m", message)
+    sha = current_commit(repo)
+    assert sha is not None
+    return sha
+
+
+@pytest.fixture
+def git_repo(tmp_path: Path) -> Path:
+    """Create an empty git repository."""
+    repo = tmp_path / "repo"
+    repo.mkdir()
+    _git(repo, "init")
+    _git(repo, "config", "user.email", "test@test.com")
+    _git(repo, "config", "user.name", "Test")
+    return repo
+
+
+class TestHealthScore:
+    """Tests for health_score()."""
+
+    def test_clean_report_is_100(self) -> None:
+        assert health_score(ScanReport(root=".", files_scanned=["main.go"])) == 100.0
+
+    def test_weights_by_severity_and_confidence(self) -> None:
+        report = ScanReport(
+            root=".",
+            findings=[_finding(Severity.CRITICAL), _finding(Severity.WARNING, confidence=0.5)],
+            files_scanned=["main.go", "util.go"],
+        )
+
+        # penalty 4.5 over 2 files
+        assert health_score(report) == round(100 / 3.25, 2)
+
+    def test_ignores_suppressed_findings(self) -> None:
+        finding = replace(_finding(Severity.CRITICAL), suppressed=True)
+        report = ScanReport(root=".", findings=[finding], files_scanned=["main.go"])
+
+        assert health_score(report) == 100.0
+
+
+class TestCurrentCommit:
+    """Tests for current_commit()."""
+
+    def test_returns_head(self, git_repo: Path) -> None:
+        sha = _commit(git_repo, "initial")
+
+        assert len(sha) == 40
+        assert current_commit(git_repo / ".") == sha
+
+    def test_outside_work_tree(self, tmp_path: Path) -> None:
+        assert current_commit(tmp_path) is None
+
+
+class TestLoadTrend:
+    """Tests for load_trend()."""
+
+    def test_runs_keyed_by_commit(self, git_repo: Path, tmp_path: Path) -> None:
+        db = tmp_path / "deepverify.db"
+        write_file(git_repo, "main.go", GO_CLEAN)
+        first = _commit(git_repo, "clean")
+        write_sqlite(Scanner().scan(git_repo), db, append=True, commit_sha=first)
+        write_file(git_repo, "main.go", GO_GOROUTINE)
+        second = _commit(git_repo, "goroutine")
+        write_sqlite(Scanner().scan(git_repo), db, append=True, commit_sha=second)
+        # Rescanning a commit replaces its point with the latest run
+        rescan = write_sqlite(Scanner().scan(git_repo), db, append=True, commit_sha=second)
+
+        points = load_trend(db, repo=git_repo)
+
+        assert [p.commit_sha for p in points] == [first, second]
+        assert points[0] == TrendPoint(first, 1, points[0].started_at, 100.0, 0, None, 0.0)
+        assert points[1].run_id == rescan
+        assert points[1].findings == 1
+        assert points[1].health_score == 20.0
+        assert points[1].delta == -80.0
+        assert points[1].effort_hours == 2.0
+
+    def test_follows_git_log_order(self, git_repo: Path, tmp_path: Path) -> None:
+        db = tmp_path / "deepverify.db"
+        shas = [_commit(git_repo, f"commit {i}") for i in range(3)]
+        failing = ScanReport(root=".", findings=[_finding(Severity.ERROR)], files_scanned=["a.go"])
+        reports = {
+            shas[2]: ScanReport(root=".", files_scanned=["a.go"]),
+            shas[0]: failing,
+            "0" * 40: ScanReport(root=".", files_scanned=["a.go"]),
+        }
+        for sha, report in reports.items():
+            write_sqlite(report, db, append=True, commit_sha=sha)
+
+        points = load_trend(db, repo=git_repo)
+
+        assert [p.commit_sha for p in points] == [shas[0], shas[2]]
+        assert [p.delta for p in points] == [None, round(100.0 - 100 / 3, 2)]
+
+    def test_without_repository_uses_run_order(self, tmp_path: Path) -> None:
+        db = tmp_path / "deepverify.db"
+        for sha in ("b" * 40, "a" * 40, "c" * 40):
+            write_sqlite(ScanReport(root="."), db, append=True, commit_sha=sha)
+
+        points = load_trend(db, limit=2)
+
+        assert [p.commit_sha for p in points] == ["a" * 40, "c" * 40]
+        assert points[0].delta is None
+
+    def test_skips_runs_without_commit(self, tmp_path: Path) -> None:
+        db = tmp_path / "deepverify.db"
+        write_sqlite(ScanReport(root="."), db, append=True)
+
+        assert load_trend(db) == []
+
+    def test_schema_version_1_database(self, tmp_path: Path) -> None:
+        db = tmp_path / "deepverify.db"
+        conn = sqlite3.connect(db)
+        conn.execute("CREATE TABLE runs (id INTEGER PRIMARY KEY, findings INTEGER)")
+        conn.close()
+
+        assert load_trend(db) == []
+
+    def test_missing_database(self, tmp_path: Path) -> None:
+        with pytest.raises(FileNotFoundError):
+            load_trend(tmp_path / "missing.db")
@@ -0,0 +1,114 @@
+"""Tests for scan config validation."""
+
+from pathlib import Path
+
+from bmad_assist.deep_verify.scan import ConfigIssue, validate_scan_config
+
+from tests.deep_verify.scan.conftest import write_file
+
+BROKEN_CONFIG = """\
+enable: ["CC-", "SEC-007"]
+disabel: ["CQ-007"]
+disable:
+  - CC-9999
+  - SEC-
+opt_in: [CC-101, CC-001-CODE-GO]
+severity:
+  CC-002: fatal
+  CC-001-CODE-G: warning
+suppression_fields: [reason, approver]
+context_holders: requestScope
+detectors:
+  CC-001:
+    words: [a]
+  CC-146:
+    secret_words: [api_seed]
+"""
+
+_SECRET_WORD_ERROR = (
+    "Value error, Invalid secret word(s): 'api_seed'. Names are split at underscores, "
+    "so words are letters and digits only"
+)
+
+
+def _issues(tmp_path: Path, text: str) -> list[tuple[int | None, str, str]]:
+    path = write_file(tmp_path, ".deepverify.yaml", text)
+    return [(i.line, i.key, i.message) for i in validate_scan_config(path)]
+
+
+class TestValidateScanConfig:
+    """Tests for validate_scan_config."""
+
+    def test_reports_each_error_with_its_location(self, tmp_path: Path) -> None:
+        """Test that every deliberate error is reported at its line."""
+        assert _issues(tmp_path, BROKEN_CONFIG) == [
+            (1, "enable", "'SEC-007' is also disabled by 'disable'"),
+            (2, "disabel", "unknown key; did you mean 'disable'?"),
+            (4, "disable", "unknown selector 'CC-9999' matches no pattern"),
+            (
+                6,
+                "opt_in",
+                "'CC-001-CODE-GO' selects no opt-in pattern; list it under 'enable'",
+            ),
+            (8, "severity.CC-002", "invalid severity 'fatal'; use critical, error, warning, info"),
+            (
+                9,
+                "severity.CC-001-CODE-G",
+                "unknown selector 'CC-001-CODE-G' matches no pattern; did you mean "
+                "'CC-001-CODE-GO'?",
+            ),
+            (10, "suppression_fields", "unknown field 'approver'; use reason, owner, expires"),
+            (11, "context_holders", "must be a list of strings"),
+            (
+                13,
+                "detectors.CC-001",
+                "not a configurable detector; use CC-142-CODE-GO, CC-146-CODE-GO",
+            ),
+            (16, "detectors.CC-146.secret_words", _SECRET_WORD_ERROR),
+        ]
+
+    def test_valid_config(self, tmp_path: Path) -> None:
+        """Test that a valid config, including an empty one, has no issues."""
+        valid = """\
+enable: ["CC-", "SEC-"]
+disable: ["CQ-007-CODE-GO", "CC-140"]
+opt_in: ["CC-129"]
+severity:
+  CC-001-CODE-GO: error
+  SEC-: critical
+suppression_fields: [reason, owner]
+context_holders: [requestScope]
+detectors:
+  CC-146:
+    secret_words: [seed]
+"""
+        assert _issues(tmp_path, valid) == []
+        assert _issues(tmp_path, "") == []
+
+    def test_invalid_yaml(self, tmp_path: Path) -> None:
+        """Test that a syntax error is reported at its line."""
+        ((line, key, message),) = _issues(tmp_path, "enable:\n  - CC-001\n bad: [\n")
+
+        assert (line, key) == (3, "")
+        assert message.startswith("invalid YAML")
+
+    def test_not_a_mapping(self, tmp_path: Path) -> None:
+        """Test that a top-level list is reported."""
+        assert _issues(tmp_path, "- CC-001\n") == [
+            (1, "", "config must be a mapping of keys to values")
+        ]
+
+    def test_missing_file(self, tmp_path: Path) -> None:
+        """Test that an unreadable file is a single issue without a line."""
+        (issue,) = validate_scan_config(tmp_path / "missing.yaml")
+
+        assert issue.line is None
+        assert issue.message.startswith("cannot read file")
+
+    def test_issue_str(self) -> None:
+        """Test the path:line: key: message rendering."""
+        issue = ConfigIssue(".deepverify.yaml", 3, "disable", "unknown selector 'X'")
+
+        assert str(issue) == ".deepverify.yaml:3: disable: unknown selector 'X'"
+        unread = ConfigIssue("c.yaml", None, "", "cannot read file")
+        assert str(unread) == "c.yaml: cannot read file"
@@ -0,0 +1,88 @@
+"""Tests for context.WithValue chains that set the same key twice (CC-153)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import ScanConfig, find_duplicate_context_keys
+
+from tests.deep_verify.scan.conftest import GO_DUPLICATE_CONTEXT_KEY
+
+DISTINCT = GO_DUPLICATE_CONTEXT_KEY.replace("ctx, userKey, tenant", "ctx, tenantKey, tenant")
+
+
+def _duplicates(text: str) -> list[tuple[int, str]]:
+    return [
+        (f.line, f.title) for f in find_duplicate_context_keys(text, "x.go", ScanConfig())
+    ]
+
+
+class TestFindDuplicateContextKeys:
+    """Tests for find_duplicate_context_keys."""
+
+    def test_duplicate_key(self) -> None:
+        """Test reporting a WithValue call that shadows a key set on its parent."""
+        (finding,) = find_duplicate_context_keys(
+            GO_DUPLICATE_CONTEXT_KEY, "identity.go", ScanConfig()
+        )
+
+        assert finding.pattern_id == "CC-153-CODE-GO"
+        assert finding.severity == Severity.INFO
+        assert (finding.line, finding.title) == (
+            17,
+            "Context key userKey shadows the value set on line 16",
+        )
+        assert finding.snippet == "ctx = context.WithValue(ctx, userKey, tenant)"
+
+    def test_distinct_keys_are_safe(self) -> None:
+        """Test that chains with different keys are not reported."""
+        assert _duplicates(DISTINCT) == []
+
+    def test_typed_constants_and_nested_calls(self) -> None:
+        """Test constants with equal type and value, and calls wrapping each other."""
+        text = """package middleware
+
+import stdctx "context"
+
+type key string
+
+const requestKey key = "request"
+const (
+    traceKey   key = "request" // same key as requestKey
+    spanKey        = key("span")
+    otherSpan      = key("span")
+)
+
+func annotate(ctx stdctx.Context, id, trace string) stdctx.Context {
+    ctx = stdctx.WithValue(ctx, requestKey, id)
+    ctx = stdctx.WithValue(ctx, traceKey, trace)
+    return stdctx.WithValue(stdctx.WithValue(ctx, spanKey, 1), otherSpan, 2)
+}
+"""
+        assert _duplicates(text) == [
+            (16, "Context key traceKey shadows the value set on line 15"),
+            (17, "Context key otherSpan shadows the value set on line 17"),
+        ]
+
+    def test_branches_siblings_and_literals(self) -> None:
+        """Test if/else branches, sibling contexts and distinct string keys."""
+        text = """package middleware
+
+import "context"
+
+func branches(ctx context.Context, admin bool) (context.Context, context.Context) {
+    if admin {
+        ctx = context.WithValue(ctx, roleKey{}, "admin")
+    } else {
+        ctx = context.WithValue(ctx, roleKey{}, "user")
+    }
+    a := context.WithValue(ctx, roleKey{}, 1)
+    b := context.WithValue(ctx, roleKey{}, 2)
+    c := context.WithValue(a, "user.id", 1)
+    c = context.WithValue(c, "user(name)", 2)
+    return b, c
+}
+"""
+        assert _duplicates(text) == []
+
+    def test_disabled_by_config(self) -> None:
+        """Test that config can disable CC-153."""
+        config = ScanConfig(disable=["CC-153"])
+        assert find_duplicate_context_keys(GO_DUPLICATE_CONTEXT_KEY, "x.go", config) == []
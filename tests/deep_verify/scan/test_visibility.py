@@ -0,0 +1,127 @@
+"""Tests for symbol visibility filtering (ScanOptions.exported_only)."""
+
+from pathlib import Path
+
+from bmad_assist.deep_verify.core.types import ArtifactDomain, PatternId, Severity
+from bmad_assist.deep_verify.scan import (
+    ScanFinding,
+    ScanOptions,
+    Scanner,
+    exported_lines,
+    filter_exported,
+)
+
+from tests.deep_verify.scan.conftest import write_file
+
+# Goroutines (CC-001-CODE-GO) in an exported method and an unexported helper
+WORKER = """package worker
+
+type Pool struct {
+    jobs chan Job
+}
+
+func (p *Pool) Start() {
+    go func() {
+        p.run()
+    }()
+}
+
+func startHelper() {
+    go func() {
+        doWork()
+    }()
+}
+"""
+
+
+def _finding(line: int, language: str = "go") -> ScanFinding:
+    return ScanFinding(
+        pattern_id=PatternId("CC-001-CODE-GO"),
+        severity=Severity.CRITICAL,
+        title="Goroutine",
+        description="Goroutine",
+        path="worker.go",
+        line=line,
+        snippet="go func() {",
+        confidence=1.0,
+        domain=ArtifactDomain.CONCURRENCY,
+        language=language,
+    )
+
+
+class TestExportedLines:
+    """Tests for exported_lines."""
+
+    def test_declarations(self) -> None:
+        """Test functions, methods and types of both visibilities."""
+        text = """package shop
+
+// Cart holds items.
+type Cart struct {
+    items []Item
+}
+
+type cartID string
+
+func (c *Cart) Add(item Item) {
+    c.items = append(c.items, item)
+}
+
+func (c *Cart) reset() {
+    c.items = nil
+}
+
+func (c *cart) Total() int {
+    return 0
+}
+
+func New(
+    items []Item,
+) *Cart {
+    return &Cart{items: items}
+}
+
+var registry = map[string]*Cart{}
+"""
+        flags = exported_lines(text)
+        exported = [i + 1 for i, flag in enumerate(flags) if flag]
+
+        assert exported == [4, 5, 6, 10, 11, 12, 22, 23, 24, 25, 26]
+
+    def test_generic_receiver(self) -> None:
+        """Test methods on generic types resolve the receiver type name."""
+        text = "package set\n\nfunc (s *Set[T]) Add(v T) {\n    s.m[v] = struct{}{}\n}\n"
+        assert exported_lines(text)[2:5] == [True, True, True]
+
+
+class TestFilterExported:
+    """Tests for filter_exported."""
+
+    def test_drops_unexported_helper(self) -> None:
+        """Test that the helper finding is dropped and the method finding kept."""
+        findings = [_finding(8), _finding(14)]
+
+        assert [f.line for f in filter_exported(findings, WORKER, "go")] == [8]
+
+    def test_other_languages_unchanged(self) -> None:
+        """Test that non-Go findings are kept."""
+        findings = [_finding(14, language="python")]
+
+        assert filter_exported(findings, WORKER, "python") == findings
+
+
+class TestScannerExportedOnly:
+    """Tests for exported_only in tree scans."""
+
+    def test_exported_only(self, tmp_path: Path) -> None:
+        """Test that a finding in an unexported helper is dropped."""
+        method, helper = WORKER.split("\nfunc startHelper")
+        write_file(tmp_path, "pool.go", method)
+        write_file(tmp_path, "helper.go", "package worker\n\nfunc startHelper" + helper)
+
+        def paths(options: ScanOptions) -> list[str]:
+            report = Scanner(options).scan(tmp_path)
+            return [f.path for f in report.findings if f.pattern_id == "CC-001-CODE-GO"]
+
+        assert paths(ScanOptions()) == ["helper.go", "pool.go"]
+        assert paths(ScanOptions(exported_only=True)) == ["pool.go"]
@@ -0,0 +1,136 @@
+"""Tests for unbounded WaitGroup wait detection in shutdown paths (CC-129)."""
+
+from bmad_assist.deep_verify.core.types import Severity
+from bmad_assist.deep_verify.scan import ScanConfig, find_unbounded_waits
+
+from tests.deep_verify.scan.conftest import GO_UNBOUNDED_WAIT
+
+OPT_IN = ScanConfig(opt_in=["CC-129"])
+
+TIMEOUT_WAIT = """package server
+
+import (
+    "context"
+    "sync"
+)
+
+type Server struct {
+    wg   sync.WaitGroup
+    quit chan struct{}
+}
+
+func (s *Server) Shutdown(ctx context.Context) error {
+    close(s.quit)
+    done := make(chan struct{})
+    go func() {
+        s.wg.Wait()
+        close(done)
+    }()
+    select {
+    case <-done:
+        return nil
+    case <-ctx.Done():
+        return ctx.Err()
+    }
+}
+"""
+
+
+def _waits(text: str) -> list[tuple[int, str]]:
+    return [(f.line, f.title) for f in find_unbounded_waits(text, "x.go", OPT_IN)]
+
+
+class TestFindUnboundedWaits:
+    """Tests for find_unbounded_waits."""
+
+    def test_bare_wait_in_shutdown(self) -> None:
+        """Test reporting a bare Wait in Shutdown."""
+        (finding,) = find_unbounded_waits(GO_UNBOUNDED_WAIT, "server.go", OPT_IN)
+
+        assert finding.pattern_id == "CC-129-CODE-GO"
+        assert finding.severity == Severity.INFO
+        assert finding.line == 15
+        assert finding.title == "Shutdown waits on s.wg with no timeout"
+        assert finding.snippet == "s.wg.Wait()"
+
+    def test_timeout_wrapped_wait_is_safe(self) -> None:
+        """Test that a Wait inside a goroutine raced against ctx is not reported."""
+        assert _waits(TIMEOUT_WAIT) == []
+
+    def test_one_line_wrapper_is_safe(self) -> None:
+        """Test a wrapper goroutine written on one line."""
+        text = """package server
+
+import "sync"
+
+var wg sync.WaitGroup
+
+func Stop(timeout <-chan struct{}) {
+    done := make(chan struct{})
+    go func() { wg.Wait(); close(done) }()
+    select {
+    case <-done:
+    case <-timeout:
+    }
+}
+"""
+        assert _waits(text) == []
+
+    def test_shutdown_like_functions(self) -> None:
+        """Test names in any case and context parameters; other functions are skipped."""
+        text = """package pool
+
+import (
+    stdctx "context"
+    "sync"
+)
+
+type Pool struct {
+    workers, jobs *sync.WaitGroup
+}
+
+func (p *Pool) close() {
+    p.workers.Wait()
+}
+
+func (p *Pool) Drain(ctx stdctx.Context) {
+    if ctx != nil {
+        p.jobs.Wait()
+    }
+}
+
+func (p *Pool) Run() {
+    p.workers.Wait()
+}
+"""
+        assert _waits(text) == [
+            (13, "close waits on p.workers with no timeout"),
+            (18, "Drain waits on p.jobs with no timeout"),
+        ]
+
+    def test_other_wait_methods_ignored(self) -> None:
+        """Test that Wait on values that are not WaitGroups is not reported."""
+        text = """package server
+
+import (
+    "os/exec"
+    "sync"
+)
+
+type Runner struct {
+    mu  sync.Mutex
+    cmd *exec.Cmd
+}
+
+func (r *Runner) Stop() error {
+    // r.wg.Wait()
+    return r.cmd.Wait()
+}
+"""
+        assert _waits(text) == []
+
+    def test_opt_in_required(self) -> None:
+        """Test that CC-129 does not run unless opted in."""
+        assert find_unbounded_waits(GO_UNBOUNDED_WAIT, "x.go", ScanConfig()) == []
+        config = ScanConfig(opt_in=["CC-129"], disable=["CC-129"])
+        assert find_unbounded_waits(GO_UNBOUNDED_WAIT, "x.go", config) == []
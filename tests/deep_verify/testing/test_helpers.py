@@ -0,0 +1,132 @@
+"""Tests for the Deep Verify pattern testing helpers."""
+
+import pytest
+
+from bmad_assist.core.exceptions import PatternNotFoundError
+from bmad_assist.deep_verify.core.types import (
+    ArtifactDomain,
+    Pattern,
+    PatternId,
+    Severity,
+    Signal,
+)
+from bmad_assist.deep_verify.scan import ScanConfig
+from bmad_assist.deep_verify.testing import WantFinding, assert_findings, run_detector
+
+GOROUTINE = """
+    func main() {
+        go func() {
+            doWork()
+        }()
+    }
+"""
+
+CLEAN = """
+    func add(a, b int) int {
+        return a + b
+    }
+"""
+
+
+class TestRunDetector:
+    """Tests for run_detector()."""
+
+    def test_builtin_detector_by_id(self) -> None:
+        """Test running a built-in detector given by ID."""
+        (finding,) = run_detector("CC-001-CODE-GO", GOROUTINE)
+
+        assert finding.pattern_id == PatternId("CC-001-CODE-GO")
+        assert finding.line == 3
+        assert finding.snippet == "go func() {"
+        assert finding.language == "go"
+
+    def test_id_is_case_insensitive(self) -> None:
+        """Test that detector IDs are normalized."""
+        assert run_detector("cc-001-code-go", GOROUTINE)
+
+    def test_only_named_detector_runs(self) -> None:
+        """Test that other patterns do not report."""
+        assert run_detector("CC-002-CODE-GO", GOROUTINE) == []
+
+    def test_custom_pattern(self) -> None:
+        """Test running a caller-built pattern."""
+        pattern = Pattern(
+            id=PatternId("ACME-001-CODE-GO"),
+            domain=ArtifactDomain.TRANSFORM,
+            signals=[Signal(type="regex", pattern=r"\bpanic\(")],
+            severity=Severity.WARNING,
+            description="Panics in library code",
+            language="go",
+        )
+
+        (finding,) = run_detector(pattern, 'func f() {\n    panic("boom")\n}\n')
+
+        assert finding.pattern_id == PatternId("ACME-001-CODE-GO")
+        assert finding.line == 2
+
+    def test_opt_in_detector_runs(self) -> None:
+        """Test that opt-in detectors, including built-in checks, run without a config."""
+        source = """
+            import "context"
+
+            func index(ctx context.Context, docs []string) {
+                for _, d := range docs {
+                    tokenize(d)
+                }
+            }
+        """
+        assert run_detector("CC-101-CODE-GO", source)
+
+    def test_config_severity_override(self) -> None:
+        """Test that the config applies to findings."""
+        config = ScanConfig(severity={"CC-001": Severity.INFO})
+
+        (finding,) = run_detector("CC-001-CODE-GO", GOROUTINE, config=config)
+
+        assert finding.severity == Severity.INFO
+
+    def test_unknown_detector(self) -> None:
+        """Test that unknown IDs raise PatternNotFoundError."""
+        with pytest.raises(PatternNotFoundError):
+            run_detector("ZZ-999-CODE-GO", GOROUTINE)
+
+
+class TestAssertFindings:
+    """Tests for assert_findings()."""
+
+    def test_expected_finding(self) -> None:
+        """Test that matching expectations pass."""
+        findings = assert_findings(GOROUTINE, [WantFinding("CC-001-CODE-GO", line=3)])
+
+        assert len(findings) == 1
+
+    def test_any_line_and_severity(self) -> None:
+        """Test expectations with line and severity checks."""
+        assert_findings(GOROUTINE, [WantFinding("CC-001-CODE-GO")])
+        assert_findings(
+            GOROUTINE, [WantFinding("CC-001-CODE-GO", severity=Severity.CRITICAL)]
+        )
+
+    def test_clean_snippet(self) -> None:
+        """Test asserting that a snippet has no findings."""
+        assert_findings(CLEAN, [], detectors=["CC-001-CODE-GO"])
+
+    def test_missing_finding_fails(self) -> None:
+        """Test that a missing expectation fails with a readable message."""
+        with pytest.raises(AssertionError, match="missing:    CC-001-CODE-GO at line 3"):
+            assert_findings(CLEAN, [WantFinding("CC-001-CODE-GO", line=3)])
+
+    def test_wrong_line_fails(self) -> None:
+        """Test that a finding on another line is both missing and unexpected."""
+        with pytest.raises(AssertionError, match="unexpected: CC-001-CODE-GO at line 3"):
+            assert_findings(GOROUTINE, [WantFinding("CC-001-CODE-GO", line=9)])
+
+    def test_unexpected_finding_fails(self) -> None:
+        """Test that unexpected findings fail."""
+        with pytest.raises(AssertionError, match="unexpected"):
+            assert_findings(GOROUTINE, [], detectors=["CC-001-CODE-GO"])
+
+    def test_requires_detectors(self) -> None:
+        """Test that an empty expectation needs explicit detectors."""
+        with pytest.raises(ValueError, match="needs detectors"):
+            assert_findings(CLEAN, [])